@@ -0,0 +1,95 @@
+// Command create-admin bootstraps a staff account so that the first admin can log in through /api/staff/login.
+//
+// Usage:
+//
+//	ADMIN_PASSWORD=... go run ./cmd/create-admin -name "Jane Roe" -email jane.roe@hotel.com
+//
+// The password is read from the environment so it does not end up in the shell history.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	applicationgateway "github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	name := flag.String("name", "", "name of the staff user")
+	email := flag.String("email", "", "email the staff user logs in with")
+	role := flag.String("role", "ADMIN", "role of the staff user")
+	flag.Parse()
+
+	defaultConfig, err := config.LoadDefaultConfig(context.TODO(), config.WithRegion("us-east-1"))
+	if err != nil {
+		panic(err)
+	}
+
+	var secretsGateway applicationgateway.ISecretsGateway
+
+	if os.Getenv("API_ENV") == "LOCAL" {
+		secretsGateway = &gateways.LocalSecretsGateway{
+			PathToFile: ".env",
+		}
+	} else {
+		secretsGateway = &gateways.AwsSecretsGateway{
+			SecretsClient: secretsmanager.NewFromConfig(defaultConfig),
+		}
+	}
+
+	postgresUrl, err := secretsGateway.Get("POSTGRES_URL")
+	if err != nil {
+		panic(err)
+	}
+
+	pool, err := pgxpool.New(context.Background(), postgresUrl)
+	if err != nil {
+		panic(err)
+	}
+
+	defer pool.Close()
+
+	passwordPolicy, err := auth.LoadPasswordPolicy(secretsGateway)
+	if err != nil {
+		panic(err)
+	}
+
+	passwordHasher, err := auth.LoadPasswordHasher(secretsGateway)
+	if err != nil {
+		panic(err)
+	}
+
+	createStaffUser := usecases.CreateStaffUser{
+		StaffUsersRepository: &repositories.StaffUsersRepository{
+			Pool: pool,
+		},
+		RolesRepository: &repositories.RolesRepository{
+			Pool: pool,
+		},
+		PasswordPolicy: passwordPolicy,
+		PasswordHasher: passwordHasher,
+	}
+
+	output, err := createStaffUser.Execute(usecases.CreateStaffUserInput{
+		Name:     *name,
+		Email:    *email,
+		Password: os.Getenv("ADMIN_PASSWORD"),
+		Role:     *role,
+	})
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("created %s staff user %s\n", *role, output.StaffUserId)
+}
@@ -0,0 +1,53 @@
+// Command create-signing-key generates a key pair for signing access tokens and prints it as an entry of the
+// JWT_SIGNING_KEYS secret.
+//
+// Usage:
+//
+//	go run ./cmd/create-signing-key -alg EdDSA -activates-at 2030-07-01T00:00:00Z
+//
+// To rotate keys, append the printed entry to JWT_SIGNING_KEYS with an activation date far enough ahead for other
+// services to fetch it from /.well-known/jwks.json, then set retiresAt on the previous key to a date after the last
+// access token it signed has expired.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+)
+
+func main() {
+	activatesAt := flag.String("activates-at", time.Now().UTC().Add(24*time.Hour).Format(time.RFC3339),
+		"RFC 3339 time from which the key signs new tokens")
+	algorithm := flag.String("alg", "EdDSA", "signing algorithm, RS256 or EdDSA")
+	id := flag.String("kid", "", "key id, defaults to the activation date")
+	flag.Parse()
+
+	activation, err := time.Parse(time.RFC3339, *activatesAt)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "activates-at must be an RFC 3339 time")
+		os.Exit(1)
+	}
+
+	if *id == "" {
+		*id = activation.UTC().Format("2006-01-02")
+	}
+
+	signingKey, err := auth.NewSigningKey(*id, *algorithm, activation)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	entry, err := json.Marshal(signingKey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(entry))
+}
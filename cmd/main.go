@@ -2,17 +2,26 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
 	applicationgateway "github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	applicationrepository "github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/gateways"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/jobs"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 )
 
@@ -41,12 +50,12 @@ func main() {
 		panic(err)
 	}
 
-	conn, err := pgx.Connect(context.Background(), postgresUrl)
+	pool, err := pgxpool.New(context.Background(), postgresUrl)
 	if err != nil {
 		panic(err)
 	}
 
-	defer conn.Close(context.Background())
+	defer pool.Close()
 
 	httpLogger := webhttp.NewHttpLogger()
 
@@ -55,70 +64,1014 @@ func main() {
 		panic(err)
 	}
 
-	httpAuthorization := webhttp.HttpAuthorization{
+	rolesRepository := repositories.RolesRepository{
+		Pool: pool,
+	}
+
+	apiKeysRepository := repositories.ApiKeysRepository{
+		Pool: pool,
+	}
+
+	signingKeysSource := auth.SigningKeysSource{
 		SecretsGateway: secretsGateway,
 	}
 
+	httpAuthorization := webhttp.HttpAuthorization{
+		SigningKeysSource: &signingKeysSource,
+		RolesRepository:   &rolesRepository,
+		ApiKeysRepository: &apiKeysRepository,
+		HttpLogger:        httpLogger,
+	}
+
 	customersGateway := gateways.CustomersGateway{
-		Conn: conn,
+		Pool: pool,
+	}
+
+	var paymentsGateway applicationgateway.IPaymentsGateway
+
+	if paymentsGatewayUrl := optionalSecret(secretsGateway, "PAYMENTS_GATEWAY_URL"); paymentsGatewayUrl != "" {
+		paymentsGateway = &gateways.HttpPaymentsGateway{
+			BaseUrl:    paymentsGatewayUrl,
+			ApiKey:     optionalSecret(secretsGateway, "PAYMENTS_GATEWAY_API_KEY"),
+			HttpClient: &http.Client{Timeout: 10 * time.Second},
+		}
+	} else {
+		paymentsGateway = &applicationgateway.FakePaymentsGateway{
+			DeclinedPaymentTokens: []string{"tok_declined"},
+		}
+	}
+
+	var emailGateway applicationgateway.IEmailGateway
+
+	if emailGatewayUrl := optionalSecret(secretsGateway, "EMAIL_GATEWAY_URL"); emailGatewayUrl != "" {
+		emailGateway = &gateways.HttpEmailGateway{
+			BaseUrl:    emailGatewayUrl,
+			ApiKey:     optionalSecret(secretsGateway, "EMAIL_GATEWAY_API_KEY"),
+			From:       optionalSecret(secretsGateway, "EMAIL_FROM"),
+			HttpClient: &http.Client{Timeout: 10 * time.Second},
+		}
+	} else {
+		emailGateway = &applicationgateway.FakeEmailGateway{}
+	}
+
+	depositPercent, _ := strconv.ParseUint(optionalSecret(secretsGateway, "DEPOSIT_PERCENT"), 10, 8)
+	depositCaptureDaysBeforeCheckIn, _ := strconv.ParseUint(optionalSecret(secretsGateway, "DEPOSIT_CAPTURE_DAYS_BEFORE_CHECK_IN"), 10, 16)
+
+	depositPolicy, err := payment.NewDepositPolicy(uint8(depositPercent), uint16(depositCaptureDaysBeforeCheckIn))
+	if err != nil {
+		panic(err)
+	}
+
+	freeCancellationDaysBeforeCheckIn, _ := strconv.ParseUint(optionalSecret(secretsGateway, "FREE_CANCELLATION_DAYS_BEFORE_CHECK_IN"), 10, 16)
+	lateCancellationRefundPercent, _ := strconv.ParseUint(optionalSecret(secretsGateway, "LATE_CANCELLATION_REFUND_PERCENT"), 10, 8)
+
+	cancellationPolicy, err := payment.NewCancellationPolicy(uint16(freeCancellationDaysBeforeCheckIn), uint8(lateCancellationRefundPercent))
+	if err != nil {
+		panic(err)
+	}
+
+	accommodationTaxPercent, _ := strconv.ParseUint(optionalSecret(secretsGateway, "ACCOMMODATION_TAX_PERCENT"), 10, 8)
+	servicesTaxPercent, _ := strconv.ParseUint(optionalSecret(secretsGateway, "SERVICES_TAX_PERCENT"), 10, 8)
+
+	taxRates, err := invoice.NewTaxRates(uint8(accommodationTaxPercent), uint8(servicesTaxPercent))
+	if err != nil {
+		panic(err)
+	}
+
+	propertyCode := optionalSecret(secretsGateway, "PROPERTY_CODE")
+	if propertyCode == "" {
+		propertyCode = "MAIN"
+	}
+
+	scheduledChargeMaxAttempts, err := strconv.ParseUint(optionalSecret(secretsGateway, "SCHEDULED_CHARGE_MAX_ATTEMPTS"), 10, 8)
+	if err != nil || scheduledChargeMaxAttempts == 0 {
+		scheduledChargeMaxAttempts = 3
+	}
+
+	scheduledChargeRetryHours, err := strconv.ParseUint(optionalSecret(secretsGateway, "SCHEDULED_CHARGE_RETRY_HOURS"), 10, 16)
+	if err != nil || scheduledChargeRetryHours == 0 {
+		scheduledChargeRetryHours = 24
+	}
+
+	accessTokenTtlMinutes, err := strconv.ParseUint(optionalSecret(secretsGateway, "ACCESS_TOKEN_TTL_MINUTES"), 10, 16)
+	if err != nil || accessTokenTtlMinutes == 0 {
+		accessTokenTtlMinutes = 15
+	}
+
+	refreshTokenTtlDays, err := strconv.ParseUint(optionalSecret(secretsGateway, "REFRESH_TOKEN_TTL_DAYS"), 10, 16)
+	if err != nil || refreshTokenTtlDays == 0 {
+		refreshTokenTtlDays = 30
+	}
+
+	passwordResetTokenTtlMinutes, err := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_RESET_TOKEN_TTL_MINUTES"), 10, 16)
+	if err != nil || passwordResetTokenTtlMinutes == 0 {
+		passwordResetTokenTtlMinutes = 30
+	}
+
+	passwordResetUrl := optionalSecret(secretsGateway, "PASSWORD_RESET_URL")
+	if passwordResetUrl == "" {
+		passwordResetUrl = "http://localhost:3000/reset-password"
+	}
+
+	emailVerificationTokenTtlHours, err := strconv.ParseUint(optionalSecret(secretsGateway, "EMAIL_VERIFICATION_TOKEN_TTL_HOURS"), 10, 16)
+	if err != nil || emailVerificationTokenTtlHours == 0 {
+		emailVerificationTokenTtlHours = 48
+	}
+
+	emailVerificationResendIntervalSeconds, err := strconv.ParseUint(optionalSecret(secretsGateway, "EMAIL_VERIFICATION_RESEND_INTERVAL_SECONDS"), 10, 32)
+	if err != nil || emailVerificationResendIntervalSeconds == 0 {
+		emailVerificationResendIntervalSeconds = 300
+	}
+
+	emailVerificationUrl := optionalSecret(secretsGateway, "EMAIL_VERIFICATION_URL")
+	if emailVerificationUrl == "" {
+		emailVerificationUrl = "http://localhost:3000/verify-email"
+	}
+
+	mfaIssuer := optionalSecret(secretsGateway, "MFA_ISSUER")
+	if mfaIssuer == "" {
+		mfaIssuer = "Hotel Booking"
+	}
+
+	mfaChallengeTtlMinutes, err := strconv.ParseUint(optionalSecret(secretsGateway, "MFA_CHALLENGE_TTL_MINUTES"), 10, 16)
+	if err != nil || mfaChallengeTtlMinutes == 0 {
+		mfaChallengeTtlMinutes = 5
+	}
+
+	oidcLoginTtlMinutes, err := strconv.ParseUint(optionalSecret(secretsGateway, "OIDC_LOGIN_TTL_MINUTES"), 10, 16)
+	if err != nil || oidcLoginTtlMinutes == 0 {
+		oidcLoginTtlMinutes = 10
+	}
+
+	requireVerifiedEmailToBook, _ := strconv.ParseBool(optionalSecret(secretsGateway, "REQUIRE_VERIFIED_EMAIL_TO_BOOK"))
+
+	loginFreeAttempts, err := strconv.ParseUint(optionalSecret(secretsGateway, "LOGIN_FREE_ATTEMPTS"), 10, 32)
+	if err != nil {
+		loginFreeAttempts = 3
+	}
+
+	loginLockoutThreshold, err := strconv.ParseUint(optionalSecret(secretsGateway, "LOGIN_LOCKOUT_THRESHOLD"), 10, 32)
+	if err != nil || loginLockoutThreshold == 0 {
+		loginLockoutThreshold = 10
+	}
+
+	loginIpFreeAttempts, err := strconv.ParseUint(optionalSecret(secretsGateway, "LOGIN_IP_FREE_ATTEMPTS"), 10, 32)
+	if err != nil {
+		loginIpFreeAttempts = 20
+	}
+
+	loginIpLockoutThreshold, err := strconv.ParseUint(optionalSecret(secretsGateway, "LOGIN_IP_LOCKOUT_THRESHOLD"), 10, 32)
+	if err != nil || loginIpLockoutThreshold == 0 {
+		loginIpLockoutThreshold = 100
+	}
+
+	loginLockoutMinutes, err := strconv.ParseUint(optionalSecret(secretsGateway, "LOGIN_LOCKOUT_MINUTES"), 10, 16)
+	if err != nil || loginLockoutMinutes == 0 {
+		loginLockoutMinutes = 15
+	}
+
+	loginAccountPolicy, err := account.NewLoginThrottlePolicy(uint32(loginFreeAttempts), uint32(loginLockoutThreshold),
+		time.Second, time.Duration(loginLockoutMinutes)*time.Minute)
+	if err != nil {
+		panic(err)
+	}
+
+	loginIpAddressPolicy, err := account.NewLoginThrottlePolicy(uint32(loginIpFreeAttempts), uint32(loginIpLockoutThreshold),
+		time.Second, time.Duration(loginLockoutMinutes)*time.Minute)
+	if err != nil {
+		panic(err)
+	}
+
+	passwordPolicy, err := auth.LoadPasswordPolicy(secretsGateway)
+	if err != nil {
+		panic(err)
+	}
+
+	passwordHasher, err := auth.LoadPasswordHasher(secretsGateway)
+	if err != nil {
+		panic(err)
 	}
 
 	roomRepository := repositories.RoomsRepository{
-		Conn: conn,
+		Pool: pool,
+	}
+
+	bookingsRepository := repositories.BookingsRepository{
+		Pool: pool,
+	}
+
+	promoCodesRepository := repositories.PromoCodesRepository{
+		Pool: pool,
+	}
+
+	pricingRulesRepository := repositories.PricingRulesRepository{
+		Pool: pool,
+	}
+
+	addOnsRepository := repositories.AddOnsRepository{
+		Pool: pool,
+	}
+
+	extraGuestRatesRepository := repositories.ExtraGuestRatesRepository{
+		Pool: pool,
+	}
+
+	calendarRepository := repositories.CalendarRepository{
+		Pool: pool,
+	}
+
+	packagesRepository := repositories.PackagesRepository{
+		Pool: pool,
+	}
+
+	paymentsRepository := repositories.PaymentsRepository{
+		Pool: pool,
+	}
+
+	paymentEventsRepository := repositories.PaymentEventsRepository{
+		Pool: pool,
+	}
+
+	refundsRepository := repositories.RefundsRepository{
+		Pool: pool,
+	}
+
+	folioEntriesRepository := repositories.FolioEntriesRepository{
+		Pool: pool,
+	}
+
+	invoicesRepository := repositories.InvoicesRepository{
+		Pool: pool,
+	}
+
+	idempotencyKeysRepository := repositories.IdempotencyKeysRepository{
+		Pool: pool,
+	}
+
+	ratePlansRepository := repositories.RatePlansRepository{
+		Pool: pool,
+	}
+
+	scheduledChargesRepository := repositories.ScheduledChargesRepository{
+		Pool: pool,
+	}
+
+	giftCardsRepository := repositories.GiftCardsRepository{
+		Pool: pool,
+	}
+
+	creditEntriesRepository := repositories.CreditEntriesRepository{
+		Pool: pool,
+	}
+
+	refreshTokensRepository := repositories.RefreshTokensRepository{
+		Pool: pool,
+	}
+
+	staffUsersRepository := repositories.StaffUsersRepository{
+		Pool: pool,
+	}
+
+	passwordResetTokensRepository := repositories.PasswordResetTokensRepository{
+		Pool: pool,
+	}
+
+	totpFactorsRepository := repositories.TotpFactorsRepository{
+		Pool: pool,
+	}
+
+	customerIdentitiesRepository := repositories.CustomerIdentitiesRepository{
+		Pool: pool,
+	}
+
+	oidcGateway := gateways.OidcGateway{
+		HttpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+
+	var loginAttemptsRepository applicationrepository.ILoginAttemptsRepository
+
+	// Keeping the attempts in memory is only safe with a single instance; otherwise every instance would allow its own
+	// share of guesses. The lockouts are an audit record, so they are always stored.
+	if optionalSecret(secretsGateway, "LOGIN_ATTEMPTS_STORAGE") == "memory" {
+		loginAttemptsRepository = &repositories.MemoryLoginAttemptsRepository{}
+	} else {
+		loginAttemptsRepository = &repositories.LoginAttemptsRepository{Pool: pool}
+	}
+
+	loginLockoutsRepository := repositories.LoginLockoutsRepository{Pool: pool}
+
+	loginThrottle := usecases.LoginThrottle{
+		LoginAttemptsRepository: loginAttemptsRepository,
+		LoginLockoutsRepository: &loginLockoutsRepository,
+		AccountPolicy:           loginAccountPolicy,
+		IpAddressPolicy:         loginIpAddressPolicy,
 	}
 
 	loginWithEmailAndPassword := usecases.LoginWithEmailAndPassword{
+		SecretsGateway:          secretsGateway,
+		SigningKeysSource:       &signingKeysSource,
+		CustomersGateway:        &customersGateway,
+		RefreshTokensRepository: &refreshTokensRepository,
+		AccessTokenTtl:          time.Duration(accessTokenTtlMinutes) * time.Minute,
+		RefreshTokenTtl:         time.Duration(refreshTokenTtlDays) * 24 * time.Hour,
+		LoginThrottle:           loginThrottle,
+		TotpFactorsRepository:   &totpFactorsRepository,
+		MfaChallengeTtl:         time.Duration(mfaChallengeTtlMinutes) * time.Minute,
+		PasswordHasher:          passwordHasher,
+	}
+
+	loginStaffWithEmailAndPassword := usecases.LoginStaffWithEmailAndPassword{
+		SecretsGateway:          secretsGateway,
+		SigningKeysSource:       &signingKeysSource,
+		StaffUsersRepository:    &staffUsersRepository,
+		RefreshTokensRepository: &refreshTokensRepository,
+		TotpFactorsRepository:   &totpFactorsRepository,
+		AccessTokenTtl:          time.Duration(accessTokenTtlMinutes) * time.Minute,
+		RefreshTokenTtl:         time.Duration(refreshTokenTtlDays) * 24 * time.Hour,
+		LoginThrottle:           loginThrottle,
+		MfaChallengeTtl:         time.Duration(mfaChallengeTtlMinutes) * time.Minute,
+		PasswordHasher:          passwordHasher,
+	}
+
+	startOidcLogin := usecases.StartOidcLogin{
+		SecretsGateway: secretsGateway,
+		OidcGateway:    &oidcGateway,
+		LoginTokenTtl:  time.Duration(oidcLoginTtlMinutes) * time.Minute,
+	}
+
+	completeOidcLogin := usecases.CompleteOidcLogin{
+		SecretsGateway:               secretsGateway,
+		SigningKeysSource:            &signingKeysSource,
+		OidcGateway:                  &oidcGateway,
+		CustomersGateway:             &customersGateway,
+		CustomerIdentitiesRepository: &customerIdentitiesRepository,
+		RefreshTokensRepository:      &refreshTokensRepository,
+		TotpFactorsRepository:        &totpFactorsRepository,
+		AccessTokenTtl:               time.Duration(accessTokenTtlMinutes) * time.Minute,
+		RefreshTokenTtl:              time.Duration(refreshTokenTtlDays) * 24 * time.Hour,
+		MfaChallengeTtl:              time.Duration(mfaChallengeTtlMinutes) * time.Minute,
+	}
+
+	enrollTotp := usecases.EnrollTotp{
+		TotpFactorsRepository: &totpFactorsRepository,
+		CustomersGateway:      &customersGateway,
+		StaffUsersRepository:  &staffUsersRepository,
+		Issuer:                mfaIssuer,
+	}
+
+	activateTotp := usecases.ActivateTotp{
+		TotpFactorsRepository: &totpFactorsRepository,
+		RecoveryCodesCount:    10,
+	}
+
+	completeMfaLogin := usecases.CompleteMfaLogin{
+		SecretsGateway:          secretsGateway,
+		SigningKeysSource:       &signingKeysSource,
+		CustomersGateway:        &customersGateway,
+		StaffUsersRepository:    &staffUsersRepository,
+		TotpFactorsRepository:   &totpFactorsRepository,
+		RefreshTokensRepository: &refreshTokensRepository,
+		LoginThrottle:           loginThrottle,
+		AccessTokenTtl:          time.Duration(accessTokenTtlMinutes) * time.Minute,
+		RefreshTokenTtl:         time.Duration(refreshTokenTtlDays) * 24 * time.Hour,
+	}
+
+	createStaffUser := usecases.CreateStaffUser{
+		StaffUsersRepository: &staffUsersRepository,
+		RolesRepository:      &rolesRepository,
+		PasswordPolicy:       passwordPolicy,
+		PasswordHasher:       passwordHasher,
+	}
+
+	createApiKey := usecases.CreateApiKey{
+		ApiKeysRepository: &apiKeysRepository,
+		RolesRepository:   &rolesRepository,
+	}
+
+	revokeApiKey := usecases.RevokeApiKey{
+		ApiKeysRepository: &apiKeysRepository,
+	}
+
+	refreshSession := usecases.RefreshSession{
+		SigningKeysSource:       &signingKeysSource,
+		RefreshTokensRepository: &refreshTokensRepository,
+		StaffUsersRepository:    &staffUsersRepository,
+		AccessTokenTtl:          time.Duration(accessTokenTtlMinutes) * time.Minute,
+		RefreshTokenTtl:         time.Duration(refreshTokenTtlDays) * 24 * time.Hour,
+	}
+
+	logout := usecases.Logout{
+		RefreshTokensRepository: &refreshTokensRepository,
+	}
+
+	signUp := usecases.SignUp{
+		SecretsGateway:       secretsGateway,
+		CustomersGateway:     &customersGateway,
+		EmailGateway:         emailGateway,
+		VerificationTokenTtl: time.Duration(emailVerificationTokenTtlHours) * time.Hour,
+		VerificationUrl:      emailVerificationUrl,
+		PasswordPolicy:       passwordPolicy,
+		PasswordHasher:       passwordHasher,
+	}
+
+	verifyEmail := usecases.VerifyEmail{
 		SecretsGateway:   secretsGateway,
 		CustomersGateway: &customersGateway,
 	}
 
-	signUp := usecases.SignUp{
+	resendVerificationEmail := usecases.ResendVerificationEmail{
+		SecretsGateway:   secretsGateway,
 		CustomersGateway: &customersGateway,
+		EmailGateway:     emailGateway,
+		TokenTtl:         time.Duration(emailVerificationTokenTtlHours) * time.Hour,
+		VerificationUrl:  emailVerificationUrl,
+		ResendInterval:   time.Duration(emailVerificationResendIntervalSeconds) * time.Second,
+	}
+
+	requestPasswordReset := usecases.RequestPasswordReset{
+		CustomersGateway:              &customersGateway,
+		PasswordResetTokensRepository: &passwordResetTokensRepository,
+		EmailGateway:                  emailGateway,
+		TokenTtl:                      time.Duration(passwordResetTokenTtlMinutes) * time.Minute,
+		ResetUrl:                      passwordResetUrl,
+	}
+
+	resetPassword := usecases.ResetPassword{
+		CustomersGateway:              &customersGateway,
+		PasswordResetTokensRepository: &passwordResetTokensRepository,
+		RefreshTokensRepository:       &refreshTokensRepository,
+		PasswordPolicy:                passwordPolicy,
+		PasswordHasher:                passwordHasher,
 	}
 
 	createRoom := usecases.CreateRoom{
 		RoomsRepository: &roomRepository,
 	}
 
+	createQuote := usecases.CreateQuote{
+		SecretsGateway:            secretsGateway,
+		RoomsRepository:           &roomRepository,
+		BookingsRepository:        &bookingsRepository,
+		PromoCodesRepository:      &promoCodesRepository,
+		PricingRulesRepository:    &pricingRulesRepository,
+		AddOnsRepository:          &addOnsRepository,
+		ExtraGuestRatesRepository: &extraGuestRatesRepository,
+		PackagesRepository:        &packagesRepository,
+	}
+
+	createBooking := usecases.CreateBooking{
+		SecretsGateway:            secretsGateway,
+		RoomsRepository:           &roomRepository,
+		BookingsRepository:        &bookingsRepository,
+		PromoCodesRepository:      &promoCodesRepository,
+		PricingRulesRepository:    &pricingRulesRepository,
+		AddOnsRepository:          &addOnsRepository,
+		ExtraGuestRatesRepository: &extraGuestRatesRepository,
+		PackagesRepository:        &packagesRepository,
+		PaymentsGateway:           paymentsGateway,
+		DepositPolicy:             depositPolicy,
+		RatePlansRepository:       &ratePlansRepository,
+		CreditEntriesRepository:   &creditEntriesRepository,
+		FolioEntriesRepository:    &folioEntriesRepository,
+		CustomersGateway:          &customersGateway,
+		RequireVerifiedEmail:      requireVerifiedEmailToBook,
+	}
+
+	createPricingRule := usecases.CreatePricingRule{
+		PricingRulesRepository: &pricingRulesRepository,
+	}
+
+	previewPricingRule := usecases.PreviewPricingRule{
+		RoomsRepository:        &roomRepository,
+		BookingsRepository:     &bookingsRepository,
+		PricingRulesRepository: &pricingRulesRepository,
+	}
+
+	enablePricingRule := usecases.EnablePricingRule{
+		PricingRulesRepository: &pricingRulesRepository,
+	}
+
+	createAddOn := usecases.CreateAddOn{
+		AddOnsRepository: &addOnsRepository,
+	}
+
+	setExtraGuestRate := usecases.SetExtraGuestRate{
+		ExtraGuestRatesRepository: &extraGuestRatesRepository,
+	}
+
+	getRoomTypeCalendar := usecases.GetRoomTypeCalendar{
+		CalendarRepository:     &calendarRepository,
+		PricingRulesRepository: &pricingRulesRepository,
+	}
+
+	setStayRestrictions := usecases.SetStayRestrictions{
+		CalendarRepository: &calendarRepository,
+	}
+
+	createPackage := usecases.CreatePackage{
+		PackagesRepository: &packagesRepository,
+		AddOnsRepository:   &addOnsRepository,
+	}
+
+	deactivatePackage := usecases.DeactivatePackage{
+		PackagesRepository: &packagesRepository,
+	}
+
+	captureDuePayments := usecases.CaptureDuePayments{
+		PaymentsGateway:    paymentsGateway,
+		PaymentsRepository: &paymentsRepository,
+	}
+
+	createRatePlan := usecases.CreateRatePlan{
+		RatePlansRepository: &ratePlansRepository,
+	}
+
+	chargeDueScheduledCharges := usecases.ChargeDueScheduledCharges{
+		PaymentsGateway:            paymentsGateway,
+		BookingsRepository:         &bookingsRepository,
+		ScheduledChargesRepository: &scheduledChargesRepository,
+		RefundsRepository:          &refundsRepository,
+		MaxAttempts:                uint8(scheduledChargeMaxAttempts),
+		RetryInterval:              time.Duration(scheduledChargeRetryHours) * time.Hour,
+	}
+
+	processPaymentEvent := usecases.ProcessPaymentEvent{
+		SecretsGateway:          secretsGateway,
+		PaymentsRepository:      &paymentsRepository,
+		BookingsRepository:      &bookingsRepository,
+		PaymentEventsRepository: &paymentEventsRepository,
+	}
+
+	cancelBooking := usecases.CancelBooking{
+		PaymentsGateway:            paymentsGateway,
+		BookingsRepository:         &bookingsRepository,
+		PaymentsRepository:         &paymentsRepository,
+		RefundsRepository:          &refundsRepository,
+		ScheduledChargesRepository: &scheduledChargesRepository,
+		FolioEntriesRepository:     &folioEntriesRepository,
+		CreditEntriesRepository:    &creditEntriesRepository,
+		CancellationPolicy:         cancellationPolicy,
+	}
+
+	issueGiftCard := usecases.IssueGiftCard{
+		GiftCardsRepository: &giftCardsRepository,
+	}
+
+	redeemGiftCard := usecases.RedeemGiftCard{
+		GiftCardsRepository: &giftCardsRepository,
+	}
+
+	issueCredit := usecases.IssueCredit{
+		CustomersGateway:        &customersGateway,
+		CreditEntriesRepository: &creditEntriesRepository,
+	}
+
+	getCredit := usecases.GetCredit{
+		CreditEntriesRepository: &creditEntriesRepository,
+	}
+
+	shortenBooking := usecases.ShortenBooking{
+		PaymentsGateway:            paymentsGateway,
+		BookingsRepository:         &bookingsRepository,
+		PaymentsRepository:         &paymentsRepository,
+		RefundsRepository:          &refundsRepository,
+		ScheduledChargesRepository: &scheduledChargesRepository,
+		RoomsRepository:            &roomRepository,
+		PromoCodesRepository:       &promoCodesRepository,
+		PricingRulesRepository:     &pricingRulesRepository,
+		AddOnsRepository:           &addOnsRepository,
+		ExtraGuestRatesRepository:  &extraGuestRatesRepository,
+		PackagesRepository:         &packagesRepository,
+	}
+
+	getFolio := usecases.GetFolio{
+		BookingsRepository:         &bookingsRepository,
+		PaymentsRepository:         &paymentsRepository,
+		ScheduledChargesRepository: &scheduledChargesRepository,
+		RefundsRepository:          &refundsRepository,
+		FolioEntriesRepository:     &folioEntriesRepository,
+	}
+
+	postFolioCharge := usecases.PostFolioCharge{
+		BookingsRepository:     &bookingsRepository,
+		FolioEntriesRepository: &folioEntriesRepository,
+	}
+
+	postFolioPayment := usecases.PostFolioPayment{
+		BookingsRepository:     &bookingsRepository,
+		FolioEntriesRepository: &folioEntriesRepository,
+	}
+
+	voidFolioCharge := usecases.VoidFolioCharge{
+		BookingsRepository:     &bookingsRepository,
+		FolioEntriesRepository: &folioEntriesRepository,
+	}
+
+	checkOutBooking := usecases.CheckOutBooking{
+		BookingsRepository:         &bookingsRepository,
+		PaymentsRepository:         &paymentsRepository,
+		ScheduledChargesRepository: &scheduledChargesRepository,
+		RefundsRepository:          &refundsRepository,
+		FolioEntriesRepository:     &folioEntriesRepository,
+	}
+
+	issueInvoice := usecases.IssueInvoice{
+		BookingsRepository:         &bookingsRepository,
+		PaymentsRepository:         &paymentsRepository,
+		ScheduledChargesRepository: &scheduledChargesRepository,
+		RefundsRepository:          &refundsRepository,
+		FolioEntriesRepository:     &folioEntriesRepository,
+		InvoicesRepository:         &invoicesRepository,
+		PropertyCode:               propertyCode,
+		TaxRates:                   taxRates,
+	}
+
+	issueCreditNote := usecases.IssueCreditNote{
+		InvoicesRepository: &invoicesRepository,
+	}
+
+	getInvoice := usecases.GetInvoice{
+		BookingsRepository: &bookingsRepository,
+		InvoicesRepository: &invoicesRepository,
+	}
+
+	issueManualRefund := usecases.IssueManualRefund{
+		PaymentsGateway:    paymentsGateway,
+		BookingsRepository: &bookingsRepository,
+		PaymentsRepository: &paymentsRepository,
+		RefundsRepository:  &refundsRepository,
+	}
+
 	loginWithEmailAndPasswordHandler := handlers.LoginWithEmailAndPasswordHandler{
 		HttpLogger:                httpLogger,
 		LoginWithEmailAndPassword: &loginWithEmailAndPassword,
 	}
 
+	loginStaffWithEmailAndPasswordHandler := handlers.LoginStaffWithEmailAndPasswordHandler{
+		HttpLogger:                     httpLogger,
+		HttpValidator:                  httpValidator,
+		LoginStaffWithEmailAndPassword: &loginStaffWithEmailAndPassword,
+	}
+
+	startOidcLoginHandler := handlers.StartOidcLoginHandler{
+		HttpLogger:     httpLogger,
+		StartOidcLogin: &startOidcLogin,
+	}
+
+	completeOidcLoginHandler := handlers.CompleteOidcLoginHandler{
+		HttpLogger:        httpLogger,
+		HttpValidator:     httpValidator,
+		CompleteOidcLogin: &completeOidcLogin,
+	}
+
+	enrollTotpHandler := handlers.EnrollTotpHandler{
+		HttpLogger: httpLogger,
+		EnrollTotp: &enrollTotp,
+	}
+
+	activateTotpHandler := handlers.ActivateTotpHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		ActivateTotp:  &activateTotp,
+	}
+
+	completeMfaLoginHandler := handlers.CompleteMfaLoginHandler{
+		HttpLogger:       httpLogger,
+		HttpValidator:    httpValidator,
+		CompleteMfaLogin: &completeMfaLogin,
+	}
+
+	createApiKeyHandler := handlers.CreateApiKeyHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		CreateApiKey:  &createApiKey,
+	}
+
+	getApiKeysHandler := handlers.GetApiKeysHandler{
+		Pool:       pool,
+		HttpLogger: httpLogger,
+	}
+
+	revokeApiKeyHandler := handlers.RevokeApiKeyHandler{
+		HttpLogger:   httpLogger,
+		RevokeApiKey: &revokeApiKey,
+	}
+
+	createStaffUserHandler := handlers.CreateStaffUserHandler{
+		HttpLogger:      httpLogger,
+		HttpValidator:   httpValidator,
+		CreateStaffUser: &createStaffUser,
+	}
+
+	refreshSessionHandler := handlers.RefreshSessionHandler{
+		HttpLogger:     httpLogger,
+		HttpValidator:  httpValidator,
+		RefreshSession: &refreshSession,
+	}
+
+	logoutHandler := handlers.LogoutHandler{
+		HttpLogger: httpLogger,
+		Logout:     &logout,
+	}
+
+	forgotPasswordHandler := handlers.ForgotPasswordHandler{
+		HttpLogger:           httpLogger,
+		HttpValidator:        httpValidator,
+		RequestPasswordReset: &requestPasswordReset,
+	}
+
+	resetPasswordHandler := handlers.ResetPasswordHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		ResetPassword: &resetPassword,
+	}
+
+	verifyEmailHandler := handlers.VerifyEmailHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		VerifyEmail:   &verifyEmail,
+	}
+
+	resendVerificationEmailHandler := handlers.ResendVerificationEmailHandler{
+		HttpLogger:              httpLogger,
+		ResendVerificationEmail: &resendVerificationEmail,
+	}
+
+	getJwksHandler := handlers.GetJwksHandler{
+		HttpLogger:        httpLogger,
+		SigningKeysSource: &signingKeysSource,
+	}
+
 	signUpHandler := handlers.SignUpHandler{
-		SignUp: &signUp,
+		HttpLogger: httpLogger,
+		SignUp:     &signUp,
 	}
 
 	createRoomHandler := handlers.CreateRoomHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		CreateRoom:    &createRoom,
+	}
+
+	getRoomsHandler := handlers.GetRoomsHandler{
+		Pool:       pool,
+		HttpLogger: httpLogger,
+	}
+
+	createQuoteHandler := handlers.CreateQuoteHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		CreateQuote:   &createQuote,
+	}
+
+	createBookingHandler := handlers.CreateBookingHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		CreateBooking: &createBooking,
+	}
+
+	createPricingRuleHandler := handlers.CreatePricingRuleHandler{
 		HttpLogger:        httpLogger,
-		HttpAuthorization: httpAuthorization,
 		HttpValidator:     httpValidator,
-		CreateRoom:        &createRoom,
+		CreatePricingRule: &createPricingRule,
 	}
 
-	getRoomsHandler := handlers.GetRoomsHandler{
-		Conn:              conn,
+	previewPricingRuleHandler := handlers.PreviewPricingRuleHandler{
+		HttpLogger:         httpLogger,
+		PreviewPricingRule: &previewPricingRule,
+	}
+
+	enablePricingRuleHandler := handlers.EnablePricingRuleHandler{
 		HttpLogger:        httpLogger,
-		HttpAuthorization: httpAuthorization,
+		EnablePricingRule: &enablePricingRule,
 	}
 
-	e := echo.New()
-	api := e.Group("/api")
+	createAddOnHandler := handlers.CreateAddOnHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		CreateAddOn:   &createAddOn,
+	}
 
-	api.POST("/login-with-email-and-password", func(c echo.Context) error {
-		return loginWithEmailAndPasswordHandler.Handle(c)
-	})
+	createRatePlanHandler := handlers.CreateRatePlanHandler{
+		HttpLogger:     httpLogger,
+		HttpValidator:  httpValidator,
+		CreateRatePlan: &createRatePlan,
+	}
 
-	api.POST("/sign-up", func(c echo.Context) error {
-		return signUpHandler.Handle(c)
-	})
+	getAddOnsHandler := handlers.GetAddOnsHandler{
+		Pool:       pool,
+		HttpLogger: httpLogger,
+	}
 
-	api.POST("/create-room", func(c echo.Context) error {
-		return createRoomHandler.Handle(c)
+	setExtraGuestRateHandler := handlers.SetExtraGuestRateHandler{
+		HttpLogger:        httpLogger,
+		HttpValidator:     httpValidator,
+		SetExtraGuestRate: &setExtraGuestRate,
+	}
+
+	getRoomTypeCalendarHandler := handlers.GetRoomTypeCalendarHandler{
+		HttpLogger:          httpLogger,
+		HttpValidator:       httpValidator,
+		GetRoomTypeCalendar: &getRoomTypeCalendar,
+	}
+
+	setStayRestrictionsHandler := handlers.SetStayRestrictionsHandler{
+		HttpLogger:          httpLogger,
+		HttpValidator:       httpValidator,
+		SetStayRestrictions: &setStayRestrictions,
+	}
+
+	createPackageHandler := handlers.CreatePackageHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		CreatePackage: &createPackage,
+	}
+
+	getPackagesHandler := handlers.GetPackagesHandler{
+		Pool:       pool,
+		HttpLogger: httpLogger,
+	}
+
+	deactivatePackageHandler := handlers.DeactivatePackageHandler{
+		HttpLogger:        httpLogger,
+		DeactivatePackage: &deactivatePackage,
+	}
+
+	receivePaymentEventHandler := handlers.ReceivePaymentEventHandler{
+		HttpLogger:          httpLogger,
+		ProcessPaymentEvent: &processPaymentEvent,
+	}
+
+	cancelBookingHandler := handlers.CancelBookingHandler{
+		HttpLogger:    httpLogger,
+		CancelBooking: &cancelBooking,
+	}
+
+	shortenBookingHandler := handlers.ShortenBookingHandler{
+		HttpLogger:     httpLogger,
+		HttpValidator:  httpValidator,
+		ShortenBooking: &shortenBooking,
+	}
+
+	issueManualRefundHandler := handlers.IssueManualRefundHandler{
+		HttpLogger:        httpLogger,
+		HttpValidator:     httpValidator,
+		IssueManualRefund: &issueManualRefund,
+	}
+
+	getBookingRefundsHandler := handlers.GetBookingRefundsHandler{
+		Pool:       pool,
+		HttpLogger: httpLogger,
+	}
+
+	getFolioHandler := handlers.GetFolioHandler{
+		HttpLogger: httpLogger,
+		GetFolio:   &getFolio,
+	}
+
+	postFolioChargeHandler := handlers.PostFolioChargeHandler{
+		HttpLogger:      httpLogger,
+		HttpValidator:   httpValidator,
+		PostFolioCharge: &postFolioCharge,
+	}
+
+	postFolioPaymentHandler := handlers.PostFolioPaymentHandler{
+		HttpLogger:       httpLogger,
+		HttpValidator:    httpValidator,
+		PostFolioPayment: &postFolioPayment,
+	}
+
+	voidFolioChargeHandler := handlers.VoidFolioChargeHandler{
+		HttpLogger:      httpLogger,
+		HttpValidator:   httpValidator,
+		VoidFolioCharge: &voidFolioCharge,
+	}
+
+	checkOutBookingHandler := handlers.CheckOutBookingHandler{
+		HttpLogger:      httpLogger,
+		HttpValidator:   httpValidator,
+		CheckOutBooking: &checkOutBooking,
+	}
+
+	issueInvoiceHandler := handlers.IssueInvoiceHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		IssueInvoice:  &issueInvoice,
+	}
+
+	issueCreditNoteHandler := handlers.IssueCreditNoteHandler{
+		HttpLogger:      httpLogger,
+		HttpValidator:   httpValidator,
+		IssueCreditNote: &issueCreditNote,
+	}
+
+	getInvoiceHandler := handlers.GetInvoiceHandler{
+		HttpLogger: httpLogger,
+		GetInvoice: &getInvoice,
+	}
+
+	issueGiftCardHandler := handlers.IssueGiftCardHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		IssueGiftCard: &issueGiftCard,
+	}
+
+	redeemGiftCardHandler := handlers.RedeemGiftCardHandler{
+		HttpLogger:     httpLogger,
+		HttpValidator:  httpValidator,
+		RedeemGiftCard: &redeemGiftCard,
+	}
+
+	issueCreditHandler := handlers.IssueCreditHandler{
+		HttpLogger:    httpLogger,
+		HttpValidator: httpValidator,
+		IssueCredit:   &issueCredit,
+	}
+
+	getCreditHandler := handlers.GetCreditHandler{
+		HttpLogger: httpLogger,
+		GetCredit:  &getCredit,
+	}
+
+	captureDuePaymentsJob := jobs.NewCaptureDuePaymentsJob(time.Hour, &captureDuePayments)
+	go captureDuePaymentsJob.Start(context.Background())
+
+	chargeDueScheduledChargesJob := jobs.NewChargeDueScheduledChargesJob(time.Hour, &chargeDueScheduledCharges)
+	go chargeDueScheduledChargesJob.Start(context.Background())
+
+	httpIdempotency := webhttp.HttpIdempotency{
+		HttpLogger:                httpLogger,
+		IdempotencyKeysRepository: &idempotencyKeysRepository,
+		Ttl:                       24 * time.Hour,
+	}
+
+	e := echo.New()
+
+	// The client address drives the per-address login throttle, so X-Forwarded-For is only read behind a proxy that
+	// overwrites it.
+	if trustProxy, _ := strconv.ParseBool(optionalSecret(secretsGateway, "TRUST_PROXY_HEADERS")); trustProxy {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
+
+	e.GET("/.well-known/jwks.json", func(c echo.Context) error {
+		return getJwksHandler.Handle(c)
 	})
 
-	api.GET("/rooms", func(c echo.Context) error {
-		return getRoomsHandler.Handle(c)
+	api := e.Group("/api", httpAuthorization.Authenticate)
+	registerApiRoutes(api, &httpAuthorization, &httpIdempotency, apiHandlers{
+		LoginWithEmailAndPassword:      &loginWithEmailAndPasswordHandler,
+		LoginStaffWithEmailAndPassword: &loginStaffWithEmailAndPasswordHandler,
+		CompleteMfaLogin:               &completeMfaLoginHandler,
+		StartOidcLogin:                 &startOidcLoginHandler,
+		CompleteOidcLogin:              &completeOidcLoginHandler,
+		EnrollTotp:                     &enrollTotpHandler,
+		ActivateTotp:                   &activateTotpHandler,
+		CreateStaffUser:                &createStaffUserHandler,
+		CreateApiKey:                   &createApiKeyHandler,
+		GetApiKeys:                     &getApiKeysHandler,
+		RevokeApiKey:                   &revokeApiKeyHandler,
+		RefreshSession:                 &refreshSessionHandler,
+		Logout:                         &logoutHandler,
+		ForgotPassword:                 &forgotPasswordHandler,
+		ResetPassword:                  &resetPasswordHandler,
+		VerifyEmail:                    &verifyEmailHandler,
+		ResendVerificationEmail:        &resendVerificationEmailHandler,
+		SignUp:                         &signUpHandler,
+		CreateRoom:                     &createRoomHandler,
+		GetRooms:                       &getRoomsHandler,
+		CreateQuote:                    &createQuoteHandler,
+		CreateBooking:                  &createBookingHandler,
+		CreatePricingRule:              &createPricingRuleHandler,
+		PreviewPricingRule:             &previewPricingRuleHandler,
+		EnablePricingRule:              &enablePricingRuleHandler,
+		CreateAddOn:                    &createAddOnHandler,
+		GetAddOns:                      &getAddOnsHandler,
+		CreateRatePlan:                 &createRatePlanHandler,
+		SetExtraGuestRate:              &setExtraGuestRateHandler,
+		GetRoomTypeCalendar:            &getRoomTypeCalendarHandler,
+		SetStayRestrictions:            &setStayRestrictionsHandler,
+		CreatePackage:                  &createPackageHandler,
+		GetPackages:                    &getPackagesHandler,
+		DeactivatePackage:              &deactivatePackageHandler,
+		ReceivePaymentEvent:            &receivePaymentEventHandler,
+		CancelBooking:                  &cancelBookingHandler,
+		ShortenBooking:                 &shortenBookingHandler,
+		IssueManualRefund:              &issueManualRefundHandler,
+		GetBookingRefunds:              &getBookingRefundsHandler,
+		GetFolio:                       &getFolioHandler,
+		PostFolioCharge:                &postFolioChargeHandler,
+		VoidFolioCharge:                &voidFolioChargeHandler,
+		PostFolioPayment:               &postFolioPaymentHandler,
+		CheckOutBooking:                &checkOutBookingHandler,
+		IssueInvoice:                   &issueInvoiceHandler,
+		GetInvoice:                     &getInvoiceHandler,
+		IssueCreditNote:                &issueCreditNoteHandler,
+		IssueGiftCard:                  &issueGiftCardHandler,
+		RedeemGiftCard:                 &redeemGiftCardHandler,
+		GetCredit:                      &getCreditHandler,
+		IssueCredit:                    &issueCreditHandler,
 	})
 
 	err = e.Start(":8080")
@@ -126,3 +1079,12 @@ func main() {
 		panic(err)
 	}
 }
+
+func optionalSecret(secretsGateway applicationgateway.ISecretsGateway, key string) string {
+	value, err := secretsGateway.Get(key)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type httpHandler interface {
+	Handle(c echo.Context) error
+}
+
+// apiHandlers holds the handler behind every route under /api.
+type apiHandlers struct {
+	LoginWithEmailAndPassword      httpHandler
+	LoginStaffWithEmailAndPassword httpHandler
+	CompleteMfaLogin               httpHandler
+	StartOidcLogin                 httpHandler
+	CompleteOidcLogin              httpHandler
+	EnrollTotp                     httpHandler
+	ActivateTotp                   httpHandler
+	CreateStaffUser                httpHandler
+	CreateApiKey                   httpHandler
+	GetApiKeys                     httpHandler
+	RevokeApiKey                   httpHandler
+	RefreshSession                 httpHandler
+	Logout                         httpHandler
+	ForgotPassword                 httpHandler
+	ResetPassword                  httpHandler
+	VerifyEmail                    httpHandler
+	ResendVerificationEmail        httpHandler
+	SignUp                         httpHandler
+	CreateRoom                     httpHandler
+	GetRooms                       httpHandler
+	CreateQuote                    httpHandler
+	CreateBooking                  httpHandler
+	CreatePricingRule              httpHandler
+	PreviewPricingRule             httpHandler
+	EnablePricingRule              httpHandler
+	CreateAddOn                    httpHandler
+	GetAddOns                      httpHandler
+	CreateRatePlan                 httpHandler
+	SetExtraGuestRate              httpHandler
+	GetRoomTypeCalendar            httpHandler
+	SetStayRestrictions            httpHandler
+	CreatePackage                  httpHandler
+	GetPackages                    httpHandler
+	DeactivatePackage              httpHandler
+	ReceivePaymentEvent            httpHandler
+	CancelBooking                  httpHandler
+	ShortenBooking                 httpHandler
+	IssueManualRefund              httpHandler
+	GetBookingRefunds              httpHandler
+	GetFolio                       httpHandler
+	PostFolioCharge                httpHandler
+	VoidFolioCharge                httpHandler
+	PostFolioPayment               httpHandler
+	CheckOutBooking                httpHandler
+	IssueInvoice                   httpHandler
+	GetInvoice                     httpHandler
+	IssueCreditNote                httpHandler
+	IssueGiftCard                  httpHandler
+	RedeemGiftCard                 httpHandler
+	GetCredit                      httpHandler
+	IssueCredit                    httpHandler
+}
+
+// registerApiRoutes maps every route under /api to its handler, the permissions it requires and whether retries are
+// deduplicated by Idempotency-Key.
+func registerApiRoutes(api *echo.Group, httpAuthorization *webhttp.HttpAuthorization,
+	httpIdempotency *webhttp.HttpIdempotency, h apiHandlers) {
+	api.POST("/login-with-email-and-password", h.LoginWithEmailAndPassword.Handle)
+	api.POST("/staff/login", h.LoginStaffWithEmailAndPassword.Handle)
+	api.POST("/login/mfa", h.CompleteMfaLogin.Handle)
+	api.POST("/oidc/:provider/authorize", h.StartOidcLogin.Handle)
+	api.POST("/oidc/:provider/callback", h.CompleteOidcLogin.Handle)
+	api.POST("/mfa/totp/enroll", h.EnrollTotp.Handle)
+	api.POST("/mfa/totp/activate", h.ActivateTotp.Handle)
+	api.POST("/staff-users", h.CreateStaffUser.Handle, httpAuthorization.Require("staff:write"))
+	api.POST("/api-keys", h.CreateApiKey.Handle, httpAuthorization.Require("api-keys:write"))
+	api.GET("/api-keys", h.GetApiKeys.Handle, httpAuthorization.Require("api-keys:write"))
+	api.POST("/api-keys/:id/revoke", h.RevokeApiKey.Handle, httpAuthorization.Require("api-keys:write"))
+	api.POST("/token/refresh", h.RefreshSession.Handle)
+	api.POST("/logout", h.Logout.Handle)
+	api.POST("/password/forgot", h.ForgotPassword.Handle)
+	api.POST("/password/reset", h.ResetPassword.Handle)
+	api.POST("/email/verify", h.VerifyEmail.Handle)
+	api.POST("/email/verify/resend", h.ResendVerificationEmail.Handle)
+	api.POST("/sign-up", h.SignUp.Handle, httpIdempotency.Middleware)
+	api.POST("/create-room", h.CreateRoom.Handle, httpAuthorization.Require("rooms:write"), httpIdempotency.Middleware)
+	api.GET("/rooms", h.GetRooms.Handle, httpAuthorization.Require("rooms:read"))
+	api.POST("/quotes", h.CreateQuote.Handle, httpAuthorization.Require("quotes:create"))
+	api.POST("/bookings", h.CreateBooking.Handle,
+		httpAuthorization.Require("bookings:create"), httpIdempotency.Middleware)
+	api.POST("/pricing-rules", h.CreatePricingRule.Handle, httpAuthorization.Require("pricing:write"))
+	api.GET("/pricing-rules/:id/preview", h.PreviewPricingRule.Handle, httpAuthorization.Require("pricing:read"))
+	api.POST("/pricing-rules/:id/enable", h.EnablePricingRule.Handle, httpAuthorization.Require("pricing:write"))
+	api.POST("/add-ons", h.CreateAddOn.Handle, httpAuthorization.Require("catalog:write"))
+	api.GET("/add-ons", h.GetAddOns.Handle, httpAuthorization.Require("catalog:read"))
+	api.POST("/rate-plans", h.CreateRatePlan.Handle, httpAuthorization.Require("pricing:write"))
+	api.PUT("/extra-guest-rates/:roomType", h.SetExtraGuestRate.Handle, httpAuthorization.Require("pricing:write"))
+	api.GET("/room-types/:type/calendar", h.GetRoomTypeCalendar.Handle)
+	api.PUT("/room-types/:type/restrictions", h.SetStayRestrictions.Handle,
+		httpAuthorization.Require("inventory:write"))
+	api.POST("/packages", h.CreatePackage.Handle, httpAuthorization.Require("catalog:write"))
+	api.GET("/packages", h.GetPackages.Handle, httpAuthorization.Require("catalog:read"))
+	api.POST("/packages/:id/deactivate", h.DeactivatePackage.Handle, httpAuthorization.Require("catalog:write"))
+	api.POST("/webhooks/payments", h.ReceivePaymentEvent.Handle)
+	api.POST("/bookings/:id/cancel", h.CancelBooking.Handle,
+		httpAuthorization.Require("bookings:write:own", "bookings:write:any"), httpIdempotency.Middleware)
+	api.POST("/bookings/:id/shorten", h.ShortenBooking.Handle,
+		httpAuthorization.Require("bookings:write:own", "bookings:write:any"), httpIdempotency.Middleware)
+	api.POST("/bookings/:id/refunds", h.IssueManualRefund.Handle,
+		httpAuthorization.Require("refunds:write"), httpIdempotency.Middleware)
+	api.GET("/bookings/:id/refunds", h.GetBookingRefunds.Handle,
+		httpAuthorization.Require("bookings:read:own", "bookings:read:any"))
+	api.GET("/bookings/:id/folio", h.GetFolio.Handle,
+		httpAuthorization.Require("bookings:read:own", "bookings:read:any"))
+	api.POST("/bookings/:id/folio/charges", h.PostFolioCharge.Handle,
+		httpAuthorization.Require("folio:post"), httpIdempotency.Middleware)
+	api.POST("/bookings/:id/folio/charges/:chargeId/void", h.VoidFolioCharge.Handle,
+		httpAuthorization.Require("folio:post"))
+	api.POST("/bookings/:id/folio/payments", h.PostFolioPayment.Handle,
+		httpAuthorization.Require("folio:post"), httpIdempotency.Middleware)
+	api.POST("/bookings/:id/check-out", h.CheckOutBooking.Handle,
+		httpAuthorization.Require("bookings:check-out"), httpIdempotency.Middleware)
+	api.POST("/bookings/:id/invoice", h.IssueInvoice.Handle,
+		httpAuthorization.Require("invoices:write"), httpIdempotency.Middleware)
+	api.GET("/bookings/:id/invoice", h.GetInvoice.Handle,
+		httpAuthorization.Require("bookings:read:own", "bookings:read:any"))
+	api.POST("/invoices/:id/credit-notes", h.IssueCreditNote.Handle,
+		httpAuthorization.Require("invoices:write"), httpIdempotency.Middleware)
+	api.POST("/gift-cards", h.IssueGiftCard.Handle,
+		httpAuthorization.Require("credit:write"), httpIdempotency.Middleware)
+	api.POST("/me/credit/gift-cards", h.RedeemGiftCard.Handle,
+		httpAuthorization.Require("credit:own"), httpIdempotency.Middleware)
+	api.GET("/me/credit", h.GetCredit.Handle, httpAuthorization.Require("credit:own"))
+	api.POST("/customers/:id/credit", h.IssueCredit.Handle,
+		httpAuthorization.Require("credit:write"), httpIdempotency.Middleware)
+}
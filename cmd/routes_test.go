@@ -0,0 +1,225 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type expectedRoute struct {
+	method      string
+	path        string
+	permissions []string
+	idempotent  bool
+}
+
+type stubHandler struct {
+	calls *int
+}
+
+func (s stubHandler) Handle(c echo.Context) error {
+	*s.calls++
+	return c.JSON(http.StatusOK, map[string]int{"call": *s.calls})
+}
+
+type RoutesSuite struct {
+	suite.Suite
+	staffUserId    uuid.UUID
+	calls          int
+	expectedRoutes []expectedRoute
+	e              *echo.Echo
+}
+
+func (r *RoutesSuite) SetupTest() {
+	r.staffUserId = uuid.New()
+	r.calls = 0
+	r.expectedRoutes = []expectedRoute{
+		{http.MethodPost, "/api/login-with-email-and-password", nil, false},
+		{http.MethodPost, "/api/staff/login", nil, false},
+		{http.MethodPost, "/api/login/mfa", nil, false},
+		{http.MethodPost, "/api/oidc/:provider/authorize", nil, false},
+		{http.MethodPost, "/api/oidc/:provider/callback", nil, false},
+		{http.MethodPost, "/api/mfa/totp/enroll", nil, false},
+		{http.MethodPost, "/api/mfa/totp/activate", nil, false},
+		{http.MethodPost, "/api/staff-users", []string{"staff:write"}, false},
+		{http.MethodPost, "/api/api-keys", []string{"api-keys:write"}, false},
+		{http.MethodGet, "/api/api-keys", []string{"api-keys:write"}, false},
+		{http.MethodPost, "/api/api-keys/:id/revoke", []string{"api-keys:write"}, false},
+		{http.MethodPost, "/api/token/refresh", nil, false},
+		{http.MethodPost, "/api/logout", nil, false},
+		{http.MethodPost, "/api/password/forgot", nil, false},
+		{http.MethodPost, "/api/password/reset", nil, false},
+		{http.MethodPost, "/api/email/verify", nil, false},
+		{http.MethodPost, "/api/email/verify/resend", nil, false},
+		{http.MethodPost, "/api/sign-up", nil, true},
+		{http.MethodPost, "/api/create-room", []string{"rooms:write"}, true},
+		{http.MethodGet, "/api/rooms", []string{"rooms:read"}, false},
+		{http.MethodPost, "/api/quotes", []string{"quotes:create"}, false},
+		{http.MethodPost, "/api/bookings", []string{"bookings:create"}, true},
+		{http.MethodPost, "/api/pricing-rules", []string{"pricing:write"}, false},
+		{http.MethodGet, "/api/pricing-rules/:id/preview", []string{"pricing:read"}, false},
+		{http.MethodPost, "/api/pricing-rules/:id/enable", []string{"pricing:write"}, false},
+		{http.MethodPost, "/api/add-ons", []string{"catalog:write"}, false},
+		{http.MethodGet, "/api/add-ons", []string{"catalog:read"}, false},
+		{http.MethodPost, "/api/rate-plans", []string{"pricing:write"}, false},
+		{http.MethodPut, "/api/extra-guest-rates/:roomType", []string{"pricing:write"}, false},
+		{http.MethodGet, "/api/room-types/:type/calendar", nil, false},
+		{http.MethodPut, "/api/room-types/:type/restrictions", []string{"inventory:write"}, false},
+		{http.MethodPost, "/api/packages", []string{"catalog:write"}, false},
+		{http.MethodGet, "/api/packages", []string{"catalog:read"}, false},
+		{http.MethodPost, "/api/packages/:id/deactivate", []string{"catalog:write"}, false},
+		{http.MethodPost, "/api/webhooks/payments", nil, false},
+		{http.MethodPost, "/api/bookings/:id/cancel", []string{"bookings:write:own", "bookings:write:any"}, true},
+		{http.MethodPost, "/api/bookings/:id/shorten", []string{"bookings:write:own", "bookings:write:any"}, true},
+		{http.MethodPost, "/api/bookings/:id/refunds", []string{"refunds:write"}, true},
+		{http.MethodGet, "/api/bookings/:id/refunds", []string{"bookings:read:own", "bookings:read:any"}, false},
+		{http.MethodGet, "/api/bookings/:id/folio", []string{"bookings:read:own", "bookings:read:any"}, false},
+		{http.MethodPost, "/api/bookings/:id/folio/charges", []string{"folio:post"}, true},
+		{http.MethodPost, "/api/bookings/:id/folio/charges/:chargeId/void", []string{"folio:post"}, false},
+		{http.MethodPost, "/api/bookings/:id/folio/payments", []string{"folio:post"}, true},
+		{http.MethodPost, "/api/bookings/:id/check-out", []string{"bookings:check-out"}, true},
+		{http.MethodPost, "/api/bookings/:id/invoice", []string{"invoices:write"}, true},
+		{http.MethodGet, "/api/bookings/:id/invoice", []string{"bookings:read:own", "bookings:read:any"}, false},
+		{http.MethodPost, "/api/invoices/:id/credit-notes", []string{"invoices:write"}, true},
+		{http.MethodPost, "/api/gift-cards", []string{"credit:write"}, true},
+		{http.MethodPost, "/api/me/credit/gift-cards", []string{"credit:own"}, true},
+		{http.MethodGet, "/api/me/credit", []string{"credit:own"}, false},
+		{http.MethodPost, "/api/customers/:id/credit", []string{"credit:write"}, true},
+	}
+
+	rolePermissions := map[string][]string{"NONE": {}}
+	for _, route := range r.expectedRoutes {
+		for _, permission := range route.permissions {
+			rolePermissions[permission] = []string{permission}
+		}
+	}
+
+	httpAuthorization := webhttp.HttpAuthorization{
+		RolesRepository: &repositories.FakeRolesRepository{RolePermissions: rolePermissions},
+		HttpLogger:      webhttp.NewHttpLogger(),
+	}
+	httpIdempotency := webhttp.HttpIdempotency{
+		HttpLogger:                webhttp.NewHttpLogger(),
+		IdempotencyKeysRepository: &repositories.FakeIdempotencyKeysRepository{},
+	}
+
+	handlers := apiHandlers{}
+	fields := reflect.ValueOf(&handlers).Elem()
+	for i := range fields.NumField() {
+		fields.Field(i).Set(reflect.ValueOf(stubHandler{calls: &r.calls}))
+	}
+
+	r.e = echo.New()
+	api := r.e.Group("/api", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if role := c.Request().Header.Get("X-Test-Role"); role != "" {
+				webhttp.SetPrincipal(c, auth.Principal{StaffUserId: r.staffUserId, Role: role})
+			}
+
+			return next(c)
+		}
+	})
+	registerApiRoutes(api, &httpAuthorization, &httpIdempotency, handlers)
+}
+
+func (r *RoutesSuite) send(route expectedRoute, role string, idempotencyKey string) *httptest.ResponseRecorder {
+	path := regexp.MustCompile(`:\w+`).ReplaceAllString(route.path, "0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	request := httptest.NewRequest(route.method, path, strings.NewReader(`{}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	if role != "" {
+		request.Header.Set("X-Test-Role", role)
+	}
+
+	if idempotencyKey != "" {
+		request.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	recorder := httptest.NewRecorder()
+	r.e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (r *RoutesSuite) TestRegisterApiRoutes_RegistersExactlyTheExpectedRoutes() {
+	expected := []string{}
+	for _, route := range r.expectedRoutes {
+		expected = append(expected, route.method+" "+route.path)
+	}
+
+	registered := []string{}
+	for _, route := range r.e.Routes() {
+		if route.Method != echo.RouteNotFound {
+			registered = append(registered, route.Method+" "+route.Path)
+		}
+	}
+
+	r.ElementsMatch(expected, registered)
+}
+
+func (r *RoutesSuite) TestRegisterApiRoutes_OnMissingPrincipal_RejectsOnlyProtectedRoutes() {
+	for _, route := range r.expectedRoutes {
+		recorder := r.send(route, "", "")
+
+		if len(route.permissions) == 0 {
+			r.Equal(http.StatusOK, recorder.Code, route.method+" "+route.path)
+		} else {
+			r.Equal(http.StatusUnauthorized, recorder.Code, route.method+" "+route.path)
+		}
+	}
+}
+
+func (r *RoutesSuite) TestRegisterApiRoutes_OnRoleWithoutRequiredPermission_ReturnsForbidden() {
+	for _, route := range r.expectedRoutes {
+		if len(route.permissions) == 0 {
+			continue
+		}
+
+		recorder := r.send(route, "NONE", "")
+
+		r.Equal(http.StatusForbidden, recorder.Code, route.method+" "+route.path)
+	}
+}
+
+func (r *RoutesSuite) TestRegisterApiRoutes_OnRoleWithAnyRequiredPermission_CallsHandler() {
+	for _, route := range r.expectedRoutes {
+		for _, permission := range route.permissions {
+			recorder := r.send(route, permission, "")
+
+			r.Equal(http.StatusOK, recorder.Code, route.method+" "+route.path+" with "+permission)
+		}
+	}
+}
+
+func (r *RoutesSuite) TestRegisterApiRoutes_OnRetriedIdempotencyKey_CallsHandlerOnceOnlyOnIdempotentRoutes() {
+	for _, route := range r.expectedRoutes {
+		role := ""
+		if len(route.permissions) > 0 {
+			role = route.permissions[0]
+		}
+
+		callsBefore := r.calls
+		key := uuid.NewString()
+		r.send(route, role, key)
+		r.send(route, role, key)
+
+		if route.idempotent {
+			r.Equal(1, r.calls-callsBefore, route.method+" "+route.path)
+		} else {
+			r.Equal(2, r.calls-callsBefore, route.method+" "+route.path)
+		}
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	suite.Run(t, new(RoutesSuite))
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+// LoadPasswordPolicy builds the password policy from the PASSWORD_* secrets, falling back to a minimum length of 8
+// and no other rule when they are not set. PASSWORD_BREACHED_LIST_PATH points to a local file with one password per
+// line, e.g. a list of the most common ones.
+func LoadPasswordPolicy(secretsGateway gateways.ISecretsGateway) (account.PasswordPolicy, error) {
+	minLength, err := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_MIN_LENGTH"), 10, 8)
+	if err != nil || minLength == 0 {
+		minLength = 8
+	}
+
+	requiredClasses, _ := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_REQUIRED_CHARACTER_CLASSES"), 10, 8)
+
+	var breachedPasswords []string
+	if breachedPasswordsPath := optionalSecret(secretsGateway, "PASSWORD_BREACHED_LIST_PATH"); breachedPasswordsPath != "" {
+		content, err := os.ReadFile(breachedPasswordsPath)
+		if err != nil {
+			return account.PasswordPolicy{}, err
+		}
+
+		breachedPasswords = strings.Split(string(content), "\n")
+	}
+
+	return account.NewPasswordPolicy(uint8(minLength), uint8(requiredClasses), breachedPasswords)
+}
+
+// LoadPasswordHasher builds the hasher new passwords are stored with from the PASSWORD_HASH_* secrets, defaulting to
+// bcrypt at cost 12.
+func LoadPasswordHasher(secretsGateway gateways.ISecretsGateway) (account.PasswordHasher, error) {
+	algorithm := optionalSecret(secretsGateway, "PASSWORD_HASH_ALGORITHM")
+	if algorithm == "" {
+		algorithm = "BCRYPT"
+	}
+
+	bcryptCost, err := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_BCRYPT_COST"), 10, 8)
+	if err != nil || bcryptCost == 0 {
+		bcryptCost = 12
+	}
+
+	argon2MemoryKib, err := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_ARGON2_MEMORY_KIB"), 10, 32)
+	if err != nil || argon2MemoryKib == 0 {
+		argon2MemoryKib = 64 * 1024
+	}
+
+	argon2Iterations, err := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_ARGON2_ITERATIONS"), 10, 32)
+	if err != nil || argon2Iterations == 0 {
+		argon2Iterations = 3
+	}
+
+	argon2Parallelism, err := strconv.ParseUint(optionalSecret(secretsGateway, "PASSWORD_ARGON2_PARALLELISM"), 10, 8)
+	if err != nil || argon2Parallelism == 0 {
+		argon2Parallelism = 2
+	}
+
+	return account.NewPasswordHasher(algorithm, int(bcryptCost), uint32(argon2MemoryKib), uint32(argon2Iterations),
+		uint8(argon2Parallelism))
+}
+
+func optionalSecret(secretsGateway gateways.ISecretsGateway, key string) string {
+	value, err := secretsGateway.Get(key)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Principal is the authenticated user acting on a request. Customers carry a customer id and staff users a staff
+// user id; Permissions are the ones granted to Role at the time of the request. Partner systems authenticated with an
+// API key carry its id instead, no role, and the scopes of the key as Permissions.
+type Principal struct {
+	CustomerId  uuid.UUID
+	StaffUserId uuid.UUID
+	ApiKeyId    uuid.UUID
+	Role        string
+	SessionId   uuid.UUID
+	Permissions []string
+}
+
+func (p Principal) IsCustomer() bool {
+	return p.CustomerId != uuid.Nil
+}
+
+func (p Principal) IsApiKey() bool {
+	return p.ApiKeyId != uuid.Nil
+}
+
+func (p Principal) HasPermission(permission string) bool {
+	return slices.Contains(p.Permissions, permission)
+}
+
+// MayAccess reports whether the principal may act on a resource owned by the given customer, either because it is
+// their own or because the principal holds the permission to act on anyone's.
+func (p Principal) MayAccess(ownerId uuid.UUID, anyOwnerPermission string) bool {
+	if p.HasPermission(anyOwnerPermission) {
+		return true
+	}
+
+	return p.IsCustomer() && p.CustomerId == ownerId
+}
@@ -0,0 +1,42 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrincipalSuite struct {
+	suite.Suite
+	customerId uuid.UUID
+}
+
+func (p *PrincipalSuite) SetupTest() {
+	p.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+}
+
+func (p *PrincipalSuite) TestMayAccess_OnOwnResource_ReturnsTrue() {
+	principal := auth.Principal{CustomerId: p.customerId, Role: "CUSTOMER", Permissions: []string{"bookings:read:own"}}
+
+	p.True(principal.MayAccess(p.customerId, "bookings:read:any"))
+	p.False(principal.MayAccess(uuid.New(), "bookings:read:any"))
+}
+
+func (p *PrincipalSuite) TestMayAccess_OnAnyOwnerPermission_ReturnsTrue() {
+	principal := auth.Principal{StaffUserId: uuid.New(), Role: "FRONT_DESK", Permissions: []string{"bookings:read:any"}}
+
+	p.True(principal.MayAccess(p.customerId, "bookings:read:any"))
+	p.False(principal.MayAccess(p.customerId, "bookings:write:any"))
+}
+
+func (p *PrincipalSuite) TestMayAccess_OnStaffWithoutPermission_ReturnsFalse() {
+	principal := auth.Principal{StaffUserId: uuid.New(), Role: "HOUSEKEEPER"}
+
+	p.False(principal.MayAccess(uuid.Nil, "bookings:read:any"))
+}
+
+func TestPrincipal(t *testing.T) {
+	suite.Run(t, new(PrincipalSuite))
+}
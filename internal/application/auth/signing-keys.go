@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+// SigningKey is one of the private keys access tokens are signed with. A key is published for verification as soon
+// as it is configured, signs tokens from ActivatesAt on and stops verifying them at RetiresAt, so a rotation is
+// scheduled by adding the next key ahead of time and retiring the previous one once its tokens have expired.
+type SigningKey struct {
+	Id          string
+	Algorithm   string
+	PrivateKey  crypto.Signer
+	ActivatesAt time.Time
+	RetiresAt   time.Time
+}
+
+type SigningKeys []SigningKey
+
+type signingKeyJson struct {
+	Id          string     `json:"kid"`
+	PrivateKey  string     `json:"privateKey"`
+	ActivatesAt time.Time  `json:"activatesAt"`
+	RetiresAt   *time.Time `json:"retiresAt,omitempty"`
+}
+
+func NewSigningKey(id string, algorithm string, activatesAt time.Time) (SigningKey, error) {
+	if id == "" {
+		return SigningKey{}, errors.New("signing key id must not be empty")
+	}
+
+	var privateKey crypto.Signer
+	var err error
+
+	switch algorithm {
+	case "RS256":
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "EdDSA":
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return SigningKey{}, errors.New("signing key algorithm must be RS256 or EdDSA")
+	}
+
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	return SigningKey{
+		Id:          id,
+		Algorithm:   algorithm,
+		PrivateKey:  privateKey,
+		ActivatesAt: activatesAt.UTC(),
+	}, nil
+}
+
+const defaultSigningKeysRefreshInterval = time.Minute
+
+// SigningKeysSource reads the key set from the JWT_SIGNING_KEYS secret and reuses it for RefreshInterval, one minute
+// when zero, so that signing, verifying and publishing keys do not cost a secrets round trip each. A key added or
+// retired in the secret takes effect within the interval, without a restart; since keys are published before they
+// activate, a scheduled rotation is picked up in time.
+type SigningKeysSource struct {
+	SecretsGateway  gateways.ISecretsGateway
+	RefreshInterval time.Duration
+	signingKeys     SigningKeys
+	loadedAt        time.Time
+	mutex           sync.Mutex
+}
+
+func (s *SigningKeysSource) Load() (SigningKeys, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	refreshInterval := s.RefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = defaultSigningKeysRefreshInterval
+	}
+
+	if s.signingKeys != nil && time.Since(s.loadedAt) < refreshInterval {
+		return s.signingKeys, nil
+	}
+
+	rawSigningKeys, err := s.SecretsGateway.Get("JWT_SIGNING_KEYS")
+	if err != nil {
+		return nil, err
+	}
+
+	signingKeys, err := ParseSigningKeys(rawSigningKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	s.signingKeys = signingKeys
+	s.loadedAt = time.Now()
+	return signingKeys, nil
+}
+
+func ParseSigningKeys(rawSigningKeys string) (SigningKeys, error) {
+	var signingKeys SigningKeys
+
+	err := json.Unmarshal([]byte(rawSigningKeys), &signingKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]bool{}
+	for _, signingKey := range signingKeys {
+		if ids[signingKey.Id] {
+			return nil, fmt.Errorf("signing key %s is configured more than once", signingKey.Id)
+		}
+
+		ids[signingKey.Id] = true
+	}
+
+	return signingKeys, nil
+}
+
+// Current returns the key new tokens are signed with: the most recently activated key that has not been retired.
+func (s SigningKeys) Current(now time.Time) (SigningKey, error) {
+	var current *SigningKey
+
+	for i, signingKey := range s {
+		if signingKey.ActivatesAt.After(now) || signingKey.IsRetired(now) {
+			continue
+		}
+
+		if current == nil || signingKey.ActivatesAt.After(current.ActivatesAt) {
+			current = &s[i]
+		}
+	}
+
+	if current == nil {
+		return SigningKey{}, errors.New("no signing key is active")
+	}
+
+	return *current, nil
+}
+
+// Find returns the key a token with the given kid must be verified with.
+func (s SigningKeys) Find(id string, now time.Time) (SigningKey, bool) {
+	for _, signingKey := range s.Published(now) {
+		if signingKey.Id == id {
+			return signingKey, true
+		}
+	}
+
+	return SigningKey{}, false
+}
+
+// Published returns the keys other services should accept, including keys scheduled to sign in the future so that
+// they are cached before the first token signed with them shows up.
+func (s SigningKeys) Published(now time.Time) SigningKeys {
+	published := SigningKeys{}
+
+	for _, signingKey := range s {
+		if !signingKey.IsRetired(now) {
+			published = append(published, signingKey)
+		}
+	}
+
+	return published
+}
+
+func (s SigningKey) IsRetired(now time.Time) bool {
+	return !s.RetiresAt.IsZero() && !now.Before(s.RetiresAt)
+}
+
+func (s SigningKey) PublicKey() crypto.PublicKey {
+	return s.PrivateKey.Public()
+}
+
+func (s SigningKey) MarshalJSON() ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(s.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signingKeyJson := signingKeyJson{
+		Id:          s.Id,
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ActivatesAt: s.ActivatesAt,
+	}
+
+	if !s.RetiresAt.IsZero() {
+		signingKeyJson.RetiresAt = &s.RetiresAt
+	}
+
+	return json.Marshal(signingKeyJson)
+}
+
+func (s *SigningKey) UnmarshalJSON(data []byte) error {
+	var signingKeyJson signingKeyJson
+
+	err := json.Unmarshal(data, &signingKeyJson)
+	if err != nil {
+		return err
+	}
+
+	if signingKeyJson.Id == "" {
+		return errors.New("signing key id must not be empty")
+	}
+
+	block, _ := pem.Decode([]byte(signingKeyJson.PrivateKey))
+	if block == nil {
+		return fmt.Errorf("signing key %s must be a PEM encoded private key", signingKeyJson.Id)
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("signing key %s must be a PKCS #8 private key: %w", signingKeyJson.Id, err)
+	}
+
+	*s = SigningKey{Id: signingKeyJson.Id, ActivatesAt: signingKeyJson.ActivatesAt}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		s.Algorithm = "RS256"
+		s.PrivateKey = key
+	case ed25519.PrivateKey:
+		s.Algorithm = "EdDSA"
+		s.PrivateKey = key
+	default:
+		return fmt.Errorf("signing key %s must be an RSA or Ed25519 key", signingKeyJson.Id)
+	}
+
+	if signingKeyJson.RetiresAt != nil {
+		s.RetiresAt = *signingKeyJson.RetiresAt
+	}
+
+	return nil
+}
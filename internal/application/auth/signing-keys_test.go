@@ -0,0 +1,154 @@
+package auth_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/stretchr/testify/suite"
+)
+
+type SigningKeysSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (s *SigningKeysSuite) SetupTest() {
+	s.now = time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *SigningKeysSuite) newSigningKey(id string, algorithm string, activatesAt time.Time) auth.SigningKey {
+	signingKey, err := auth.NewSigningKey(id, algorithm, activatesAt)
+	s.Require().NoError(err)
+	return signingKey
+}
+
+func (s *SigningKeysSuite) TestParseSigningKeys_OnMarshalledKeys_ReturnsTheSameKeys() {
+	rsaKey := s.newSigningKey("2030-05", "RS256", s.now.AddDate(0, -1, 0))
+	rsaKey.RetiresAt = s.now.AddDate(0, 0, 1)
+	edKey := s.newSigningKey("2030-06", "EdDSA", s.now.AddDate(0, 0, -1))
+
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys{rsaKey, edKey})
+	s.Require().NoError(err)
+
+	signingKeys, err := auth.ParseSigningKeys(string(rawSigningKeys))
+	s.Require().NoError(err)
+
+	s.Len(signingKeys, 2)
+	s.Equal("2030-05", signingKeys[0].Id)
+	s.Equal("RS256", signingKeys[0].Algorithm)
+	s.Equal(rsaKey.RetiresAt, signingKeys[0].RetiresAt)
+	s.Equal(rsaKey.PublicKey(), signingKeys[0].PublicKey())
+	s.Equal("2030-06", signingKeys[1].Id)
+	s.Equal("EdDSA", signingKeys[1].Algorithm)
+	s.True(signingKeys[1].RetiresAt.IsZero())
+	s.Equal(edKey.PublicKey(), signingKeys[1].PublicKey())
+}
+
+func (s *SigningKeysSuite) TestParseSigningKeys_OnDuplicatedId_ReturnsError() {
+	signingKey := s.newSigningKey("2030-06", "EdDSA", s.now)
+
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys{signingKey, signingKey})
+	s.Require().NoError(err)
+
+	_, err = auth.ParseSigningKeys(string(rawSigningKeys))
+
+	s.EqualError(err, "signing key 2030-06 is configured more than once")
+}
+
+func (s *SigningKeysSuite) TestParseSigningKeys_OnInvalidPrivateKey_ReturnsError() {
+	_, err := auth.ParseSigningKeys(`[{"kid": "2030-06", "privateKey": "abc", "activatesAt": "2030-06-01T00:00:00Z"}]`)
+
+	s.EqualError(err, "signing key 2030-06 must be a PEM encoded private key")
+}
+
+func (s *SigningKeysSuite) TestNewSigningKey_OnUnsupportedAlgorithm_ReturnsError() {
+	_, err := auth.NewSigningKey("2030-06", "HS256", s.now)
+
+	s.EqualError(err, "signing key algorithm must be RS256 or EdDSA")
+}
+
+func (s *SigningKeysSuite) TestCurrent_OnScheduledRotation_ReturnsTheLatestActivatedKey() {
+	previousKey := s.newSigningKey("2030-05", "EdDSA", s.now.AddDate(0, -1, 0))
+	currentKey := s.newSigningKey("2030-06", "EdDSA", s.now.AddDate(0, 0, -1))
+	nextKey := s.newSigningKey("2030-07", "EdDSA", s.now.AddDate(0, 1, 0))
+	signingKeys := auth.SigningKeys{previousKey, nextKey, currentKey}
+
+	signingKey, err := signingKeys.Current(s.now)
+	s.Require().NoError(err)
+	s.Equal("2030-06", signingKey.Id)
+
+	signingKey, err = signingKeys.Current(s.now.AddDate(0, 1, 0))
+	s.Require().NoError(err)
+	s.Equal("2030-07", signingKey.Id)
+}
+
+func (s *SigningKeysSuite) TestCurrent_OnlyRetiredOrScheduledKeys_ReturnsError() {
+	retiredKey := s.newSigningKey("2030-05", "EdDSA", s.now.AddDate(0, -1, 0))
+	retiredKey.RetiresAt = s.now
+	nextKey := s.newSigningKey("2030-07", "EdDSA", s.now.AddDate(0, 1, 0))
+
+	_, err := auth.SigningKeys{retiredKey, nextKey}.Current(s.now)
+
+	s.EqualError(err, "no signing key is active")
+}
+
+func (s *SigningKeysSuite) TestFind_OnRetiredKey_ReturnsFalse() {
+	retiredKey := s.newSigningKey("2030-05", "EdDSA", s.now.AddDate(0, -1, 0))
+	retiredKey.RetiresAt = s.now.Add(-time.Minute)
+	nextKey := s.newSigningKey("2030-07", "EdDSA", s.now.AddDate(0, 1, 0))
+	signingKeys := auth.SigningKeys{retiredKey, nextKey}
+
+	_, ok := signingKeys.Find("2030-05", s.now)
+	s.False(ok)
+
+	signingKey, ok := signingKeys.Find("2030-07", s.now)
+	s.True(ok)
+	s.Equal(nextKey.PublicKey(), signingKey.PublicKey())
+	s.Len(signingKeys.Published(s.now), 1)
+}
+
+func (s *SigningKeysSuite) TestLoad_WithinRefreshInterval_ReusesTheLoadedKeys() {
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys{s.newSigningKey("2030-06", "EdDSA", s.now)})
+	s.Require().NoError(err)
+	fakeSecretsGateway := gateways.FakeSecretsGateway{
+		Secrets: map[string]string{"JWT_SIGNING_KEYS": string(rawSigningKeys)},
+	}
+	signingKeysSource := auth.SigningKeysSource{SecretsGateway: &fakeSecretsGateway}
+
+	_, err = signingKeysSource.Load()
+	s.Require().NoError(err)
+	fakeSecretsGateway.Secrets["JWT_SIGNING_KEYS"] = "invalid"
+	signingKeys, err := signingKeysSource.Load()
+
+	s.Require().NoError(err)
+	s.Len(signingKeys, 1)
+	s.Equal("2030-06", signingKeys[0].Id)
+}
+
+func (s *SigningKeysSuite) TestLoad_AfterRefreshInterval_ReadsTheSecretAgain() {
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys{s.newSigningKey("2030-06", "EdDSA", s.now)})
+	s.Require().NoError(err)
+	fakeSecretsGateway := gateways.FakeSecretsGateway{
+		Secrets: map[string]string{"JWT_SIGNING_KEYS": string(rawSigningKeys)},
+	}
+	signingKeysSource := auth.SigningKeysSource{SecretsGateway: &fakeSecretsGateway, RefreshInterval: time.Nanosecond}
+
+	_, err = signingKeysSource.Load()
+	s.Require().NoError(err)
+	rawSigningKeys, err = json.Marshal(auth.SigningKeys{s.newSigningKey("2030-07", "EdDSA", s.now)})
+	s.Require().NoError(err)
+	fakeSecretsGateway.Secrets["JWT_SIGNING_KEYS"] = string(rawSigningKeys)
+	time.Sleep(time.Millisecond)
+	signingKeys, err := signingKeysSource.Load()
+
+	s.Require().NoError(err)
+	s.Len(signingKeys, 1)
+	s.Equal("2030-07", signingKeys[0].Id)
+}
+
+func TestSigningKeys(t *testing.T) {
+	suite.Run(t, new(SigningKeysSuite))
+}
@@ -1,16 +1,29 @@
 package gateways
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type CustomerDTO struct {
 	Id             uuid.UUID
 	Name           string
 	Email          string
 	HashedPassword string
+	Status         string
+	// VerificationEmailSentAt is zero when no verification email has been sent, as for customers who signed up
+	// before email verification existed.
+	VerificationEmailSentAt time.Time
 }
 
 type ICustomersGateway interface {
 	Create(customerDTO CustomerDTO) error
 	FindOneByEmail(email string) (*CustomerDTO, error)
+	FindOneById(id uuid.UUID) (*CustomerDTO, error)
 	ExistsByEmail(email string) (bool, error)
+	ExistsById(id uuid.UUID) (bool, error)
+	UpdatePassword(id uuid.UUID, hashedPassword string) error
+	MarkEmailVerified(id uuid.UUID) error
+	UpdateVerificationEmailSentAt(id uuid.UUID, sentAt time.Time) error
 }
@@ -0,0 +1,11 @@
+package gateways
+
+type EmailDTO struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type IEmailGateway interface {
+	Send(email EmailDTO) error
+}
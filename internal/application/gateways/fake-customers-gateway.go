@@ -1,5 +1,11 @@
 package gateways
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type FakeCustomersGateway struct {
 	CustomersDTO []CustomerDTO
 }
@@ -19,6 +25,16 @@ func (f *FakeCustomersGateway) FindOneByEmail(email string) (*CustomerDTO, error
 	return nil, nil
 }
 
+func (f *FakeCustomersGateway) FindOneById(id uuid.UUID) (*CustomerDTO, error) {
+	for _, customerDTO := range f.CustomersDTO {
+		if customerDTO.Id == id {
+			return &customerDTO, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (f *FakeCustomersGateway) ExistsByEmail(email string) (bool, error) {
 	for _, customerDTO := range f.CustomersDTO {
 		if customerDTO.Email == email {
@@ -28,3 +44,46 @@ func (f *FakeCustomersGateway) ExistsByEmail(email string) (bool, error) {
 
 	return false, nil
 }
+
+func (f *FakeCustomersGateway) ExistsById(id uuid.UUID) (bool, error) {
+	for _, customerDTO := range f.CustomersDTO {
+		if customerDTO.Id == id {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *FakeCustomersGateway) UpdatePassword(id uuid.UUID, hashedPassword string) error {
+	for i := range f.CustomersDTO {
+		if f.CustomersDTO[i].Id == id {
+			f.CustomersDTO[i].HashedPassword = hashedPassword
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeCustomersGateway) MarkEmailVerified(id uuid.UUID) error {
+	for i := range f.CustomersDTO {
+		if f.CustomersDTO[i].Id == id {
+			f.CustomersDTO[i].Status = "VERIFIED"
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeCustomersGateway) UpdateVerificationEmailSentAt(id uuid.UUID, sentAt time.Time) error {
+	for i := range f.CustomersDTO {
+		if f.CustomersDTO[i].Id == id {
+			f.CustomersDTO[i].VerificationEmailSentAt = sentAt
+			return nil
+		}
+	}
+
+	return nil
+}
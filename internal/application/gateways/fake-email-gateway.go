@@ -0,0 +1,21 @@
+package gateways
+
+import "sync"
+
+type FakeEmailGateway struct {
+	SentEmails []EmailDTO
+	SendErr    error
+	mutex      sync.Mutex
+}
+
+func (f *FakeEmailGateway) Send(email EmailDTO) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.SendErr != nil {
+		return f.SendErr
+	}
+
+	f.SentEmails = append(f.SentEmails, email)
+	return nil
+}
@@ -0,0 +1,36 @@
+package gateways
+
+import (
+	"errors"
+	"net/url"
+	"sync"
+)
+
+type FakeOidcGateway struct {
+	// Identities are returned by ExchangeCode, keyed by authorization code.
+	Identities            map[string]OidcIdentityDTO
+	AuthorizationRequests []OidcAuthorizationRequestDTO
+	CodeVerifiers         []string
+	mutex                 sync.Mutex
+}
+
+func (f *FakeOidcGateway) AuthorizationUrl(provider OidcProviderDTO, request OidcAuthorizationRequestDTO) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.AuthorizationRequests = append(f.AuthorizationRequests, request)
+	return provider.Issuer + "/authorize?" + url.Values{"state": {request.State}}.Encode(), nil
+}
+
+func (f *FakeOidcGateway) ExchangeCode(provider OidcProviderDTO, code string, codeVerifier string) (OidcIdentityDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	identity, ok := f.Identities[code]
+	if !ok {
+		return OidcIdentityDTO{}, errors.New("identity provider rejected the authorization code")
+	}
+
+	f.CodeVerifiers = append(f.CodeVerifiers, codeVerifier)
+	return identity, nil
+}
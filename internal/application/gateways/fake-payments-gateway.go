@@ -0,0 +1,113 @@
+package gateways
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+type FakePaymentTransaction struct {
+	Id               string
+	Reference        uuid.UUID
+	AuthorizedAmount uint64
+	CapturedAmount   uint64
+	RefundedAmount   uint64
+	Status           string
+}
+
+type FakePaymentsGateway struct {
+	Transactions          []FakePaymentTransaction
+	DeclinedPaymentTokens []string
+	mutex                 sync.Mutex
+}
+
+func (f *FakePaymentsGateway) Authorize(reference uuid.UUID, paymentToken string, amount uint64) (PaymentResultDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, declinedPaymentToken := range f.DeclinedPaymentTokens {
+		if declinedPaymentToken == paymentToken {
+			return PaymentResultDTO{Approved: false, DeclineReason: "card declined"}, nil
+		}
+	}
+
+	transaction := FakePaymentTransaction{
+		Id:               uuid.NewString(),
+		Reference:        reference,
+		AuthorizedAmount: amount,
+		Status:           "AUTHORIZED",
+	}
+	f.Transactions = append(f.Transactions, transaction)
+
+	return PaymentResultDTO{TransactionId: transaction.Id, Approved: true}, nil
+}
+
+func (f *FakePaymentsGateway) Capture(transactionId string, amount uint64) (PaymentResultDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	transaction, err := f.findTransaction(transactionId)
+	if err != nil {
+		return PaymentResultDTO{}, err
+	}
+
+	if transaction.Status != "AUTHORIZED" {
+		return PaymentResultDTO{TransactionId: transactionId, DeclineReason: "transaction is not authorized"}, nil
+	}
+
+	if amount > transaction.AuthorizedAmount {
+		return PaymentResultDTO{TransactionId: transactionId, DeclineReason: "amount exceeds authorized amount"}, nil
+	}
+
+	transaction.CapturedAmount = amount
+	transaction.Status = "CAPTURED"
+
+	return PaymentResultDTO{TransactionId: transactionId, Approved: true}, nil
+}
+
+func (f *FakePaymentsGateway) Void(transactionId string) (PaymentResultDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	transaction, err := f.findTransaction(transactionId)
+	if err != nil {
+		return PaymentResultDTO{}, err
+	}
+
+	if transaction.Status != "AUTHORIZED" {
+		return PaymentResultDTO{TransactionId: transactionId, DeclineReason: "transaction is not authorized"}, nil
+	}
+
+	transaction.Status = "VOIDED"
+
+	return PaymentResultDTO{TransactionId: transactionId, Approved: true}, nil
+}
+
+func (f *FakePaymentsGateway) Refund(transactionId string, amount uint64) (PaymentResultDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	transaction, err := f.findTransaction(transactionId)
+	if err != nil {
+		return PaymentResultDTO{}, err
+	}
+
+	if transaction.Status != "CAPTURED" || transaction.RefundedAmount+amount > transaction.CapturedAmount {
+		return PaymentResultDTO{TransactionId: transactionId, DeclineReason: "amount exceeds refundable amount"}, nil
+	}
+
+	transaction.RefundedAmount += amount
+
+	return PaymentResultDTO{TransactionId: transactionId, Approved: true}, nil
+}
+
+func (f *FakePaymentsGateway) findTransaction(transactionId string) (*FakePaymentTransaction, error) {
+	for i := range f.Transactions {
+		if f.Transactions[i].Id == transactionId {
+			return &f.Transactions[i], nil
+		}
+	}
+
+	return nil, errors.New("transaction not found")
+}
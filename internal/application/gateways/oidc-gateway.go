@@ -0,0 +1,35 @@
+package gateways
+
+// OidcProviderDTO is one entry of the OIDC_PROVIDERS secret. Everything else the login needs, such as the endpoints
+// and the keys ID tokens are signed with, is discovered from the issuer.
+type OidcProviderDTO struct {
+	Name         string   `json:"name"`
+	Issuer       string   `json:"issuer"`
+	ClientId     string   `json:"clientId"`
+	ClientSecret string   `json:"clientSecret"`
+	RedirectUrl  string   `json:"redirectUrl"`
+	Scopes       []string `json:"scopes"`
+}
+
+type OidcAuthorizationRequestDTO struct {
+	State         string
+	Nonce         string
+	CodeChallenge string
+}
+
+// OidcIdentityDTO holds the claims of an ID token whose signature, issuer, audience and expiry have been verified.
+type OidcIdentityDTO struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Nonce         string
+}
+
+type IOidcGateway interface {
+	AuthorizationUrl(provider OidcProviderDTO, request OidcAuthorizationRequestDTO) (string, error)
+	// ExchangeCode fails with "identity provider rejected the authorization code" when the provider refuses the code
+	// or the PKCE verifier, and with "identity provider returned an invalid id token" when the ID token does not
+	// verify.
+	ExchangeCode(provider OidcProviderDTO, code string, codeVerifier string) (OidcIdentityDTO, error)
+}
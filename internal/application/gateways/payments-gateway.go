@@ -0,0 +1,16 @@
+package gateways
+
+import "github.com/google/uuid"
+
+type PaymentResultDTO struct {
+	TransactionId string
+	Approved      bool
+	DeclineReason string
+}
+
+type IPaymentsGateway interface {
+	Authorize(reference uuid.UUID, paymentToken string, amount uint64) (PaymentResultDTO, error)
+	Capture(transactionId string, amount uint64) (PaymentResultDTO, error)
+	Void(transactionId string) (PaymentResultDTO, error)
+	Refund(transactionId string, amount uint64) (PaymentResultDTO, error)
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+
+type IAddOnsRepository interface {
+	Create(addOn addon.AddOn) error
+	ExistsByCode(code string) (bool, error)
+	FindAll() ([]addon.AddOn, error)
+	FindAllByCodes(codes []string) ([]addon.AddOn, error)
+}
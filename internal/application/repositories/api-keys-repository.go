@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+)
+
+type IApiKeysRepository interface {
+	Create(apiKey apikey.ApiKey) error
+	FindOneById(id uuid.UUID) (*apikey.ApiKey, error)
+	FindOneByPrefix(prefix string) (*apikey.ApiKey, error)
+	UpdateLastUsedAt(id uuid.UUID, lastUsedAt time.Time) error
+	// Revoke fails with "api key is already revoked" when another request revoked the key first.
+	Revoke(apiKey apikey.ApiKey) error
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type IBookingsRepository interface {
+	Create(booking booking.Booking) error
+	// CreateWithPayments stores the booking together with its payments and scheduled charges in one transaction, so
+	// a booking is never left without the payment records taken for it.
+	CreateWithPayments(booking booking.Booking, payments []payment.Payment, scheduledCharges []payment.ScheduledCharge) error
+	Update(booking booking.Booking) error
+	FindOneById(bookingId uuid.UUID) (*booking.Booking, error)
+	ExistsOverlapping(roomIds []uuid.UUID, checkIn time.Time, checkOut time.Time) (bool, error)
+	FindOccupancy(from time.Time, to time.Time) ([]pricing.Occupancy, error)
+}
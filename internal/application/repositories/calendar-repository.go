@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+)
+
+type ICalendarRepository interface {
+	FindDays(roomType string, from time.Time, to time.Time) ([]calendar.CalendarDay, error)
+	SaveRestrictions(restrictions []calendar.StayRestriction) error
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type ICreditEntriesRepository interface {
+	Append(entry credit.CreditEntry) (credit.CreditEntry, error)
+	FindAllByCustomerId(customerId uuid.UUID) ([]credit.CreditEntry, error)
+}
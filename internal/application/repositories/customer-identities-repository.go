@@ -0,0 +1,8 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+
+type ICustomerIdentitiesRepository interface {
+	Create(customerIdentity account.CustomerIdentity) error
+	FindOne(provider string, subject string) (*account.CustomerIdentity, error)
+}
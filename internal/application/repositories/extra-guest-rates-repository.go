@@ -0,0 +1,8 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+
+type IExtraGuestRatesRepository interface {
+	Save(rate pricing.ExtraGuestRate) error
+	FindAll() ([]pricing.ExtraGuestRate, error)
+}
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"slices"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+)
+
+type FakeAddOnsRepository struct {
+	AddOns []addon.AddOn
+}
+
+func (f *FakeAddOnsRepository) Create(addOn addon.AddOn) error {
+	f.AddOns = append(f.AddOns, addOn)
+	return nil
+}
+
+func (f *FakeAddOnsRepository) ExistsByCode(code string) (bool, error) {
+	for _, addOn := range f.AddOns {
+		if addOn.Code == code {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *FakeAddOnsRepository) FindAll() ([]addon.AddOn, error) {
+	return f.AddOns, nil
+}
+
+func (f *FakeAddOnsRepository) FindAllByCodes(codes []string) ([]addon.AddOn, error) {
+	addOns := []addon.AddOn{}
+
+	for _, addOn := range f.AddOns {
+		if slices.Contains(codes, addOn.Code) {
+			addOns = append(addOns, addOn)
+		}
+	}
+
+	return addOns, nil
+}
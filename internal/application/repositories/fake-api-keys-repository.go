@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+)
+
+type FakeApiKeysRepository struct {
+	ApiKeys []apikey.ApiKey
+	mutex   sync.Mutex
+}
+
+func (f *FakeApiKeysRepository) Create(apiKey apikey.ApiKey) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.ApiKeys = append(f.ApiKeys, apiKey)
+	return nil
+}
+
+func (f *FakeApiKeysRepository) FindOneById(id uuid.UUID) (*apikey.ApiKey, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, apiKey := range f.ApiKeys {
+		if apiKey.Id == id {
+			return &apiKey, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeApiKeysRepository) FindOneByPrefix(prefix string) (*apikey.ApiKey, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, apiKey := range f.ApiKeys {
+		if apiKey.Prefix == prefix {
+			return &apiKey, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeApiKeysRepository) UpdateLastUsedAt(id uuid.UUID, lastUsedAt time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.ApiKeys {
+		if f.ApiKeys[i].Id == id {
+			f.ApiKeys[i].LastUsedAt = lastUsedAt
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeApiKeysRepository) Revoke(apiKey apikey.ApiKey) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.ApiKeys {
+		if f.ApiKeys[i].Id != apiKey.Id {
+			continue
+		}
+
+		if f.ApiKeys[i].IsRevoked() {
+			return errors.New("api key is already revoked")
+		}
+
+		f.ApiKeys[i].RevokedAt = apiKey.RevokedAt
+		return nil
+	}
+
+	return errors.New("api key not found")
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type FakeBookingsRepository struct {
+	Bookings         []booking.Booking
+	Occupancies      []pricing.Occupancy
+	Payments         []payment.Payment
+	ScheduledCharges []payment.ScheduledCharge
+	CreateErr        error
+}
+
+func (f *FakeBookingsRepository) Create(booking booking.Booking) error {
+	return f.CreateWithPayments(booking, nil, nil)
+}
+
+func (f *FakeBookingsRepository) CreateWithPayments(booking booking.Booking, payments []payment.Payment,
+	scheduledCharges []payment.ScheduledCharge) error {
+	if f.CreateErr != nil {
+		return f.CreateErr
+	}
+
+	f.Bookings = append(f.Bookings, booking)
+	f.Payments = append(f.Payments, payments...)
+	f.ScheduledCharges = append(f.ScheduledCharges, scheduledCharges...)
+	return nil
+}
+
+func (f *FakeBookingsRepository) Update(booking booking.Booking) error {
+	for i := range f.Bookings {
+		if f.Bookings[i].Id == booking.Id {
+			f.Bookings[i] = booking
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeBookingsRepository) FindOneById(bookingId uuid.UUID) (*booking.Booking, error) {
+	for _, booking := range f.Bookings {
+		if booking.Id == bookingId {
+			return &booking, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeBookingsRepository) ExistsOverlapping(roomIds []uuid.UUID, checkIn time.Time, checkOut time.Time) (bool, error) {
+	for _, booking := range f.Bookings {
+		if booking.Status == "CANCELLED" {
+			continue
+		}
+
+		if !booking.CheckIn.Before(checkOut) || !booking.CheckOut.After(checkIn) {
+			continue
+		}
+
+		for _, bookedRoomId := range booking.RoomIds {
+			for _, roomId := range roomIds {
+				if bookedRoomId == roomId {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (f *FakeBookingsRepository) FindOccupancy(from time.Time, to time.Time) ([]pricing.Occupancy, error) {
+	occupancies := []pricing.Occupancy{}
+
+	for _, occupancy := range f.Occupancies {
+		if !occupancy.Date.Before(from) && occupancy.Date.Before(to) {
+			occupancies = append(occupancies, occupancy)
+		}
+	}
+
+	return occupancies, nil
+}
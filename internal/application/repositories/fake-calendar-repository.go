@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+)
+
+type FakeCalendarRepository struct {
+	Days         []calendar.CalendarDay
+	Restrictions []calendar.StayRestriction
+}
+
+func (f *FakeCalendarRepository) FindDays(roomType string, from time.Time, to time.Time) ([]calendar.CalendarDay, error) {
+	days := []calendar.CalendarDay{}
+
+	for _, day := range f.Days {
+		if !day.Date.Before(from) && !day.Date.After(to) {
+			days = append(days, day)
+		}
+	}
+
+	return days, nil
+}
+
+func (f *FakeCalendarRepository) SaveRestrictions(restrictions []calendar.StayRestriction) error {
+	for _, restriction := range restrictions {
+		replaced := false
+
+		for i := range f.Restrictions {
+			if f.Restrictions[i].RoomType == restriction.RoomType && f.Restrictions[i].Date.Equal(restriction.Date) {
+				f.Restrictions[i] = restriction
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			f.Restrictions = append(f.Restrictions, restriction)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type FakeCreditEntriesRepository struct {
+	CreditEntries []credit.CreditEntry
+	mutex         sync.Mutex
+}
+
+func (f *FakeCreditEntriesRepository) Append(entry credit.CreditEntry) (credit.CreditEntry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var balance uint64
+	for _, creditEntry := range f.CreditEntries {
+		if creditEntry.CustomerId == entry.CustomerId {
+			balance = creditEntry.BalanceAfter
+		}
+	}
+
+	if err := entry.Apply(balance); err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	f.CreditEntries = append(f.CreditEntries, entry)
+
+	return entry, nil
+}
+
+func (f *FakeCreditEntriesRepository) FindAllByCustomerId(customerId uuid.UUID) ([]credit.CreditEntry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	creditEntries := []credit.CreditEntry{}
+
+	for _, creditEntry := range f.CreditEntries {
+		if creditEntry.CustomerId == customerId {
+			creditEntries = append(creditEntries, creditEntry)
+		}
+	}
+
+	return creditEntries, nil
+}
@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"sync"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type FakeCustomerIdentitiesRepository struct {
+	CustomerIdentities []account.CustomerIdentity
+	mutex              sync.Mutex
+}
+
+func (f *FakeCustomerIdentitiesRepository) Create(customerIdentity account.CustomerIdentity) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.CustomerIdentities = append(f.CustomerIdentities, customerIdentity)
+	return nil
+}
+
+func (f *FakeCustomerIdentitiesRepository) FindOne(provider string, subject string) (*account.CustomerIdentity, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, customerIdentity := range f.CustomerIdentities {
+		if customerIdentity.Provider == provider && customerIdentity.Subject == subject {
+			return &customerIdentity, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+
+type FakeExtraGuestRatesRepository struct {
+	Rates []pricing.ExtraGuestRate
+}
+
+func (f *FakeExtraGuestRatesRepository) Save(rate pricing.ExtraGuestRate) error {
+	for i := range f.Rates {
+		if f.Rates[i].RoomType == rate.RoomType {
+			f.Rates[i] = rate
+			return nil
+		}
+	}
+
+	f.Rates = append(f.Rates, rate)
+	return nil
+}
+
+func (f *FakeExtraGuestRatesRepository) FindAll() ([]pricing.ExtraGuestRate, error) {
+	return f.Rates, nil
+}
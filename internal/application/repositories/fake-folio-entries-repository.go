@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+)
+
+type FakeFolioEntriesRepository struct {
+	FolioEntries []folio.FolioEntry
+}
+
+func (f *FakeFolioEntriesRepository) Create(entry folio.FolioEntry) error {
+	f.FolioEntries = append(f.FolioEntries, entry)
+	return nil
+}
+
+func (f *FakeFolioEntriesRepository) Update(entry folio.FolioEntry) error {
+	for i := range f.FolioEntries {
+		if f.FolioEntries[i].Id == entry.Id {
+			f.FolioEntries[i] = entry
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeFolioEntriesRepository) FindOneById(id uuid.UUID) (*folio.FolioEntry, error) {
+	for _, entry := range f.FolioEntries {
+		if entry.Id == id {
+			return &entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeFolioEntriesRepository) FindAllByBookingId(bookingId uuid.UUID) ([]folio.FolioEntry, error) {
+	entries := []folio.FolioEntry{}
+
+	for _, entry := range f.FolioEntries {
+		if entry.BookingId == bookingId {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type FakeGiftCardsRepository struct {
+	GiftCards               []credit.GiftCard
+	CreditEntriesRepository *FakeCreditEntriesRepository
+}
+
+func (f *FakeGiftCardsRepository) Create(giftCard credit.GiftCard) error {
+	f.GiftCards = append(f.GiftCards, giftCard)
+	return nil
+}
+
+func (f *FakeGiftCardsRepository) FindOneByCode(code string) (*credit.GiftCard, error) {
+	for _, giftCard := range f.GiftCards {
+		if giftCard.Code == code {
+			return &giftCard, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeGiftCardsRepository) Redeem(giftCard credit.GiftCard, entry credit.CreditEntry) (credit.CreditEntry, error) {
+	for i := range f.GiftCards {
+		if f.GiftCards[i].Id != giftCard.Id {
+			continue
+		}
+
+		if f.GiftCards[i].Status != "ACTIVE" {
+			return credit.CreditEntry{}, errors.New("gift card has already been redeemed")
+		}
+
+		appendedEntry, err := f.CreditEntriesRepository.Append(entry)
+		if err != nil {
+			return credit.CreditEntry{}, err
+		}
+
+		f.GiftCards[i] = giftCard
+
+		return appendedEntry, nil
+	}
+
+	return credit.CreditEntry{}, errors.New("gift card not found")
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+)
+
+type FakeIdempotencyRecord struct {
+	Record    IdempotencyRecordDTO
+	CreatedAt time.Time
+}
+
+type FakeIdempotencyKeysRepository struct {
+	Records []FakeIdempotencyRecord
+	mutex   sync.Mutex
+}
+
+func (f *FakeIdempotencyKeysRepository) Reserve(scope string, key string, requestHash string,
+	expiredBefore time.Time) (*IdempotencyRecordDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i, stored := range f.Records {
+		if stored.Record.Scope != scope || stored.Record.Key != key {
+			continue
+		}
+
+		if stored.CreatedAt.Before(expiredBefore) {
+			f.Records = append(f.Records[:i], f.Records[i+1:]...)
+			break
+		}
+
+		record := stored.Record
+		return &record, nil
+	}
+
+	f.Records = append(f.Records, FakeIdempotencyRecord{
+		Record:    IdempotencyRecordDTO{Scope: scope, Key: key, RequestHash: requestHash},
+		CreatedAt: time.Now().UTC(),
+	})
+
+	return nil, nil
+}
+
+func (f *FakeIdempotencyKeysRepository) Complete(record IdempotencyRecordDTO) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.Records {
+		if f.Records[i].Record.Scope == record.Scope && f.Records[i].Record.Key == record.Key {
+			f.Records[i].Record = record
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeIdempotencyKeysRepository) Release(scope string, key string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.Records {
+		if f.Records[i].Record.Scope == scope && f.Records[i].Record.Key == key {
+			f.Records = append(f.Records[:i], f.Records[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
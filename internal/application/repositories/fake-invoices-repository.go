@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+)
+
+type FakeInvoicesRepository struct {
+	Invoices  []invoice.Invoice
+	Sequences map[string]uint64
+	mutex     sync.Mutex
+}
+
+func (f *FakeInvoicesRepository) Issue(newInvoice invoice.Invoice) (invoice.Invoice, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if newInvoice.Type == "CREDIT_NOTE" && invoice.IsCredited(f.Invoices, newInvoice.CorrectsInvoiceId) {
+		return invoice.Invoice{}, errors.New("invoice has already been credited")
+	}
+
+	if f.Sequences == nil {
+		f.Sequences = map[string]uint64{}
+	}
+
+	f.Sequences[newInvoice.PropertyCode]++
+	newInvoice.AssignNumber(f.Sequences[newInvoice.PropertyCode])
+	f.Invoices = append(f.Invoices, newInvoice)
+
+	return newInvoice, nil
+}
+
+func (f *FakeInvoicesRepository) FindOneById(id uuid.UUID) (*invoice.Invoice, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, document := range f.Invoices {
+		if document.Id == id {
+			return &document, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeInvoicesRepository) FindAllByBookingId(bookingId uuid.UUID) ([]invoice.Invoice, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	documents := []invoice.Invoice{}
+
+	for _, document := range f.Invoices {
+		if document.BookingId == bookingId {
+			documents = append(documents, document)
+		}
+	}
+
+	return documents, nil
+}
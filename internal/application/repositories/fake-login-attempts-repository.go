@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type FakeLoginAttemptsRepository struct {
+	LoginAttempts []account.LoginAttempts
+	mutex         sync.Mutex
+}
+
+func (f *FakeLoginAttemptsRepository) FindOne(scope string, subject string) (*account.LoginAttempts, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, loginAttempts := range f.LoginAttempts {
+		if loginAttempts.Scope == scope && loginAttempts.Subject == subject {
+			return &loginAttempts, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeLoginAttemptsRepository) RecordFailure(scope string, subject string, failedAt time.Time,
+	resetBefore time.Time) (account.LoginAttempts, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.LoginAttempts {
+		if f.LoginAttempts[i].Scope != scope || f.LoginAttempts[i].Subject != subject {
+			continue
+		}
+
+		if f.LoginAttempts[i].LastFailedAt.Before(resetBefore) {
+			f.LoginAttempts[i].Failures = 0
+		}
+
+		f.LoginAttempts[i].Failures++
+		f.LoginAttempts[i].LastFailedAt = failedAt
+		return f.LoginAttempts[i], nil
+	}
+
+	loginAttempts := account.LoginAttempts{Scope: scope, Subject: subject, Failures: 1, LastFailedAt: failedAt}
+	f.LoginAttempts = append(f.LoginAttempts, loginAttempts)
+	return loginAttempts, nil
+}
+
+func (f *FakeLoginAttemptsRepository) Delete(scope string, subject string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.LoginAttempts {
+		if f.LoginAttempts[i].Scope == scope && f.LoginAttempts[i].Subject == subject {
+			f.LoginAttempts = append(f.LoginAttempts[:i], f.LoginAttempts[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"sync"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type FakeLoginLockoutsRepository struct {
+	LoginLockouts []account.LoginLockout
+	mutex         sync.Mutex
+}
+
+func (f *FakeLoginLockoutsRepository) Create(loginLockout account.LoginLockout) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.LoginLockouts = append(f.LoginLockouts, loginLockout)
+	return nil
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+)
+
+type FakePackagesRepository struct {
+	Packages []bundle.Package
+}
+
+func (f *FakePackagesRepository) Create(travelPackage bundle.Package) error {
+	f.Packages = append(f.Packages, travelPackage)
+	return nil
+}
+
+func (f *FakePackagesRepository) Update(travelPackage bundle.Package) error {
+	for i := range f.Packages {
+		if f.Packages[i].Id == travelPackage.Id {
+			f.Packages[i] = travelPackage
+		}
+	}
+
+	return nil
+}
+
+func (f *FakePackagesRepository) ExistsByCode(code string) (bool, error) {
+	for _, travelPackage := range f.Packages {
+		if travelPackage.Code == code {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *FakePackagesRepository) FindOneById(packageId uuid.UUID) (*bundle.Package, error) {
+	for _, travelPackage := range f.Packages {
+		if travelPackage.Id == packageId {
+			return &travelPackage, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakePackagesRepository) FindOneByCode(code string) (*bundle.Package, error) {
+	for _, travelPackage := range f.Packages {
+		if travelPackage.Code == code {
+			return &travelPackage, nil
+		}
+	}
+
+	return nil, nil
+}
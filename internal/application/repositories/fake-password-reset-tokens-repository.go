@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type FakePasswordResetTokensRepository struct {
+	PasswordResetTokens []account.PasswordResetToken
+	mutex               sync.Mutex
+}
+
+func (f *FakePasswordResetTokensRepository) Create(passwordResetToken account.PasswordResetToken) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.PasswordResetTokens = append(f.PasswordResetTokens, passwordResetToken)
+	return nil
+}
+
+func (f *FakePasswordResetTokensRepository) FindOneByHashedToken(hashedToken string) (*account.PasswordResetToken, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, passwordResetToken := range f.PasswordResetTokens {
+		if passwordResetToken.HashedToken == hashedToken {
+			return &passwordResetToken, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakePasswordResetTokensRepository) Use(passwordResetToken account.PasswordResetToken) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.PasswordResetTokens {
+		if f.PasswordResetTokens[i].Id != passwordResetToken.Id {
+			continue
+		}
+
+		if f.PasswordResetTokens[i].Status != "ACTIVE" {
+			return errors.New("password reset token is invalid or has expired")
+		}
+
+		f.PasswordResetTokens[i].Status = passwordResetToken.Status
+		return nil
+	}
+
+	return errors.New("password reset token not found")
+}
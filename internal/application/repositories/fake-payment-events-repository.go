@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type FakePaymentEventsRepository struct {
+	PaymentEvents      []payment.PaymentEvent
+	PaymentsRepository *FakePaymentsRepository
+	BookingsRepository *FakeBookingsRepository
+}
+
+func (f *FakePaymentEventsRepository) Record(paymentEvent payment.PaymentEvent, changedPayment *payment.Payment,
+	changedBooking *booking.Booking) (bool, error) {
+	for _, existingPaymentEvent := range f.PaymentEvents {
+		if existingPaymentEvent.Id == paymentEvent.Id {
+			return false, nil
+		}
+	}
+
+	f.PaymentEvents = append(f.PaymentEvents, paymentEvent)
+
+	if changedPayment != nil {
+		if err := f.PaymentsRepository.Update(*changedPayment); err != nil {
+			return false, err
+		}
+	}
+
+	if changedBooking != nil {
+		if err := f.BookingsRepository.Update(*changedBooking); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type FakePaymentsRepository struct {
+	Payments []payment.Payment
+}
+
+func (f *FakePaymentsRepository) Create(payment payment.Payment) error {
+	f.Payments = append(f.Payments, payment)
+	return nil
+}
+
+func (f *FakePaymentsRepository) Update(payment payment.Payment) error {
+	for i := range f.Payments {
+		if f.Payments[i].Id == payment.Id {
+			f.Payments[i] = payment
+		}
+	}
+
+	return nil
+}
+
+func (f *FakePaymentsRepository) FindOneByTransactionId(transactionId string) (*payment.Payment, error) {
+	for _, payment := range f.Payments {
+		if payment.TransactionId == transactionId {
+			return &payment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakePaymentsRepository) FindOneByBookingId(bookingId uuid.UUID) (*payment.Payment, error) {
+	for _, payment := range f.Payments {
+		if payment.BookingId == bookingId {
+			return &payment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakePaymentsRepository) FindAllDueForCapture(date time.Time) ([]payment.Payment, error) {
+	payments := []payment.Payment{}
+
+	for _, payment := range f.Payments {
+		if payment.Status == "AUTHORIZED" && !payment.CaptureOn.After(date) {
+			payments = append(payments, payment)
+		}
+	}
+
+	return payments, nil
+}
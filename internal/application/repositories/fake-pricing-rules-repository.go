@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type FakePricingRulesRepository struct {
+	Rules []pricing.OccupancyPricingRule
+}
+
+func (f *FakePricingRulesRepository) Create(rule pricing.OccupancyPricingRule) error {
+	f.Rules = append(f.Rules, rule)
+	return nil
+}
+
+func (f *FakePricingRulesRepository) Update(rule pricing.OccupancyPricingRule) error {
+	for i := range f.Rules {
+		if f.Rules[i].Id == rule.Id {
+			f.Rules[i] = rule
+		}
+	}
+
+	return nil
+}
+
+func (f *FakePricingRulesRepository) FindOneById(ruleId uuid.UUID) (*pricing.OccupancyPricingRule, error) {
+	for _, rule := range f.Rules {
+		if rule.Id == ruleId {
+			return &rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakePricingRulesRepository) FindAllEnabled() ([]pricing.OccupancyPricingRule, error) {
+	rules := []pricing.OccupancyPricingRule{}
+
+	for _, rule := range f.Rules {
+		if rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
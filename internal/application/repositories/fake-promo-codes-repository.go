@@ -0,0 +1,17 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+
+type FakePromoCodesRepository struct {
+	PromoCodes []promocode.PromoCode
+}
+
+func (f *FakePromoCodesRepository) FindOneByCode(code string) (*promocode.PromoCode, error) {
+	for _, promoCode := range f.PromoCodes {
+		if promoCode.Code == code {
+			return &promoCode, nil
+		}
+	}
+
+	return nil, nil
+}
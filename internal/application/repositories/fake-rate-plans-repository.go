@@ -0,0 +1,32 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+
+type FakeRatePlansRepository struct {
+	RatePlans []rateplan.RatePlan
+}
+
+func (f *FakeRatePlansRepository) Create(ratePlan rateplan.RatePlan) error {
+	f.RatePlans = append(f.RatePlans, ratePlan)
+	return nil
+}
+
+func (f *FakeRatePlansRepository) ExistsByCode(code string) (bool, error) {
+	for _, ratePlan := range f.RatePlans {
+		if ratePlan.Code == code {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *FakeRatePlansRepository) FindOneByCode(code string) (*rateplan.RatePlan, error) {
+	for _, ratePlan := range f.RatePlans {
+		if ratePlan.Code == code {
+			return &ratePlan, nil
+		}
+	}
+
+	return nil, nil
+}
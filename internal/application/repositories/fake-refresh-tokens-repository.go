@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+)
+
+type FakeRefreshTokensRepository struct {
+	RefreshTokens []session.RefreshToken
+	mutex         sync.Mutex
+}
+
+func (f *FakeRefreshTokensRepository) Create(refreshToken session.RefreshToken) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.RefreshTokens = append(f.RefreshTokens, refreshToken)
+	return nil
+}
+
+func (f *FakeRefreshTokensRepository) FindOneByHashedToken(hashedToken string) (*session.RefreshToken, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, refreshToken := range f.RefreshTokens {
+		if refreshToken.HashedToken == hashedToken {
+			return &refreshToken, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeRefreshTokensRepository) Rotate(current session.RefreshToken, next session.RefreshToken) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.RefreshTokens {
+		if f.RefreshTokens[i].Id != current.Id {
+			continue
+		}
+
+		if f.RefreshTokens[i].Status != "ACTIVE" {
+			return errors.New("refresh token has already been used")
+		}
+
+		f.RefreshTokens[i].Status = current.Status
+		f.RefreshTokens = append(f.RefreshTokens, next)
+		return nil
+	}
+
+	return errors.New("refresh token not found")
+}
+
+func (f *FakeRefreshTokensRepository) RevokeFamily(familyId uuid.UUID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.RefreshTokens {
+		if f.RefreshTokens[i].FamilyId == familyId && f.RefreshTokens[i].Status == "ACTIVE" {
+			f.RefreshTokens[i].Status = "REVOKED"
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeRefreshTokensRepository) RevokeAllByCustomerId(customerId uuid.UUID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.RefreshTokens {
+		if f.RefreshTokens[i].CustomerId == customerId && f.RefreshTokens[i].Status == "ACTIVE" {
+			f.RefreshTokens[i].Status = "REVOKED"
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type FakeRefundsRepository struct {
+	Refunds         []payment.Refund
+	CapturedAmounts map[uuid.UUID]uint64
+	mutex           sync.Mutex
+}
+
+func (f *FakeRefundsRepository) Reserve(refund payment.Refund) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var refundedAmount uint64
+	for _, existingRefund := range f.Refunds {
+		if existingRefund.RefundedChargeId() == refund.RefundedChargeId() && existingRefund.CountsTowardsRefundedAmount() {
+			refundedAmount += existingRefund.Amount
+		}
+	}
+
+	if refundedAmount+refund.Amount > f.CapturedAmounts[refund.RefundedChargeId()] {
+		return errors.New("refund amount exceeds the refundable amount")
+	}
+
+	f.Refunds = append(f.Refunds, refund)
+	return nil
+}
+
+func (f *FakeRefundsRepository) Update(refund payment.Refund) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.Refunds {
+		if f.Refunds[i].Id == refund.Id {
+			f.Refunds[i] = refund
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeRefundsRepository) FindAllByBookingId(bookingId uuid.UUID) ([]payment.Refund, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	refunds := []payment.Refund{}
+
+	for _, refund := range f.Refunds {
+		if refund.BookingId == bookingId {
+			refunds = append(refunds, refund)
+		}
+	}
+
+	return refunds, nil
+}
@@ -0,0 +1,14 @@
+package repositories
+
+type FakeRolesRepository struct {
+	RolePermissions map[string][]string
+}
+
+func (f *FakeRolesRepository) ExistsByName(name string) (bool, error) {
+	_, exists := f.RolePermissions[name]
+	return exists, nil
+}
+
+func (f *FakeRolesRepository) FindPermissionsByRole(role string) ([]string, error) {
+	return f.RolePermissions[role], nil
+}
@@ -1,6 +1,9 @@
 package repositories
 
-import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+)
 
 type FakeRoomsRepository struct {
 	Rooms []room.Room
@@ -20,3 +23,29 @@ func (f *FakeRoomsRepository) ExistsByRoomNumber(roomNumber string) (bool, error
 
 	return false, nil
 }
+
+func (f *FakeRoomsRepository) FindAllByIds(roomIds []uuid.UUID) ([]room.Room, error) {
+	rooms := []room.Room{}
+
+	for _, roomId := range roomIds {
+		for _, room := range f.Rooms {
+			if room.Id == roomId {
+				rooms = append(rooms, room)
+			}
+		}
+	}
+
+	return rooms, nil
+}
+
+func (f *FakeRoomsRepository) FindAllByType(roomType string) ([]room.Room, error) {
+	rooms := []room.Room{}
+
+	for _, room := range f.Rooms {
+		if room.Type == roomType {
+			rooms = append(rooms, room)
+		}
+	}
+
+	return rooms, nil
+}
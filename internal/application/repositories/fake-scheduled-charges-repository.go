@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type FakeScheduledChargesRepository struct {
+	ScheduledCharges []payment.ScheduledCharge
+	CreateErr        error
+}
+
+func (f *FakeScheduledChargesRepository) Create(scheduledCharge payment.ScheduledCharge) error {
+	if f.CreateErr != nil {
+		return f.CreateErr
+	}
+
+	f.ScheduledCharges = append(f.ScheduledCharges, scheduledCharge)
+	return nil
+}
+
+func (f *FakeScheduledChargesRepository) Update(scheduledCharge payment.ScheduledCharge) error {
+	for i := range f.ScheduledCharges {
+		if f.ScheduledCharges[i].Id == scheduledCharge.Id {
+			f.ScheduledCharges[i] = scheduledCharge
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeScheduledChargesRepository) FindAllDue(now time.Time) ([]payment.ScheduledCharge, error) {
+	scheduledCharges := []payment.ScheduledCharge{}
+
+	for _, scheduledCharge := range f.ScheduledCharges {
+		if scheduledCharge.IsDue(now) {
+			scheduledCharges = append(scheduledCharges, scheduledCharge)
+		}
+	}
+
+	return scheduledCharges, nil
+}
+
+func (f *FakeScheduledChargesRepository) FindAllByBookingId(bookingId uuid.UUID) ([]payment.ScheduledCharge, error) {
+	scheduledCharges := []payment.ScheduledCharge{}
+
+	for _, scheduledCharge := range f.ScheduledCharges {
+		if scheduledCharge.BookingId == bookingId {
+			scheduledCharges = append(scheduledCharges, scheduledCharge)
+		}
+	}
+
+	return scheduledCharges, nil
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+)
+
+type FakeStaffUsersRepository struct {
+	StaffUsers []staff.StaffUser
+}
+
+func (f *FakeStaffUsersRepository) Create(staffUser staff.StaffUser) error {
+	f.StaffUsers = append(f.StaffUsers, staffUser)
+	return nil
+}
+
+func (f *FakeStaffUsersRepository) ExistsByEmail(email string) (bool, error) {
+	staffUser, err := f.FindOneByEmail(email)
+	return staffUser != nil, err
+}
+
+func (f *FakeStaffUsersRepository) FindOneByEmail(email string) (*staff.StaffUser, error) {
+	for _, staffUser := range f.StaffUsers {
+		if staffUser.Email == email {
+			return &staffUser, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeStaffUsersRepository) FindOneById(staffUserId uuid.UUID) (*staff.StaffUser, error) {
+	for _, staffUser := range f.StaffUsers {
+		if staffUser.Id == staffUserId {
+			return &staffUser, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeStaffUsersRepository) UpdatePassword(staffUserId uuid.UUID, hashedPassword string) error {
+	for i := range f.StaffUsers {
+		if f.StaffUsers[i].Id == staffUserId {
+			f.StaffUsers[i].HashedPassword = hashedPassword
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+)
+
+type FakeTotpFactorsRepository struct {
+	TotpFactors   []mfa.TotpFactor
+	RecoveryCodes []mfa.RecoveryCode
+	mutex         sync.Mutex
+}
+
+func (f *FakeTotpFactorsRepository) Create(totpFactor mfa.TotpFactor) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.TotpFactors = append(f.TotpFactors, totpFactor)
+	return nil
+}
+
+func (f *FakeTotpFactorsRepository) FindOneByCustomerId(customerId uuid.UUID) (*mfa.TotpFactor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, totpFactor := range f.TotpFactors {
+		if totpFactor.CustomerId == customerId {
+			return &totpFactor, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeTotpFactorsRepository) FindOneByStaffUserId(staffUserId uuid.UUID) (*mfa.TotpFactor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, totpFactor := range f.TotpFactors {
+		if totpFactor.StaffUserId == staffUserId {
+			return &totpFactor, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *FakeTotpFactorsRepository) Delete(totpFactorId uuid.UUID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.TotpFactors = slices.DeleteFunc(f.TotpFactors, func(totpFactor mfa.TotpFactor) bool {
+		return totpFactor.Id == totpFactorId
+	})
+	f.RecoveryCodes = slices.DeleteFunc(f.RecoveryCodes, func(recoveryCode mfa.RecoveryCode) bool {
+		return recoveryCode.TotpFactorId == totpFactorId
+	})
+
+	return nil
+}
+
+func (f *FakeTotpFactorsRepository) Activate(totpFactor mfa.TotpFactor, recoveryCodes []mfa.RecoveryCode) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.TotpFactors {
+		if f.TotpFactors[i].Id != totpFactor.Id {
+			continue
+		}
+
+		if f.TotpFactors[i].Status != "PENDING" {
+			return errors.New("two-factor authentication is already enabled")
+		}
+
+		f.TotpFactors[i] = totpFactor
+		f.RecoveryCodes = append(f.RecoveryCodes, recoveryCodes...)
+		return nil
+	}
+
+	return errors.New("totp factor not found")
+}
+
+func (f *FakeTotpFactorsRepository) UseStep(totpFactorId uuid.UUID, step int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.TotpFactors {
+		if f.TotpFactors[i].Id == totpFactorId && f.TotpFactors[i].LastUsedStep < step {
+			f.TotpFactors[i].LastUsedStep = step
+			return nil
+		}
+	}
+
+	return errors.New("verification code is invalid")
+}
+
+func (f *FakeTotpFactorsRepository) UseRecoveryCode(totpFactorId uuid.UUID, hashedCode string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i, recoveryCode := range f.RecoveryCodes {
+		if recoveryCode.TotpFactorId == totpFactorId && recoveryCode.HashedCode == hashedCode {
+			f.RecoveryCodes = append(f.RecoveryCodes[:i], f.RecoveryCodes[i+1:]...)
+			return nil
+		}
+	}
+
+	return errors.New("verification code is invalid")
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+)
+
+type IFolioEntriesRepository interface {
+	Create(entry folio.FolioEntry) error
+	Update(entry folio.FolioEntry) error
+	FindOneById(id uuid.UUID) (*folio.FolioEntry, error)
+	FindAllByBookingId(bookingId uuid.UUID) ([]folio.FolioEntry, error)
+}
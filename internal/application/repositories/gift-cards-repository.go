@@ -0,0 +1,9 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+
+type IGiftCardsRepository interface {
+	Create(giftCard credit.GiftCard) error
+	FindOneByCode(code string) (*credit.GiftCard, error)
+	Redeem(giftCard credit.GiftCard, entry credit.CreditEntry) (credit.CreditEntry, error)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import "time"
+
+type IdempotencyRecordDTO struct {
+	Scope       string
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+type IIdempotencyKeysRepository interface {
+	// Reserve claims the key for a new request and returns nil, or returns the record already stored for it.
+	// Records created before expiredBefore are discarded. A record with a zero status code is still in flight.
+	Reserve(scope string, key string, requestHash string, expiredBefore time.Time) (*IdempotencyRecordDTO, error)
+	Complete(record IdempotencyRecordDTO) error
+	Release(scope string, key string) error
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+)
+
+type IInvoicesRepository interface {
+	Issue(newInvoice invoice.Invoice) (invoice.Invoice, error)
+	FindOneById(id uuid.UUID) (*invoice.Invoice, error)
+	FindAllByBookingId(bookingId uuid.UUID) ([]invoice.Invoice, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type ILoginAttemptsRepository interface {
+	FindOne(scope string, subject string) (*account.LoginAttempts, error)
+	// RecordFailure counts one more failed login in a single statement, so that instances sharing the storage do not
+	// lose failures to each other. Counting starts over when the previous failure happened before resetBefore.
+	RecordFailure(scope string, subject string, failedAt time.Time, resetBefore time.Time) (account.LoginAttempts, error)
+	Delete(scope string, subject string) error
+}
@@ -0,0 +1,7 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+
+type ILoginLockoutsRepository interface {
+	Create(loginLockout account.LoginLockout) error
+}
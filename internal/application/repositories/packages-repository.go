@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+)
+
+type IPackagesRepository interface {
+	Create(travelPackage bundle.Package) error
+	Update(travelPackage bundle.Package) error
+	ExistsByCode(code string) (bool, error)
+	FindOneById(packageId uuid.UUID) (*bundle.Package, error)
+	FindOneByCode(code string) (*bundle.Package, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+
+type IPasswordResetTokensRepository interface {
+	Create(passwordResetToken account.PasswordResetToken) error
+	FindOneByHashedToken(hashedToken string) (*account.PasswordResetToken, error)
+	// Use marks the token as used. It fails with "password reset token is invalid or has expired" when the token is
+	// no longer active, so the same link cannot reset the password twice.
+	Use(passwordResetToken account.PasswordResetToken) error
+}
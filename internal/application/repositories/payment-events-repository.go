@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type IPaymentEventsRepository interface {
+	// Record stores the event together with the payment and booking it changed in one transaction. It returns false
+	// and changes nothing when an event with the same id was already recorded.
+	Record(paymentEvent payment.PaymentEvent, changedPayment *payment.Payment, changedBooking *booking.Booking) (bool, error)
+}
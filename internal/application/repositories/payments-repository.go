@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type IPaymentsRepository interface {
+	Create(payment payment.Payment) error
+	Update(payment payment.Payment) error
+	FindOneByTransactionId(transactionId string) (*payment.Payment, error)
+	FindOneByBookingId(bookingId uuid.UUID) (*payment.Payment, error)
+	FindAllDueForCapture(date time.Time) ([]payment.Payment, error)
+}
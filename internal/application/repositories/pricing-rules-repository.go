@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type IPricingRulesRepository interface {
+	Create(rule pricing.OccupancyPricingRule) error
+	Update(rule pricing.OccupancyPricingRule) error
+	FindOneById(ruleId uuid.UUID) (*pricing.OccupancyPricingRule, error)
+	FindAllEnabled() ([]pricing.OccupancyPricingRule, error)
+}
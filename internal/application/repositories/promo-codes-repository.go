@@ -0,0 +1,7 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+
+type IPromoCodesRepository interface {
+	FindOneByCode(code string) (*promocode.PromoCode, error)
+}
@@ -0,0 +1,9 @@
+package repositories
+
+import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+
+type IRatePlansRepository interface {
+	Create(ratePlan rateplan.RatePlan) error
+	ExistsByCode(code string) (bool, error)
+	FindOneByCode(code string) (*rateplan.RatePlan, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+)
+
+type IRefreshTokensRepository interface {
+	Create(refreshToken session.RefreshToken) error
+	FindOneByHashedToken(hashedToken string) (*session.RefreshToken, error)
+	// Rotate marks the current token as rotated and stores the next one. It fails with
+	// "refresh token has already been used" when the current token is no longer active.
+	Rotate(current session.RefreshToken, next session.RefreshToken) error
+	RevokeFamily(familyId uuid.UUID) error
+	RevokeAllByCustomerId(customerId uuid.UUID) error
+}
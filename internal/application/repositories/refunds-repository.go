@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type IRefundsRepository interface {
+	Reserve(refund payment.Refund) error
+	Update(refund payment.Refund) error
+	FindAllByBookingId(bookingId uuid.UUID) ([]payment.Refund, error)
+}
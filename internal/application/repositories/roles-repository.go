@@ -0,0 +1,6 @@
+package repositories
+
+type IRolesRepository interface {
+	ExistsByName(name string) (bool, error)
+	FindPermissionsByRole(role string) ([]string, error)
+}
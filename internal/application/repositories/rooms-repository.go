@@ -1,8 +1,13 @@
 package repositories
 
-import "github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+)
 
 type IRoomsRepository interface {
 	Create(room room.Room) error
 	ExistsByRoomNumber(roomNumber string) (bool, error)
+	FindAllByIds(roomIds []uuid.UUID) ([]room.Room, error)
+	FindAllByType(roomType string) ([]room.Room, error)
 }
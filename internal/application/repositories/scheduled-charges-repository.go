@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type IScheduledChargesRepository interface {
+	Create(scheduledCharge payment.ScheduledCharge) error
+	Update(scheduledCharge payment.ScheduledCharge) error
+	FindAllDue(now time.Time) ([]payment.ScheduledCharge, error)
+	FindAllByBookingId(bookingId uuid.UUID) ([]payment.ScheduledCharge, error)
+}
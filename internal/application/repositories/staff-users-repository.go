@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+)
+
+type IStaffUsersRepository interface {
+	Create(staffUser staff.StaffUser) error
+	ExistsByEmail(email string) (bool, error)
+	FindOneByEmail(email string) (*staff.StaffUser, error)
+	FindOneById(staffUserId uuid.UUID) (*staff.StaffUser, error)
+	UpdatePassword(staffUserId uuid.UUID, hashedPassword string) error
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+)
+
+type ITotpFactorsRepository interface {
+	Create(totpFactor mfa.TotpFactor) error
+	FindOneByCustomerId(customerId uuid.UUID) (*mfa.TotpFactor, error)
+	FindOneByStaffUserId(staffUserId uuid.UUID) (*mfa.TotpFactor, error)
+	Delete(totpFactorId uuid.UUID) error
+	// Activate stores the factor as ACTIVE together with its recovery codes. It fails with "two-factor authentication
+	// is already enabled" when the factor is no longer pending.
+	Activate(totpFactor mfa.TotpFactor, recoveryCodes []mfa.RecoveryCode) error
+	// UseStep records the step of an accepted code. It fails with "verification code is invalid" when a code of the
+	// same or a later step was accepted in the meantime.
+	UseStep(totpFactorId uuid.UUID, step int64) error
+	// UseRecoveryCode consumes a recovery code of the factor and fails with "verification code is invalid" when there
+	// is no unused code with that hash.
+	UseRecoveryCode(totpFactorId uuid.UUID, hashedCode string) error
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+)
+
+type JwtClaims struct {
+	CustomerId uuid.UUID `json:"customerId"`
+	Role       string    `json:"role"`
+	SessionId  uuid.UUID `json:"sessionId"`
+	jwt.RegisteredClaims
+}
+
+type StaffJwtClaims struct {
+	StaffUserId uuid.UUID `json:"staffUserId"`
+	Role        string    `json:"role"`
+	SessionId   uuid.UUID `json:"sessionId"`
+	jwt.RegisteredClaims
+}
+
+// signAccessToken issues the short-lived token sent on every request. The session id ties it to the refresh token
+// family it came from so that logging out can revoke that family.
+func signAccessToken(signingKeysSource *auth.SigningKeysSource, customerId uuid.UUID, sessionId uuid.UUID,
+	expiresAt time.Time) (string, error) {
+	return signJwt(signingKeysSource, &JwtClaims{
+		CustomerId: customerId,
+		Role:       "CUSTOMER",
+		SessionId:  sessionId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	})
+}
+
+// signStaffAccessToken carries the role stored on the staff user, so a role change applies from the next refresh.
+func signStaffAccessToken(signingKeysSource *auth.SigningKeysSource, staffUser staff.StaffUser, sessionId uuid.UUID,
+	expiresAt time.Time) (string, error) {
+	return signJwt(signingKeysSource, &StaffJwtClaims{
+		StaffUserId: staffUser.Id,
+		Role:        staffUser.Role,
+		SessionId:   sessionId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	})
+}
+
+// signJwt signs with the current key of the JWT_SIGNING_KEYS set and names it in the kid header, so that verifiers
+// holding only the published keys can tell which one to check the signature against.
+func signJwt(signingKeysSource *auth.SigningKeysSource, claims jwt.Claims) (string, error) {
+	signingKeys, err := signingKeysSource.Load()
+	if err != nil {
+		return "", err
+	}
+
+	signingKey, err := signingKeys.Current(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(signingKey.Algorithm), claims)
+	token.Header["kid"] = signingKey.Id
+
+	return token.SignedString(signingKey.PrivateKey)
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+)
+
+type ActivateTotpInput struct {
+	Principal auth.Principal
+	Code      string
+}
+
+type ActivateTotpOutput struct {
+	RecoveryCodes []string
+}
+
+type IActivateTotp interface {
+	Execute(input ActivateTotpInput) (ActivateTotpOutput, error)
+}
+
+type ActivateTotp struct {
+	TotpFactorsRepository repositories.ITotpFactorsRepository
+	RecoveryCodesCount    int
+}
+
+// Execute turns two-factor authentication on once the user proves the authenticator app works, and returns the
+// recovery codes. They are shown this one time; only their hashes are kept.
+func (a *ActivateTotp) Execute(input ActivateTotpInput) (ActivateTotpOutput, error) {
+	totpFactor, err := findTotpFactor(a.TotpFactorsRepository, input.Principal.CustomerId, input.Principal.StaffUserId)
+	if err != nil {
+		return ActivateTotpOutput{}, err
+	}
+
+	if totpFactor == nil {
+		return ActivateTotpOutput{}, errors.New("two-factor enrollment not found")
+	}
+
+	step, err := totpFactor.Verify(input.Code, time.Now())
+	if err != nil {
+		return ActivateTotpOutput{}, err
+	}
+
+	err = totpFactor.Activate(step)
+	if err != nil {
+		return ActivateTotpOutput{}, err
+	}
+
+	recoveryCodes, plainCodes, err := mfa.NewRecoveryCodes(totpFactor.Id, a.RecoveryCodesCount)
+	if err != nil {
+		return ActivateTotpOutput{}, err
+	}
+
+	err = a.TotpFactorsRepository.Activate(*totpFactor, recoveryCodes)
+	if err != nil {
+		return ActivateTotpOutput{}, err
+	}
+
+	return ActivateTotpOutput{RecoveryCodes: plainCodes}, nil
+}
@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type CancelBookingInput struct {
+	BookingId uuid.UUID
+	Principal auth.Principal
+}
+
+type CancelBookingOutput struct {
+	RefundAmount       uint64
+	RefundStatus       string
+	CreditRefundAmount uint64
+}
+
+type ICancelBooking interface {
+	Execute(input CancelBookingInput) (CancelBookingOutput, error)
+}
+
+type CancelBooking struct {
+	PaymentsGateway            gateways.IPaymentsGateway
+	BookingsRepository         repositories.IBookingsRepository
+	PaymentsRepository         repositories.IPaymentsRepository
+	RefundsRepository          repositories.IRefundsRepository
+	ScheduledChargesRepository repositories.IScheduledChargesRepository
+	CancellationPolicy         payment.CancellationPolicy
+	FolioEntriesRepository     repositories.IFolioEntriesRepository
+	CreditEntriesRepository    repositories.ICreditEntriesRepository
+}
+
+func (c *CancelBooking) Execute(input CancelBookingInput) (CancelBookingOutput, error) {
+	foundBooking, err := c.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return CancelBookingOutput{}, err
+	}
+
+	if foundBooking == nil || !input.Principal.MayAccess(foundBooking.CustomerId, "bookings:write:any") {
+		return CancelBookingOutput{}, errors.New("booking not found")
+	}
+
+	err = foundBooking.RequestCancellation()
+	if err != nil {
+		return CancelBookingOutput{}, err
+	}
+
+	err = c.BookingsRepository.Update(*foundBooking)
+	if err != nil {
+		return CancelBookingOutput{}, err
+	}
+
+	creditRefundAmount, err := c.returnCredit(*foundBooking)
+	if err != nil {
+		return CancelBookingOutput{}, err
+	}
+
+	now := time.Now().UTC()
+	retainedAmount := func(paidAmount uint64) uint64 {
+		return paidAmount - c.CancellationPolicy.RefundAmount(paidAmount, foundBooking.CheckIn, now)
+	}
+
+	settlement := depositSettlementResult{}
+
+	deposit, err := c.PaymentsRepository.FindOneByBookingId(foundBooking.Id)
+	if err != nil {
+		return CancelBookingOutput{}, err
+	}
+
+	if deposit != nil {
+		paidAmount := deposit.Amount
+		if deposit.Status == "CAPTURED" {
+			paidAmount = deposit.CapturedAmount
+		}
+
+		settlement, err = depositSettlement{
+			paymentsGateway:    c.PaymentsGateway,
+			paymentsRepository: c.PaymentsRepository,
+			refundsRepository:  c.RefundsRepository,
+		}.settle(*deposit, retainedAmount(paidAmount), true, "CANCELLATION")
+		if err != nil {
+			return CancelBookingOutput{}, err
+		}
+	}
+
+	chargesSettlement, err := scheduledChargeSettlement{
+		paymentsGateway:            c.PaymentsGateway,
+		scheduledChargesRepository: c.ScheduledChargesRepository,
+		refundsRepository:          c.RefundsRepository,
+	}.settle(foundBooking.Id, retainedAmount, "CANCELLATION")
+	if err != nil {
+		return CancelBookingOutput{}, err
+	}
+
+	settlement = settlement.combine(chargesSettlement)
+
+	return CancelBookingOutput{
+		RefundAmount:       settlement.RefundAmount,
+		RefundStatus:       settlement.RefundStatus,
+		CreditRefundAmount: creditRefundAmount,
+	}, nil
+}
+
+// returnCredit gives back to the customer credit balance the refundable part of what was paid from credit.
+func (c *CancelBooking) returnCredit(cancelledBooking booking.Booking) (uint64, error) {
+	entries, err := c.FolioEntriesRepository.FindAllByBookingId(cancelledBooking.Id)
+	if err != nil {
+		return 0, err
+	}
+
+	var paidFromCredit uint64
+	for _, entry := range entries {
+		if entry.Type == "PAYMENT" && entry.Category == "CREDIT" && entry.Status == "POSTED" {
+			paidFromCredit += entry.Amount
+		}
+	}
+
+	refundAmount := c.CancellationPolicy.RefundAmount(paidFromCredit, cancelledBooking.CheckIn, time.Now().UTC())
+	if refundAmount == 0 {
+		return 0, nil
+	}
+
+	refund, err := credit.NewCredit(cancelledBooking.CustomerId, "REFUND", refundAmount, cancelledBooking.Id,
+		"Cancelled booking")
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = c.CreditEntriesRepository.Append(refund)
+	if err != nil {
+		return 0, err
+	}
+
+	return refundAmount, nil
+}
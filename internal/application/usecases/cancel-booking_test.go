@@ -0,0 +1,236 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type CancelBookingSuite struct {
+	suite.Suite
+	bookingId                      uuid.UUID
+	customerId                     uuid.UUID
+	principal                      auth.Principal
+	fakePaymentsGateway            gateways.FakePaymentsGateway
+	fakeBookingsRepository         repositories.FakeBookingsRepository
+	fakePaymentsRepository         repositories.FakePaymentsRepository
+	fakeRefundsRepository          repositories.FakeRefundsRepository
+	fakeFolioEntriesRepository     repositories.FakeFolioEntriesRepository
+	fakeCreditEntriesRepository    repositories.FakeCreditEntriesRepository
+	fakeScheduledChargesRepository repositories.FakeScheduledChargesRepository
+	cancelBooking                  usecases.CancelBooking
+}
+
+func (c *CancelBookingSuite) SetupTest() {
+	c.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	c.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	c.principal = auth.Principal{CustomerId: c.customerId, Role: "CUSTOMER", Permissions: []string{"bookings:write:own"}}
+	c.fakePaymentsGateway = gateways.FakePaymentsGateway{}
+	c.fakeBookingsRepository = repositories.FakeBookingsRepository{}
+	c.fakePaymentsRepository = repositories.FakePaymentsRepository{}
+	c.fakeRefundsRepository = repositories.FakeRefundsRepository{CapturedAmounts: map[uuid.UUID]uint64{}}
+	c.fakeFolioEntriesRepository = repositories.FakeFolioEntriesRepository{}
+	c.fakeCreditEntriesRepository = repositories.FakeCreditEntriesRepository{}
+	c.fakeScheduledChargesRepository = repositories.FakeScheduledChargesRepository{}
+	c.cancelBooking = usecases.CancelBooking{
+		PaymentsGateway:            &c.fakePaymentsGateway,
+		BookingsRepository:         &c.fakeBookingsRepository,
+		PaymentsRepository:         &c.fakePaymentsRepository,
+		RefundsRepository:          &c.fakeRefundsRepository,
+		ScheduledChargesRepository: &c.fakeScheduledChargesRepository,
+		CancellationPolicy:         payment.CancellationPolicy{FreeCancellationDaysBeforeCheckIn: 7, LateCancellationRefundPercent: 50},
+		FolioEntriesRepository:     &c.fakeFolioEntriesRepository,
+		CreditEntriesRepository:    &c.fakeCreditEntriesRepository,
+	}
+}
+
+func (c *CancelBookingSuite) givenBooking(daysUntilCheckIn int) {
+	checkIn := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, daysUntilCheckIn)
+	c.fakeBookingsRepository.Bookings = []booking.Booking{
+		{
+			Id:         c.bookingId,
+			CustomerId: c.customerId,
+			CheckIn:    checkIn,
+			CheckOut:   checkIn.AddDate(0, 0, 2),
+			TotalPrice: 500,
+			Status:     "CONFIRMED",
+		},
+	}
+}
+
+func (c *CancelBookingSuite) givenDeposit(captured bool) {
+	result, err := c.fakePaymentsGateway.Authorize(c.bookingId, "tok_visa", 150)
+	c.Require().NoError(err)
+	deposit := payment.Payment{Id: uuid.New(), BookingId: c.bookingId, Amount: 150, TransactionId: result.TransactionId,
+		Status: "AUTHORIZED"}
+
+	if captured {
+		captureResult, err := c.fakePaymentsGateway.Capture(result.TransactionId, 150)
+		c.Require().NoError(err)
+		c.Require().NoError(deposit.RecordCapture(150, captureResult.Approved, captureResult.DeclineReason))
+		c.fakeRefundsRepository.CapturedAmounts[deposit.Id] = 150
+	}
+
+	c.fakePaymentsRepository.Payments = []payment.Payment{deposit}
+}
+
+func (c *CancelBookingSuite) givenPaidScheduledCharge(amount uint64) payment.ScheduledCharge {
+	result, err := c.fakePaymentsGateway.Authorize(c.bookingId, "tok_visa", amount)
+	c.Require().NoError(err)
+	_, err = c.fakePaymentsGateway.Capture(result.TransactionId, amount)
+	c.Require().NoError(err)
+	scheduledCharge := payment.ScheduledCharge{Id: uuid.New(), BookingId: c.bookingId, Amount: amount,
+		TransactionId: result.TransactionId, Status: "PAID"}
+	c.fakeScheduledChargesRepository.ScheduledCharges = append(c.fakeScheduledChargesRepository.ScheduledCharges,
+		scheduledCharge)
+	c.fakeRefundsRepository.CapturedAmounts[scheduledCharge.Id] = amount
+
+	return scheduledCharge
+}
+
+func (c *CancelBookingSuite) TestExecute_OnEarlyCancellationOfCapturedDeposit_RefundsItFully() {
+	c.givenBooking(30)
+	c.givenDeposit(true)
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(150), output.RefundAmount)
+	c.Equal("APPROVED", output.RefundStatus)
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+	refund := c.fakeRefundsRepository.Refunds[0]
+	c.Equal("CANCELLATION", refund.Type)
+	c.Equal(uint64(150), refund.Amount)
+	c.Equal("APPROVED", refund.Status)
+	c.Equal(uint64(150), c.fakePaymentsGateway.Transactions[0].RefundedAmount)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnLateCancellationOfCapturedDeposit_RefundsItPartially() {
+	c.givenBooking(3)
+	c.givenDeposit(true)
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(75), output.RefundAmount)
+	c.Equal(uint64(75), c.fakeRefundsRepository.Refunds[0].Amount)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnLateCancellationWithPaidScheduledCharge_RefundsItPartially() {
+	c.givenBooking(3)
+	c.givenDeposit(true)
+	paidCharge := c.givenPaidScheduledCharge(200)
+	c.fakeScheduledChargesRepository.ScheduledCharges = append(c.fakeScheduledChargesRepository.ScheduledCharges,
+		payment.ScheduledCharge{Id: uuid.New(), BookingId: c.bookingId, Amount: 150, Status: "SCHEDULED"})
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(175), output.RefundAmount)
+	c.Equal("APPROVED", output.RefundStatus)
+	c.Require().Len(c.fakeRefundsRepository.Refunds, 2)
+	chargeRefund := c.fakeRefundsRepository.Refunds[1]
+	c.Equal(paidCharge.Id, chargeRefund.ScheduledChargeId)
+	c.Equal(uint64(100), chargeRefund.Amount)
+	c.Equal("CANCELLATION", chargeRefund.Type)
+	c.Equal(uint64(100), c.fakePaymentsGateway.Transactions[1].RefundedAmount)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnEarlyCancellationOfAuthorizedDeposit_VoidsIt() {
+	c.givenBooking(30)
+	c.givenDeposit(false)
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(150), output.RefundAmount)
+	c.Equal("RELEASED", output.RefundStatus)
+	c.Equal("VOIDED", c.fakePaymentsRepository.Payments[0].Status)
+	c.Equal("VOIDED", c.fakePaymentsGateway.Transactions[0].Status)
+	c.Empty(c.fakeRefundsRepository.Refunds)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnLateCancellationOfAuthorizedDeposit_CapturesTheRetainedAmount() {
+	c.givenBooking(3)
+	c.givenDeposit(false)
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(75), output.RefundAmount)
+	c.Equal("CAPTURED", c.fakePaymentsRepository.Payments[0].Status)
+	c.Equal(uint64(75), c.fakePaymentsRepository.Payments[0].CapturedAmount)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnBookingWithoutDeposit_CancelsIt() {
+	c.givenBooking(30)
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.CancelBookingOutput{}, output)
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnAnotherCustomersBooking_ReturnsError() {
+	c.givenBooking(30)
+
+	_, err := c.cancelBooking.Execute(usecases.CancelBookingInput{
+		BookingId: c.bookingId,
+		Principal: auth.Principal{CustomerId: uuid.New(), Role: "CUSTOMER", Permissions: []string{"bookings:write:own"}},
+	})
+
+	c.EqualError(err, "booking not found")
+	c.Equal("CONFIRMED", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnStaffWithAnyBookingPermission_CancelsTheBooking() {
+	c.givenBooking(30)
+
+	_, err := c.cancelBooking.Execute(usecases.CancelBookingInput{
+		BookingId: c.bookingId,
+		Principal: auth.Principal{StaffUserId: uuid.New(), Role: "FRONT_DESK", Permissions: []string{"bookings:write:any"}},
+	})
+	c.Require().NoError(err)
+
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *CancelBookingSuite) TestExecute_OnAlreadyCancelledBooking_ReturnsError() {
+	c.givenBooking(30)
+	c.fakeBookingsRepository.Bookings[0].Status = "CANCELLED"
+
+	_, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+
+	c.EqualError(err, "only confirmed bookings can be cancelled")
+}
+
+func (c *CancelBookingSuite) TestExecute_OnLateCancellationPaidWithCredit_ReturnsRefundableCredit() {
+	c.givenBooking(3)
+	creditPayment, err := folio.NewCreditPayment(c.bookingId, 200)
+	c.Require().NoError(err)
+	c.fakeFolioEntriesRepository.FolioEntries = []folio.FolioEntry{creditPayment}
+
+	output, err := c.cancelBooking.Execute(usecases.CancelBookingInput{BookingId: c.bookingId, Principal: c.principal})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.CancelBookingOutput{CreditRefundAmount: 100}, output)
+	refund := c.fakeCreditEntriesRepository.CreditEntries[0]
+	c.Equal(c.customerId, refund.CustomerId)
+	c.Equal("REFUND", refund.Type)
+	c.Equal(int64(100), refund.Amount)
+	c.Equal(c.bookingId, refund.Reference)
+}
+
+func TestCancelBooking(t *testing.T) {
+	suite.Run(t, new(CancelBookingSuite))
+}
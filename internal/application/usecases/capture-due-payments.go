@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type CaptureDuePaymentsInput struct {
+	Date time.Time
+}
+
+type CaptureDuePaymentsOutput struct {
+	Captured uint
+	Failed   uint
+}
+
+type ICaptureDuePayments interface {
+	Execute(input CaptureDuePaymentsInput) (CaptureDuePaymentsOutput, error)
+}
+
+type CaptureDuePayments struct {
+	PaymentsGateway    gateways.IPaymentsGateway
+	PaymentsRepository repositories.IPaymentsRepository
+}
+
+func (c *CaptureDuePayments) Execute(input CaptureDuePaymentsInput) (CaptureDuePaymentsOutput, error) {
+	duePayments, err := c.PaymentsRepository.FindAllDueForCapture(input.Date)
+	if err != nil {
+		return CaptureDuePaymentsOutput{}, err
+	}
+
+	output := CaptureDuePaymentsOutput{}
+
+	for _, duePayment := range duePayments {
+		result, err := c.PaymentsGateway.Capture(duePayment.TransactionId, duePayment.Amount)
+
+		if err != nil {
+			duePayment.RecordFailure("CAPTURE", err.Error())
+		} else {
+			err = duePayment.RecordCapture(duePayment.Amount, result.Approved, result.DeclineReason)
+			if err != nil {
+				return output, err
+			}
+		}
+
+		err = c.PaymentsRepository.Update(duePayment)
+		if err != nil {
+			return output, err
+		}
+
+		if duePayment.Status == "CAPTURED" {
+			output.Captured++
+		} else {
+			output.Failed++
+		}
+	}
+
+	return output, nil
+}
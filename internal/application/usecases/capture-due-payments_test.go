@@ -0,0 +1,104 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type CaptureDuePaymentsSuite struct {
+	suite.Suite
+	today                  time.Time
+	fakePaymentsGateway    gateways.FakePaymentsGateway
+	fakePaymentsRepository repositories.FakePaymentsRepository
+	captureDuePayments     usecases.CaptureDuePayments
+}
+
+func (c *CaptureDuePaymentsSuite) SetupTest() {
+	c.today = time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	c.fakePaymentsGateway = gateways.FakePaymentsGateway{}
+	c.fakePaymentsRepository = repositories.FakePaymentsRepository{}
+	c.captureDuePayments = usecases.CaptureDuePayments{
+		PaymentsGateway:    &c.fakePaymentsGateway,
+		PaymentsRepository: &c.fakePaymentsRepository,
+	}
+}
+
+func (c *CaptureDuePaymentsSuite) authorizedPayment(captureOn time.Time) payment.Payment {
+	bookingId := uuid.New()
+	authorizedPayment, err := payment.NewDepositPayment(bookingId, 150, captureOn)
+	c.Require().NoError(err)
+	result, err := c.fakePaymentsGateway.Authorize(bookingId, "tok_visa", 150)
+	c.Require().NoError(err)
+	c.Require().NoError(authorizedPayment.RecordAuthorization(result.TransactionId, result.Approved, result.DeclineReason))
+
+	return authorizedPayment
+}
+
+func (c *CaptureDuePaymentsSuite) TestExecute_OnDuePayments_CapturesThem() {
+	c.fakePaymentsRepository.Payments = []payment.Payment{
+		c.authorizedPayment(c.today),
+		c.authorizedPayment(c.today.AddDate(0, 0, -1)),
+	}
+
+	output, err := c.captureDuePayments.Execute(usecases.CaptureDuePaymentsInput{Date: c.today})
+	c.Require().NoError(err)
+
+	c.Equal(uint(2), output.Captured)
+	c.Equal(uint(0), output.Failed)
+	for _, capturedPayment := range c.fakePaymentsRepository.Payments {
+		c.Equal("CAPTURED", capturedPayment.Status)
+		c.Equal("CAPTURE", capturedPayment.Attempts[1].Operation)
+		c.Equal("APPROVED", capturedPayment.Attempts[1].Status)
+	}
+	c.Equal("CAPTURED", c.fakePaymentsGateway.Transactions[0].Status)
+}
+
+func (c *CaptureDuePaymentsSuite) TestExecute_OnPaymentNotDueYet_LeavesItAuthorized() {
+	c.fakePaymentsRepository.Payments = []payment.Payment{c.authorizedPayment(c.today.AddDate(0, 0, 1))}
+
+	output, err := c.captureDuePayments.Execute(usecases.CaptureDuePaymentsInput{Date: c.today})
+	c.Require().NoError(err)
+
+	c.Equal(uint(0), output.Captured)
+	c.Equal("AUTHORIZED", c.fakePaymentsRepository.Payments[0].Status)
+	c.Len(c.fakePaymentsRepository.Payments[0].Attempts, 1)
+}
+
+func (c *CaptureDuePaymentsSuite) TestExecute_OnDeclinedCapture_RecordsAttemptAndKeepsPaymentAuthorized() {
+	duePayment := c.authorizedPayment(c.today)
+	_, err := c.fakePaymentsGateway.Void(duePayment.TransactionId)
+	c.Require().NoError(err)
+	c.fakePaymentsRepository.Payments = []payment.Payment{duePayment}
+
+	output, err := c.captureDuePayments.Execute(usecases.CaptureDuePaymentsInput{Date: c.today})
+	c.Require().NoError(err)
+
+	c.Equal(uint(1), output.Failed)
+	c.Equal("AUTHORIZED", c.fakePaymentsRepository.Payments[0].Status)
+	c.Equal("DECLINED", c.fakePaymentsRepository.Payments[0].Attempts[1].Status)
+	c.Equal("transaction is not authorized", c.fakePaymentsRepository.Payments[0].Attempts[1].FailureReason)
+}
+
+func (c *CaptureDuePaymentsSuite) TestExecute_OnGatewayError_RecordsFailedAttempt() {
+	duePayment := c.authorizedPayment(c.today)
+	duePayment.TransactionId = "unknown"
+	c.fakePaymentsRepository.Payments = []payment.Payment{duePayment}
+
+	output, err := c.captureDuePayments.Execute(usecases.CaptureDuePaymentsInput{Date: c.today})
+	c.Require().NoError(err)
+
+	c.Equal(uint(1), output.Failed)
+	c.Equal("FAILED", c.fakePaymentsRepository.Payments[0].Attempts[1].Status)
+	c.Equal("transaction not found", c.fakePaymentsRepository.Payments[0].Attempts[1].FailureReason)
+}
+
+func TestCaptureDuePayments(t *testing.T) {
+	suite.Run(t, new(CaptureDuePaymentsSuite))
+}
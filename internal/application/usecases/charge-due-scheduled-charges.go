@@ -0,0 +1,162 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type ChargeDueScheduledChargesInput struct {
+	Now time.Time
+}
+
+type ChargeDueScheduledChargesOutput struct {
+	Paid              uint
+	Retrying          uint
+	Failed            uint
+	CancelledBookings uint
+}
+
+type IChargeDueScheduledCharges interface {
+	Execute(input ChargeDueScheduledChargesInput) (ChargeDueScheduledChargesOutput, error)
+}
+
+type ChargeDueScheduledCharges struct {
+	PaymentsGateway            gateways.IPaymentsGateway
+	BookingsRepository         repositories.IBookingsRepository
+	ScheduledChargesRepository repositories.IScheduledChargesRepository
+	RefundsRepository          repositories.IRefundsRepository
+	MaxAttempts                uint8
+	RetryInterval              time.Duration
+}
+
+func (c *ChargeDueScheduledCharges) Execute(input ChargeDueScheduledChargesInput) (ChargeDueScheduledChargesOutput, error) {
+	dueCharges, err := c.ScheduledChargesRepository.FindAllDue(input.Now)
+	if err != nil {
+		return ChargeDueScheduledChargesOutput{}, err
+	}
+
+	output := ChargeDueScheduledChargesOutput{}
+
+	for _, dueCharge := range dueCharges {
+		foundBooking, err := c.BookingsRepository.FindOneById(dueCharge.BookingId)
+		if err != nil {
+			return output, err
+		}
+
+		if foundBooking == nil || foundBooking.Status != "CONFIRMED" {
+			dueCharge.Cancel()
+
+			if err := c.ScheduledChargesRepository.Update(dueCharge); err != nil {
+				return output, err
+			}
+
+			continue
+		}
+
+		transactionId, failureReason := chargeNow(c.PaymentsGateway, dueCharge.BookingId, dueCharge.PaymentToken,
+			dueCharge.Amount)
+
+		if failureReason == "" {
+			err = dueCharge.RecordSuccess(transactionId)
+		} else {
+			err = dueCharge.RecordFailure(failureReason, input.Now, c.MaxAttempts, c.RetryInterval)
+		}
+
+		if err != nil {
+			return output, err
+		}
+
+		err = c.ScheduledChargesRepository.Update(dueCharge)
+		if err != nil {
+			return output, err
+		}
+
+		switch dueCharge.Status {
+		case "PAID":
+			output.Paid++
+		case "SCHEDULED":
+			output.Retrying++
+		case "FAILED":
+			output.Failed++
+
+			if !dueCharge.Mandatory {
+				continue
+			}
+
+			foundBooking.Cancel()
+
+			if err := c.BookingsRepository.Update(*foundBooking); err != nil {
+				return output, err
+			}
+
+			if err := c.cancelRemainingCharges(dueCharge); err != nil {
+				return output, err
+			}
+
+			_, err := scheduledChargeSettlement{
+				paymentsGateway:            c.PaymentsGateway,
+				scheduledChargesRepository: c.ScheduledChargesRepository,
+				refundsRepository:          c.RefundsRepository,
+			}.settle(foundBooking.Id, func(uint64) uint64 { return 0 }, "CANCELLATION")
+			if err != nil {
+				return output, err
+			}
+
+			output.CancelledBookings++
+		}
+	}
+
+	return output, nil
+}
+
+func (c *ChargeDueScheduledCharges) cancelRemainingCharges(failedCharge payment.ScheduledCharge) error {
+	scheduledCharges, err := c.ScheduledChargesRepository.FindAllByBookingId(failedCharge.BookingId)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledCharge := range scheduledCharges {
+		if scheduledCharge.Id == failedCharge.Id || scheduledCharge.Status != "SCHEDULED" {
+			continue
+		}
+
+		scheduledCharge.Cancel()
+
+		if err := c.ScheduledChargesRepository.Update(scheduledCharge); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chargeNow authorizes and immediately captures an amount, voiding the authorization when the capture fails. It
+// returns the transaction id, or the reason the charge failed.
+func chargeNow(paymentsGateway gateways.IPaymentsGateway, reference uuid.UUID, paymentToken string,
+	amount uint64) (string, string) {
+	authorization, err := paymentsGateway.Authorize(reference, paymentToken, amount)
+	if err != nil {
+		return "", err.Error()
+	}
+
+	if !authorization.Approved {
+		return "", authorization.DeclineReason
+	}
+
+	capture, err := paymentsGateway.Capture(authorization.TransactionId, amount)
+	if err != nil || !capture.Approved {
+		_, _ = paymentsGateway.Void(authorization.TransactionId)
+
+		if err != nil {
+			return "", err.Error()
+		}
+
+		return "", capture.DeclineReason
+	}
+
+	return authorization.TransactionId, ""
+}
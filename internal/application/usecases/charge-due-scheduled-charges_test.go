@@ -0,0 +1,165 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChargeDueScheduledChargesSuite struct {
+	suite.Suite
+	now                            time.Time
+	booking                        booking.Booking
+	fakePaymentsGateway            gateways.FakePaymentsGateway
+	fakeBookingsRepository         repositories.FakeBookingsRepository
+	fakeScheduledChargesRepository repositories.FakeScheduledChargesRepository
+	fakeRefundsRepository          repositories.FakeRefundsRepository
+	chargeDueScheduledCharges      usecases.ChargeDueScheduledCharges
+}
+
+func (c *ChargeDueScheduledChargesSuite) SetupTest() {
+	c.now = time.Date(2030, 1, 5, 6, 0, 0, 0, time.UTC)
+	c.booking = booking.Booking{
+		Id:         uuid.MustParse("d7a1f5a2-63f4-4c0e-9d55-0f5b7b1c2e31"),
+		CustomerId: uuid.New(),
+		RoomIds:    []uuid.UUID{uuid.New()},
+		CheckIn:    time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 1, 12, 0, 0, 0, 0, time.UTC),
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	}
+	c.fakePaymentsGateway = gateways.FakePaymentsGateway{DeclinedPaymentTokens: []string{"tok_declined"}}
+	c.fakeBookingsRepository = repositories.FakeBookingsRepository{Bookings: []booking.Booking{c.booking}}
+	c.fakeScheduledChargesRepository = repositories.FakeScheduledChargesRepository{}
+	c.fakeRefundsRepository = repositories.FakeRefundsRepository{CapturedAmounts: map[uuid.UUID]uint64{}}
+	c.chargeDueScheduledCharges = usecases.ChargeDueScheduledCharges{
+		PaymentsGateway:            &c.fakePaymentsGateway,
+		BookingsRepository:         &c.fakeBookingsRepository,
+		ScheduledChargesRepository: &c.fakeScheduledChargesRepository,
+		RefundsRepository:          &c.fakeRefundsRepository,
+		MaxAttempts:                2,
+		RetryInterval:              24 * time.Hour,
+	}
+}
+
+func (c *ChargeDueScheduledChargesSuite) scheduledCharge(sequence uint8, dueOn time.Time, paymentToken string,
+	mandatory bool) payment.ScheduledCharge {
+	scheduledCharge, err := payment.NewScheduledCharge(c.booking.Id, payment.PlannedCharge{
+		Sequence:  sequence,
+		Amount:    250,
+		DueOn:     dueOn,
+		Mandatory: mandatory,
+	}, paymentToken)
+	c.Require().NoError(err)
+
+	return scheduledCharge
+}
+
+func (c *ChargeDueScheduledChargesSuite) TestExecute_OnDueCharge_ChargesIt() {
+	c.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{
+		c.scheduledCharge(1, time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), "tok_visa", true),
+		c.scheduledCharge(2, time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC), "tok_visa", false),
+	}
+
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{Now: c.now})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.ChargeDueScheduledChargesOutput{Paid: 1}, output)
+	c.Equal("PAID", c.fakeScheduledChargesRepository.ScheduledCharges[0].Status)
+	c.Equal(c.fakePaymentsGateway.Transactions[0].Id, c.fakeScheduledChargesRepository.ScheduledCharges[0].TransactionId)
+	c.Equal(uint64(250), c.fakePaymentsGateway.Transactions[0].CapturedAmount)
+	c.Equal("SCHEDULED", c.fakeScheduledChargesRepository.ScheduledCharges[1].Status)
+}
+
+func (c *ChargeDueScheduledChargesSuite) TestExecute_OnDeclinedCharge_RetriesLater() {
+	c.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{
+		c.scheduledCharge(1, time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), "tok_declined", true),
+	}
+
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{Now: c.now})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.ChargeDueScheduledChargesOutput{Retrying: 1}, output)
+	retryingCharge := c.fakeScheduledChargesRepository.ScheduledCharges[0]
+	c.Equal("SCHEDULED", retryingCharge.Status)
+	c.Equal(uint8(1), retryingCharge.Attempts)
+	c.Equal(c.now.Add(24*time.Hour), retryingCharge.NextAttemptAt)
+	c.Equal("card declined", retryingCharge.LastFailureReason)
+}
+
+func (c *ChargeDueScheduledChargesSuite) TestExecute_OnMandatoryChargeOutOfAttempts_CancelsBooking() {
+	failingCharge := c.scheduledCharge(1, time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), "tok_declined", true)
+	failingCharge.Attempts = 1
+	c.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{
+		failingCharge,
+		c.scheduledCharge(2, time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC), "tok_declined", false),
+	}
+
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{Now: c.now})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.ChargeDueScheduledChargesOutput{Failed: 1, CancelledBookings: 1}, output)
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+	c.Equal("FAILED", c.fakeScheduledChargesRepository.ScheduledCharges[0].Status)
+	c.Equal("CANCELLED", c.fakeScheduledChargesRepository.ScheduledCharges[1].Status)
+}
+
+func (c *ChargeDueScheduledChargesSuite) TestExecute_OnMandatoryChargeOutOfAttempts_RefundsPaidCharges() {
+	result, err := c.fakePaymentsGateway.Authorize(c.booking.Id, "tok_visa", 250)
+	c.Require().NoError(err)
+	_, err = c.fakePaymentsGateway.Capture(result.TransactionId, 250)
+	c.Require().NoError(err)
+	paidCharge := c.scheduledCharge(1, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), "tok_visa", true)
+	c.Require().NoError(paidCharge.RecordSuccess(result.TransactionId))
+	c.fakeRefundsRepository.CapturedAmounts[paidCharge.Id] = 250
+	failingCharge := c.scheduledCharge(2, time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), "tok_declined", true)
+	failingCharge.Attempts = 1
+	c.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{paidCharge, failingCharge}
+
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{Now: c.now})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.ChargeDueScheduledChargesOutput{Failed: 1, CancelledBookings: 1}, output)
+	c.Require().Len(c.fakeRefundsRepository.Refunds, 1)
+	c.Equal(paidCharge.Id, c.fakeRefundsRepository.Refunds[0].ScheduledChargeId)
+	c.Equal(uint64(250), c.fakeRefundsRepository.Refunds[0].Amount)
+	c.Equal("APPROVED", c.fakeRefundsRepository.Refunds[0].Status)
+	c.Equal(uint64(250), c.fakePaymentsGateway.Transactions[0].RefundedAmount)
+}
+
+func (c *ChargeDueScheduledChargesSuite) TestExecute_OnOptionalChargeOutOfAttempts_KeepsBooking() {
+	failingCharge := c.scheduledCharge(1, time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), "tok_declined", false)
+	failingCharge.Attempts = 1
+	c.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{failingCharge}
+
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{Now: c.now})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.ChargeDueScheduledChargesOutput{Failed: 1}, output)
+	c.Equal("CONFIRMED", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *ChargeDueScheduledChargesSuite) TestExecute_OnCancelledBooking_CancelsCharge() {
+	c.fakeBookingsRepository.Bookings[0].Status = "CANCELLED"
+	c.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{
+		c.scheduledCharge(1, time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), "tok_visa", true),
+	}
+
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{Now: c.now})
+	c.Require().NoError(err)
+
+	c.Equal(usecases.ChargeDueScheduledChargesOutput{}, output)
+	c.Equal("CANCELLED", c.fakeScheduledChargesRepository.ScheduledCharges[0].Status)
+	c.Empty(c.fakePaymentsGateway.Transactions)
+}
+
+func TestChargeDueScheduledCharges(t *testing.T) {
+	suite.Run(t, new(ChargeDueScheduledChargesSuite))
+}
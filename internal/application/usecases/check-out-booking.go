@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type CheckOutBookingInput struct {
+	BookingId uuid.UUID
+	Override  bool
+}
+
+type CheckOutBookingOutput struct {
+	Balance    int64
+	Overridden bool
+}
+
+type ICheckOutBooking interface {
+	Execute(input CheckOutBookingInput) (CheckOutBookingOutput, error)
+}
+
+type CheckOutBooking struct {
+	BookingsRepository         repositories.IBookingsRepository
+	PaymentsRepository         repositories.IPaymentsRepository
+	ScheduledChargesRepository repositories.IScheduledChargesRepository
+	RefundsRepository          repositories.IRefundsRepository
+	FolioEntriesRepository     repositories.IFolioEntriesRepository
+}
+
+func (c *CheckOutBooking) Execute(input CheckOutBookingInput) (CheckOutBookingOutput, error) {
+	foundBooking, err := c.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return CheckOutBookingOutput{}, err
+	}
+
+	if foundBooking == nil {
+		return CheckOutBookingOutput{}, errors.New("booking not found")
+	}
+
+	guestFolio, err := loadFolio(c.PaymentsRepository, c.ScheduledChargesRepository, c.RefundsRepository, c.FolioEntriesRepository, *foundBooking)
+	if err != nil {
+		return CheckOutBookingOutput{}, err
+	}
+
+	if !guestFolio.IsSettled() && !input.Override {
+		return CheckOutBookingOutput{}, errors.New("folio balance must be settled before check-out")
+	}
+
+	err = foundBooking.CheckOutStay()
+	if err != nil {
+		return CheckOutBookingOutput{}, err
+	}
+
+	err = c.BookingsRepository.Update(*foundBooking)
+	if err != nil {
+		return CheckOutBookingOutput{}, err
+	}
+
+	return CheckOutBookingOutput{
+		Balance:    guestFolio.Balance,
+		Overridden: !guestFolio.IsSettled(),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type CheckOutBookingSuite struct {
+	suite.Suite
+	bookingId                      uuid.UUID
+	fakeBookingsRepository         repositories.FakeBookingsRepository
+	fakePaymentsRepository         repositories.FakePaymentsRepository
+	fakeScheduledChargesRepository repositories.FakeScheduledChargesRepository
+	fakeRefundsRepository          repositories.FakeRefundsRepository
+	fakeFolioEntriesRepository     repositories.FakeFolioEntriesRepository
+	checkOutBooking                usecases.CheckOutBooking
+}
+
+func (c *CheckOutBookingSuite) SetupTest() {
+	c.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	c.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{
+			{
+				Id:         c.bookingId,
+				CheckIn:    time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+				CheckOut:   time.Date(2030, 1, 12, 0, 0, 0, 0, time.UTC),
+				TotalPrice: 400,
+				Status:     "CONFIRMED",
+			},
+		},
+	}
+	c.fakePaymentsRepository = repositories.FakePaymentsRepository{
+		Payments: []payment.Payment{
+			{Id: uuid.New(), BookingId: c.bookingId, Amount: 100, CapturedAmount: 100, Status: "CAPTURED"},
+		},
+	}
+	c.fakeRefundsRepository = repositories.FakeRefundsRepository{}
+	c.fakeFolioEntriesRepository = repositories.FakeFolioEntriesRepository{}
+	c.checkOutBooking = usecases.CheckOutBooking{
+		BookingsRepository:         &c.fakeBookingsRepository,
+		PaymentsRepository:         &c.fakePaymentsRepository,
+		ScheduledChargesRepository: &c.fakeScheduledChargesRepository,
+		RefundsRepository:          &c.fakeRefundsRepository,
+		FolioEntriesRepository:     &c.fakeFolioEntriesRepository,
+	}
+}
+
+func (c *CheckOutBookingSuite) TestExecute_OnSettledFolio_ChecksOut() {
+	c.fakeFolioEntriesRepository.FolioEntries = []folio.FolioEntry{
+		{Id: uuid.New(), BookingId: c.bookingId, Type: "PAYMENT", Category: "CARD", Amount: 300, Status: "POSTED",
+			PostedAt: time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC)},
+	}
+
+	output, err := c.checkOutBooking.Execute(usecases.CheckOutBookingInput{BookingId: c.bookingId})
+	c.Require().NoError(err)
+
+	c.Equal(int64(0), output.Balance)
+	c.False(output.Overridden)
+	c.Equal("CHECKED_OUT", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *CheckOutBookingSuite) TestExecute_OnOutstandingBalance_ReturnsError() {
+	_, err := c.checkOutBooking.Execute(usecases.CheckOutBookingInput{BookingId: c.bookingId})
+
+	c.EqualError(err, "folio balance must be settled before check-out")
+	c.Equal("CONFIRMED", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *CheckOutBookingSuite) TestExecute_OnOutstandingBalanceWithOverride_ChecksOut() {
+	output, err := c.checkOutBooking.Execute(usecases.CheckOutBookingInput{BookingId: c.bookingId, Override: true})
+	c.Require().NoError(err)
+
+	c.Equal(int64(300), output.Balance)
+	c.True(output.Overridden)
+	c.Equal("CHECKED_OUT", c.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (c *CheckOutBookingSuite) TestExecute_OnUnknownBooking_ReturnsError() {
+	_, err := c.checkOutBooking.Execute(usecases.CheckOutBookingInput{BookingId: uuid.New()})
+
+	c.EqualError(err, "booking not found")
+}
+
+func TestCheckOutBooking(t *testing.T) {
+	suite.Run(t, new(CheckOutBookingSuite))
+}
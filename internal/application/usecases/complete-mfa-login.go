@@ -0,0 +1,200 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+)
+
+type CompleteMfaLoginInput struct {
+	ChallengeToken string
+	Code           string
+	RecoveryCode   string
+	IpAddress      string
+}
+
+type CompleteMfaLoginOutput struct {
+	CustomerId           uuid.UUID
+	StaffUserId          uuid.UUID
+	Name                 string
+	Role                 string
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+	// RetryAfter is set when the attempt was refused because of too many wrong codes.
+	RetryAfter time.Duration
+}
+
+type ICompleteMfaLogin interface {
+	Execute(input CompleteMfaLoginInput) (CompleteMfaLoginOutput, error)
+}
+
+type CompleteMfaLogin struct {
+	SecretsGateway          gateways.ISecretsGateway
+	SigningKeysSource       *auth.SigningKeysSource
+	CustomersGateway        gateways.ICustomersGateway
+	StaffUsersRepository    repositories.IStaffUsersRepository
+	TotpFactorsRepository   repositories.ITotpFactorsRepository
+	RefreshTokensRepository repositories.IRefreshTokensRepository
+	LoginThrottle           LoginThrottle
+	AccessTokenTtl          time.Duration
+	RefreshTokenTtl         time.Duration
+}
+
+// Execute exchanges the challenge issued by a password login and a code from the authenticator app, or one of the
+// recovery codes, for a session. Wrong codes count towards the login throttle like wrong passwords do.
+func (c *CompleteMfaLogin) Execute(input CompleteMfaLoginInput) (CompleteMfaLoginOutput, error) {
+	if input.Code == "" && input.RecoveryCode == "" {
+		return CompleteMfaLoginOutput{}, errors.New("a verification code or a recovery code is required")
+	}
+
+	claims, err := parseMfaChallengeToken(c.SecretsGateway, input.ChallengeToken)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	now := time.Now().UTC()
+	userId := claims.CustomerId
+	if userId == uuid.Nil {
+		userId = claims.StaffUserId
+	}
+
+	throttleSubjects := c.LoginThrottle.mfaSubjects(userId, input.IpAddress)
+
+	retryAfter, err := c.LoginThrottle.retryAfter(throttleSubjects, now)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	if retryAfter > 0 {
+		return CompleteMfaLoginOutput{RetryAfter: retryAfter},
+			errors.New("too many failed login attempts. Please try again later")
+	}
+
+	totpFactor, err := findTotpFactor(c.TotpFactorsRepository, claims.CustomerId, claims.StaffUserId)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	if totpFactor == nil || !totpFactor.IsActive() {
+		return CompleteMfaLoginOutput{}, errors.New("mfa challenge is invalid or has expired")
+	}
+
+	err = c.verify(*totpFactor, input, now)
+	if err != nil {
+		if err.Error() != "verification code is invalid" {
+			return CompleteMfaLoginOutput{}, err
+		}
+
+		recordErr := c.LoginThrottle.recordFailure(throttleSubjects, now)
+		if recordErr != nil {
+			return CompleteMfaLoginOutput{}, recordErr
+		}
+
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	err = c.LoginThrottle.reset(throttleSubjects)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	if claims.CustomerId != uuid.Nil {
+		return c.startCustomerSession(claims.CustomerId)
+	}
+
+	return c.startStaffSession(claims.StaffUserId)
+}
+
+func (c *CompleteMfaLogin) verify(totpFactor mfa.TotpFactor, input CompleteMfaLoginInput, now time.Time) error {
+	if input.RecoveryCode != "" {
+		return c.TotpFactorsRepository.UseRecoveryCode(totpFactor.Id, mfa.HashRecoveryCode(input.RecoveryCode))
+	}
+
+	step, err := totpFactor.Verify(input.Code, now)
+	if err != nil {
+		return err
+	}
+
+	return c.TotpFactorsRepository.UseStep(totpFactor.Id, step)
+}
+
+func (c *CompleteMfaLogin) startCustomerSession(customerId uuid.UUID) (CompleteMfaLoginOutput, error) {
+	customerDTO, err := c.CustomersGateway.FindOneById(customerId)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	if customerDTO == nil {
+		return CompleteMfaLoginOutput{}, errors.New("mfa challenge is invalid or has expired")
+	}
+
+	refreshToken, plainRefreshToken, err := session.NewRefreshToken(customerId, c.RefreshTokenTtl)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	err = c.RefreshTokensRepository.Create(refreshToken)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	accessTokenExpiresAt := time.Now().UTC().Add(c.AccessTokenTtl)
+
+	signedToken, err := signAccessToken(c.SigningKeysSource, customerId, refreshToken.FamilyId, accessTokenExpiresAt)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	return CompleteMfaLoginOutput{
+		CustomerId:           customerId,
+		Name:                 customerDTO.Name,
+		Role:                 "CUSTOMER",
+		AccessToken:          signedToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         plainRefreshToken,
+	}, nil
+}
+
+func (c *CompleteMfaLogin) startStaffSession(staffUserId uuid.UUID) (CompleteMfaLoginOutput, error) {
+	staffUser, err := c.StaffUsersRepository.FindOneById(staffUserId)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	if staffUser == nil {
+		return CompleteMfaLoginOutput{}, errors.New("mfa challenge is invalid or has expired")
+	}
+
+	refreshToken, plainRefreshToken, err := session.NewStaffRefreshToken(staffUserId, c.RefreshTokenTtl)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	err = c.RefreshTokensRepository.Create(refreshToken)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	accessTokenExpiresAt := time.Now().UTC().Add(c.AccessTokenTtl)
+
+	signedToken, err := signStaffAccessToken(c.SigningKeysSource, *staffUser, refreshToken.FamilyId, accessTokenExpiresAt)
+	if err != nil {
+		return CompleteMfaLoginOutput{}, err
+	}
+
+	return CompleteMfaLoginOutput{
+		StaffUserId:          staffUserId,
+		Name:                 staffUser.Name,
+		Role:                 staffUser.Role,
+		AccessToken:          signedToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         plainRefreshToken,
+	}, nil
+}
@@ -0,0 +1,236 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompleteMfaLoginSuite struct {
+	suite.Suite
+	staffUser          staff.StaffUser
+	fakeSecretsGateway gateways.FakeSecretsGateway
+	fakeStaffUsers     repositories.FakeStaffUsersRepository
+	fakeRefreshTokens  repositories.FakeRefreshTokensRepository
+	fakeTotpFactors    repositories.FakeTotpFactorsRepository
+	fakeLoginAttempts  repositories.FakeLoginAttemptsRepository
+	fakeLoginLockouts  repositories.FakeLoginLockoutsRepository
+	enrollTotp         usecases.EnrollTotp
+	activateTotp       usecases.ActivateTotp
+	loginStaff         usecases.LoginStaffWithEmailAndPassword
+	completeMfaLogin   usecases.CompleteMfaLogin
+}
+
+func (c *CompleteMfaLoginSuite) SetupTest() {
+	var err error
+	c.staffUser, err = staff.NewStaffUser("Jane Roe", "jane.roe@hotel.com", "s3cret-password", "ADMIN", account.PasswordHasher{})
+	c.Require().NoError(err)
+	_, rawSigningKeys := newSigningKeys(&c.Suite)
+	c.fakeSecretsGateway = gateways.FakeSecretsGateway{
+		Secrets: map[string]string{
+			"JWT_SIGNING_KEYS":         rawSigningKeys,
+			"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1",
+		},
+	}
+	c.fakeStaffUsers = repositories.FakeStaffUsersRepository{StaffUsers: []staff.StaffUser{c.staffUser}}
+	c.fakeRefreshTokens = repositories.FakeRefreshTokensRepository{}
+	c.fakeTotpFactors = repositories.FakeTotpFactorsRepository{}
+	c.fakeLoginAttempts = repositories.FakeLoginAttemptsRepository{}
+	c.fakeLoginLockouts = repositories.FakeLoginLockoutsRepository{}
+	accountPolicy, err := account.NewLoginThrottlePolicy(2, 3, time.Second, 15*time.Minute)
+	c.Require().NoError(err)
+	ipAddressPolicy, err := account.NewLoginThrottlePolicy(20, 100, time.Second, 15*time.Minute)
+	c.Require().NoError(err)
+	c.enrollTotp = usecases.EnrollTotp{
+		TotpFactorsRepository: &c.fakeTotpFactors,
+		StaffUsersRepository:  &c.fakeStaffUsers,
+		Issuer:                "Hotel Booking",
+	}
+	c.activateTotp = usecases.ActivateTotp{
+		TotpFactorsRepository: &c.fakeTotpFactors,
+		RecoveryCodesCount:    10,
+	}
+	loginThrottle := usecases.LoginThrottle{
+		LoginAttemptsRepository: &c.fakeLoginAttempts,
+		LoginLockoutsRepository: &c.fakeLoginLockouts,
+		AccountPolicy:           accountPolicy,
+		IpAddressPolicy:         ipAddressPolicy,
+	}
+	c.loginStaff = usecases.LoginStaffWithEmailAndPassword{
+		SecretsGateway:          &c.fakeSecretsGateway,
+		SigningKeysSource:       &auth.SigningKeysSource{SecretsGateway: &c.fakeSecretsGateway},
+		StaffUsersRepository:    &c.fakeStaffUsers,
+		RefreshTokensRepository: &c.fakeRefreshTokens,
+		TotpFactorsRepository:   &c.fakeTotpFactors,
+		AccessTokenTtl:          15 * time.Minute,
+		RefreshTokenTtl:         time.Hour,
+		LoginThrottle:           loginThrottle,
+		MfaChallengeTtl:         5 * time.Minute,
+	}
+	c.completeMfaLogin = usecases.CompleteMfaLogin{
+		SecretsGateway:          &c.fakeSecretsGateway,
+		SigningKeysSource:       &auth.SigningKeysSource{SecretsGateway: &c.fakeSecretsGateway},
+		StaffUsersRepository:    &c.fakeStaffUsers,
+		TotpFactorsRepository:   &c.fakeTotpFactors,
+		RefreshTokensRepository: &c.fakeRefreshTokens,
+		LoginThrottle:           loginThrottle,
+		AccessTokenTtl:          15 * time.Minute,
+		RefreshTokenTtl:         time.Hour,
+	}
+}
+
+// enable enrolls the staff user, activates the factor with the code of the current step and returns the recovery
+// codes. The current step is used up afterwards, so the tests log in with the code of the next one.
+func (c *CompleteMfaLoginSuite) enable() []string {
+	principal := auth.Principal{StaffUserId: c.staffUser.Id, Role: "ADMIN"}
+
+	enrolled, err := c.enrollTotp.Execute(usecases.EnrollTotpInput{Principal: principal})
+	c.Require().NoError(err)
+	c.Contains(enrolled.ProvisioningUri, "otpauth://totp/Hotel%20Booking:jane.roe@hotel.com?")
+
+	activated, err := c.activateTotp.Execute(usecases.ActivateTotpInput{
+		Principal: principal,
+		Code:      mfa.GenerateTotpCode(c.fakeTotpFactors.TotpFactors[0].Secret, mfa.CurrentTotpStep(time.Now())),
+	})
+	c.Require().NoError(err)
+	c.Require().Len(activated.RecoveryCodes, 10)
+
+	return activated.RecoveryCodes
+}
+
+func (c *CompleteMfaLoginSuite) challenge() string {
+	output, err := c.loginStaff.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+	c.Require().NoError(err)
+	c.Require().NotEmpty(output.MfaChallengeToken)
+	return output.MfaChallengeToken
+}
+
+func (c *CompleteMfaLoginSuite) nextCode() string {
+	return mfa.GenerateTotpCode(c.fakeTotpFactors.TotpFactors[0].Secret, mfa.CurrentTotpStep(time.Now())+1)
+}
+
+func (c *CompleteMfaLoginSuite) TestExecute_OnValidCode_StartsStaffSession() {
+	c.enable()
+
+	output, err := c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+		ChallengeToken: c.challenge(),
+		Code:           c.nextCode(),
+	})
+	c.Require().NoError(err)
+
+	c.Equal(c.staffUser.Id, output.StaffUserId)
+	c.Equal(uuid.Nil, output.CustomerId)
+	c.Equal("ADMIN", output.Role)
+	c.NotEmpty(output.AccessToken)
+	c.Require().Len(c.fakeRefreshTokens.RefreshTokens, 1)
+	c.Equal(c.staffUser.Id, c.fakeRefreshTokens.RefreshTokens[0].StaffUserId)
+}
+
+func (c *CompleteMfaLoginSuite) TestExecute_OnReusedCode_ReturnsError() {
+	c.enable()
+	code := c.nextCode()
+
+	_, err := c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{ChallengeToken: c.challenge(), Code: code})
+	c.Require().NoError(err)
+
+	_, err = c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{ChallengeToken: c.challenge(), Code: code})
+
+	c.EqualError(err, "verification code is invalid")
+	c.Len(c.fakeRefreshTokens.RefreshTokens, 1)
+}
+
+func (c *CompleteMfaLoginSuite) TestExecute_OnRecoveryCode_AcceptsItOnce() {
+	recoveryCodes := c.enable()
+
+	_, err := c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+		ChallengeToken: c.challenge(),
+		RecoveryCode:   recoveryCodes[0],
+	})
+	c.Require().NoError(err)
+	c.Len(c.fakeTotpFactors.RecoveryCodes, 9)
+
+	_, err = c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+		ChallengeToken: c.challenge(),
+		RecoveryCode:   recoveryCodes[0],
+	})
+
+	c.EqualError(err, "verification code is invalid")
+}
+
+func (c *CompleteMfaLoginSuite) TestExecute_OnRepeatedWrongCodes_ThrottlesTheAccount() {
+	c.enable()
+	challengeToken := c.challenge()
+
+	for range 3 {
+		_, err := c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+			ChallengeToken: challengeToken,
+			Code:           "000000",
+			IpAddress:      "192.0.2.1",
+		})
+		c.EqualError(err, "verification code is invalid")
+	}
+
+	output, err := c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+		ChallengeToken: challengeToken,
+		Code:           c.nextCode(),
+		IpAddress:      "192.0.2.1",
+	})
+
+	c.EqualError(err, "too many failed login attempts. Please try again later")
+	c.Greater(output.RetryAfter, 14*time.Minute)
+	c.Len(c.fakeLoginLockouts.LoginLockouts, 1)
+	c.Empty(c.fakeRefreshTokens.RefreshTokens)
+}
+
+func (c *CompleteMfaLoginSuite) TestExecute_OnInvalidChallenge_ReturnsError() {
+	c.enable()
+
+	_, err := c.completeMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+		ChallengeToken: "invalid-challenge",
+		Code:           c.nextCode(),
+	})
+
+	c.EqualError(err, "mfa challenge is invalid or has expired")
+}
+
+func (c *CompleteMfaLoginSuite) TestExecute_OnEnrollmentNotActivated_DoesNotAskForCode() {
+	_, err := c.enrollTotp.Execute(usecases.EnrollTotpInput{
+		Principal: auth.Principal{StaffUserId: c.staffUser.Id, Role: "ADMIN"},
+	})
+	c.Require().NoError(err)
+
+	output, err := c.loginStaff.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+	c.Require().NoError(err)
+
+	c.Empty(output.MfaChallengeToken)
+	c.NotEmpty(output.AccessToken)
+}
+
+func (c *CompleteMfaLoginSuite) TestEnroll_OnAlreadyEnabled_ReturnsError() {
+	c.enable()
+
+	_, err := c.enrollTotp.Execute(usecases.EnrollTotpInput{
+		Principal: auth.Principal{StaffUserId: c.staffUser.Id, Role: "ADMIN"},
+	})
+
+	c.EqualError(err, "two-factor authentication is already enabled")
+}
+
+func TestCompleteMfaLogin(t *testing.T) {
+	suite.Run(t, new(CompleteMfaLoginSuite))
+}
@@ -0,0 +1,217 @@
+package usecases
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+)
+
+type CompleteOidcLoginInput struct {
+	Provider   string
+	Code       string
+	State      string
+	LoginToken string
+}
+
+type CompleteOidcLoginOutput struct {
+	CustomerId           uuid.UUID
+	CustomerName         string
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+	// MfaChallengeToken replaces the tokens above when the customer has two-factor authentication enabled, exactly
+	// as it does for a password login.
+	MfaChallengeToken     string
+	MfaChallengeExpiresAt time.Time
+	// CustomerCreated is true when this login created the customer.
+	CustomerCreated bool
+}
+
+type ICompleteOidcLogin interface {
+	Execute(input CompleteOidcLoginInput) (CompleteOidcLoginOutput, error)
+}
+
+type CompleteOidcLogin struct {
+	SecretsGateway               gateways.ISecretsGateway
+	SigningKeysSource            *auth.SigningKeysSource
+	OidcGateway                  gateways.IOidcGateway
+	CustomersGateway             gateways.ICustomersGateway
+	CustomerIdentitiesRepository repositories.ICustomerIdentitiesRepository
+	RefreshTokensRepository      repositories.IRefreshTokensRepository
+	TotpFactorsRepository        repositories.ITotpFactorsRepository
+	AccessTokenTtl               time.Duration
+	RefreshTokenTtl              time.Duration
+	MfaChallengeTtl              time.Duration
+}
+
+// Execute redeems the code the provider redirected with and signs the customer linked to the provider subject in.
+// A first login creates the customer, or links an existing one with the same email when both the provider and this
+// service have verified that email; linking to an unverified account would let whoever registered it first take over
+// the provider login.
+func (c *CompleteOidcLogin) Execute(input CompleteOidcLoginInput) (CompleteOidcLoginOutput, error) {
+	claims, err := parseOidcLoginToken(c.SecretsGateway, input.LoginToken)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	if claims.Provider != input.Provider || claims.State != input.State {
+		return CompleteOidcLoginOutput{}, errors.New("oidc login is invalid or has expired")
+	}
+
+	provider, err := findOidcProvider(c.SecretsGateway, input.Provider)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	identity, err := c.OidcGateway.ExchangeCode(provider, input.Code, claims.CodeVerifier)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	if identity.Nonce != claims.Nonce {
+		return CompleteOidcLoginOutput{}, errors.New("identity provider returned an invalid id token")
+	}
+
+	customerDTO, customerCreated, err := c.findOrCreateCustomer(provider.Name, identity)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	now := time.Now().UTC()
+
+	totpFactor, err := c.TotpFactorsRepository.FindOneByCustomerId(customerDTO.Id)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	if totpFactor != nil && totpFactor.IsActive() {
+		mfaChallengeExpiresAt := now.Add(c.MfaChallengeTtl)
+
+		mfaChallengeToken, err := signMfaChallengeToken(c.SecretsGateway, customerDTO.Id, uuid.Nil, mfaChallengeExpiresAt)
+		if err != nil {
+			return CompleteOidcLoginOutput{}, err
+		}
+
+		return CompleteOidcLoginOutput{
+			MfaChallengeToken:     mfaChallengeToken,
+			MfaChallengeExpiresAt: mfaChallengeExpiresAt,
+		}, nil
+	}
+
+	refreshToken, plainRefreshToken, err := session.NewRefreshToken(customerDTO.Id, c.RefreshTokenTtl)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	err = c.RefreshTokensRepository.Create(refreshToken)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	accessTokenExpiresAt := now.Add(c.AccessTokenTtl)
+
+	signedToken, err := signAccessToken(c.SigningKeysSource, customerDTO.Id, refreshToken.FamilyId, accessTokenExpiresAt)
+	if err != nil {
+		return CompleteOidcLoginOutput{}, err
+	}
+
+	return CompleteOidcLoginOutput{
+		CustomerId:           customerDTO.Id,
+		CustomerName:         customerDTO.Name,
+		AccessToken:          signedToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         plainRefreshToken,
+		CustomerCreated:      customerCreated,
+	}, nil
+}
+
+func (c *CompleteOidcLogin) findOrCreateCustomer(provider string,
+	identity gateways.OidcIdentityDTO) (*gateways.CustomerDTO, bool, error) {
+	customerIdentity, err := c.CustomerIdentitiesRepository.FindOne(provider, identity.Subject)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if customerIdentity != nil {
+		customerDTO, err := c.CustomersGateway.FindOneById(customerIdentity.CustomerId)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if customerDTO == nil {
+			return nil, false, errors.New("customer not found")
+		}
+
+		return customerDTO, false, nil
+	}
+
+	if identity.Email == "" {
+		return nil, false, errors.New("identity provider did not share an email address")
+	}
+
+	customerDTO, err := c.CustomersGateway.FindOneByEmail(identity.Email)
+	if err != nil {
+		return nil, false, err
+	}
+
+	customerCreated := false
+
+	if customerDTO != nil {
+		if !identity.EmailVerified || customerDTO.Status != "VERIFIED" {
+			return nil, false, errors.New("email address is already associated with another account")
+		}
+	} else {
+		customerDTO = &gateways.CustomerDTO{
+			Id:     uuid.New(),
+			Name:   oidcCustomerName(identity),
+			Email:  identity.Email,
+			Status: "UNVERIFIED",
+		}
+
+		if identity.EmailVerified {
+			customerDTO.Status = "VERIFIED"
+		}
+
+		err = c.CustomersGateway.Create(*customerDTO)
+		if err != nil {
+			return nil, false, err
+		}
+
+		customerCreated = true
+	}
+
+	newCustomerIdentity, err := account.NewCustomerIdentity(customerDTO.Id, provider, identity.Subject)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = c.CustomerIdentitiesRepository.Create(newCustomerIdentity)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return customerDTO, customerCreated, nil
+}
+
+// oidcCustomerName falls back to the local part of the email when the provider shares no name, and is cut to the 50
+// characters the customers table allows.
+func oidcCustomerName(identity gateways.OidcIdentityDTO) string {
+	name := strings.TrimSpace(identity.Name)
+	if name == "" {
+		name, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	runes := []rune(name)
+	if len(runes) > 50 {
+		name = string(runes[:50])
+	}
+
+	return name
+}
@@ -0,0 +1,251 @@
+package usecases_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompleteOidcLoginSuite struct {
+	suite.Suite
+	fakeSecretsGateway     gateways.FakeSecretsGateway
+	fakeOidcGateway        gateways.FakeOidcGateway
+	fakeCustomersGateway   gateways.FakeCustomersGateway
+	fakeCustomerIdentities repositories.FakeCustomerIdentitiesRepository
+	fakeRefreshTokens      repositories.FakeRefreshTokensRepository
+	fakeTotpFactors        repositories.FakeTotpFactorsRepository
+	startOidcLogin         usecases.StartOidcLogin
+	completeOidcLogin      usecases.CompleteOidcLogin
+}
+
+func (c *CompleteOidcLoginSuite) SetupTest() {
+	_, rawSigningKeys := newSigningKeys(&c.Suite)
+	c.fakeSecretsGateway = gateways.FakeSecretsGateway{
+		Secrets: map[string]string{
+			"JWT_SIGNING_KEYS":         rawSigningKeys,
+			"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1",
+			"OIDC_PROVIDERS": `[{
+				"name": "acme",
+				"issuer": "https://id.acme.test",
+				"clientId": "hotel-booking",
+				"clientSecret": "any_client_secret",
+				"redirectUrl": "http://localhost:3000/oidc/acme/callback"
+			}]`,
+		},
+	}
+	c.fakeOidcGateway = gateways.FakeOidcGateway{Identities: map[string]gateways.OidcIdentityDTO{}}
+	c.fakeCustomersGateway = gateways.FakeCustomersGateway{}
+	c.fakeCustomerIdentities = repositories.FakeCustomerIdentitiesRepository{}
+	c.fakeRefreshTokens = repositories.FakeRefreshTokensRepository{}
+	c.fakeTotpFactors = repositories.FakeTotpFactorsRepository{}
+	c.startOidcLogin = usecases.StartOidcLogin{
+		SecretsGateway: &c.fakeSecretsGateway,
+		OidcGateway:    &c.fakeOidcGateway,
+		LoginTokenTtl:  10 * time.Minute,
+	}
+	c.completeOidcLogin = usecases.CompleteOidcLogin{
+		SecretsGateway:               &c.fakeSecretsGateway,
+		SigningKeysSource:            &auth.SigningKeysSource{SecretsGateway: &c.fakeSecretsGateway},
+		OidcGateway:                  &c.fakeOidcGateway,
+		CustomersGateway:             &c.fakeCustomersGateway,
+		CustomerIdentitiesRepository: &c.fakeCustomerIdentities,
+		RefreshTokensRepository:      &c.fakeRefreshTokens,
+		TotpFactorsRepository:        &c.fakeTotpFactors,
+		AccessTokenTtl:               15 * time.Minute,
+		RefreshTokenTtl:              time.Hour,
+		MfaChallengeTtl:              5 * time.Minute,
+	}
+}
+
+// login starts a login and completes it with an authorization code the fake provider maps to the given identity,
+// carrying the nonce of the authorization request as a real provider would.
+func (c *CompleteOidcLoginSuite) login(identity gateways.OidcIdentityDTO) (usecases.CompleteOidcLoginOutput, error) {
+	started, err := c.startOidcLogin.Execute(usecases.StartOidcLoginInput{Provider: "acme"})
+	c.Require().NoError(err)
+	authorizationRequest := c.fakeOidcGateway.AuthorizationRequests[len(c.fakeOidcGateway.AuthorizationRequests)-1]
+	identity.Nonce = authorizationRequest.Nonce
+	c.fakeOidcGateway.Identities["any_code"] = identity
+
+	return c.completeOidcLogin.Execute(usecases.CompleteOidcLoginInput{
+		Provider:   "acme",
+		Code:       "any_code",
+		State:      authorizationRequest.State,
+		LoginToken: started.LoginToken,
+	})
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnFirstLogin_CreatesAndLinksCustomer() {
+	output, err := c.login(gateways.OidcIdentityDTO{
+		Subject:       "248289761001",
+		Email:         "jane.doe@gmail.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+	})
+	c.Require().NoError(err)
+
+	c.True(output.CustomerCreated)
+	c.Equal("Jane Doe", output.CustomerName)
+	c.NotEmpty(output.AccessToken)
+	c.Require().Len(c.fakeCustomersGateway.CustomersDTO, 1)
+	c.Equal(output.CustomerId, c.fakeCustomersGateway.CustomersDTO[0].Id)
+	c.Equal("VERIFIED", c.fakeCustomersGateway.CustomersDTO[0].Status)
+	c.Empty(c.fakeCustomersGateway.CustomersDTO[0].HashedPassword)
+	c.Require().Len(c.fakeCustomerIdentities.CustomerIdentities, 1)
+	c.Equal("acme", c.fakeCustomerIdentities.CustomerIdentities[0].Provider)
+	c.Equal("248289761001", c.fakeCustomerIdentities.CustomerIdentities[0].Subject)
+	c.Require().Len(c.fakeRefreshTokens.RefreshTokens, 1)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnReturningCustomer_UsesTheLinkEvenIfTheEmailChanged() {
+	first, err := c.login(gateways.OidcIdentityDTO{Subject: "248289761001", Email: "jane.doe@gmail.com"})
+	c.Require().NoError(err)
+
+	second, err := c.login(gateways.OidcIdentityDTO{Subject: "248289761001", Email: "jane@doe.com"})
+	c.Require().NoError(err)
+
+	c.False(second.CustomerCreated)
+	c.Equal(first.CustomerId, second.CustomerId)
+	c.Len(c.fakeCustomersGateway.CustomersDTO, 1)
+	c.Len(c.fakeCustomerIdentities.CustomerIdentities, 1)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnVerifiedEmailOfVerifiedCustomer_LinksTheExistingCustomer() {
+	customerId := uuid.New()
+	c.fakeCustomersGateway.CustomersDTO = []gateways.CustomerDTO{
+		{Id: customerId, Name: "Jane Doe", Email: "jane.doe@gmail.com", Status: "VERIFIED"},
+	}
+
+	output, err := c.login(gateways.OidcIdentityDTO{
+		Subject:       "248289761001",
+		Email:         "jane.doe@gmail.com",
+		EmailVerified: true,
+	})
+	c.Require().NoError(err)
+
+	c.False(output.CustomerCreated)
+	c.Equal(customerId, output.CustomerId)
+	c.Require().Len(c.fakeCustomerIdentities.CustomerIdentities, 1)
+	c.Equal(customerId, c.fakeCustomerIdentities.CustomerIdentities[0].CustomerId)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnEmailOfUnverifiedCustomer_ReturnsError() {
+	c.fakeCustomersGateway.CustomersDTO = []gateways.CustomerDTO{
+		{Id: uuid.New(), Name: "Jane Doe", Email: "jane.doe@gmail.com", Status: "UNVERIFIED"},
+	}
+
+	_, err := c.login(gateways.OidcIdentityDTO{
+		Subject:       "248289761001",
+		Email:         "jane.doe@gmail.com",
+		EmailVerified: true,
+	})
+
+	c.EqualError(err, "email address is already associated with another account")
+	c.Empty(c.fakeCustomerIdentities.CustomerIdentities)
+	c.Empty(c.fakeRefreshTokens.RefreshTokens)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnActiveTotpFactor_ReturnsChallengeInsteadOfTokens() {
+	customerId := uuid.New()
+	c.fakeCustomersGateway.CustomersDTO = []gateways.CustomerDTO{
+		{Id: customerId, Name: "Jane Doe", Email: "jane.doe@gmail.com", Status: "VERIFIED"},
+	}
+	c.fakeCustomerIdentities.CustomerIdentities = []account.CustomerIdentity{
+		{Id: uuid.New(), CustomerId: customerId, Provider: "acme", Subject: "248289761001"},
+	}
+	totpFactor, err := mfa.NewTotpFactor(customerId, uuid.Nil)
+	c.Require().NoError(err)
+	totpFactor.Status = "ACTIVE"
+	c.fakeTotpFactors.TotpFactors = []mfa.TotpFactor{totpFactor}
+
+	output, err := c.login(gateways.OidcIdentityDTO{Subject: "248289761001"})
+	c.Require().NoError(err)
+
+	c.NotEmpty(output.MfaChallengeToken)
+	c.Empty(output.AccessToken)
+	c.Empty(c.fakeRefreshTokens.RefreshTokens)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnStateMismatch_ReturnsError() {
+	started, err := c.startOidcLogin.Execute(usecases.StartOidcLoginInput{Provider: "acme"})
+	c.Require().NoError(err)
+	c.fakeOidcGateway.Identities["any_code"] = gateways.OidcIdentityDTO{Subject: "248289761001"}
+
+	_, err = c.completeOidcLogin.Execute(usecases.CompleteOidcLoginInput{
+		Provider:   "acme",
+		Code:       "any_code",
+		State:      "another_state",
+		LoginToken: started.LoginToken,
+	})
+
+	c.EqualError(err, "oidc login is invalid or has expired")
+	c.Empty(c.fakeOidcGateway.CodeVerifiers)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnLoginTokenForAnotherSubject_ReturnsError() {
+	loginToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &usecases.OidcLoginClaims{
+		Provider: "acme",
+		State:    "any_state",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "QUOTE",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		},
+	}).SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
+	c.Require().NoError(err)
+	c.fakeOidcGateway.Identities["any_code"] = gateways.OidcIdentityDTO{Subject: "248289761001"}
+
+	_, err = c.completeOidcLogin.Execute(usecases.CompleteOidcLoginInput{
+		Provider:   "acme",
+		Code:       "any_code",
+		State:      "any_state",
+		LoginToken: loginToken,
+	})
+
+	c.EqualError(err, "oidc login is invalid or has expired")
+	c.Empty(c.fakeOidcGateway.CodeVerifiers)
+}
+
+func (c *CompleteOidcLoginSuite) TestExecute_OnNonceMismatch_ReturnsError() {
+	started, err := c.startOidcLogin.Execute(usecases.StartOidcLoginInput{Provider: "acme"})
+	c.Require().NoError(err)
+	c.fakeOidcGateway.Identities["any_code"] = gateways.OidcIdentityDTO{Subject: "248289761001", Nonce: "another_nonce"}
+
+	_, err = c.completeOidcLogin.Execute(usecases.CompleteOidcLoginInput{
+		Provider:   "acme",
+		Code:       "any_code",
+		State:      c.fakeOidcGateway.AuthorizationRequests[0].State,
+		LoginToken: started.LoginToken,
+	})
+
+	c.EqualError(err, "identity provider returned an invalid id token")
+	c.Empty(c.fakeCustomersGateway.CustomersDTO)
+}
+
+func (c *CompleteOidcLoginSuite) TestStartOidcLogin_OnNoErrors_SendsTheS256ChallengeOfTheVerifier() {
+	_, err := c.login(gateways.OidcIdentityDTO{Subject: "248289761001", Email: "jane.doe@gmail.com"})
+	c.Require().NoError(err)
+
+	hash := sha256.Sum256([]byte(c.fakeOidcGateway.CodeVerifiers[0]))
+	c.Equal(base64.RawURLEncoding.EncodeToString(hash[:]), c.fakeOidcGateway.AuthorizationRequests[0].CodeChallenge)
+	c.GreaterOrEqual(len(c.fakeOidcGateway.CodeVerifiers[0]), 43)
+}
+
+func (c *CompleteOidcLoginSuite) TestStartOidcLogin_OnUnknownProvider_ReturnsError() {
+	_, err := c.startOidcLogin.Execute(usecases.StartOidcLoginInput{Provider: "unknown"})
+
+	c.EqualError(err, "identity provider not found")
+}
+
+func TestCompleteOidcLogin(t *testing.T) {
+	suite.Run(t, new(CompleteOidcLoginSuite))
+}
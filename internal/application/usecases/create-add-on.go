@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+)
+
+type CreateAddOnInput struct {
+	Code  string
+	Name  string
+	Price uint64
+	Unit  string
+}
+
+type CreateAddOnOutput struct {
+	AddOnId uuid.UUID
+}
+
+type ICreateAddOn interface {
+	Execute(input CreateAddOnInput) (CreateAddOnOutput, error)
+}
+
+type CreateAddOn struct {
+	AddOnsRepository repositories.IAddOnsRepository
+}
+
+func (c *CreateAddOn) Execute(input CreateAddOnInput) (CreateAddOnOutput, error) {
+	exists, err := c.AddOnsRepository.ExistsByCode(input.Code)
+	if err != nil {
+		return CreateAddOnOutput{}, err
+	}
+
+	if exists {
+		return CreateAddOnOutput{}, errors.New("an add-on with this code already exists")
+	}
+
+	newAddOn, err := addon.NewAddOn(input.Code, input.Name, input.Price, input.Unit)
+	if err != nil {
+		return CreateAddOnOutput{}, err
+	}
+
+	err = c.AddOnsRepository.Create(newAddOn)
+	if err != nil {
+		return CreateAddOnOutput{}, err
+	}
+
+	return CreateAddOnOutput{
+		AddOnId: newAddOn.Id,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateAddOnSuite struct {
+	suite.Suite
+	fakeAddOnsRepository repositories.FakeAddOnsRepository
+	createAddOn          usecases.CreateAddOn
+}
+
+func (c *CreateAddOnSuite) SetupTest() {
+	c.fakeAddOnsRepository = repositories.FakeAddOnsRepository{}
+	c.createAddOn = usecases.CreateAddOn{
+		AddOnsRepository: &c.fakeAddOnsRepository,
+	}
+}
+
+func (c *CreateAddOnSuite) TestExecute_OnNoErrors_CreatesAddOn() {
+	output, err := c.createAddOn.Execute(usecases.CreateAddOnInput{
+		Code:  "BREAKFAST",
+		Name:  "Breakfast",
+		Price: 15,
+		Unit:  "PER_PERSON_PER_NIGHT",
+	})
+	c.Require().NoError(err)
+
+	createdAddOn := c.fakeAddOnsRepository.AddOns[0]
+	c.Equal(output.AddOnId, createdAddOn.Id)
+	c.Equal("BREAKFAST", createdAddOn.Code)
+	c.Equal("Breakfast", createdAddOn.Name)
+	c.Equal(uint64(15), createdAddOn.Price)
+	c.Equal("PER_PERSON_PER_NIGHT", createdAddOn.Unit)
+}
+
+func (c *CreateAddOnSuite) TestExecute_OnCodeAlreadyExists_ReturnsError() {
+	c.fakeAddOnsRepository.AddOns = []addon.AddOn{
+		{Id: uuid.New(), Code: "BREAKFAST", Name: "Breakfast", Price: 15, Unit: "PER_PERSON_PER_NIGHT"},
+	}
+
+	_, err := c.createAddOn.Execute(usecases.CreateAddOnInput{
+		Code:  "BREAKFAST",
+		Name:  "Continental breakfast",
+		Price: 20,
+		Unit:  "PER_PERSON_PER_NIGHT",
+	})
+
+	c.EqualError(err, "an add-on with this code already exists")
+	c.Len(c.fakeAddOnsRepository.AddOns, 1)
+}
+
+func (c *CreateAddOnSuite) TestExecute_OnInvalidUnit_ReturnsError() {
+	_, err := c.createAddOn.Execute(usecases.CreateAddOnInput{
+		Code:  "PARKING",
+		Name:  "Parking",
+		Price: 10,
+		Unit:  "PER_HOUR",
+	})
+
+	c.EqualError(err, "add-on unit must be PER_STAY, PER_NIGHT or PER_PERSON_PER_NIGHT")
+	c.Empty(c.fakeAddOnsRepository.AddOns)
+}
+
+func TestCreateAddOn(t *testing.T) {
+	suite.Run(t, new(CreateAddOnSuite))
+}
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+)
+
+type CreateApiKeyInput struct {
+	Principal auth.Principal
+	Name      string
+	Scopes    []string
+	// ExpiresAt is zero for a key that does not expire.
+	ExpiresAt time.Time
+}
+
+type CreateApiKeyOutput struct {
+	ApiKeyId uuid.UUID
+	Prefix   string
+	// ApiKey is the plain key. It is returned this one time; only a hash of its secret is kept.
+	ApiKey string
+}
+
+type ICreateApiKey interface {
+	Execute(input CreateApiKeyInput) (CreateApiKeyOutput, error)
+}
+
+type CreateApiKey struct {
+	ApiKeysRepository repositories.IApiKeysRepository
+	RolesRepository   repositories.IRolesRepository
+}
+
+// Execute only grants scopes the creating staff user holds through their role, and the partner scopes, the permissions
+// of the PARTNER role, which let a partner system book for customers. Managing staff users and API keys is never
+// delegated to a key, so a leaked key cannot be used to mint more access.
+func (c *CreateApiKey) Execute(input CreateApiKeyInput) (CreateApiKeyOutput, error) {
+	if input.Principal.StaffUserId == uuid.Nil {
+		return CreateApiKeyOutput{}, errors.New("only staff users can create api keys")
+	}
+
+	granted, err := c.RolesRepository.FindPermissionsByRole(input.Principal.Role)
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	partnerScopes, err := c.RolesRepository.FindPermissionsByRole("PARTNER")
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	for _, scope := range input.Scopes {
+		if scope == "staff:write" || scope == "api-keys:write" {
+			return CreateApiKeyOutput{}, errors.New("api keys cannot manage staff users or api keys")
+		}
+
+		if !slices.Contains(granted, scope) && !slices.Contains(partnerScopes, scope) {
+			return CreateApiKeyOutput{}, errors.New("api key scopes must be permissions of your own role or partner scopes")
+		}
+	}
+
+	apiKey, plainKey, err := apikey.NewApiKey(input.Name, input.Scopes, input.ExpiresAt, input.Principal.StaffUserId)
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	err = c.ApiKeysRepository.Create(apiKey)
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	return CreateApiKeyOutput{
+		ApiKeyId: apiKey.Id,
+		Prefix:   apiKey.Prefix,
+		ApiKey:   plainKey,
+	}, nil
+}
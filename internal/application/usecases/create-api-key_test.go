@@ -0,0 +1,121 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateApiKeySuite struct {
+	suite.Suite
+	principal    auth.Principal
+	fakeApiKeys  repositories.FakeApiKeysRepository
+	createApiKey usecases.CreateApiKey
+	revokeApiKey usecases.RevokeApiKey
+}
+
+func (c *CreateApiKeySuite) SetupTest() {
+	c.principal = auth.Principal{StaffUserId: uuid.New(), Role: "ADMIN"}
+	c.fakeApiKeys = repositories.FakeApiKeysRepository{}
+	c.createApiKey = usecases.CreateApiKey{
+		ApiKeysRepository: &c.fakeApiKeys,
+		RolesRepository: &repositories.FakeRolesRepository{
+			RolePermissions: map[string][]string{
+				"ADMIN":   {"rooms:read", "bookings:read:any", "staff:write", "api-keys:write"},
+				"PARTNER": {"rooms:read", "bookings:create"},
+			},
+		},
+	}
+	c.revokeApiKey = usecases.RevokeApiKey{ApiKeysRepository: &c.fakeApiKeys}
+}
+
+func (c *CreateApiKeySuite) TestExecute_OnNoErrors_StoresOnlyTheHashOfTheSecret() {
+	output, err := c.createApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: c.principal,
+		Name:      "Channel manager",
+		Scopes:    []string{"rooms:read", "bookings:read:any"},
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	})
+	c.Require().NoError(err)
+
+	c.Require().Len(c.fakeApiKeys.ApiKeys, 1)
+	storedKey := c.fakeApiKeys.ApiKeys[0]
+	c.Equal(output.ApiKeyId, storedKey.Id)
+	c.Equal(c.principal.StaffUserId, storedKey.CreatedByStaffUserId)
+	prefix, secret, ok := apikey.SplitApiKey(output.ApiKey)
+	c.Require().True(ok)
+	c.Equal(output.Prefix, prefix)
+	c.Equal(apikey.HashApiKeySecret(secret), storedKey.HashedSecret)
+}
+
+func (c *CreateApiKeySuite) TestExecute_OnScopeOutsideTheRole_ReturnsError() {
+	_, err := c.createApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: c.principal,
+		Name:      "Channel manager",
+		Scopes:    []string{"rooms:read", "refunds:write"},
+	})
+
+	c.EqualError(err, "api key scopes must be permissions of your own role or partner scopes")
+	c.Empty(c.fakeApiKeys.ApiKeys)
+}
+
+func (c *CreateApiKeySuite) TestExecute_OnPartnerScope_GrantsItAlthoughTheRoleLacksIt() {
+	_, err := c.createApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: c.principal,
+		Name:      "Travel agency",
+		Scopes:    []string{"rooms:read", "bookings:create"},
+	})
+	c.Require().NoError(err)
+
+	c.Require().Len(c.fakeApiKeys.ApiKeys, 1)
+	c.Equal([]string{"bookings:create", "rooms:read"}, c.fakeApiKeys.ApiKeys[0].Scopes)
+}
+
+func (c *CreateApiKeySuite) TestExecute_OnAdministrativeScope_ReturnsError() {
+	_, err := c.createApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: c.principal,
+		Name:      "Channel manager",
+		Scopes:    []string{"api-keys:write"},
+	})
+
+	c.EqualError(err, "api keys cannot manage staff users or api keys")
+}
+
+func (c *CreateApiKeySuite) TestExecute_OnApiKeyPrincipal_ReturnsError() {
+	_, err := c.createApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: auth.Principal{ApiKeyId: uuid.New(), Permissions: []string{"rooms:read"}},
+		Name:      "Channel manager",
+		Scopes:    []string{"rooms:read"},
+	})
+
+	c.EqualError(err, "only staff users can create api keys")
+}
+
+func (c *CreateApiKeySuite) TestRevokeApiKey_OnActiveKey_RevokesItOnce() {
+	output, err := c.createApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: c.principal,
+		Name:      "Channel manager",
+		Scopes:    []string{"rooms:read"},
+	})
+	c.Require().NoError(err)
+
+	err = c.revokeApiKey.Execute(usecases.RevokeApiKeyInput{ApiKeyId: output.ApiKeyId})
+	c.Require().NoError(err)
+	c.True(c.fakeApiKeys.ApiKeys[0].IsRevoked())
+
+	err = c.revokeApiKey.Execute(usecases.RevokeApiKeyInput{ApiKeyId: output.ApiKeyId})
+	c.EqualError(err, "api key is already revoked")
+
+	err = c.revokeApiKey.Execute(usecases.RevokeApiKeyInput{ApiKeyId: uuid.New()})
+	c.EqualError(err, "api key not found")
+}
+
+func TestCreateApiKey(t *testing.T) {
+	suite.Run(t, new(CreateApiKeySuite))
+}
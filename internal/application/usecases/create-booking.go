@@ -0,0 +1,376 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+)
+
+type CreateBookingInput struct {
+	CustomerId   uuid.UUID
+	RoomIds      []uuid.UUID
+	CheckIn      time.Time
+	CheckOut     time.Time
+	Adults       uint8
+	ChildrenAges []uint8
+	AddOns       []CreateQuoteInputAddOn
+	Package      string
+	PromoCode    string
+	QuoteToken   string
+	PaymentToken string
+	RatePlan     string
+	CreditAmount uint64
+}
+
+type CreateBookingOutput struct {
+	BookingId     uuid.UUID
+	TotalPrice    uint64
+	DepositAmount uint64
+	PrepaidAmount uint64
+	CreditAmount  uint64
+}
+
+type ICreateBooking interface {
+	Execute(input CreateBookingInput) (CreateBookingOutput, error)
+}
+
+type CreateBooking struct {
+	SecretsGateway            gateways.ISecretsGateway
+	RoomsRepository           repositories.IRoomsRepository
+	BookingsRepository        repositories.IBookingsRepository
+	PromoCodesRepository      repositories.IPromoCodesRepository
+	PricingRulesRepository    repositories.IPricingRulesRepository
+	AddOnsRepository          repositories.IAddOnsRepository
+	ExtraGuestRatesRepository repositories.IExtraGuestRatesRepository
+	PackagesRepository        repositories.IPackagesRepository
+	PaymentsGateway           gateways.IPaymentsGateway
+	DepositPolicy             payment.DepositPolicy
+	RatePlansRepository       repositories.IRatePlansRepository
+	CreditEntriesRepository   repositories.ICreditEntriesRepository
+	FolioEntriesRepository    repositories.IFolioEntriesRepository
+	CustomersGateway          gateways.ICustomersGateway
+	// RequireVerifiedEmail turns away customers who have not verified their email yet.
+	RequireVerifiedEmail bool
+}
+
+func (c *CreateBooking) Execute(input CreateBookingInput) (CreateBookingOutput, error) {
+	// Partner systems name the customer they book for, so the customer is looked up even when the email does not
+	// have to be verified.
+	customerDTO, err := c.CustomersGateway.FindOneById(input.CustomerId)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	if customerDTO == nil {
+		return CreateBookingOutput{}, errors.New("customer not found")
+	}
+
+	if c.RequireVerifiedEmail && customerDTO.Status != "VERIFIED" {
+		return CreateBookingOutput{}, errors.New("email must be verified before booking")
+	}
+
+	var selectedRatePlan *rateplan.RatePlan
+
+	if input.RatePlan != "" {
+		foundRatePlan, err := c.RatePlansRepository.FindOneByCode(input.RatePlan)
+		if err != nil {
+			return CreateBookingOutput{}, err
+		}
+
+		if foundRatePlan == nil {
+			return CreateBookingOutput{}, errors.New("rate plan not found")
+		}
+
+		selectedRatePlan = foundRatePlan
+	}
+
+	pricedQuote, err := quotePricer{
+		roomsRepository:           c.RoomsRepository,
+		bookingsRepository:        c.BookingsRepository,
+		promoCodesRepository:      c.PromoCodesRepository,
+		pricingRulesRepository:    c.PricingRulesRepository,
+		addOnsRepository:          c.AddOnsRepository,
+		extraGuestRatesRepository: c.ExtraGuestRatesRepository,
+		packagesRepository:        c.PackagesRepository,
+	}.price(CreateQuoteInput{
+		RoomIds:      input.RoomIds,
+		CheckIn:      input.CheckIn,
+		CheckOut:     input.CheckOut,
+		Adults:       input.Adults,
+		ChildrenAges: input.ChildrenAges,
+		AddOns:       input.AddOns,
+		Package:      input.Package,
+		PromoCode:    input.PromoCode,
+	})
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	totalPrice := pricedQuote.Total
+
+	if input.QuoteToken != "" {
+		quoteClaims, err := parseQuoteToken(c.SecretsGateway, input.QuoteToken)
+		if err != nil {
+			return CreateBookingOutput{}, err
+		}
+
+		if !quoteClaims.Matches(pricedQuote) {
+			return CreateBookingOutput{}, errors.New("quote does not match the booking details")
+		}
+
+		totalPrice = quoteClaims.Total
+	}
+
+	bookingAddOns := []booking.BookingAddOn{}
+	for _, addOn := range pricedQuote.AddOns {
+		bookingAddOns = append(bookingAddOns, booking.BookingAddOn(addOn))
+	}
+
+	newBooking, err := booking.NewBooking(input.CustomerId, pricedQuote.RoomIds, pricedQuote.CheckIn, pricedQuote.CheckOut,
+		pricedQuote.Guests, bookingAddOns, pricedQuote.PackageId, pricedQuote.PromoCode, totalPrice)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	if input.CreditAmount > newBooking.TotalPrice {
+		return CreateBookingOutput{}, errors.New("credit amount cannot exceed the booking total")
+	}
+
+	payableAmount := newBooking.TotalPrice - input.CreditAmount
+
+	err = c.requirePaymentToken(selectedRatePlan, payableAmount, input.PaymentToken)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	if input.CreditAmount == 0 {
+		return c.collectPayment(&newBooking, selectedRatePlan, payableAmount, input.PaymentToken)
+	}
+
+	debit, err := credit.NewDebit(input.CustomerId, input.CreditAmount, newBooking.Id)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	_, err = c.CreditEntriesRepository.Append(debit)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	output, err := c.collectPayment(&newBooking, selectedRatePlan, payableAmount, input.PaymentToken)
+	if err != nil {
+		if creditErr := c.returnCredit(newBooking, input.CreditAmount); creditErr != nil {
+			return CreateBookingOutput{}, creditErr
+		}
+
+		return CreateBookingOutput{}, err
+	}
+
+	creditPayment, err := folio.NewCreditPayment(newBooking.Id, input.CreditAmount)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	err = c.FolioEntriesRepository.Create(creditPayment)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	output.CreditAmount = input.CreditAmount
+
+	return output, nil
+}
+
+// requirePaymentToken checks that a card is available when part of the payable amount has to be charged.
+func (c *CreateBooking) requirePaymentToken(ratePlan *rateplan.RatePlan, payableAmount uint64, paymentToken string) error {
+	if paymentToken != "" || payableAmount == 0 {
+		return nil
+	}
+
+	if ratePlan != nil {
+		if ratePlan.Schedule.RequiresPaymentMethod() {
+			return errors.New("a payment token is required by the rate plan payment schedule")
+		}
+
+		return nil
+	}
+
+	if c.DepositPolicy.Required() {
+		return errors.New("a payment token is required to pay the deposit")
+	}
+
+	return nil
+}
+
+// returnCredit gives the credit back when the booking it paid for could not be confirmed.
+func (c *CreateBooking) returnCredit(newBooking booking.Booking, amount uint64) error {
+	refund, err := credit.NewCredit(newBooking.CustomerId, "REFUND", amount, newBooking.Id, "Booking could not be confirmed")
+	if err != nil {
+		return err
+	}
+
+	_, err = c.CreditEntriesRepository.Append(refund)
+
+	return err
+}
+
+// collectPayment charges the part of the stay that is not paid from credit, following the rate plan payment
+// schedule or, without one, the deposit policy.
+func (c *CreateBooking) collectPayment(newBooking *booking.Booking, ratePlan *rateplan.RatePlan, payableAmount uint64,
+	paymentToken string) (CreateBookingOutput, error) {
+	if ratePlan != nil && payableAmount > 0 {
+		prepaidAmount, err := c.scheduleCharges(newBooking, ratePlan.Schedule, payableAmount, paymentToken)
+		if err != nil {
+			return CreateBookingOutput{}, err
+		}
+
+		return CreateBookingOutput{
+			BookingId:     newBooking.Id,
+			TotalPrice:    newBooking.TotalPrice,
+			PrepaidAmount: prepaidAmount,
+		}, nil
+	}
+
+	var depositAmount uint64
+	if ratePlan == nil {
+		depositAmount = c.DepositPolicy.Amount(payableAmount)
+	}
+
+	if depositAmount == 0 {
+		err := c.BookingsRepository.Create(*newBooking)
+		if err != nil {
+			return CreateBookingOutput{}, err
+		}
+
+		return CreateBookingOutput{
+			BookingId:  newBooking.Id,
+			TotalPrice: newBooking.TotalPrice,
+		}, nil
+	}
+
+	deposit, err := payment.NewDepositPayment(newBooking.Id, depositAmount, c.DepositPolicy.CaptureOn(newBooking.CheckIn))
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	err = c.authorizeDeposit(newBooking, &deposit, paymentToken)
+	if err != nil {
+		return CreateBookingOutput{}, err
+	}
+
+	return CreateBookingOutput{
+		BookingId:     newBooking.Id,
+		TotalPrice:    newBooking.TotalPrice,
+		DepositAmount: deposit.Amount,
+	}, nil
+}
+
+func (c *CreateBooking) authorizeDeposit(newBooking *booking.Booking, deposit *payment.Payment, paymentToken string) error {
+	result, err := c.PaymentsGateway.Authorize(newBooking.Id, paymentToken, deposit.Amount)
+	if err != nil {
+		deposit.RecordFailure("AUTHORIZE", err.Error())
+		newBooking.Cancel()
+
+		if storeErr := c.store(*newBooking, *deposit); storeErr != nil {
+			return storeErr
+		}
+
+		return err
+	}
+
+	err = deposit.RecordAuthorization(result.TransactionId, result.Approved, result.DeclineReason)
+	if err != nil {
+		return err
+	}
+
+	if !result.Approved {
+		newBooking.Cancel()
+
+		if err := c.store(*newBooking, *deposit); err != nil {
+			return err
+		}
+
+		return errors.New("the deposit payment was declined")
+	}
+
+	err = c.store(*newBooking, *deposit)
+	if err != nil {
+		_, _ = c.PaymentsGateway.Void(result.TransactionId)
+		return err
+	}
+
+	return nil
+}
+
+func (c *CreateBooking) store(newBooking booking.Booking, deposit payment.Payment) error {
+	return c.BookingsRepository.CreateWithPayments(newBooking, []payment.Payment{deposit}, nil)
+}
+
+// scheduleCharges plans the rate plan installments. Everything due at booking is charged now in a single
+// transaction; when it fails the booking is stored as cancelled together with its charges.
+func (c *CreateBooking) scheduleCharges(newBooking *booking.Booking, schedule payment.PaymentSchedule, payableAmount uint64,
+	paymentToken string) (uint64, error) {
+	bookedOn := time.Now().UTC().Truncate(24 * time.Hour)
+	scheduledCharges := []payment.ScheduledCharge{}
+	dueNow := []int{}
+
+	var dueNowAmount uint64
+	for _, plannedCharge := range schedule.Plan(payableAmount, bookedOn, newBooking.CheckIn) {
+		scheduledCharge, err := payment.NewScheduledCharge(newBooking.Id, plannedCharge, paymentToken)
+		if err != nil {
+			return 0, err
+		}
+
+		if plannedCharge.Due == "AT_BOOKING" {
+			dueNowAmount += scheduledCharge.Amount
+			dueNow = append(dueNow, len(scheduledCharges))
+		}
+
+		scheduledCharges = append(scheduledCharges, scheduledCharge)
+	}
+
+	var transactionId, failureReason string
+	if dueNowAmount > 0 {
+		transactionId, failureReason = chargeNow(c.PaymentsGateway, newBooking.Id, paymentToken, dueNowAmount)
+
+		for _, i := range dueNow {
+			if failureReason != "" {
+				_ = scheduledCharges[i].RecordFailure(failureReason, time.Now().UTC(), 1, 0)
+				continue
+			}
+
+			_ = scheduledCharges[i].RecordSuccess(transactionId)
+		}
+
+		if failureReason != "" {
+			newBooking.Cancel()
+
+			for i := range scheduledCharges {
+				scheduledCharges[i].Cancel()
+			}
+		}
+	}
+
+	err := c.BookingsRepository.CreateWithPayments(*newBooking, nil, scheduledCharges)
+	if err != nil {
+		if transactionId != "" {
+			_, _ = c.PaymentsGateway.Refund(transactionId, dueNowAmount)
+		}
+
+		return 0, err
+	}
+
+	if newBooking.Status == "CANCELLED" {
+		return 0, errors.New("the prepayment was declined")
+	}
+
+	return dueNowAmount, nil
+}
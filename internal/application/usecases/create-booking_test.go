@@ -0,0 +1,661 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateBookingSuite struct {
+	suite.Suite
+	checkIn                       time.Time
+	checkOut                      time.Time
+	roomId                        uuid.UUID
+	customerId                    uuid.UUID
+	fakeSecretsGateway            gateways.FakeSecretsGateway
+	fakeRoomsRepository           repositories.FakeRoomsRepository
+	fakeBookingsRepository        repositories.FakeBookingsRepository
+	fakePromoCodesRepository      repositories.FakePromoCodesRepository
+	fakePricingRulesRepository    repositories.FakePricingRulesRepository
+	fakeAddOnsRepository          repositories.FakeAddOnsRepository
+	fakeExtraGuestRatesRepository repositories.FakeExtraGuestRatesRepository
+	fakePackagesRepository        repositories.FakePackagesRepository
+	fakePaymentsGateway           gateways.FakePaymentsGateway
+	fakeRatePlansRepository       repositories.FakeRatePlansRepository
+	fakeCreditEntriesRepository   repositories.FakeCreditEntriesRepository
+	fakeFolioEntriesRepository    repositories.FakeFolioEntriesRepository
+	fakeCustomersGateway          gateways.FakeCustomersGateway
+	createQuote                   usecases.CreateQuote
+	createBooking                 usecases.CreateBooking
+}
+
+func (c *CreateBookingSuite) SetupTest() {
+	c.checkIn = time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 10)
+	c.checkOut = c.checkIn.AddDate(0, 0, 2)
+	c.roomId = uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	c.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	c.fakeSecretsGateway = gateways.FakeSecretsGateway{
+		Secrets: map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"},
+	}
+	c.fakeRoomsRepository = repositories.FakeRoomsRepository{
+		Rooms: []room.Room{
+			{
+				Id:       c.roomId,
+				Number:   "101",
+				Type:     "SUITE",
+				Capacity: 2,
+				Price:    250,
+			},
+		},
+	}
+	c.fakeBookingsRepository = repositories.FakeBookingsRepository{}
+	c.fakePromoCodesRepository = repositories.FakePromoCodesRepository{}
+	c.fakePricingRulesRepository = repositories.FakePricingRulesRepository{}
+	c.fakeAddOnsRepository = repositories.FakeAddOnsRepository{}
+	c.fakeExtraGuestRatesRepository = repositories.FakeExtraGuestRatesRepository{}
+	c.fakePackagesRepository = repositories.FakePackagesRepository{}
+	c.fakePaymentsGateway = gateways.FakePaymentsGateway{DeclinedPaymentTokens: []string{"tok_declined"}}
+	c.fakeRatePlansRepository = repositories.FakeRatePlansRepository{
+		RatePlans: []rateplan.RatePlan{
+			{
+				Id:   uuid.New(),
+				Code: "FLEXIBLE_SPLIT",
+				Name: "Flexible split",
+				Schedule: payment.PaymentSchedule{
+					Installments: []payment.Installment{
+						{Percent: 20, Due: "AT_BOOKING"},
+						{Percent: 50, Due: "BEFORE_ARRIVAL", DaysBeforeArrival: 5},
+						{Percent: 30, Due: "AT_CHECK_IN"},
+					},
+				},
+			},
+		},
+	}
+	c.fakeCreditEntriesRepository = repositories.FakeCreditEntriesRepository{
+		CreditEntries: []credit.CreditEntry{
+			{Id: uuid.New(), CustomerId: c.customerId, Type: "GIFT_CARD", Amount: 200, BalanceAfter: 200},
+		},
+	}
+	c.fakeFolioEntriesRepository = repositories.FakeFolioEntriesRepository{}
+	c.fakeCustomersGateway = gateways.FakeCustomersGateway{
+		CustomersDTO: []gateways.CustomerDTO{
+			{Id: c.customerId, Name: "John Doe", Email: "john.doe@gmail.com", Status: "UNVERIFIED"},
+		},
+	}
+	c.createQuote = usecases.CreateQuote{
+		SecretsGateway:            &c.fakeSecretsGateway,
+		RoomsRepository:           &c.fakeRoomsRepository,
+		BookingsRepository:        &c.fakeBookingsRepository,
+		PromoCodesRepository:      &c.fakePromoCodesRepository,
+		PricingRulesRepository:    &c.fakePricingRulesRepository,
+		AddOnsRepository:          &c.fakeAddOnsRepository,
+		ExtraGuestRatesRepository: &c.fakeExtraGuestRatesRepository,
+		PackagesRepository:        &c.fakePackagesRepository,
+	}
+	c.createBooking = usecases.CreateBooking{
+		SecretsGateway:            &c.fakeSecretsGateway,
+		RoomsRepository:           &c.fakeRoomsRepository,
+		BookingsRepository:        &c.fakeBookingsRepository,
+		PromoCodesRepository:      &c.fakePromoCodesRepository,
+		PricingRulesRepository:    &c.fakePricingRulesRepository,
+		AddOnsRepository:          &c.fakeAddOnsRepository,
+		ExtraGuestRatesRepository: &c.fakeExtraGuestRatesRepository,
+		PackagesRepository:        &c.fakePackagesRepository,
+		PaymentsGateway:           &c.fakePaymentsGateway,
+		RatePlansRepository:       &c.fakeRatePlansRepository,
+		CreditEntriesRepository:   &c.fakeCreditEntriesRepository,
+		FolioEntriesRepository:    &c.fakeFolioEntriesRepository,
+		CustomersGateway:          &c.fakeCustomersGateway,
+	}
+}
+
+func (c *CreateBookingSuite) TestExecute_OnNoQuoteToken_ReturnsBookingWithCurrentPrice() {
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(500), output.TotalPrice)
+	createdBooking := c.fakeBookingsRepository.Bookings[0]
+	c.Equal(output.BookingId, createdBooking.Id)
+	c.Equal(c.customerId, createdBooking.CustomerId)
+	c.Equal([]uuid.UUID{c.roomId}, createdBooking.RoomIds)
+	c.Equal(c.checkIn, createdBooking.CheckIn)
+	c.Equal(c.checkOut, createdBooking.CheckOut)
+	c.Equal(guests.Guests{Adults: 2, ChildrenAges: []uint8{}}, createdBooking.Guests)
+	c.Equal(uint64(500), createdBooking.TotalPrice)
+	c.Equal("CONFIRMED", createdBooking.Status)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnValidQuoteToken_HonoursQuotedPrice() {
+	quoteOutput, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+	})
+	c.Require().NoError(err)
+	c.fakeRoomsRepository.Rooms[0].Price = 400
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+		QuoteToken: quoteOutput.QuoteToken,
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(500), output.TotalPrice)
+	c.Equal(uint64(500), c.fakeBookingsRepository.Bookings[0].TotalPrice)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnQuoteTokenForDifferentDetails_ReturnsError() {
+	quoteOutput, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+	})
+	c.Require().NoError(err)
+
+	_, err = c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut.AddDate(0, 0, 1),
+		Adults:     2,
+		QuoteToken: quoteOutput.QuoteToken,
+	})
+
+	c.EqualError(err, "quote does not match the booking details")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnInvalidQuoteToken_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+		QuoteToken: "abc",
+	})
+
+	c.EqualError(err, "quote has expired or is invalid. Please request a new quote")
+}
+
+func (c *CreateBookingSuite) TestExecute_OnExpiredQuoteToken_ReturnsError() {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, usecases.QuoteClaims{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn.Format(time.DateOnly),
+		CheckOut: c.checkOut.Format(time.DateOnly),
+		Adults:   2,
+		Total:    100,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "QUOTE",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		},
+	})
+	expiredToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
+	c.Require().NoError(err)
+
+	_, err = c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+		QuoteToken: expiredToken,
+	})
+
+	c.EqualError(err, "quote has expired or is invalid. Please request a new quote")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnRoomAlreadyBooked_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+	c.Require().NoError(err)
+
+	_, err = c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn.AddDate(0, 0, 1),
+		CheckOut:   c.checkOut.AddDate(0, 0, 1),
+		Adults:     2,
+	})
+
+	c.EqualError(err, "one or more rooms are not available for the selected dates")
+}
+
+func (c *CreateBookingSuite) TestExecute_OnAddOns_StoresAddOnsWithTheirPrice() {
+	c.fakeAddOnsRepository.AddOns = []addon.AddOn{
+		{Id: uuid.New(), Code: "LATE_CHECK_OUT", Name: "Late check-out", Price: 30, Unit: "PER_STAY"},
+	}
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       1,
+		ChildrenAges: []uint8{3},
+		AddOns:       []usecases.CreateQuoteInputAddOn{{Code: "LATE_CHECK_OUT", Quantity: 1}},
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(530), output.TotalPrice)
+	createdBooking := c.fakeBookingsRepository.Bookings[0]
+	c.Equal(guests.Guests{Adults: 1, ChildrenAges: []uint8{3}}, createdBooking.Guests)
+	c.Equal([]booking.BookingAddOn{{Code: "LATE_CHECK_OUT", Quantity: 1, Price: 30}}, createdBooking.AddOns)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnPackage_StoresBookingWithPackage() {
+	packageId := uuid.MustParse("5b0e3c8c-5f5e-4d8f-9d0c-3f0d3c1a7b11")
+	c.fakeAddOnsRepository.AddOns = []addon.AddOn{
+		{Id: uuid.New(), Code: "DINNER", Name: "Dinner", Price: 80, Unit: "PER_STAY"},
+	}
+	c.fakePackagesRepository.Packages = []bundle.Package{
+		{
+			Id:            packageId,
+			Code:          "ROMANTIC_WEEKEND",
+			Name:          "Romantic weekend",
+			RoomType:      "SUITE",
+			Components:    []bundle.PackageComponent{{AddOnCode: "DINNER", Quantity: 1}},
+			PricePerNight: 400,
+			ValidFrom:     c.checkIn,
+			ValidUntil:    c.checkIn,
+			MinStayNights: 2,
+			Active:        true,
+		},
+	}
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+		Package:    "ROMANTIC_WEEKEND",
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(800), output.TotalPrice)
+	c.Equal(packageId, c.fakeBookingsRepository.Bookings[0].PackageId)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnUnknownPackage_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+		Package:    "ROMANTIC_WEEKEND",
+	})
+
+	c.EqualError(err, "package not found")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnDepositPolicy_AuthorizesDeposit() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 30, CaptureDaysBeforeCheckIn: 1}
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_visa",
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(150), output.DepositAmount)
+	c.Equal("CONFIRMED", c.fakeBookingsRepository.Bookings[0].Status)
+	deposit := c.fakeBookingsRepository.Payments[0]
+	c.Equal(output.BookingId, deposit.BookingId)
+	c.Equal(uint64(150), deposit.Amount)
+	c.Equal(c.checkIn.AddDate(0, 0, -1), deposit.CaptureOn)
+	c.Equal("AUTHORIZED", deposit.Status)
+	c.Equal(c.fakePaymentsGateway.Transactions[0].Id, deposit.TransactionId)
+	c.Equal("AUTHORIZE", deposit.Attempts[0].Operation)
+	c.Equal("APPROVED", deposit.Attempts[0].Status)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnDepositThatCannotBeStored_VoidsIt() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 30}
+	c.fakeBookingsRepository.CreateErr = errors.New("connection refused")
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_visa",
+	})
+
+	c.EqualError(err, "connection refused")
+	c.Equal("VOIDED", c.fakePaymentsGateway.Transactions[0].Status)
+	c.Empty(c.fakeBookingsRepository.Bookings)
+	c.Empty(c.fakeBookingsRepository.Payments)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnDeclinedDeposit_CancelsBookingAndReturnsError() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 30}
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_declined",
+	})
+
+	c.EqualError(err, "the deposit payment was declined")
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+	c.Equal("DECLINED", c.fakeBookingsRepository.Payments[0].Status)
+	c.Equal("card declined", c.fakeBookingsRepository.Payments[0].Attempts[0].FailureReason)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnDepositPolicyWithoutPaymentToken_ReturnsError() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 30}
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+
+	c.EqualError(err, "a payment token is required to pay the deposit")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnNoDepositPolicy_DoesNotChargeAnything() {
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(0), output.DepositAmount)
+	c.Empty(c.fakeBookingsRepository.Payments)
+	c.Empty(c.fakePaymentsGateway.Transactions)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnRatePlan_ChargesDueAtBookingAndSchedulesTheRest() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 30}
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_visa",
+		RatePlan:     "FLEXIBLE_SPLIT",
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(100), output.PrepaidAmount)
+	c.Equal(uint64(0), output.DepositAmount)
+	c.Empty(c.fakeBookingsRepository.Payments)
+	c.Equal("CONFIRMED", c.fakeBookingsRepository.Bookings[0].Status)
+	c.Equal(uint64(100), c.fakePaymentsGateway.Transactions[0].CapturedAmount)
+	scheduledCharges := c.fakeBookingsRepository.ScheduledCharges
+	c.Require().Len(scheduledCharges, 3)
+	c.Equal("PAID", scheduledCharges[0].Status)
+	c.Equal(c.fakePaymentsGateway.Transactions[0].Id, scheduledCharges[0].TransactionId)
+	c.Equal(uint64(250), scheduledCharges[1].Amount)
+	c.Equal(c.checkIn.AddDate(0, 0, -5), scheduledCharges[1].DueOn)
+	c.Equal("SCHEDULED", scheduledCharges[1].Status)
+	c.True(scheduledCharges[1].Mandatory)
+	c.Equal(uint64(150), scheduledCharges[2].Amount)
+	c.Equal(c.checkIn, scheduledCharges[2].DueOn)
+	c.False(scheduledCharges[2].Mandatory)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnDeclinedPrepayment_CancelsBookingAndCharges() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_declined",
+		RatePlan:     "FLEXIBLE_SPLIT",
+	})
+
+	c.EqualError(err, "the prepayment was declined")
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+	scheduledCharges := c.fakeBookingsRepository.ScheduledCharges
+	c.Equal("FAILED", scheduledCharges[0].Status)
+	c.Equal("card declined", scheduledCharges[0].LastFailureReason)
+	c.Equal("CANCELLED", scheduledCharges[1].Status)
+	c.Equal("CANCELLED", scheduledCharges[2].Status)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnPrepaymentThatCannotBeStored_RefundsIt() {
+	c.fakeBookingsRepository.CreateErr = errors.New("connection refused")
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_visa",
+		RatePlan:     "FLEXIBLE_SPLIT",
+	})
+
+	c.EqualError(err, "connection refused")
+	c.Equal(uint64(100), c.fakePaymentsGateway.Transactions[0].CapturedAmount)
+	c.Equal(uint64(100), c.fakePaymentsGateway.Transactions[0].RefundedAmount)
+	c.Empty(c.fakeBookingsRepository.Bookings)
+	c.Empty(c.fakeBookingsRepository.ScheduledCharges)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnRatePlanWithoutPaymentToken_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+		RatePlan:   "FLEXIBLE_SPLIT",
+	})
+
+	c.EqualError(err, "a payment token is required by the rate plan payment schedule")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnUnknownRatePlan_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_visa",
+		RatePlan:     "UNKNOWN",
+	})
+
+	c.EqualError(err, "rate plan not found")
+}
+
+func (c *CreateBookingSuite) TestExecute_OnCreditAmount_PaysPartiallyFromCredit() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 50}
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_visa",
+		CreditAmount: 200,
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(200), output.CreditAmount)
+	c.Equal(uint64(150), output.DepositAmount)
+	debit := c.fakeCreditEntriesRepository.CreditEntries[1]
+	c.Equal("BOOKING_PAYMENT", debit.Type)
+	c.Equal(int64(-200), debit.Amount)
+	c.Equal(uint64(0), debit.BalanceAfter)
+	c.Equal(output.BookingId, debit.Reference)
+	creditPayment := c.fakeFolioEntriesRepository.FolioEntries[0]
+	c.Equal(output.BookingId, creditPayment.BookingId)
+	c.Equal("CREDIT", creditPayment.Category)
+	c.Equal(uint64(200), creditPayment.Amount)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnCreditCoveringTheStay_DoesNotRequirePaymentToken() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 50}
+	c.fakeCreditEntriesRepository.CreditEntries[0].BalanceAfter = 600
+
+	output, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		CreditAmount: 500,
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(500), output.CreditAmount)
+	c.Equal(uint64(0), output.DepositAmount)
+	c.Equal("CONFIRMED", c.fakeBookingsRepository.Bookings[0].Status)
+	c.Empty(c.fakePaymentsGateway.Transactions)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnInsufficientCredit_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		CreditAmount: 300,
+	})
+
+	c.EqualError(err, "insufficient credit balance")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+	c.Len(c.fakeCreditEntriesRepository.CreditEntries, 1)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnCreditAboveTotal_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		CreditAmount: 501,
+	})
+
+	c.EqualError(err, "credit amount cannot exceed the booking total")
+}
+
+func (c *CreateBookingSuite) TestExecute_OnDeclinedDepositWithCredit_ReturnsCredit() {
+	c.createBooking.DepositPolicy = payment.DepositPolicy{Percent: 50}
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   c.customerId,
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		PaymentToken: "tok_declined",
+		CreditAmount: 100,
+	})
+
+	c.EqualError(err, "the deposit payment was declined")
+	c.Equal("CANCELLED", c.fakeBookingsRepository.Bookings[0].Status)
+	c.Len(c.fakeCreditEntriesRepository.CreditEntries, 3)
+	c.Equal("REFUND", c.fakeCreditEntriesRepository.CreditEntries[2].Type)
+	c.Equal(uint64(200), c.fakeCreditEntriesRepository.CreditEntries[2].BalanceAfter)
+	c.Empty(c.fakeFolioEntriesRepository.FolioEntries)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnUnverifiedEmailWhenVerificationIsRequired_ReturnsError() {
+	c.createBooking.RequireVerifiedEmail = true
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+
+	c.EqualError(err, "email must be verified before booking")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnVerifiedEmailWhenVerificationIsRequired_CreatesBooking() {
+	c.createBooking.RequireVerifiedEmail = true
+	c.fakeCustomersGateway.CustomersDTO[0].Status = "VERIFIED"
+
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: c.customerId,
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+
+	c.Require().NoError(err)
+	c.Len(c.fakeBookingsRepository.Bookings, 1)
+}
+
+func (c *CreateBookingSuite) TestExecute_OnUnknownCustomer_ReturnsError() {
+	_, err := c.createBooking.Execute(usecases.CreateBookingInput{
+		CustomerId: uuid.New(),
+		RoomIds:    []uuid.UUID{c.roomId},
+		CheckIn:    c.checkIn,
+		CheckOut:   c.checkOut,
+		Adults:     2,
+	})
+
+	c.EqualError(err, "customer not found")
+	c.Empty(c.fakeBookingsRepository.Bookings)
+}
+
+func TestCreateBooking(t *testing.T) {
+	suite.Run(t, new(CreateBookingSuite))
+}
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+)
+
+type CreatePackageInputComponent struct {
+	AddOnCode string
+	Quantity  uint8
+}
+
+type CreatePackageInput struct {
+	Code          string
+	Name          string
+	RoomType      string
+	Components    []CreatePackageInputComponent
+	PricePerNight uint64
+	ValidFrom     time.Time
+	ValidUntil    time.Time
+	MinStayNights uint8
+}
+
+type CreatePackageOutput struct {
+	PackageId uuid.UUID
+}
+
+type ICreatePackage interface {
+	Execute(input CreatePackageInput) (CreatePackageOutput, error)
+}
+
+type CreatePackage struct {
+	PackagesRepository repositories.IPackagesRepository
+	AddOnsRepository   repositories.IAddOnsRepository
+}
+
+func (c *CreatePackage) Execute(input CreatePackageInput) (CreatePackageOutput, error) {
+	exists, err := c.PackagesRepository.ExistsByCode(input.Code)
+	if err != nil {
+		return CreatePackageOutput{}, err
+	}
+
+	if exists {
+		return CreatePackageOutput{}, errors.New("a package with this code already exists")
+	}
+
+	components := []bundle.PackageComponent{}
+	codes := []string{}
+	for _, component := range input.Components {
+		components = append(components, bundle.PackageComponent(component))
+		codes = append(codes, component.AddOnCode)
+	}
+
+	newPackage, err := bundle.NewPackage(input.Code, input.Name, input.RoomType, components, input.PricePerNight, input.ValidFrom,
+		input.ValidUntil, input.MinStayNights)
+	if err != nil {
+		return CreatePackageOutput{}, err
+	}
+
+	addOns, err := c.AddOnsRepository.FindAllByCodes(codes)
+	if err != nil {
+		return CreatePackageOutput{}, err
+	}
+
+	if len(addOns) != len(codes) {
+		return CreatePackageOutput{}, errors.New("one or more add-ons were not found")
+	}
+
+	err = c.PackagesRepository.Create(newPackage)
+	if err != nil {
+		return CreatePackageOutput{}, err
+	}
+
+	return CreatePackageOutput{
+		PackageId: newPackage.Id,
+	}, nil
+}
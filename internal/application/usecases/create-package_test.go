@@ -0,0 +1,77 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreatePackageSuite struct {
+	suite.Suite
+	input                  usecases.CreatePackageInput
+	fakePackagesRepository repositories.FakePackagesRepository
+	fakeAddOnsRepository   repositories.FakeAddOnsRepository
+	createPackage          usecases.CreatePackage
+}
+
+func (c *CreatePackageSuite) SetupTest() {
+	c.input = usecases.CreatePackageInput{
+		Code:          "ROMANTIC_WEEKEND",
+		Name:          "Romantic weekend",
+		RoomType:      "SUITE",
+		Components:    []usecases.CreatePackageInputComponent{{AddOnCode: "DINNER", Quantity: 1}},
+		PricePerNight: 400,
+		ValidFrom:     time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+		ValidUntil:    time.Date(2030, 2, 28, 0, 0, 0, 0, time.UTC),
+		MinStayNights: 2,
+	}
+	c.fakePackagesRepository = repositories.FakePackagesRepository{}
+	c.fakeAddOnsRepository = repositories.FakeAddOnsRepository{
+		AddOns: []addon.AddOn{
+			{Id: uuid.New(), Code: "DINNER", Name: "Dinner", Price: 80, Unit: "PER_STAY"},
+		},
+	}
+	c.createPackage = usecases.CreatePackage{
+		PackagesRepository: &c.fakePackagesRepository,
+		AddOnsRepository:   &c.fakeAddOnsRepository,
+	}
+}
+
+func (c *CreatePackageSuite) TestExecute_OnNoErrors_CreatesActivePackage() {
+	output, err := c.createPackage.Execute(c.input)
+	c.Require().NoError(err)
+
+	createdPackage := c.fakePackagesRepository.Packages[0]
+	c.Equal(output.PackageId, createdPackage.Id)
+	c.Equal("ROMANTIC_WEEKEND", createdPackage.Code)
+	c.Equal([]bundle.PackageComponent{{AddOnCode: "DINNER", Quantity: 1}}, createdPackage.Components)
+	c.True(createdPackage.Active)
+}
+
+func (c *CreatePackageSuite) TestExecute_OnUnknownAddOn_ReturnsError() {
+	c.input.Components = append(c.input.Components, usecases.CreatePackageInputComponent{AddOnCode: "SPA", Quantity: 1})
+
+	_, err := c.createPackage.Execute(c.input)
+
+	c.EqualError(err, "one or more add-ons were not found")
+	c.Empty(c.fakePackagesRepository.Packages)
+}
+
+func (c *CreatePackageSuite) TestExecute_OnCodeAlreadyExists_ReturnsError() {
+	c.fakePackagesRepository.Packages = []bundle.Package{{Id: uuid.New(), Code: "ROMANTIC_WEEKEND"}}
+
+	_, err := c.createPackage.Execute(c.input)
+
+	c.EqualError(err, "a package with this code already exists")
+	c.Len(c.fakePackagesRepository.Packages, 1)
+}
+
+func TestCreatePackage(t *testing.T) {
+	suite.Run(t, new(CreatePackageSuite))
+}
@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type CreatePricingRuleInput struct {
+	RoomType           string
+	OccupancyThreshold uint8
+	AdjustmentPercent  uint8
+	FloorPrice         uint64
+	CeilingPrice       uint64
+}
+
+type CreatePricingRuleOutput struct {
+	PricingRuleId uuid.UUID
+}
+
+type ICreatePricingRule interface {
+	Execute(input CreatePricingRuleInput) (CreatePricingRuleOutput, error)
+}
+
+type CreatePricingRule struct {
+	PricingRulesRepository repositories.IPricingRulesRepository
+}
+
+func (c *CreatePricingRule) Execute(input CreatePricingRuleInput) (CreatePricingRuleOutput, error) {
+	rule, err := pricing.NewOccupancyPricingRule(input.RoomType, input.OccupancyThreshold, input.AdjustmentPercent,
+		input.FloorPrice, input.CeilingPrice)
+	if err != nil {
+		return CreatePricingRuleOutput{}, err
+	}
+
+	err = c.PricingRulesRepository.Create(rule)
+	if err != nil {
+		return CreatePricingRuleOutput{}, err
+	}
+
+	return CreatePricingRuleOutput{
+		PricingRuleId: rule.Id,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreatePricingRuleSuite struct {
+	suite.Suite
+	fakePricingRulesRepository repositories.FakePricingRulesRepository
+	createPricingRule          usecases.CreatePricingRule
+}
+
+func (c *CreatePricingRuleSuite) SetupTest() {
+	c.fakePricingRulesRepository = repositories.FakePricingRulesRepository{}
+	c.createPricingRule = usecases.CreatePricingRule{
+		PricingRulesRepository: &c.fakePricingRulesRepository,
+	}
+}
+
+func (c *CreatePricingRuleSuite) TestExecute_OnNoErrors_CreatesDisabledRule() {
+	output, err := c.createPricingRule.Execute(usecases.CreatePricingRuleInput{
+		RoomType:           "SUITE",
+		OccupancyThreshold: 80,
+		AdjustmentPercent:  15,
+		FloorPrice:         200,
+		CeilingPrice:       400,
+	})
+	c.Require().NoError(err)
+
+	createdRule := c.fakePricingRulesRepository.Rules[0]
+	c.Equal(output.PricingRuleId, createdRule.Id)
+	c.Equal("SUITE", createdRule.RoomType)
+	c.Equal(uint8(80), createdRule.OccupancyThreshold)
+	c.Equal(uint8(15), createdRule.AdjustmentPercent)
+	c.Equal(uint64(200), createdRule.FloorPrice)
+	c.Equal(uint64(400), createdRule.CeilingPrice)
+	c.False(createdRule.Enabled)
+}
+
+func (c *CreatePricingRuleSuite) TestExecute_OnInvalidRule_ReturnsError() {
+	_, err := c.createPricingRule.Execute(usecases.CreatePricingRuleInput{
+		RoomType:           "SUITE",
+		OccupancyThreshold: 80,
+		AdjustmentPercent:  15,
+		FloorPrice:         400,
+		CeilingPrice:       200,
+	})
+
+	c.EqualError(err, "ceiling price must be greater than or equal to floor price")
+	c.Empty(c.fakePricingRulesRepository.Rules)
+}
+
+func TestCreatePricingRule(t *testing.T) {
+	suite.Run(t, new(CreatePricingRuleSuite))
+}
@@ -0,0 +1,340 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/quote"
+)
+
+type CreateQuoteInputAddOn struct {
+	Code     string
+	Quantity uint8
+}
+
+type CreateQuoteInput struct {
+	RoomIds      []uuid.UUID
+	CheckIn      time.Time
+	CheckOut     time.Time
+	Adults       uint8
+	ChildrenAges []uint8
+	AddOns       []CreateQuoteInputAddOn
+	Package      string
+	PromoCode    string
+}
+
+type CreateQuoteOutputItem struct {
+	Type        string
+	RoomId      uuid.UUID
+	Date        time.Time
+	Description string
+	Price       uint64
+}
+
+type CreateQuoteOutput struct {
+	QuoteToken string
+	ExpiresAt  time.Time
+	Items      []CreateQuoteOutputItem
+	Subtotal   uint64
+	Discount   uint64
+	Total      uint64
+}
+
+type ICreateQuote interface {
+	Execute(input CreateQuoteInput) (CreateQuoteOutput, error)
+}
+
+type CreateQuote struct {
+	SecretsGateway            gateways.ISecretsGateway
+	RoomsRepository           repositories.IRoomsRepository
+	BookingsRepository        repositories.IBookingsRepository
+	PromoCodesRepository      repositories.IPromoCodesRepository
+	PricingRulesRepository    repositories.IPricingRulesRepository
+	AddOnsRepository          repositories.IAddOnsRepository
+	ExtraGuestRatesRepository repositories.IExtraGuestRatesRepository
+	PackagesRepository        repositories.IPackagesRepository
+}
+
+func (c *CreateQuote) Execute(input CreateQuoteInput) (CreateQuoteOutput, error) {
+	pricedQuote, err := quotePricer{
+		roomsRepository:           c.RoomsRepository,
+		bookingsRepository:        c.BookingsRepository,
+		promoCodesRepository:      c.PromoCodesRepository,
+		pricingRulesRepository:    c.PricingRulesRepository,
+		addOnsRepository:          c.AddOnsRepository,
+		extraGuestRatesRepository: c.ExtraGuestRatesRepository,
+		packagesRepository:        c.PackagesRepository,
+	}.price(input)
+	if err != nil {
+		return CreateQuoteOutput{}, err
+	}
+
+	FIFTEEN_MINUTES := time.Now().Add(15 * time.Minute)
+
+	quoteToken, err := signQuoteToken(c.SecretsGateway, pricedQuote, FIFTEEN_MINUTES)
+	if err != nil {
+		return CreateQuoteOutput{}, err
+	}
+
+	output := CreateQuoteOutput{
+		QuoteToken: quoteToken,
+		ExpiresAt:  FIFTEEN_MINUTES,
+		Subtotal:   pricedQuote.Subtotal,
+		Discount:   pricedQuote.Discount,
+		Total:      pricedQuote.Total,
+	}
+
+	for _, item := range pricedQuote.Items {
+		output.Items = append(output.Items, CreateQuoteOutputItem(item))
+	}
+
+	return output, nil
+}
+
+type quotePricer struct {
+	roomsRepository           repositories.IRoomsRepository
+	bookingsRepository        repositories.IBookingsRepository
+	promoCodesRepository      repositories.IPromoCodesRepository
+	pricingRulesRepository    repositories.IPricingRulesRepository
+	addOnsRepository          repositories.IAddOnsRepository
+	extraGuestRatesRepository repositories.IExtraGuestRatesRepository
+	packagesRepository        repositories.IPackagesRepository
+}
+
+func (q quotePricer) price(input CreateQuoteInput) (quote.Quote, error) {
+	stayGuests, err := guests.NewGuests(input.Adults, input.ChildrenAges)
+	if err != nil {
+		return quote.Quote{}, err
+	}
+
+	rooms, err := q.roomsRepository.FindAllByIds(input.RoomIds)
+	if err != nil {
+		return quote.Quote{}, err
+	}
+
+	if len(rooms) != len(input.RoomIds) {
+		return quote.Quote{}, errors.New("one or more rooms were not found")
+	}
+
+	overlaps, err := q.bookingsRepository.ExistsOverlapping(input.RoomIds, input.CheckIn, input.CheckOut)
+	if err != nil {
+		return quote.Quote{}, err
+	}
+
+	if overlaps {
+		return quote.Quote{}, errors.New("one or more rooms are not available for the selected dates")
+	}
+
+	var promoCode *promocode.PromoCode
+
+	if input.PromoCode != "" {
+		promoCode, err = q.promoCodesRepository.FindOneByCode(input.PromoCode)
+		if err != nil {
+			return quote.Quote{}, err
+		}
+
+		if promoCode == nil {
+			return quote.Quote{}, errors.New("promo code is invalid or has expired")
+		}
+	}
+
+	selectedAddOns, err := q.selectAddOns(input.AddOns)
+	if err != nil {
+		return quote.Quote{}, err
+	}
+
+	selectedPackage, err := q.selectPackage(input.Package)
+	if err != nil {
+		return quote.Quote{}, err
+	}
+
+	pricingEngine, err := q.pricingEngine(input.CheckIn, input.CheckOut)
+	if err != nil {
+		return quote.Quote{}, err
+	}
+
+	return quote.NewQuote(rooms, input.CheckIn, input.CheckOut, stayGuests, selectedAddOns, selectedPackage, promoCode,
+		pricingEngine)
+}
+
+// reprice returns what the booked stay would cost now if it ended on checkOut, with the rooms, guests, add-ons,
+// package and promo code it was booked with.
+func (q quotePricer) reprice(stay booking.Booking, checkOut time.Time) (uint64, error) {
+	rooms, err := q.roomsRepository.FindAllByIds(stay.RoomIds)
+	if err != nil {
+		return 0, err
+	}
+
+	inputAddOns := []CreateQuoteInputAddOn{}
+	for _, addOn := range stay.AddOns {
+		inputAddOns = append(inputAddOns, CreateQuoteInputAddOn{Code: addOn.Code, Quantity: addOn.Quantity})
+	}
+
+	selectedAddOns, err := q.selectAddOns(inputAddOns)
+	if err != nil {
+		return 0, err
+	}
+
+	var selectedPackage *quote.SelectedPackage
+	if stay.PackageId != uuid.Nil {
+		travelPackage, err := q.packagesRepository.FindOneById(stay.PackageId)
+		if err != nil {
+			return 0, err
+		}
+
+		if travelPackage != nil {
+			selectedPackage, err = q.packageComponents(*travelPackage)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var promoCode *promocode.PromoCode
+	if stay.PromoCode != "" {
+		promoCode, err = q.promoCodesRepository.FindOneByCode(stay.PromoCode)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	pricingEngine, err := q.pricingEngine(stay.CheckIn, checkOut)
+	if err != nil {
+		return 0, err
+	}
+
+	return quote.RepriceStay(rooms, stay.CheckIn, checkOut, stay.Guests, selectedAddOns, selectedPackage, promoCode,
+		pricingEngine).Total, nil
+}
+
+func (q quotePricer) pricingEngine(from time.Time, to time.Time) (pricing.PricingEngine, error) {
+	pricingEngine, err := loadPricingEngine(q.pricingRulesRepository, q.bookingsRepository, from, to)
+	if err != nil {
+		return pricing.PricingEngine{}, err
+	}
+
+	extraGuestRates, err := q.extraGuestRatesRepository.FindAll()
+	if err != nil {
+		return pricing.PricingEngine{}, err
+	}
+
+	pricingEngine.ExtraGuestRates = map[string]pricing.ExtraGuestRate{}
+	for _, rate := range extraGuestRates {
+		pricingEngine.ExtraGuestRates[rate.RoomType] = rate
+	}
+
+	return pricingEngine, nil
+}
+
+func (q quotePricer) selectAddOns(inputAddOns []CreateQuoteInputAddOn) ([]quote.SelectedAddOn, error) {
+	if len(inputAddOns) == 0 {
+		return nil, nil
+	}
+
+	codes := []string{}
+	for _, inputAddOn := range inputAddOns {
+		if slices.Contains(codes, inputAddOn.Code) {
+			return nil, errors.New("each add-on can only be selected once")
+		}
+
+		codes = append(codes, inputAddOn.Code)
+	}
+
+	addOns, err := q.addOnsRepository.FindAllByCodes(codes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addOns) != len(codes) {
+		return nil, errors.New("one or more add-ons were not found")
+	}
+
+	selectedAddOns := []quote.SelectedAddOn{}
+	for _, inputAddOn := range inputAddOns {
+		for _, addOn := range addOns {
+			if addOn.Code == inputAddOn.Code {
+				selectedAddOns = append(selectedAddOns, quote.SelectedAddOn{AddOn: addOn, Quantity: inputAddOn.Quantity})
+			}
+		}
+	}
+
+	return selectedAddOns, nil
+}
+
+func (q quotePricer) selectPackage(code string) (*quote.SelectedPackage, error) {
+	if code == "" {
+		return nil, nil
+	}
+
+	travelPackage, err := q.packagesRepository.FindOneByCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if travelPackage == nil {
+		return nil, errors.New("package not found")
+	}
+
+	return q.packageComponents(*travelPackage)
+}
+
+func (q quotePricer) packageComponents(travelPackage bundle.Package) (*quote.SelectedPackage, error) {
+	codes := []string{}
+	for _, component := range travelPackage.Components {
+		codes = append(codes, component.AddOnCode)
+	}
+
+	addOns, err := q.addOnsRepository.FindAllByCodes(codes)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedPackage := quote.SelectedPackage{
+		Package: travelPackage,
+	}
+
+	for _, component := range travelPackage.Components {
+		for _, addOn := range addOns {
+			if addOn.Code == component.AddOnCode {
+				selectedPackage.Components = append(selectedPackage.Components, quote.SelectedAddOn{
+					AddOn:    addOn,
+					Quantity: component.Quantity,
+				})
+			}
+		}
+	}
+
+	return &selectedPackage, nil
+}
+
+func loadPricingEngine(pricingRulesRepository repositories.IPricingRulesRepository, bookingsRepository repositories.IBookingsRepository,
+	from time.Time, to time.Time) (pricing.PricingEngine, error) {
+	rules, err := pricingRulesRepository.FindAllEnabled()
+	if err != nil {
+		return pricing.PricingEngine{}, err
+	}
+
+	occupancies, err := bookingsRepository.FindOccupancy(from, to)
+	if err != nil {
+		return pricing.PricingEngine{}, err
+	}
+
+	pricingEngine := pricing.PricingEngine{
+		Occupancy: pricing.NewOccupancyCalendar(occupancies),
+	}
+
+	for _, rule := range rules {
+		pricingEngine.Strategies = append(pricingEngine.Strategies, rule)
+	}
+
+	return pricingEngine, nil
+}
@@ -0,0 +1,257 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateQuoteSuite struct {
+	suite.Suite
+	checkIn                       time.Time
+	checkOut                      time.Time
+	roomId                        uuid.UUID
+	fakeSecretsGateway            gateways.FakeSecretsGateway
+	fakeRoomsRepository           repositories.FakeRoomsRepository
+	fakeBookingsRepository        repositories.FakeBookingsRepository
+	fakePromoCodesRepository      repositories.FakePromoCodesRepository
+	fakePricingRulesRepository    repositories.FakePricingRulesRepository
+	fakeAddOnsRepository          repositories.FakeAddOnsRepository
+	fakeExtraGuestRatesRepository repositories.FakeExtraGuestRatesRepository
+	fakePackagesRepository        repositories.FakePackagesRepository
+	createQuote                   usecases.CreateQuote
+}
+
+func (c *CreateQuoteSuite) SetupTest() {
+	c.checkIn = time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 10)
+	c.checkOut = c.checkIn.AddDate(0, 0, 2)
+	c.roomId = uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	c.fakeSecretsGateway = gateways.FakeSecretsGateway{
+		Secrets: map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"},
+	}
+	c.fakeRoomsRepository = repositories.FakeRoomsRepository{
+		Rooms: []room.Room{
+			{
+				Id:       c.roomId,
+				Number:   "101",
+				Type:     "SUITE",
+				Capacity: 2,
+				Price:    250,
+			},
+		},
+	}
+	c.fakeBookingsRepository = repositories.FakeBookingsRepository{}
+	c.fakePromoCodesRepository = repositories.FakePromoCodesRepository{}
+	c.fakePricingRulesRepository = repositories.FakePricingRulesRepository{}
+	c.fakeAddOnsRepository = repositories.FakeAddOnsRepository{}
+	c.fakeExtraGuestRatesRepository = repositories.FakeExtraGuestRatesRepository{}
+	c.fakePackagesRepository = repositories.FakePackagesRepository{}
+	c.createQuote = usecases.CreateQuote{
+		SecretsGateway:            &c.fakeSecretsGateway,
+		RoomsRepository:           &c.fakeRoomsRepository,
+		BookingsRepository:        &c.fakeBookingsRepository,
+		PromoCodesRepository:      &c.fakePromoCodesRepository,
+		PricingRulesRepository:    &c.fakePricingRulesRepository,
+		AddOnsRepository:          &c.fakeAddOnsRepository,
+		ExtraGuestRatesRepository: &c.fakeExtraGuestRatesRepository,
+		PackagesRepository:        &c.fakePackagesRepository,
+	}
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnNoErrors_ReturnsPricedQuoteWithToken() {
+	output, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+	})
+	c.Require().NoError(err)
+
+	c.NotEmpty(output.QuoteToken)
+	c.WithinDuration(time.Now().Add(15*time.Minute), output.ExpiresAt, time.Minute)
+	c.Len(output.Items, 2)
+	c.Equal(c.roomId, output.Items[0].RoomId)
+	c.Equal(c.checkIn, output.Items[0].Date)
+	c.Equal(uint64(250), output.Items[0].Price)
+	c.Equal(uint64(500), output.Subtotal)
+	c.Equal(uint64(0), output.Discount)
+	c.Equal(uint64(500), output.Total)
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnValidPromoCode_ReturnsDiscountedQuote() {
+	c.fakePromoCodesRepository.PromoCodes = []promocode.PromoCode{
+		{
+			Code:            "SUMMER",
+			DiscountPercent: 20,
+			ValidFrom:       c.checkIn.AddDate(0, 0, -30),
+			ValidUntil:      c.checkIn.AddDate(0, 0, 30),
+		},
+	}
+
+	output, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:   []uuid.UUID{c.roomId},
+		CheckIn:   c.checkIn,
+		CheckOut:  c.checkOut,
+		Adults:    2,
+		PromoCode: "SUMMER",
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(500), output.Subtotal)
+	c.Equal(uint64(100), output.Discount)
+	c.Equal(uint64(400), output.Total)
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnEnabledPricingRuleAndHighOccupancy_ReturnsAdjustedPrice() {
+	c.fakePricingRulesRepository.Rules = []pricing.OccupancyPricingRule{
+		{
+			Id:                 uuid.New(),
+			RoomType:           "SUITE",
+			OccupancyThreshold: 80,
+			AdjustmentPercent:  20,
+			FloorPrice:         100,
+			CeilingPrice:       1000,
+			Enabled:            true,
+		},
+		{
+			Id:                 uuid.New(),
+			RoomType:           "SUITE",
+			OccupancyThreshold: 10,
+			AdjustmentPercent:  50,
+			FloorPrice:         100,
+			CeilingPrice:       1000,
+			Enabled:            false,
+		},
+	}
+	c.fakeBookingsRepository.Occupancies = []pricing.Occupancy{
+		{RoomType: "SUITE", Date: c.checkIn, BookedRooms: 9, TotalRooms: 10},
+		{RoomType: "SUITE", Date: c.checkIn.AddDate(0, 0, 1), BookedRooms: 5, TotalRooms: 10},
+	}
+
+	output, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+	})
+	c.Require().NoError(err)
+
+	c.Equal(uint64(300), output.Items[0].Price)
+	c.Equal(uint64(250), output.Items[1].Price)
+	c.Equal(uint64(550), output.Total)
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnUnknownPromoCode_ReturnsError() {
+	_, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:   []uuid.UUID{c.roomId},
+		CheckIn:   c.checkIn,
+		CheckOut:  c.checkOut,
+		Adults:    2,
+		PromoCode: "UNKNOWN",
+	})
+
+	c.EqualError(err, "promo code is invalid or has expired")
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnRoomNotFound_ReturnsError() {
+	_, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{uuid.New()},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+	})
+
+	c.EqualError(err, "one or more rooms were not found")
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnRoomAlreadyBooked_ReturnsError() {
+	c.fakeBookingsRepository.Bookings = []booking.Booking{
+		{
+			Id:       uuid.New(),
+			RoomIds:  []uuid.UUID{c.roomId},
+			CheckIn:  c.checkIn.AddDate(0, 0, 1),
+			CheckOut: c.checkOut.AddDate(0, 0, 1),
+			Guests:   guests.Guests{Adults: 1},
+			Status:   "CONFIRMED",
+		},
+	}
+
+	_, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+	})
+
+	c.EqualError(err, "one or more rooms are not available for the selected dates")
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnChildrenAndAddOns_ReturnsQuoteWithExtraCharges() {
+	c.fakeRoomsRepository.Rooms[0].Capacity = 3
+	c.fakeExtraGuestRatesRepository.Rates = []pricing.ExtraGuestRate{
+		{RoomType: "SUITE", BaseOccupancy: 2, ExtraAdultPrice: 40, ExtraChildPrice: 20, ChildMaxAge: 11},
+	}
+	c.fakeAddOnsRepository.AddOns = []addon.AddOn{
+		{Id: uuid.New(), Code: "BREAKFAST", Name: "Breakfast", Price: 15, Unit: "PER_PERSON_PER_NIGHT"},
+		{Id: uuid.New(), Code: "PARKING", Name: "Parking", Price: 10, Unit: "PER_NIGHT"},
+	}
+
+	output, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:      []uuid.UUID{c.roomId},
+		CheckIn:      c.checkIn,
+		CheckOut:     c.checkOut,
+		Adults:       2,
+		ChildrenAges: []uint8{6},
+		AddOns:       []usecases.CreateQuoteInputAddOn{{Code: "BREAKFAST", Quantity: 1}},
+	})
+	c.Require().NoError(err)
+
+	c.Len(output.Items, 5)
+	c.Equal("EXTRA_CHILD", output.Items[1].Type)
+	c.Equal(uint64(20), output.Items[1].Price)
+	c.Equal("ADD_ON", output.Items[4].Type)
+	c.Equal(uint64(90), output.Items[4].Price)
+	c.Equal(uint64(630), output.Total)
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnAddOnNotFound_ReturnsError() {
+	_, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+		AddOns:   []usecases.CreateQuoteInputAddOn{{Code: "SPA", Quantity: 1}},
+	})
+
+	c.EqualError(err, "one or more add-ons were not found")
+}
+
+func (c *CreateQuoteSuite) TestExecute_OnRepeatedAddOn_ReturnsError() {
+	_, err := c.createQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:  []uuid.UUID{c.roomId},
+		CheckIn:  c.checkIn,
+		CheckOut: c.checkOut,
+		Adults:   2,
+		AddOns: []usecases.CreateQuoteInputAddOn{
+			{Code: "PARKING", Quantity: 1},
+			{Code: "PARKING", Quantity: 1},
+		},
+	})
+
+	c.EqualError(err, "each add-on can only be selected once")
+}
+
+func TestCreateQuote(t *testing.T) {
+	suite.Run(t, new(CreateQuoteSuite))
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+)
+
+type CreateRatePlanInputInstallment struct {
+	Percent           uint8
+	Due               string
+	DaysBeforeArrival uint16
+}
+
+type CreateRatePlanInput struct {
+	Code         string
+	Name         string
+	Installments []CreateRatePlanInputInstallment
+}
+
+type CreateRatePlanOutput struct {
+	RatePlanId uuid.UUID
+}
+
+type ICreateRatePlan interface {
+	Execute(input CreateRatePlanInput) (CreateRatePlanOutput, error)
+}
+
+type CreateRatePlan struct {
+	RatePlansRepository repositories.IRatePlansRepository
+}
+
+func (c *CreateRatePlan) Execute(input CreateRatePlanInput) (CreateRatePlanOutput, error) {
+	exists, err := c.RatePlansRepository.ExistsByCode(input.Code)
+	if err != nil {
+		return CreateRatePlanOutput{}, err
+	}
+
+	if exists {
+		return CreateRatePlanOutput{}, errors.New("a rate plan with this code already exists")
+	}
+
+	installments := []payment.Installment{}
+
+	for _, installment := range input.Installments {
+		installments = append(installments, payment.Installment{
+			Percent:           installment.Percent,
+			Due:               installment.Due,
+			DaysBeforeArrival: installment.DaysBeforeArrival,
+		})
+	}
+
+	schedule, err := payment.NewPaymentSchedule(installments)
+	if err != nil {
+		return CreateRatePlanOutput{}, err
+	}
+
+	newRatePlan, err := rateplan.NewRatePlan(input.Code, input.Name, schedule)
+	if err != nil {
+		return CreateRatePlanOutput{}, err
+	}
+
+	err = c.RatePlansRepository.Create(newRatePlan)
+	if err != nil {
+		return CreateRatePlanOutput{}, err
+	}
+
+	return CreateRatePlanOutput{
+		RatePlanId: newRatePlan.Id,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateRatePlanSuite struct {
+	suite.Suite
+	fakeRatePlansRepository repositories.FakeRatePlansRepository
+	createRatePlan          usecases.CreateRatePlan
+}
+
+func (c *CreateRatePlanSuite) SetupTest() {
+	c.fakeRatePlansRepository = repositories.FakeRatePlansRepository{}
+	c.createRatePlan = usecases.CreateRatePlan{
+		RatePlansRepository: &c.fakeRatePlansRepository,
+	}
+}
+
+func (c *CreateRatePlanSuite) TestExecute_OnNoErrors_CreatesRatePlan() {
+	output, err := c.createRatePlan.Execute(usecases.CreateRatePlanInput{
+		Code: "FLEXIBLE_SPLIT",
+		Name: "Flexible split",
+		Installments: []usecases.CreateRatePlanInputInstallment{
+			{Percent: 20, Due: "AT_BOOKING"},
+			{Percent: 80, Due: "BEFORE_ARRIVAL", DaysBeforeArrival: 7},
+		},
+	})
+	c.Require().NoError(err)
+
+	createdRatePlan := c.fakeRatePlansRepository.RatePlans[0]
+	c.Equal(output.RatePlanId, createdRatePlan.Id)
+	c.Equal("FLEXIBLE_SPLIT", createdRatePlan.Code)
+	c.Equal("Flexible split", createdRatePlan.Name)
+	c.Equal([]payment.Installment{
+		{Percent: 20, Due: "AT_BOOKING"},
+		{Percent: 80, Due: "BEFORE_ARRIVAL", DaysBeforeArrival: 7},
+	}, createdRatePlan.Schedule.Installments)
+}
+
+func (c *CreateRatePlanSuite) TestExecute_OnCodeAlreadyExists_ReturnsError() {
+	c.fakeRatePlansRepository.RatePlans = []rateplan.RatePlan{
+		{Id: uuid.New(), Code: "NON_REFUNDABLE", Name: "Non refundable"},
+	}
+
+	_, err := c.createRatePlan.Execute(usecases.CreateRatePlanInput{
+		Code:         "NON_REFUNDABLE",
+		Name:         "Non refundable",
+		Installments: []usecases.CreateRatePlanInputInstallment{{Percent: 100, Due: "AT_BOOKING"}},
+	})
+
+	c.EqualError(err, "a rate plan with this code already exists")
+	c.Len(c.fakeRatePlansRepository.RatePlans, 1)
+}
+
+func (c *CreateRatePlanSuite) TestExecute_OnPercentagesAboveHundred_ReturnsError() {
+	_, err := c.createRatePlan.Execute(usecases.CreateRatePlanInput{
+		Code: "FLEXIBLE_SPLIT",
+		Name: "Flexible split",
+		Installments: []usecases.CreateRatePlanInputInstallment{
+			{Percent: 60, Due: "AT_BOOKING"},
+			{Percent: 60, Due: "AT_CHECK_IN"},
+		},
+	})
+
+	c.EqualError(err, "payment schedule percentages cannot exceed 100")
+	c.Empty(c.fakeRatePlansRepository.RatePlans)
+}
+
+func TestCreateRatePlan(t *testing.T) {
+	suite.Run(t, new(CreateRatePlanSuite))
+}
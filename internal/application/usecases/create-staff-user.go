@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+)
+
+type CreateStaffUserInput struct {
+	Name     string
+	Email    string
+	Password string
+	Role     string
+}
+
+type CreateStaffUserOutput struct {
+	StaffUserId uuid.UUID
+}
+
+type ICreateStaffUser interface {
+	Execute(input CreateStaffUserInput) (CreateStaffUserOutput, error)
+}
+
+type CreateStaffUser struct {
+	StaffUsersRepository repositories.IStaffUsersRepository
+	RolesRepository      repositories.IRolesRepository
+	PasswordPolicy       account.PasswordPolicy
+	PasswordHasher       account.PasswordHasher
+}
+
+func (c *CreateStaffUser) Execute(input CreateStaffUserInput) (CreateStaffUserOutput, error) {
+	err := c.PasswordPolicy.Validate(input.Password)
+	if err != nil {
+		return CreateStaffUserOutput{}, err
+	}
+
+	staffUser, err := staff.NewStaffUser(input.Name, input.Email, input.Password, input.Role, c.PasswordHasher)
+	if err != nil {
+		return CreateStaffUserOutput{}, err
+	}
+
+	roleExists, err := c.RolesRepository.ExistsByName(staffUser.Role)
+	if err != nil {
+		return CreateStaffUserOutput{}, err
+	}
+
+	if !roleExists {
+		return CreateStaffUserOutput{}, errors.New("staff role does not exist")
+	}
+
+	exists, err := c.StaffUsersRepository.ExistsByEmail(staffUser.Email)
+	if err != nil {
+		return CreateStaffUserOutput{}, err
+	}
+
+	if exists {
+		return CreateStaffUserOutput{}, errors.New("email address is already associated with another account")
+	}
+
+	err = c.StaffUsersRepository.Create(staffUser)
+	if err != nil {
+		return CreateStaffUserOutput{}, err
+	}
+
+	return CreateStaffUserOutput{
+		StaffUserId: staffUser.Id,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package usecases_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateStaffUserSuite struct {
+	suite.Suite
+	fakeStaffUsersRepository repositories.FakeStaffUsersRepository
+	fakeRolesRepository      repositories.FakeRolesRepository
+	createStaffUser          usecases.CreateStaffUser
+}
+
+func (c *CreateStaffUserSuite) SetupTest() {
+	passwordPolicy, err := account.NewPasswordPolicy(12, 3, []string{"correct-horse-battery"})
+	c.Require().NoError(err)
+	passwordHasher, err := account.NewPasswordHasher("ARGON2ID", 12, 19*1024, 2, 1)
+	c.Require().NoError(err)
+
+	c.fakeStaffUsersRepository = repositories.FakeStaffUsersRepository{}
+	c.fakeRolesRepository = repositories.FakeRolesRepository{RolePermissions: map[string][]string{"ADMIN": {}}}
+	c.createStaffUser = usecases.CreateStaffUser{
+		StaffUsersRepository: &c.fakeStaffUsersRepository,
+		RolesRepository:      &c.fakeRolesRepository,
+		PasswordPolicy:       passwordPolicy,
+		PasswordHasher:       passwordHasher,
+	}
+}
+
+func (c *CreateStaffUserSuite) TestExecute_OnNoErrors_StoresPasswordHashedWithTheConfiguredHasher() {
+	output, err := c.createStaffUser.Execute(usecases.CreateStaffUserInput{
+		Name:     "Jane Roe",
+		Email:    "jane.roe@hotel.com",
+		Password: "S3cret-password",
+		Role:     "ADMIN",
+	})
+	c.Require().NoError(err)
+
+	staffUser := c.fakeStaffUsersRepository.StaffUsers[0]
+	c.Equal(output.StaffUserId, staffUser.Id)
+	c.True(strings.HasPrefix(staffUser.HashedPassword, "$argon2id$"))
+	c.True(staffUser.PasswordMatches("S3cret-password"))
+}
+
+func (c *CreateStaffUserSuite) TestExecute_OnPasswordViolatingPolicy_ReturnsError() {
+	_, err := c.createStaffUser.Execute(usecases.CreateStaffUserInput{
+		Name:     "Jane Roe",
+		Email:    "jane.roe@hotel.com",
+		Password: "secretpassword",
+		Role:     "ADMIN",
+	})
+
+	c.EqualError(err, "password must contain at least 3 of the following: lowercase letters, uppercase letters, digits and symbols")
+	c.Empty(c.fakeStaffUsersRepository.StaffUsers)
+}
+
+func TestCreateStaffUser(t *testing.T) {
+	suite.Run(t, new(CreateStaffUserSuite))
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type DeactivatePackageInput struct {
+	PackageId uuid.UUID
+}
+
+type IDeactivatePackage interface {
+	Execute(input DeactivatePackageInput) error
+}
+
+type DeactivatePackage struct {
+	PackagesRepository repositories.IPackagesRepository
+}
+
+func (d *DeactivatePackage) Execute(input DeactivatePackageInput) error {
+	travelPackage, err := d.PackagesRepository.FindOneById(input.PackageId)
+	if err != nil {
+		return err
+	}
+
+	if travelPackage == nil {
+		return errors.New("package not found")
+	}
+
+	travelPackage.Deactivate()
+
+	return d.PackagesRepository.Update(*travelPackage)
+}
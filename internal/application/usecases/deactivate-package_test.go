@@ -0,0 +1,45 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeactivatePackageSuite struct {
+	suite.Suite
+	packageId              uuid.UUID
+	fakePackagesRepository repositories.FakePackagesRepository
+	deactivatePackage      usecases.DeactivatePackage
+}
+
+func (d *DeactivatePackageSuite) SetupTest() {
+	d.packageId = uuid.MustParse("5b0e3c8c-5f5e-4d8f-9d0c-3f0d3c1a7b11")
+	d.fakePackagesRepository = repositories.FakePackagesRepository{
+		Packages: []bundle.Package{{Id: d.packageId, Code: "ROMANTIC_WEEKEND", Active: true}},
+	}
+	d.deactivatePackage = usecases.DeactivatePackage{
+		PackagesRepository: &d.fakePackagesRepository,
+	}
+}
+
+func (d *DeactivatePackageSuite) TestExecute_OnNoErrors_DeactivatesPackage() {
+	err := d.deactivatePackage.Execute(usecases.DeactivatePackageInput{PackageId: d.packageId})
+	d.Require().NoError(err)
+
+	d.False(d.fakePackagesRepository.Packages[0].Active)
+}
+
+func (d *DeactivatePackageSuite) TestExecute_OnPackageNotFound_ReturnsError() {
+	err := d.deactivatePackage.Execute(usecases.DeactivatePackageInput{PackageId: uuid.New()})
+
+	d.EqualError(err, "package not found")
+}
+
+func TestDeactivatePackage(t *testing.T) {
+	suite.Run(t, new(DeactivatePackageSuite))
+}
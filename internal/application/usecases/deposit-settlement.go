@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type depositSettlementResult struct {
+	RefundAmount uint64
+	RefundStatus string
+}
+
+// combine adds up two settlements, reporting a failed or declined refund over a successful one.
+func (r depositSettlementResult) combine(other depositSettlementResult) depositSettlementResult {
+	status := r.RefundStatus
+	if status == "" || other.RefundStatus == "FAILED" || other.RefundStatus == "DECLINED" {
+		status = other.RefundStatus
+	}
+
+	if other.RefundStatus == "" {
+		status = r.RefundStatus
+	}
+
+	return depositSettlementResult{RefundAmount: r.RefundAmount + other.RefundAmount, RefundStatus: status}
+}
+
+type depositSettlement struct {
+	paymentsGateway    gateways.IPaymentsGateway
+	paymentsRepository repositories.IPaymentsRepository
+	refundsRepository  repositories.IRefundsRepository
+}
+
+// settle keeps retainedAmount of the deposit and gives the rest back to the customer: an uncaptured authorization is voided,
+// captured or reduced, while a captured deposit is refunded through the refund ledger.
+func (d depositSettlement) settle(deposit payment.Payment, retainedAmount uint64, captureNow bool,
+	refundType string) (depositSettlementResult, error) {
+	switch deposit.Status {
+	case "AUTHORIZED":
+		return d.release(deposit, retainedAmount, captureNow)
+	case "CAPTURED":
+		refundedAmount, err := d.refundedAmount(deposit)
+		if err != nil {
+			return depositSettlementResult{}, err
+		}
+
+		refundableAmount := deposit.RefundableAmount(refundedAmount)
+		if refundableAmount <= retainedAmount {
+			return depositSettlementResult{}, nil
+		}
+
+		refund, err := d.refund(deposit, refundableAmount-retainedAmount, refundType, "")
+		if err != nil {
+			return depositSettlementResult{}, err
+		}
+
+		return depositSettlementResult{RefundAmount: refund.Amount, RefundStatus: refund.Status}, nil
+	}
+
+	return depositSettlementResult{}, nil
+}
+
+func (d depositSettlement) release(deposit payment.Payment, retainedAmount uint64, captureNow bool) (depositSettlementResult,
+	error) {
+	releasedAmount := deposit.Amount - min(retainedAmount, deposit.Amount)
+	if releasedAmount == 0 && !captureNow {
+		return depositSettlementResult{}, nil
+	}
+
+	var expectedStatus string
+
+	if retainedAmount == 0 {
+		expectedStatus = "VOIDED"
+		result, err := d.paymentsGateway.Void(deposit.TransactionId)
+		if err != nil {
+			deposit.RecordFailure("VOID", err.Error())
+		} else if err := deposit.RecordVoid(result.Approved, result.DeclineReason); err != nil {
+			return depositSettlementResult{}, err
+		}
+	} else if captureNow {
+		expectedStatus = "CAPTURED"
+		result, err := d.paymentsGateway.Capture(deposit.TransactionId, retainedAmount)
+		if err != nil {
+			deposit.RecordFailure("CAPTURE", err.Error())
+		} else if err := deposit.RecordCapture(retainedAmount, result.Approved, result.DeclineReason); err != nil {
+			return depositSettlementResult{}, err
+		}
+	} else {
+		expectedStatus = "AUTHORIZED"
+		if err := deposit.ReduceAmount(retainedAmount); err != nil {
+			return depositSettlementResult{}, err
+		}
+	}
+
+	err := d.paymentsRepository.Update(deposit)
+	if err != nil {
+		return depositSettlementResult{}, err
+	}
+
+	if deposit.Status != expectedStatus {
+		return depositSettlementResult{RefundAmount: releasedAmount, RefundStatus: "FAILED"}, nil
+	}
+
+	if releasedAmount == 0 {
+		return depositSettlementResult{}, nil
+	}
+
+	return depositSettlementResult{RefundAmount: releasedAmount, RefundStatus: "RELEASED"}, nil
+}
+
+func (d depositSettlement) refundedAmount(deposit payment.Payment) (uint64, error) {
+	refunds, err := d.refundsRepository.FindAllByBookingId(deposit.BookingId)
+	if err != nil {
+		return 0, err
+	}
+
+	var refundedAmount uint64
+	for _, refund := range refunds {
+		if refund.PaymentId == deposit.Id && refund.CountsTowardsRefundedAmount() {
+			refundedAmount += refund.Amount
+		}
+	}
+
+	return refundedAmount, nil
+}
+
+func (d depositSettlement) refund(deposit payment.Payment, amount uint64, refundType string, reason string) (payment.Refund,
+	error) {
+	refund, err := payment.NewRefund(deposit.BookingId, deposit.Id, amount, refundType, reason)
+	if err != nil {
+		return payment.Refund{}, err
+	}
+
+	err = d.refundsRepository.Reserve(refund)
+	if err != nil {
+		return payment.Refund{}, err
+	}
+
+	result, err := d.paymentsGateway.Refund(deposit.TransactionId, amount)
+	if err != nil {
+		refund.RecordFailure(err.Error())
+	} else {
+		refund.RecordResult(result.TransactionId, result.Approved, result.DeclineReason)
+	}
+
+	return refund, d.refundsRepository.Update(refund)
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+// EmailVerificationClaims carry the email so that a link stops working if it was sent to an address the customer no
+// longer uses.
+type EmailVerificationClaims struct {
+	CustomerId uuid.UUID `json:"customerId"`
+	Email      string    `json:"email"`
+	jwt.RegisteredClaims
+}
+
+func (e *EmailVerificationClaims) setRegisteredClaims(registeredClaims jwt.RegisteredClaims) {
+	e.RegisteredClaims = registeredClaims
+}
+
+func signEmailVerificationToken(secretsGateway gateways.ISecretsGateway, customerDTO gateways.CustomerDTO,
+	expiresAt time.Time) (string, error) {
+	return signHmacToken(secretsGateway, "EMAIL_VERIFICATION", &EmailVerificationClaims{
+		CustomerId: customerDTO.Id,
+		Email:      customerDTO.Email,
+	}, expiresAt)
+}
+
+func parseEmailVerificationToken(secretsGateway gateways.ISecretsGateway, verificationToken string) (*EmailVerificationClaims, error) {
+	return parseHmacToken[EmailVerificationClaims](secretsGateway, "EMAIL_VERIFICATION", verificationToken,
+		errors.New("email verification token is invalid or has expired"))
+}
+
+// sendVerificationEmail records the sent time, which throttles resending, only once the email went out, so a customer
+// whose email failed can ask for another one right away.
+func sendVerificationEmail(secretsGateway gateways.ISecretsGateway, emailGateway gateways.IEmailGateway,
+	customersGateway gateways.ICustomersGateway, customerDTO gateways.CustomerDTO, tokenTtl time.Duration,
+	verificationUrl string) error {
+	verificationToken, err := signEmailVerificationToken(secretsGateway, customerDTO, time.Now().Add(tokenTtl))
+	if err != nil {
+		return err
+	}
+
+	err = emailGateway.Send(gateways.EmailDTO{
+		To:      customerDTO.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to verify your email. It expires in %d hours.\n\n%s?token=%s"+
+			"\n\nIf you did not create an account, you can ignore this email.", customerDTO.Name, int(tokenTtl.Hours()),
+			verificationUrl, url.QueryEscape(verificationToken)),
+	})
+	if err != nil {
+		return err
+	}
+
+	return customersGateway.UpdateVerificationEmailSentAt(customerDTO.Id, time.Now())
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type EnablePricingRuleInput struct {
+	PricingRuleId uuid.UUID
+}
+
+type IEnablePricingRule interface {
+	Execute(input EnablePricingRuleInput) error
+}
+
+type EnablePricingRule struct {
+	PricingRulesRepository repositories.IPricingRulesRepository
+}
+
+func (e *EnablePricingRule) Execute(input EnablePricingRuleInput) error {
+	rule, err := e.PricingRulesRepository.FindOneById(input.PricingRuleId)
+	if err != nil {
+		return err
+	}
+
+	if rule == nil {
+		return errors.New("pricing rule not found")
+	}
+
+	rule.Enable()
+
+	return e.PricingRulesRepository.Update(*rule)
+}
@@ -0,0 +1,46 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/stretchr/testify/suite"
+)
+
+type EnablePricingRuleSuite struct {
+	suite.Suite
+	fakePricingRulesRepository repositories.FakePricingRulesRepository
+	enablePricingRule          usecases.EnablePricingRule
+}
+
+func (e *EnablePricingRuleSuite) SetupTest() {
+	e.fakePricingRulesRepository = repositories.FakePricingRulesRepository{}
+	e.enablePricingRule = usecases.EnablePricingRule{
+		PricingRulesRepository: &e.fakePricingRulesRepository,
+	}
+}
+
+func (e *EnablePricingRuleSuite) TestExecute_OnExistingRule_EnablesIt() {
+	ruleId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	e.fakePricingRulesRepository.Rules = []pricing.OccupancyPricingRule{
+		{Id: ruleId, RoomType: "SUITE", OccupancyThreshold: 80, AdjustmentPercent: 15, FloorPrice: 200, CeilingPrice: 400},
+	}
+
+	err := e.enablePricingRule.Execute(usecases.EnablePricingRuleInput{PricingRuleId: ruleId})
+	e.Require().NoError(err)
+
+	e.True(e.fakePricingRulesRepository.Rules[0].Enabled)
+}
+
+func (e *EnablePricingRuleSuite) TestExecute_OnUnknownRule_ReturnsError() {
+	err := e.enablePricingRule.Execute(usecases.EnablePricingRuleInput{PricingRuleId: uuid.New()})
+
+	e.EqualError(err, "pricing rule not found")
+}
+
+func TestEnablePricingRule(t *testing.T) {
+	suite.Run(t, new(EnablePricingRuleSuite))
+}
@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+)
+
+type EnrollTotpInput struct {
+	Principal auth.Principal
+}
+
+type EnrollTotpOutput struct {
+	Secret          string
+	ManualEntryKey  string
+	ProvisioningUri string
+}
+
+type IEnrollTotp interface {
+	Execute(input EnrollTotpInput) (EnrollTotpOutput, error)
+}
+
+type EnrollTotp struct {
+	TotpFactorsRepository repositories.ITotpFactorsRepository
+	CustomersGateway      gateways.ICustomersGateway
+	StaffUsersRepository  repositories.IStaffUsersRepository
+	Issuer                string
+}
+
+// Execute starts a new enrollment, replacing one that was never activated. Two-factor authentication only takes
+// effect once ActivateTotp has checked a first code.
+func (e *EnrollTotp) Execute(input EnrollTotpInput) (EnrollTotpOutput, error) {
+	accountName, err := e.findAccountName(input.Principal)
+	if err != nil {
+		return EnrollTotpOutput{}, err
+	}
+
+	existingFactor, err := findTotpFactor(e.TotpFactorsRepository, input.Principal.CustomerId,
+		input.Principal.StaffUserId)
+	if err != nil {
+		return EnrollTotpOutput{}, err
+	}
+
+	if existingFactor != nil {
+		if existingFactor.IsActive() {
+			return EnrollTotpOutput{}, errors.New("two-factor authentication is already enabled")
+		}
+
+		err = e.TotpFactorsRepository.Delete(existingFactor.Id)
+		if err != nil {
+			return EnrollTotpOutput{}, err
+		}
+	}
+
+	totpFactor, err := mfa.NewTotpFactor(input.Principal.CustomerId, input.Principal.StaffUserId)
+	if err != nil {
+		return EnrollTotpOutput{}, err
+	}
+
+	err = e.TotpFactorsRepository.Create(totpFactor)
+	if err != nil {
+		return EnrollTotpOutput{}, err
+	}
+
+	return EnrollTotpOutput{
+		Secret:          totpFactor.EncodedSecret(),
+		ManualEntryKey:  totpFactor.ManualEntryKey(),
+		ProvisioningUri: totpFactor.ProvisioningUri(e.Issuer, accountName),
+	}, nil
+}
+
+func (e *EnrollTotp) findAccountName(principal auth.Principal) (string, error) {
+	if principal.IsCustomer() {
+		customerDTO, err := e.CustomersGateway.FindOneById(principal.CustomerId)
+		if err != nil {
+			return "", err
+		}
+
+		if customerDTO == nil {
+			return "", errors.New("user not found")
+		}
+
+		return customerDTO.Email, nil
+	}
+
+	staffUser, err := e.StaffUsersRepository.FindOneById(principal.StaffUserId)
+	if err != nil {
+		return "", err
+	}
+
+	if staffUser == nil {
+		return "", errors.New("user not found")
+	}
+
+	return staffUser.Email, nil
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type GetCreditInput struct {
+	CustomerId uuid.UUID
+}
+
+type GetCreditOutputTransaction struct {
+	Id           uuid.UUID
+	Type         string
+	Amount       int64
+	BalanceAfter uint64
+	Reference    uuid.UUID
+	Description  string
+	CreatedAt    time.Time
+}
+
+type GetCreditOutput struct {
+	Balance      uint64
+	Transactions []GetCreditOutputTransaction
+}
+
+type IGetCredit interface {
+	Execute(input GetCreditInput) (GetCreditOutput, error)
+}
+
+type GetCredit struct {
+	CreditEntriesRepository repositories.ICreditEntriesRepository
+}
+
+func (g *GetCredit) Execute(input GetCreditInput) (GetCreditOutput, error) {
+	entries, err := g.CreditEntriesRepository.FindAllByCustomerId(input.CustomerId)
+	if err != nil {
+		return GetCreditOutput{}, err
+	}
+
+	transactions := []GetCreditOutputTransaction{}
+	for _, entry := range slices.Backward(entries) {
+		transactions = append(transactions, GetCreditOutputTransaction{
+			Id:           entry.Id,
+			Type:         entry.Type,
+			Amount:       entry.Amount,
+			BalanceAfter: entry.BalanceAfter,
+			Reference:    entry.Reference,
+			Description:  entry.Description,
+			CreatedAt:    entry.CreatedAt,
+		})
+	}
+
+	return GetCreditOutput{
+		Balance:      credit.Balance(entries),
+		Transactions: transactions,
+	}, nil
+}
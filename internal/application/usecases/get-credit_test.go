@@ -0,0 +1,62 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCreditSuite struct {
+	suite.Suite
+	customerId                  uuid.UUID
+	fakeCreditEntriesRepository repositories.FakeCreditEntriesRepository
+	getCredit                   usecases.GetCredit
+}
+
+func (g *GetCreditSuite) SetupTest() {
+	g.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	g.fakeCreditEntriesRepository = repositories.FakeCreditEntriesRepository{}
+	g.getCredit = usecases.GetCredit{
+		CreditEntriesRepository: &g.fakeCreditEntriesRepository,
+	}
+}
+
+func (g *GetCreditSuite) TestExecute_OnEntries_ReturnsBalanceAndNewestFirst() {
+	giftCard, err := credit.NewCredit(g.customerId, "GIFT_CARD", 200, uuid.New(), "Gift card ****-ABCD")
+	g.Require().NoError(err)
+	_, err = g.fakeCreditEntriesRepository.Append(giftCard)
+	g.Require().NoError(err)
+	debit, err := credit.NewDebit(g.customerId, 120, uuid.New())
+	g.Require().NoError(err)
+	_, err = g.fakeCreditEntriesRepository.Append(debit)
+	g.Require().NoError(err)
+	otherCustomer, err := credit.NewCredit(uuid.New(), "ADJUSTMENT", 10, uuid.Nil, "Goodwill")
+	g.Require().NoError(err)
+	_, err = g.fakeCreditEntriesRepository.Append(otherCustomer)
+	g.Require().NoError(err)
+
+	output, err := g.getCredit.Execute(usecases.GetCreditInput{CustomerId: g.customerId})
+	g.Require().NoError(err)
+
+	g.Equal(uint64(80), output.Balance)
+	g.Len(output.Transactions, 2)
+	g.Equal("BOOKING_PAYMENT", output.Transactions[0].Type)
+	g.Equal(int64(-120), output.Transactions[0].Amount)
+	g.Equal(uint64(80), output.Transactions[0].BalanceAfter)
+	g.Equal("GIFT_CARD", output.Transactions[1].Type)
+}
+
+func (g *GetCreditSuite) TestExecute_OnNoEntries_ReturnsZeroBalance() {
+	output, err := g.getCredit.Execute(usecases.GetCreditInput{CustomerId: g.customerId})
+	g.Require().NoError(err)
+
+	g.Equal(usecases.GetCreditOutput{Transactions: []usecases.GetCreditOutputTransaction{}}, output)
+}
+
+func TestGetCredit(t *testing.T) {
+	suite.Run(t, new(GetCreditSuite))
+}
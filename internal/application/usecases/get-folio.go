@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+)
+
+type GetFolioInput struct {
+	BookingId uuid.UUID
+	Principal auth.Principal
+}
+
+type GetFolioOutputLine struct {
+	EntryId     uuid.UUID
+	Type        string
+	Category    string
+	Description string
+	Amount      uint64
+	Status      string
+	PostedAt    time.Time
+	Balance     int64
+}
+
+type GetFolioOutput struct {
+	BookingId     uuid.UUID
+	Lines         []GetFolioOutputLine
+	TotalCharges  uint64
+	TotalPayments uint64
+	TotalRefunds  uint64
+	Balance       int64
+}
+
+type IGetFolio interface {
+	Execute(input GetFolioInput) (GetFolioOutput, error)
+}
+
+type GetFolio struct {
+	BookingsRepository         repositories.IBookingsRepository
+	PaymentsRepository         repositories.IPaymentsRepository
+	ScheduledChargesRepository repositories.IScheduledChargesRepository
+	RefundsRepository          repositories.IRefundsRepository
+	FolioEntriesRepository     repositories.IFolioEntriesRepository
+}
+
+func (g *GetFolio) Execute(input GetFolioInput) (GetFolioOutput, error) {
+	foundBooking, err := g.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return GetFolioOutput{}, err
+	}
+
+	if foundBooking == nil || !input.Principal.MayAccess(foundBooking.CustomerId, "bookings:read:any") {
+		return GetFolioOutput{}, errors.New("booking not found")
+	}
+
+	guestFolio, err := loadFolio(g.PaymentsRepository, g.ScheduledChargesRepository, g.RefundsRepository, g.FolioEntriesRepository, *foundBooking)
+	if err != nil {
+		return GetFolioOutput{}, err
+	}
+
+	lines := []GetFolioOutputLine{}
+	for _, line := range guestFolio.Lines {
+		lines = append(lines, GetFolioOutputLine{
+			EntryId:     line.EntryId,
+			Type:        line.Type,
+			Category:    line.Category,
+			Description: line.Description,
+			Amount:      line.Amount,
+			Status:      line.Status,
+			PostedAt:    line.PostedAt,
+			Balance:     line.Balance,
+		})
+	}
+
+	return GetFolioOutput{
+		BookingId:     guestFolio.BookingId,
+		Lines:         lines,
+		TotalCharges:  guestFolio.TotalCharges,
+		TotalPayments: guestFolio.TotalPayments,
+		TotalRefunds:  guestFolio.TotalRefunds,
+		Balance:       guestFolio.Balance,
+	}, nil
+}
+
+func loadFolio(paymentsRepository repositories.IPaymentsRepository,
+	scheduledChargesRepository repositories.IScheduledChargesRepository, refundsRepository repositories.IRefundsRepository,
+	folioEntriesRepository repositories.IFolioEntriesRepository, stay booking.Booking) (folio.Folio, error) {
+	deposit, err := paymentsRepository.FindOneByBookingId(stay.Id)
+	if err != nil {
+		return folio.Folio{}, err
+	}
+
+	scheduledCharges, err := scheduledChargesRepository.FindAllByBookingId(stay.Id)
+	if err != nil {
+		return folio.Folio{}, err
+	}
+
+	refunds, err := refundsRepository.FindAllByBookingId(stay.Id)
+	if err != nil {
+		return folio.Folio{}, err
+	}
+
+	entries, err := folioEntriesRepository.FindAllByBookingId(stay.Id)
+	if err != nil {
+		return folio.Folio{}, err
+	}
+
+	return folio.NewFolio(stay, deposit, scheduledCharges, refunds, entries), nil
+}
+
+func findBookingOpenForFolio(bookingsRepository repositories.IBookingsRepository, bookingId uuid.UUID) (*booking.Booking, error) {
+	foundBooking, err := bookingsRepository.FindOneById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if foundBooking == nil {
+		return nil, errors.New("booking not found")
+	}
+
+	if foundBooking.Status != "CONFIRMED" {
+		return nil, errors.New("folio can only be changed while the booking is confirmed")
+	}
+
+	return foundBooking, nil
+}
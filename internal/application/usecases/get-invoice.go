@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+)
+
+type GetInvoiceInput struct {
+	BookingId uuid.UUID
+	Principal auth.Principal
+}
+
+type GetInvoiceOutputLine struct {
+	Category    string
+	Description string
+	TaxRate     uint8
+	NetAmount   int64
+	TaxAmount   int64
+	GrossAmount int64
+}
+
+type GetInvoiceOutputTax struct {
+	TaxRate     uint8
+	NetAmount   int64
+	TaxAmount   int64
+	GrossAmount int64
+}
+
+type GetInvoiceOutputCreditNote struct {
+	CreditNoteId uuid.UUID
+	Number       string
+	Reason       string
+	GrossTotal   int64
+	IssuedAt     time.Time
+}
+
+type GetInvoiceOutput struct {
+	InvoiceId      uuid.UUID
+	Number         string
+	BookingId      uuid.UUID
+	BillingName    string
+	BillingTaxId   string
+	BillingAddress string
+	BillingEmail   string
+	Lines          []GetInvoiceOutputLine
+	TaxBreakdown   []GetInvoiceOutputTax
+	NetTotal       int64
+	TaxTotal       int64
+	GrossTotal     int64
+	AmountPaid     int64
+	AmountDue      int64
+	IssuedAt       time.Time
+	CreditNotes    []GetInvoiceOutputCreditNote
+}
+
+type IGetInvoice interface {
+	Execute(input GetInvoiceInput) (GetInvoiceOutput, error)
+}
+
+type GetInvoice struct {
+	BookingsRepository repositories.IBookingsRepository
+	InvoicesRepository repositories.IInvoicesRepository
+}
+
+func (g *GetInvoice) Execute(input GetInvoiceInput) (GetInvoiceOutput, error) {
+	foundBooking, err := g.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return GetInvoiceOutput{}, err
+	}
+
+	if foundBooking == nil || !input.Principal.MayAccess(foundBooking.CustomerId, "bookings:read:any") {
+		return GetInvoiceOutput{}, errors.New("booking not found")
+	}
+
+	documents, err := g.InvoicesRepository.FindAllByBookingId(foundBooking.Id)
+	if err != nil {
+		return GetInvoiceOutput{}, err
+	}
+
+	current := invoice.ActiveInvoice(documents)
+	if current == nil {
+		return GetInvoiceOutput{}, errors.New("invoice not found")
+	}
+
+	lines := []GetInvoiceOutputLine{}
+	for _, line := range current.Lines {
+		lines = append(lines, GetInvoiceOutputLine(line))
+	}
+
+	taxBreakdown := []GetInvoiceOutputTax{}
+	for _, tax := range current.TaxBreakdown {
+		taxBreakdown = append(taxBreakdown, GetInvoiceOutputTax(tax))
+	}
+
+	creditNotes := []GetInvoiceOutputCreditNote{}
+	for _, document := range documents {
+		if document.Type != "CREDIT_NOTE" {
+			continue
+		}
+
+		creditNotes = append(creditNotes, GetInvoiceOutputCreditNote{
+			CreditNoteId: document.Id,
+			Number:       document.Number,
+			Reason:       document.Reason,
+			GrossTotal:   document.GrossTotal,
+			IssuedAt:     document.IssuedAt,
+		})
+	}
+
+	return GetInvoiceOutput{
+		InvoiceId:      current.Id,
+		Number:         current.Number,
+		BookingId:      current.BookingId,
+		BillingName:    current.BillTo.Name,
+		BillingTaxId:   current.BillTo.TaxId,
+		BillingAddress: current.BillTo.Address,
+		BillingEmail:   current.BillTo.Email,
+		Lines:          lines,
+		TaxBreakdown:   taxBreakdown,
+		NetTotal:       current.NetTotal,
+		TaxTotal:       current.TaxTotal,
+		GrossTotal:     current.GrossTotal,
+		AmountPaid:     current.AmountPaid,
+		AmountDue:      current.AmountDue,
+		IssuedAt:       current.IssuedAt,
+		CreditNotes:    creditNotes,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type GetRoomTypeCalendarInput struct {
+	RoomType string
+	From     time.Time
+	To       time.Time
+}
+
+type GetRoomTypeCalendarOutputDay struct {
+	Date              time.Time
+	AvailableRooms    int
+	LowestPrice       *uint64
+	MinStayNights     uint8
+	ClosedToArrival   bool
+	ClosedToDeparture bool
+}
+
+type GetRoomTypeCalendarOutput struct {
+	RoomType string
+	Days     []GetRoomTypeCalendarOutputDay
+}
+
+type IGetRoomTypeCalendar interface {
+	Execute(input GetRoomTypeCalendarInput) (GetRoomTypeCalendarOutput, error)
+}
+
+type GetRoomTypeCalendar struct {
+	CalendarRepository     repositories.ICalendarRepository
+	PricingRulesRepository repositories.IPricingRulesRepository
+}
+
+func (g *GetRoomTypeCalendar) Execute(input GetRoomTypeCalendarInput) (GetRoomTypeCalendarOutput, error) {
+	err := calendar.ValidateRange(input.RoomType, input.From, input.To)
+	if err != nil {
+		return GetRoomTypeCalendarOutput{}, err
+	}
+
+	days, err := g.CalendarRepository.FindDays(input.RoomType, input.From, input.To)
+	if err != nil {
+		return GetRoomTypeCalendarOutput{}, err
+	}
+
+	rules, err := g.PricingRulesRepository.FindAllEnabled()
+	if err != nil {
+		return GetRoomTypeCalendarOutput{}, err
+	}
+
+	occupancies := []pricing.Occupancy{}
+	for _, day := range days {
+		occupancies = append(occupancies, pricing.Occupancy{
+			RoomType:    input.RoomType,
+			Date:        day.Date,
+			BookedRooms: day.TotalRooms - day.AvailableRooms,
+			TotalRooms:  day.TotalRooms,
+		})
+	}
+
+	pricingEngine := pricing.PricingEngine{
+		Occupancy: pricing.NewOccupancyCalendar(occupancies),
+	}
+
+	for _, rule := range rules {
+		pricingEngine.Strategies = append(pricingEngine.Strategies, rule)
+	}
+
+	output := GetRoomTypeCalendarOutput{
+		RoomType: input.RoomType,
+		Days:     []GetRoomTypeCalendarOutputDay{},
+	}
+
+	for _, day := range days {
+		outputDay := GetRoomTypeCalendarOutputDay{
+			Date:              day.Date,
+			AvailableRooms:    day.AvailableRooms,
+			MinStayNights:     day.Restriction.MinStayNights,
+			ClosedToArrival:   day.Restriction.ClosedToArrival,
+			ClosedToDeparture: day.Restriction.ClosedToDeparture,
+		}
+
+		if day.AvailableRooms > 0 {
+			lowestPrice := pricingEngine.NightlyRate(input.RoomType, day.LowestBaseRate, day.Date)
+			outputDay.LowestPrice = &lowestPrice
+		}
+
+		output.Days = append(output.Days, outputDay)
+	}
+
+	return output, nil
+}
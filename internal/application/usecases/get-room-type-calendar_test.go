@@ -0,0 +1,115 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetRoomTypeCalendarSuite struct {
+	suite.Suite
+	from                       time.Time
+	fakeCalendarRepository     repositories.FakeCalendarRepository
+	fakePricingRulesRepository repositories.FakePricingRulesRepository
+	getRoomTypeCalendar        usecases.GetRoomTypeCalendar
+}
+
+func (g *GetRoomTypeCalendarSuite) SetupTest() {
+	g.from = time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	g.fakeCalendarRepository = repositories.FakeCalendarRepository{
+		Days: []calendar.CalendarDay{
+			{
+				Date:           g.from,
+				TotalRooms:     10,
+				AvailableRooms: 5,
+				LowestBaseRate: 200,
+				Restriction:    calendar.StayRestriction{RoomType: "SUITE", Date: g.from, MinStayNights: 1},
+			},
+			{
+				Date:           g.from.AddDate(0, 0, 1),
+				TotalRooms:     10,
+				AvailableRooms: 1,
+				LowestBaseRate: 250,
+				Restriction: calendar.StayRestriction{
+					RoomType:        "SUITE",
+					Date:            g.from.AddDate(0, 0, 1),
+					MinStayNights:   2,
+					ClosedToArrival: true,
+				},
+			},
+			{
+				Date:           g.from.AddDate(0, 0, 2),
+				TotalRooms:     10,
+				AvailableRooms: 0,
+				LowestBaseRate: 0,
+				Restriction:    calendar.StayRestriction{RoomType: "SUITE", Date: g.from.AddDate(0, 0, 2), MinStayNights: 1},
+			},
+		},
+	}
+	g.fakePricingRulesRepository = repositories.FakePricingRulesRepository{}
+	g.getRoomTypeCalendar = usecases.GetRoomTypeCalendar{
+		CalendarRepository:     &g.fakeCalendarRepository,
+		PricingRulesRepository: &g.fakePricingRulesRepository,
+	}
+}
+
+func (g *GetRoomTypeCalendarSuite) TestExecute_OnNoErrors_ReturnsAvailabilityPriceAndRestrictionsPerDate() {
+	g.fakePricingRulesRepository.Rules = []pricing.OccupancyPricingRule{
+		{
+			Id:                 uuid.New(),
+			RoomType:           "SUITE",
+			OccupancyThreshold: 80,
+			AdjustmentPercent:  20,
+			FloorPrice:         100,
+			CeilingPrice:       1000,
+			Enabled:            true,
+		},
+	}
+
+	output, err := g.getRoomTypeCalendar.Execute(usecases.GetRoomTypeCalendarInput{
+		RoomType: "SUITE",
+		From:     g.from,
+		To:       g.from.AddDate(0, 0, 2),
+	})
+	g.Require().NoError(err)
+
+	g.Equal("SUITE", output.RoomType)
+	g.Len(output.Days, 3)
+	g.Equal(5, output.Days[0].AvailableRooms)
+	g.Equal(uint64(200), *output.Days[0].LowestPrice)
+	g.Equal(uint64(300), *output.Days[1].LowestPrice)
+	g.Equal(uint8(2), output.Days[1].MinStayNights)
+	g.True(output.Days[1].ClosedToArrival)
+	g.Equal(0, output.Days[2].AvailableRooms)
+	g.Nil(output.Days[2].LowestPrice)
+}
+
+func (g *GetRoomTypeCalendarSuite) TestExecute_OnRangeAboveOneYear_ReturnsError() {
+	_, err := g.getRoomTypeCalendar.Execute(usecases.GetRoomTypeCalendarInput{
+		RoomType: "SUITE",
+		From:     g.from,
+		To:       g.from.AddDate(1, 1, 0),
+	})
+
+	g.EqualError(err, "date range cannot exceed 366 days")
+}
+
+func (g *GetRoomTypeCalendarSuite) TestExecute_OnInvalidRoomType_ReturnsError() {
+	_, err := g.getRoomTypeCalendar.Execute(usecases.GetRoomTypeCalendarInput{
+		RoomType: "PENTHOUSE",
+		From:     g.from,
+		To:       g.from,
+	})
+
+	g.EqualError(err, "room type must be SINGLE, DOUBLE, TWIN or SUITE")
+}
+
+func TestGetRoomTypeCalendar(t *testing.T) {
+	suite.Run(t, new(GetRoomTypeCalendarSuite))
+}
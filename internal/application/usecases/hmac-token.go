@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+// hmacTokenClaims are the claims of a token that only this service issues and verifies, such as quote, email
+// verification and mfa challenge tokens. They are HMAC-signed with JWT_SIGNING_ACCESS_TOKEN rather than with the
+// access token signing keys, so none of them is ever accepted as an access token, and the subject keeps one kind from
+// being accepted as another.
+type hmacTokenClaims interface {
+	jwt.Claims
+	setRegisteredClaims(registeredClaims jwt.RegisteredClaims)
+}
+
+func signHmacToken(secretsGateway gateways.ISecretsGateway, subject string, claims hmacTokenClaims,
+	expiresAt time.Time) (string, error) {
+	claims.setRegisteredClaims(jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	})
+
+	jwtSigningAccessToken, err := secretsGateway.Get("JWT_SIGNING_ACCESS_TOKEN")
+	if err != nil {
+		return "", err
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(jwtSigningAccessToken))
+}
+
+// parseHmacToken returns invalidErr for any token that is malformed, expired, not HMAC-signed with
+// JWT_SIGNING_ACCESS_TOKEN or issued for another subject.
+func parseHmacToken[T any, C interface {
+	*T
+	jwt.Claims
+}](secretsGateway gateways.ISecretsGateway, subject string, tokenString string, invalidErr error) (C, error) {
+	jwtSigningAccessToken, err := secretsGateway.Get("JWT_SIGNING_ACCESS_TOKEN")
+	if err != nil {
+		return nil, err
+	}
+
+	claims := C(new(T))
+	_, err = jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		return []byte(jwtSigningAccessToken), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithSubject(subject),
+		jwt.WithExpirationRequired())
+
+	if err != nil {
+		return nil, invalidErr
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+)
+
+type IssueCreditNoteInput struct {
+	InvoiceId uuid.UUID
+	Reason    string
+}
+
+type IssueCreditNoteOutput struct {
+	CreditNoteId uuid.UUID
+	Number       string
+}
+
+type IIssueCreditNote interface {
+	Execute(input IssueCreditNoteInput) (IssueCreditNoteOutput, error)
+}
+
+type IssueCreditNote struct {
+	InvoicesRepository repositories.IInvoicesRepository
+}
+
+func (i *IssueCreditNote) Execute(input IssueCreditNoteInput) (IssueCreditNoteOutput, error) {
+	original, err := i.InvoicesRepository.FindOneById(input.InvoiceId)
+	if err != nil {
+		return IssueCreditNoteOutput{}, err
+	}
+
+	if original == nil {
+		return IssueCreditNoteOutput{}, errors.New("invoice not found")
+	}
+
+	documents, err := i.InvoicesRepository.FindAllByBookingId(original.BookingId)
+	if err != nil {
+		return IssueCreditNoteOutput{}, err
+	}
+
+	if invoice.IsCredited(documents, original.Id) {
+		return IssueCreditNoteOutput{}, errors.New("invoice has already been credited")
+	}
+
+	creditNote, err := invoice.NewCreditNote(*original, input.Reason)
+	if err != nil {
+		return IssueCreditNoteOutput{}, err
+	}
+
+	issuedCreditNote, err := i.InvoicesRepository.Issue(creditNote)
+	if err != nil {
+		return IssueCreditNoteOutput{}, err
+	}
+
+	return IssueCreditNoteOutput{
+		CreditNoteId: issuedCreditNote.Id,
+		Number:       issuedCreditNote.Number,
+	}, nil
+}
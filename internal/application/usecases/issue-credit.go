@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type IssueCreditInput struct {
+	CustomerId  uuid.UUID
+	Type        string
+	Amount      uint64
+	BookingId   uuid.UUID
+	Description string
+}
+
+type IssueCreditOutput struct {
+	EntryId uuid.UUID
+	Balance uint64
+}
+
+type IIssueCredit interface {
+	Execute(input IssueCreditInput) (IssueCreditOutput, error)
+}
+
+// IssueCredit lets staff give stored credit instead of a cash refund, or as a goodwill adjustment.
+type IssueCredit struct {
+	CustomersGateway        gateways.ICustomersGateway
+	CreditEntriesRepository repositories.ICreditEntriesRepository
+}
+
+func (i *IssueCredit) Execute(input IssueCreditInput) (IssueCreditOutput, error) {
+	if input.Type == "GIFT_CARD" {
+		return IssueCreditOutput{}, errors.New("credit type must be REFUND or ADJUSTMENT")
+	}
+
+	exists, err := i.CustomersGateway.ExistsById(input.CustomerId)
+	if err != nil {
+		return IssueCreditOutput{}, err
+	}
+
+	if !exists {
+		return IssueCreditOutput{}, errors.New("customer not found")
+	}
+
+	entry, err := credit.NewCredit(input.CustomerId, input.Type, input.Amount, input.BookingId, input.Description)
+	if err != nil {
+		if err.Error() == "credit type must be GIFT_CARD, REFUND or ADJUSTMENT" {
+			return IssueCreditOutput{}, errors.New("credit type must be REFUND or ADJUSTMENT")
+		}
+
+		return IssueCreditOutput{}, err
+	}
+
+	entry, err = i.CreditEntriesRepository.Append(entry)
+	if err != nil {
+		return IssueCreditOutput{}, err
+	}
+
+	return IssueCreditOutput{
+		EntryId: entry.Id,
+		Balance: entry.BalanceAfter,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/stretchr/testify/suite"
+)
+
+type IssueCreditSuite struct {
+	suite.Suite
+	customerId                  uuid.UUID
+	fakeCustomersGateway        gateways.FakeCustomersGateway
+	fakeCreditEntriesRepository repositories.FakeCreditEntriesRepository
+	issueCredit                 usecases.IssueCredit
+}
+
+func (i *IssueCreditSuite) SetupTest() {
+	i.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	i.fakeCustomersGateway = gateways.FakeCustomersGateway{
+		CustomersDTO: []gateways.CustomerDTO{{Id: i.customerId, Name: "John Doe", Email: "john.doe@gmail.com"}},
+	}
+	i.fakeCreditEntriesRepository = repositories.FakeCreditEntriesRepository{}
+	i.issueCredit = usecases.IssueCredit{
+		CustomersGateway:        &i.fakeCustomersGateway,
+		CreditEntriesRepository: &i.fakeCreditEntriesRepository,
+	}
+}
+
+func (i *IssueCreditSuite) TestExecute_OnRefundAsCredit_AppendsCredit() {
+	bookingId := uuid.New()
+
+	output, err := i.issueCredit.Execute(usecases.IssueCreditInput{
+		CustomerId:  i.customerId,
+		Type:        "REFUND",
+		Amount:      80,
+		BookingId:   bookingId,
+		Description: "Air conditioning broken",
+	})
+	i.Require().NoError(err)
+
+	entry := i.fakeCreditEntriesRepository.CreditEntries[0]
+	i.Equal(usecases.IssueCreditOutput{EntryId: entry.Id, Balance: 80}, output)
+	i.Equal(bookingId, entry.Reference)
+	i.Equal("Air conditioning broken", entry.Description)
+}
+
+func (i *IssueCreditSuite) TestExecute_OnGiftCardType_ReturnsError() {
+	_, err := i.issueCredit.Execute(usecases.IssueCreditInput{
+		CustomerId:  i.customerId,
+		Type:        "GIFT_CARD",
+		Amount:      80,
+		Description: "Free money",
+	})
+
+	i.EqualError(err, "credit type must be REFUND or ADJUSTMENT")
+}
+
+func (i *IssueCreditSuite) TestExecute_OnUnknownCustomer_ReturnsError() {
+	_, err := i.issueCredit.Execute(usecases.IssueCreditInput{
+		CustomerId:  uuid.New(),
+		Type:        "ADJUSTMENT",
+		Amount:      80,
+		Description: "Goodwill",
+	})
+
+	i.EqualError(err, "customer not found")
+	i.Empty(i.fakeCreditEntriesRepository.CreditEntries)
+}
+
+func TestIssueCredit(t *testing.T) {
+	suite.Run(t, new(IssueCreditSuite))
+}
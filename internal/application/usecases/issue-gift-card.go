@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type IssueGiftCardInput struct {
+	Amount       uint64
+	ValidForDays uint16
+}
+
+type IssueGiftCardOutput struct {
+	GiftCardId uuid.UUID
+	Code       string
+	ExpiresAt  time.Time
+}
+
+type IIssueGiftCard interface {
+	Execute(input IssueGiftCardInput) (IssueGiftCardOutput, error)
+}
+
+type IssueGiftCard struct {
+	GiftCardsRepository repositories.IGiftCardsRepository
+}
+
+func (i *IssueGiftCard) Execute(input IssueGiftCardInput) (IssueGiftCardOutput, error) {
+	if input.ValidForDays == 0 {
+		return IssueGiftCardOutput{}, errors.New("gift card must be valid for at least one day")
+	}
+
+	expiresAt := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, int(input.ValidForDays)+1)
+
+	giftCard, err := credit.NewGiftCard(input.Amount, expiresAt)
+	if err != nil {
+		return IssueGiftCardOutput{}, err
+	}
+
+	err = i.GiftCardsRepository.Create(giftCard)
+	if err != nil {
+		return IssueGiftCardOutput{}, err
+	}
+
+	return IssueGiftCardOutput{
+		GiftCardId: giftCard.Id,
+		Code:       giftCard.Code,
+		ExpiresAt:  giftCard.ExpiresAt,
+	}, nil
+}
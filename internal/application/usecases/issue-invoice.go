@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+)
+
+type IssueInvoiceInput struct {
+	BookingId      uuid.UUID
+	BillingName    string
+	BillingTaxId   string
+	BillingAddress string
+	BillingEmail   string
+}
+
+type IssueInvoiceOutput struct {
+	InvoiceId uuid.UUID
+	Number    string
+}
+
+type IIssueInvoice interface {
+	Execute(input IssueInvoiceInput) (IssueInvoiceOutput, error)
+}
+
+type IssueInvoice struct {
+	BookingsRepository         repositories.IBookingsRepository
+	PaymentsRepository         repositories.IPaymentsRepository
+	ScheduledChargesRepository repositories.IScheduledChargesRepository
+	RefundsRepository          repositories.IRefundsRepository
+	FolioEntriesRepository     repositories.IFolioEntriesRepository
+	InvoicesRepository         repositories.IInvoicesRepository
+	PropertyCode               string
+	TaxRates                   invoice.TaxRates
+}
+
+func (i *IssueInvoice) Execute(input IssueInvoiceInput) (IssueInvoiceOutput, error) {
+	foundBooking, err := i.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return IssueInvoiceOutput{}, err
+	}
+
+	if foundBooking == nil {
+		return IssueInvoiceOutput{}, errors.New("booking not found")
+	}
+
+	if foundBooking.Status != "CHECKED_OUT" {
+		return IssueInvoiceOutput{}, errors.New("invoices can only be issued for checked-out bookings")
+	}
+
+	documents, err := i.InvoicesRepository.FindAllByBookingId(foundBooking.Id)
+	if err != nil {
+		return IssueInvoiceOutput{}, err
+	}
+
+	if invoice.ActiveInvoice(documents) != nil {
+		return IssueInvoiceOutput{}, errors.New("booking already has an active invoice")
+	}
+
+	guestFolio, err := loadFolio(i.PaymentsRepository, i.ScheduledChargesRepository, i.RefundsRepository, i.FolioEntriesRepository, *foundBooking)
+	if err != nil {
+		return IssueInvoiceOutput{}, err
+	}
+
+	newInvoice, err := invoice.NewInvoice(i.PropertyCode, guestFolio, invoice.BillingDetails{
+		Name:    input.BillingName,
+		TaxId:   input.BillingTaxId,
+		Address: input.BillingAddress,
+		Email:   input.BillingEmail,
+	}, i.TaxRates)
+	if err != nil {
+		return IssueInvoiceOutput{}, err
+	}
+
+	issuedInvoice, err := i.InvoicesRepository.Issue(newInvoice)
+	if err != nil {
+		return IssueInvoiceOutput{}, err
+	}
+
+	return IssueInvoiceOutput{
+		InvoiceId: issuedInvoice.Id,
+		Number:    issuedInvoice.Number,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+	"github.com/stretchr/testify/suite"
+)
+
+type IssueInvoiceSuite struct {
+	suite.Suite
+	bookingId                      uuid.UUID
+	fakeBookingsRepository         repositories.FakeBookingsRepository
+	fakePaymentsRepository         repositories.FakePaymentsRepository
+	fakeScheduledChargesRepository repositories.FakeScheduledChargesRepository
+	fakeRefundsRepository          repositories.FakeRefundsRepository
+	fakeFolioEntriesRepository     repositories.FakeFolioEntriesRepository
+	fakeInvoicesRepository         repositories.FakeInvoicesRepository
+	issueInvoice                   usecases.IssueInvoice
+	issueCreditNote                usecases.IssueCreditNote
+}
+
+func (i *IssueInvoiceSuite) SetupTest() {
+	i.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	i.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{
+			{
+				Id:         i.bookingId,
+				CheckIn:    time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+				CheckOut:   time.Date(2030, 1, 12, 0, 0, 0, 0, time.UTC),
+				TotalPrice: 440,
+				Status:     "CHECKED_OUT",
+			},
+		},
+	}
+	i.fakePaymentsRepository = repositories.FakePaymentsRepository{}
+	i.fakeRefundsRepository = repositories.FakeRefundsRepository{}
+	i.fakeFolioEntriesRepository = repositories.FakeFolioEntriesRepository{
+		FolioEntries: []folio.FolioEntry{
+			{Id: uuid.New(), BookingId: i.bookingId, Type: "CHARGE", Category: "MINIBAR", Description: "Sodas",
+				Amount: 24, Status: "POSTED", PostedAt: time.Date(2030, 1, 10, 20, 0, 0, 0, time.UTC)},
+			{Id: uuid.New(), BookingId: i.bookingId, Type: "PAYMENT", Category: "CARD", Description: "Payment at front desk",
+				Amount: 464, Status: "POSTED", PostedAt: time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC)},
+		},
+	}
+	i.fakeInvoicesRepository = repositories.FakeInvoicesRepository{}
+	i.issueInvoice = usecases.IssueInvoice{
+		BookingsRepository:         &i.fakeBookingsRepository,
+		PaymentsRepository:         &i.fakePaymentsRepository,
+		ScheduledChargesRepository: &i.fakeScheduledChargesRepository,
+		RefundsRepository:          &i.fakeRefundsRepository,
+		FolioEntriesRepository:     &i.fakeFolioEntriesRepository,
+		InvoicesRepository:         &i.fakeInvoicesRepository,
+		PropertyCode:               "MAIN",
+		TaxRates:                   invoice.TaxRates{AccommodationPercent: 10, ServicesPercent: 20},
+	}
+	i.issueCreditNote = usecases.IssueCreditNote{
+		InvoicesRepository: &i.fakeInvoicesRepository,
+	}
+}
+
+func (i *IssueInvoiceSuite) TestExecute_OnClosedFolio_IssuesNumberedInvoice() {
+	output, err := i.issueInvoice.Execute(usecases.IssueInvoiceInput{BookingId: i.bookingId, BillingName: "Acme Corp"})
+	i.Require().NoError(err)
+
+	i.Equal("MAIN-INV-000001", output.Number)
+	i.Require().Len(i.fakeInvoicesRepository.Invoices, 1)
+	i.Equal(int64(464), i.fakeInvoicesRepository.Invoices[0].GrossTotal)
+	i.Equal(int64(44), i.fakeInvoicesRepository.Invoices[0].TaxTotal)
+}
+
+func (i *IssueInvoiceSuite) TestExecute_OnActiveInvoice_ReturnsError() {
+	_, err := i.issueInvoice.Execute(usecases.IssueInvoiceInput{BookingId: i.bookingId, BillingName: "Acme Corp"})
+	i.Require().NoError(err)
+
+	_, err = i.issueInvoice.Execute(usecases.IssueInvoiceInput{BookingId: i.bookingId, BillingName: "Acme Corp"})
+
+	i.EqualError(err, "booking already has an active invoice")
+}
+
+func (i *IssueInvoiceSuite) TestExecute_OnCreditedInvoice_IssuesCorrectionWithNextNumber() {
+	original, err := i.issueInvoice.Execute(usecases.IssueInvoiceInput{BookingId: i.bookingId, BillingName: "Acme"})
+	i.Require().NoError(err)
+
+	creditNote, err := i.issueCreditNote.Execute(usecases.IssueCreditNoteInput{
+		InvoiceId: original.InvoiceId,
+		Reason:    "wrong company name",
+	})
+	i.Require().NoError(err)
+
+	corrected, err := i.issueInvoice.Execute(usecases.IssueInvoiceInput{BookingId: i.bookingId, BillingName: "Acme Corp"})
+	i.Require().NoError(err)
+
+	i.Equal("MAIN-CN-000002", creditNote.Number)
+	i.Equal("MAIN-INV-000003", corrected.Number)
+	i.Equal(int64(-464), i.fakeInvoicesRepository.Invoices[1].GrossTotal)
+
+	_, err = i.issueCreditNote.Execute(usecases.IssueCreditNoteInput{
+		InvoiceId: original.InvoiceId,
+		Reason:    "wrong company name",
+	})
+	i.EqualError(err, "invoice has already been credited")
+}
+
+func (i *IssueInvoiceSuite) TestExecute_OnOpenBooking_ReturnsError() {
+	i.fakeBookingsRepository.Bookings[0].Status = "CONFIRMED"
+
+	_, err := i.issueInvoice.Execute(usecases.IssueInvoiceInput{BookingId: i.bookingId, BillingName: "Acme Corp"})
+
+	i.EqualError(err, "invoices can only be issued for checked-out bookings")
+	i.Empty(i.fakeInvoicesRepository.Invoices)
+}
+
+func TestIssueInvoice(t *testing.T) {
+	suite.Run(t, new(IssueInvoiceSuite))
+}
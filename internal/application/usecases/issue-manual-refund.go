@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type IssueManualRefundInput struct {
+	BookingId uuid.UUID
+	Amount    uint64
+	Reason    string
+}
+
+type IssueManualRefundOutput struct {
+	RefundId uuid.UUID
+	Status   string
+}
+
+type IIssueManualRefund interface {
+	Execute(input IssueManualRefundInput) (IssueManualRefundOutput, error)
+}
+
+type IssueManualRefund struct {
+	PaymentsGateway    gateways.IPaymentsGateway
+	BookingsRepository repositories.IBookingsRepository
+	PaymentsRepository repositories.IPaymentsRepository
+	RefundsRepository  repositories.IRefundsRepository
+}
+
+func (i *IssueManualRefund) Execute(input IssueManualRefundInput) (IssueManualRefundOutput, error) {
+	foundBooking, err := i.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return IssueManualRefundOutput{}, err
+	}
+
+	if foundBooking == nil {
+		return IssueManualRefundOutput{}, errors.New("booking not found")
+	}
+
+	deposit, err := i.PaymentsRepository.FindOneByBookingId(foundBooking.Id)
+	if err != nil {
+		return IssueManualRefundOutput{}, err
+	}
+
+	if deposit == nil || deposit.Status != "CAPTURED" {
+		return IssueManualRefundOutput{}, errors.New("booking has no captured payment to refund")
+	}
+
+	settlement := depositSettlement{
+		paymentsGateway:    i.PaymentsGateway,
+		paymentsRepository: i.PaymentsRepository,
+		refundsRepository:  i.RefundsRepository,
+	}
+
+	refundedAmount, err := settlement.refundedAmount(*deposit)
+	if err != nil {
+		return IssueManualRefundOutput{}, err
+	}
+
+	if input.Amount > deposit.RefundableAmount(refundedAmount) {
+		return IssueManualRefundOutput{}, errors.New("refund amount exceeds the refundable amount")
+	}
+
+	refund, err := settlement.refund(*deposit, input.Amount, "MANUAL", input.Reason)
+	if err != nil {
+		return IssueManualRefundOutput{}, err
+	}
+
+	return IssueManualRefundOutput{
+		RefundId: refund.Id,
+		Status:   refund.Status,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package usecases_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type IssueManualRefundSuite struct {
+	suite.Suite
+	bookingId              uuid.UUID
+	depositId              uuid.UUID
+	fakePaymentsGateway    gateways.FakePaymentsGateway
+	fakeBookingsRepository repositories.FakeBookingsRepository
+	fakePaymentsRepository repositories.FakePaymentsRepository
+	fakeRefundsRepository  repositories.FakeRefundsRepository
+	issueManualRefund      usecases.IssueManualRefund
+}
+
+func (i *IssueManualRefundSuite) SetupTest() {
+	i.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	i.depositId = uuid.MustParse("5b0e3c8c-5f5e-4d8f-9d0c-3f0d3c1a7b11")
+	i.fakePaymentsGateway = gateways.FakePaymentsGateway{}
+	result, err := i.fakePaymentsGateway.Authorize(i.bookingId, "tok_visa", 150)
+	i.Require().NoError(err)
+	_, err = i.fakePaymentsGateway.Capture(result.TransactionId, 150)
+	i.Require().NoError(err)
+	i.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{{Id: i.bookingId, Status: "CONFIRMED"}},
+	}
+	i.fakePaymentsRepository = repositories.FakePaymentsRepository{
+		Payments: []payment.Payment{
+			{Id: i.depositId, BookingId: i.bookingId, Amount: 150, CapturedAmount: 150, TransactionId: result.TransactionId,
+				Status: "CAPTURED"},
+		},
+	}
+	i.fakeRefundsRepository = repositories.FakeRefundsRepository{CapturedAmounts: map[uuid.UUID]uint64{i.depositId: 150}}
+	i.issueManualRefund = usecases.IssueManualRefund{
+		PaymentsGateway:    &i.fakePaymentsGateway,
+		BookingsRepository: &i.fakeBookingsRepository,
+		PaymentsRepository: &i.fakePaymentsRepository,
+		RefundsRepository:  &i.fakeRefundsRepository,
+	}
+}
+
+func (i *IssueManualRefundSuite) TestExecute_OnNoErrors_RecordsRefundWithReason() {
+	output, err := i.issueManualRefund.Execute(usecases.IssueManualRefundInput{
+		BookingId: i.bookingId,
+		Amount:    50,
+		Reason:    "air conditioning was broken",
+	})
+	i.Require().NoError(err)
+
+	i.Equal("APPROVED", output.Status)
+	refund := i.fakeRefundsRepository.Refunds[0]
+	i.Equal(output.RefundId, refund.Id)
+	i.Equal("MANUAL", refund.Type)
+	i.Equal("air conditioning was broken", refund.Reason)
+	i.Equal(uint64(50), refund.Amount)
+}
+
+func (i *IssueManualRefundSuite) TestExecute_OnAmountAboveRefundable_ReturnsError() {
+	_, err := i.issueManualRefund.Execute(usecases.IssueManualRefundInput{
+		BookingId: i.bookingId,
+		Amount:    151,
+		Reason:    "air conditioning was broken",
+	})
+
+	i.EqualError(err, "refund amount exceeds the refundable amount")
+	i.Empty(i.fakeRefundsRepository.Refunds)
+}
+
+func (i *IssueManualRefundSuite) TestExecute_OnMissingReason_ReturnsError() {
+	_, err := i.issueManualRefund.Execute(usecases.IssueManualRefundInput{BookingId: i.bookingId, Amount: 50})
+
+	i.EqualError(err, "manual refunds require a reason of at least 3 characters")
+}
+
+func (i *IssueManualRefundSuite) TestExecute_OnUncapturedDeposit_ReturnsError() {
+	i.fakePaymentsRepository.Payments[0].Status = "AUTHORIZED"
+
+	_, err := i.issueManualRefund.Execute(usecases.IssueManualRefundInput{BookingId: i.bookingId, Amount: 50, Reason: "goodwill"})
+
+	i.EqualError(err, "booking has no captured payment to refund")
+}
+
+func (i *IssueManualRefundSuite) TestExecute_OnConcurrentRefunds_NeverExceedsCapturedAmount() {
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = i.issueManualRefund.Execute(usecases.IssueManualRefundInput{
+				BookingId: i.bookingId,
+				Amount:    40,
+				Reason:    "goodwill gesture",
+			})
+		}()
+	}
+	wg.Wait()
+
+	var refundedAmount uint64
+	for _, refund := range i.fakeRefundsRepository.Refunds {
+		if refund.CountsTowardsRefundedAmount() {
+			refundedAmount += refund.Amount
+		}
+	}
+	i.Equal(uint64(120), refundedAmount)
+	i.Equal(uint64(120), i.fakePaymentsGateway.Transactions[0].RefundedAmount)
+}
+
+func TestIssueManualRefund(t *testing.T) {
+	suite.Run(t, new(IssueManualRefundSuite))
+}
@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+)
+
+type LoginStaffWithEmailAndPasswordInput struct {
+	Email         string
+	PlainPassword string
+	IpAddress     string
+}
+
+type LoginStaffWithEmailAndPasswordOutput struct {
+	StaffUserId          uuid.UUID
+	Name                 string
+	Role                 string
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+	// MfaChallengeToken replaces the tokens above when the staff user has two-factor authentication enabled. It is
+	// exchanged for them by CompleteMfaLogin.
+	MfaChallengeToken     string
+	MfaChallengeExpiresAt time.Time
+	// RetryAfter is set when the attempt was refused because of too many failed logins.
+	RetryAfter time.Duration
+}
+
+type ILoginStaffWithEmailAndPassword interface {
+	Execute(input LoginStaffWithEmailAndPasswordInput) (LoginStaffWithEmailAndPasswordOutput, error)
+}
+
+type LoginStaffWithEmailAndPassword struct {
+	SecretsGateway          gateways.ISecretsGateway
+	SigningKeysSource       *auth.SigningKeysSource
+	StaffUsersRepository    repositories.IStaffUsersRepository
+	RefreshTokensRepository repositories.IRefreshTokensRepository
+	AccessTokenTtl          time.Duration
+	RefreshTokenTtl         time.Duration
+	LoginThrottle           LoginThrottle
+	TotpFactorsRepository   repositories.ITotpFactorsRepository
+	MfaChallengeTtl         time.Duration
+	PasswordHasher          account.PasswordHasher
+}
+
+// Execute throttles failed attempts like LoginWithEmailAndPassword does, counting them per staff account and per client
+// address. A password hashed with an outdated algorithm or cost is rehashed with the configured one once it matched.
+func (l *LoginStaffWithEmailAndPassword) Execute(input LoginStaffWithEmailAndPasswordInput) (LoginStaffWithEmailAndPasswordOutput, error) {
+	now := time.Now().UTC()
+
+	throttleSubjects := l.LoginThrottle.staffPasswordSubjects(input.Email, input.IpAddress)
+
+	retryAfter, err := l.LoginThrottle.retryAfter(throttleSubjects, now)
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	if retryAfter > 0 {
+		return LoginStaffWithEmailAndPasswordOutput{RetryAfter: retryAfter},
+			errors.New("too many failed login attempts. Please try again later")
+	}
+
+	staffUser, err := l.StaffUsersRepository.FindOneByEmail(staff.NormalizeEmail(input.Email))
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	if staffUser == nil || !staffUser.PasswordMatches(input.PlainPassword) {
+		err = l.LoginThrottle.recordFailure(throttleSubjects, now)
+		if err != nil {
+			return LoginStaffWithEmailAndPasswordOutput{}, err
+		}
+
+		return LoginStaffWithEmailAndPasswordOutput{}, errors.New("email or password is incorrect")
+	}
+
+	err = l.LoginThrottle.reset(throttleSubjects)
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	if l.PasswordHasher.NeedsRehash(staffUser.HashedPassword) {
+		hashedPassword, err := l.PasswordHasher.Hash(input.PlainPassword)
+		if err != nil {
+			return LoginStaffWithEmailAndPasswordOutput{}, err
+		}
+
+		err = l.StaffUsersRepository.UpdatePassword(staffUser.Id, hashedPassword)
+		if err != nil {
+			return LoginStaffWithEmailAndPasswordOutput{}, err
+		}
+	}
+
+	totpFactor, err := l.TotpFactorsRepository.FindOneByStaffUserId(staffUser.Id)
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	if totpFactor != nil && totpFactor.IsActive() {
+		mfaChallengeExpiresAt := time.Now().UTC().Add(l.MfaChallengeTtl)
+
+		mfaChallengeToken, err := signMfaChallengeToken(l.SecretsGateway, uuid.Nil, staffUser.Id, mfaChallengeExpiresAt)
+		if err != nil {
+			return LoginStaffWithEmailAndPasswordOutput{}, err
+		}
+
+		return LoginStaffWithEmailAndPasswordOutput{
+			MfaChallengeToken:     mfaChallengeToken,
+			MfaChallengeExpiresAt: mfaChallengeExpiresAt,
+		}, nil
+	}
+
+	refreshToken, plainRefreshToken, err := session.NewStaffRefreshToken(staffUser.Id, l.RefreshTokenTtl)
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	err = l.RefreshTokensRepository.Create(refreshToken)
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	accessTokenExpiresAt := time.Now().UTC().Add(l.AccessTokenTtl)
+
+	signedToken, err := signStaffAccessToken(l.SigningKeysSource, *staffUser, refreshToken.FamilyId, accessTokenExpiresAt)
+	if err != nil {
+		return LoginStaffWithEmailAndPasswordOutput{}, err
+	}
+
+	return LoginStaffWithEmailAndPasswordOutput{
+		StaffUserId:          staffUser.Id,
+		Name:                 staffUser.Name,
+		Role:                 staffUser.Role,
+		AccessToken:          signedToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         plainRefreshToken,
+	}, nil
+}
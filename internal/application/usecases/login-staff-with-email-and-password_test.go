@@ -0,0 +1,255 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginStaffWithEmailAndPasswordSuite struct {
+	suite.Suite
+	staffUser                      staff.StaffUser
+	signingKey                     auth.SigningKey
+	fakeSecretsGateway             gateways.FakeSecretsGateway
+	fakeStaffUsers                 repositories.FakeStaffUsersRepository
+	fakeRefreshTokens              repositories.FakeRefreshTokensRepository
+	fakeTotpFactors                repositories.FakeTotpFactorsRepository
+	fakeLoginAttempts              repositories.FakeLoginAttemptsRepository
+	fakeLoginLockouts              repositories.FakeLoginLockoutsRepository
+	loginStaffWithEmailAndPassword usecases.LoginStaffWithEmailAndPassword
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) SetupTest() {
+	var err error
+	l.staffUser, err = staff.NewStaffUser("Jane Roe", "jane.roe@hotel.com", "s3cret-password", "ADMIN", account.PasswordHasher{})
+	l.Require().NoError(err)
+	var rawSigningKeys string
+	l.signingKey, rawSigningKeys = newSigningKeys(&l.Suite)
+	l.fakeSecretsGateway = gateways.FakeSecretsGateway{
+		Secrets: map[string]string{
+			"JWT_SIGNING_KEYS":         rawSigningKeys,
+			"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1",
+		},
+	}
+	l.fakeStaffUsers = repositories.FakeStaffUsersRepository{StaffUsers: []staff.StaffUser{l.staffUser}}
+	l.fakeRefreshTokens = repositories.FakeRefreshTokensRepository{}
+	l.fakeTotpFactors = repositories.FakeTotpFactorsRepository{}
+	l.fakeLoginAttempts = repositories.FakeLoginAttemptsRepository{}
+	l.fakeLoginLockouts = repositories.FakeLoginLockoutsRepository{}
+	accountPolicy, err := account.NewLoginThrottlePolicy(3, 5, time.Second, 15*time.Minute)
+	l.Require().NoError(err)
+	ipAddressPolicy, err := account.NewLoginThrottlePolicy(20, 100, time.Second, 15*time.Minute)
+	l.Require().NoError(err)
+	l.loginStaffWithEmailAndPassword = usecases.LoginStaffWithEmailAndPassword{
+		SecretsGateway:          &l.fakeSecretsGateway,
+		SigningKeysSource:       &auth.SigningKeysSource{SecretsGateway: &l.fakeSecretsGateway},
+		StaffUsersRepository:    &l.fakeStaffUsers,
+		RefreshTokensRepository: &l.fakeRefreshTokens,
+		AccessTokenTtl:          15 * time.Minute,
+		RefreshTokenTtl:         time.Hour,
+		LoginThrottle: usecases.LoginThrottle{
+			LoginAttemptsRepository: &l.fakeLoginAttempts,
+			LoginLockoutsRepository: &l.fakeLoginLockouts,
+			AccountPolicy:           accountPolicy,
+			IpAddressPolicy:         ipAddressPolicy,
+		},
+		TotpFactorsRepository: &l.fakeTotpFactors,
+		MfaChallengeTtl:       5 * time.Minute,
+	}
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnActiveTotpFactor_ReturnsChallengeInsteadOfTokens() {
+	totpFactor, err := mfa.NewTotpFactor(uuid.Nil, l.staffUser.Id)
+	l.Require().NoError(err)
+	totpFactor.Status = "ACTIVE"
+	l.fakeTotpFactors.TotpFactors = []mfa.TotpFactor{totpFactor}
+
+	output, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+	l.Require().NoError(err)
+
+	l.NotEmpty(output.MfaChallengeToken)
+	l.WithinDuration(time.Now().Add(5*time.Minute), output.MfaChallengeExpiresAt, time.Minute)
+	l.Empty(output.AccessToken)
+	l.Empty(output.RefreshToken)
+	l.Empty(l.fakeRefreshTokens.RefreshTokens)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnHigherConfiguredBcryptCost_RehashesThePassword() {
+	passwordHasher, err := account.NewPasswordHasher("BCRYPT", 13, 19*1024, 2, 1)
+	l.Require().NoError(err)
+	l.loginStaffWithEmailAndPassword.PasswordHasher = passwordHasher
+
+	_, err = l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+	l.Require().NoError(err)
+
+	rehashedPassword := l.fakeStaffUsers.StaffUsers[0].HashedPassword
+	l.NotEqual(l.staffUser.HashedPassword, rehashedPassword)
+	l.False(passwordHasher.NeedsRehash(rehashedPassword))
+	l.True(account.PasswordMatches(rehashedPassword, "s3cret-password"))
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) parseClaims(accessToken string) jwt.MapClaims {
+	token, err := jwt.Parse(accessToken, func(token *jwt.Token) (any, error) {
+		return l.signingKey.PublicKey(), nil
+	})
+	l.Require().NoError(err)
+	l.Equal(l.signingKey.Id, token.Header["kid"])
+	return token.Claims.(jwt.MapClaims)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnCorrectEmailAndPassword_ReturnsTokenWithStoredRole() {
+	output, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "Jane.Roe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+	l.Require().NoError(err)
+
+	l.Equal(l.staffUser.Id, output.StaffUserId)
+	l.Equal("ADMIN", output.Role)
+	claims := l.parseClaims(output.AccessToken)
+	l.Equal("ADMIN", claims["role"])
+	l.Equal(l.staffUser.Id.String(), claims["staffUserId"])
+	l.Nil(claims["customerId"])
+	l.Require().Len(l.fakeRefreshTokens.RefreshTokens, 1)
+	l.Equal(l.staffUser.Id, l.fakeRefreshTokens.RefreshTokens[0].StaffUserId)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnRefresh_UsesCurrentRole() {
+	output, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+	l.Require().NoError(err)
+	l.fakeStaffUsers.StaffUsers[0].Role = "FRONT_DESK"
+	refreshSession := usecases.RefreshSession{
+		SigningKeysSource:       &auth.SigningKeysSource{SecretsGateway: &l.fakeSecretsGateway},
+		RefreshTokensRepository: &l.fakeRefreshTokens,
+		StaffUsersRepository:    &l.fakeStaffUsers,
+		AccessTokenTtl:          15 * time.Minute,
+		RefreshTokenTtl:         time.Hour,
+	}
+
+	refreshed, err := refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: output.RefreshToken})
+	l.Require().NoError(err)
+
+	l.Equal("FRONT_DESK", l.parseClaims(refreshed.AccessToken)["role"])
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnIncorrectPassword_ReturnsError() {
+	_, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "wrong-password",
+	})
+
+	l.EqualError(err, "email or password is incorrect")
+	l.Empty(l.fakeRefreshTokens.RefreshTokens)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnUnknownEmail_ReturnsError() {
+	_, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "john.doe@hotel.com",
+		PlainPassword: "s3cret-password",
+	})
+
+	l.EqualError(err, "email or password is incorrect")
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnFailuresBeyondTheFreeAttempts_ReturnsRetryAfter() {
+	input := usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "wrong-password",
+		IpAddress:     "203.0.113.7",
+	}
+
+	for range 4 {
+		_, err := l.loginStaffWithEmailAndPassword.Execute(input)
+		l.Require().EqualError(err, "email or password is incorrect")
+	}
+
+	output, err := l.loginStaffWithEmailAndPassword.Execute(input)
+
+	l.EqualError(err, "too many failed login attempts. Please try again later")
+	l.InDelta(time.Second, output.RetryAfter, float64(time.Second))
+	l.Empty(l.fakeRefreshTokens.RefreshTokens)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnReachingTheLockoutThreshold_RecordsAStaffAccountLockout() {
+	l.fakeLoginAttempts.LoginAttempts = []account.LoginAttempts{
+		{Scope: "STAFF_ACCOUNT", Subject: "jane.roe@hotel.com", Failures: 4, LastFailedAt: time.Now().Add(-time.Minute)},
+	}
+
+	_, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "Jane.Roe@hotel.com",
+		PlainPassword: "wrong-password",
+		IpAddress:     "203.0.113.7",
+	})
+	l.Require().EqualError(err, "email or password is incorrect")
+
+	l.Require().Len(l.fakeLoginLockouts.LoginLockouts, 1)
+	loginLockout := l.fakeLoginLockouts.LoginLockouts[0]
+	l.Equal("STAFF_ACCOUNT", loginLockout.Scope)
+	l.Equal("jane.roe@hotel.com", loginLockout.Subject)
+	l.WithinDuration(time.Now().Add(15*time.Minute), loginLockout.LockedUntil, time.Minute)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnCustomerFailuresForTheSameEmail_StillLogsIn() {
+	l.fakeLoginAttempts.LoginAttempts = []account.LoginAttempts{
+		{Scope: "ACCOUNT", Subject: "jane.roe@hotel.com", Failures: 5, LastFailedAt: time.Now()},
+	}
+
+	output, err := l.loginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+		IpAddress:     "203.0.113.7",
+	})
+	l.Require().NoError(err)
+
+	l.NotEmpty(output.AccessToken)
+}
+
+func (l *LoginStaffWithEmailAndPasswordSuite) TestExecute_OnCreateStaffUserWithTakenEmail_ReturnsError() {
+	createStaffUser := usecases.CreateStaffUser{
+		StaffUsersRepository: &l.fakeStaffUsers,
+		RolesRepository: &repositories.FakeRolesRepository{
+			RolePermissions: map[string][]string{"FRONT_DESK": {"bookings:read:any"}},
+		},
+	}
+
+	_, err := createStaffUser.Execute(usecases.CreateStaffUserInput{
+		Name:     "Jane Roe",
+		Email:    "JANE.ROE@hotel.com",
+		Password: "another-password",
+		Role:     "FRONT_DESK",
+	})
+
+	l.EqualError(err, "email address is already associated with another account")
+
+	_, err = createStaffUser.Execute(usecases.CreateStaffUserInput{
+		Name:     "John Roe",
+		Email:    "john.roe@hotel.com",
+		Password: "another-password",
+		Role:     "NIGHT_AUDITOR",
+	})
+
+	l.EqualError(err, "staff role does not exist")
+}
+
+func TestLoginStaffWithEmailAndPassword(t *testing.T) {
+	suite.Run(t, new(LoginStaffWithEmailAndPasswordSuite))
+}
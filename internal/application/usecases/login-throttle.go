@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+// LoginThrottle slows down and then locks out repeated failed logins, both for the account being guessed and for the
+// client address guessing, so that spreading attempts over many accounts or many addresses does not get around it.
+type LoginThrottle struct {
+	LoginAttemptsRepository repositories.ILoginAttemptsRepository
+	LoginLockoutsRepository repositories.ILoginLockoutsRepository
+	AccountPolicy           account.LoginThrottlePolicy
+	IpAddressPolicy         account.LoginThrottlePolicy
+}
+
+type loginThrottleSubject struct {
+	scope   string
+	subject string
+	policy  account.LoginThrottlePolicy
+}
+
+// retryAfter returns how long the caller has to wait before the next attempt is even checked, or zero.
+func (l *LoginThrottle) retryAfter(subjects []loginThrottleSubject, now time.Time) (time.Duration, error) {
+	var retryAfter time.Duration
+
+	for _, subject := range subjects {
+		loginAttempts, err := l.LoginAttemptsRepository.FindOne(subject.scope, subject.subject)
+		if err != nil {
+			return 0, err
+		}
+
+		if loginAttempts != nil {
+			retryAfter = max(retryAfter, subject.policy.RetryAfter(*loginAttempts, now))
+		}
+	}
+
+	return retryAfter, nil
+}
+
+// recordFailure counts the failed attempt and writes a lockout record for every subject it locks out.
+func (l *LoginThrottle) recordFailure(subjects []loginThrottleSubject, now time.Time) error {
+	for _, subject := range subjects {
+		loginAttempts, err := l.LoginAttemptsRepository.RecordFailure(subject.scope, subject.subject, now,
+			subject.policy.ResetBefore(now))
+		if err != nil {
+			return err
+		}
+
+		if !subject.policy.IsLockedOut(loginAttempts.Failures) {
+			continue
+		}
+
+		err = l.LoginLockoutsRepository.Create(account.NewLoginLockout(loginAttempts, subject.policy.LockoutDuration))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reset forgets the failures of the first subject, the account or the user, after a successful login. Failures of
+// the address are kept, otherwise logging into an account of one's own would clear the count between guesses at
+// other accounts.
+func (l *LoginThrottle) reset(subjects []loginThrottleSubject) error {
+	return l.LoginAttemptsRepository.Delete(subjects[0].scope, subjects[0].subject)
+}
+
+func (l *LoginThrottle) passwordSubjects(email string, ipAddress string) []loginThrottleSubject {
+	return l.withIpAddress(loginThrottleSubject{scope: "ACCOUNT", subject: normalizeEmail(email),
+		policy: l.AccountPolicy}, ipAddress)
+}
+
+// staffPasswordSubjects counts staff logins apart from customer logins, so a customer sharing the email of a staff
+// user cannot lock them out.
+func (l *LoginThrottle) staffPasswordSubjects(email string, ipAddress string) []loginThrottleSubject {
+	return l.withIpAddress(loginThrottleSubject{scope: "STAFF_ACCOUNT", subject: normalizeEmail(email),
+		policy: l.AccountPolicy}, ipAddress)
+}
+
+// mfaSubjects limits the guesses at the second factor of a user who already got the password right.
+func (l *LoginThrottle) mfaSubjects(userId uuid.UUID, ipAddress string) []loginThrottleSubject {
+	return l.withIpAddress(loginThrottleSubject{scope: "MFA", subject: userId.String(), policy: l.AccountPolicy},
+		ipAddress)
+}
+
+func (l *LoginThrottle) withIpAddress(subject loginThrottleSubject, ipAddress string) []loginThrottleSubject {
+	subjects := []loginThrottleSubject{subject}
+
+	if ipAddress != "" {
+		subjects = append(subjects, loginThrottleSubject{scope: "IP_ADDRESS", subject: ipAddress,
+			policy: l.IpAddressPolicy})
+	}
+
+	return subjects
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -4,27 +4,32 @@ import (
 	"errors"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
 )
 
-type JwtClaims struct {
-	CustomerId uuid.UUID `json:"customerId"`
-	Role       string    `json:"role"`
-	jwt.RegisteredClaims
-}
-
 type LoginWithEmailAndPasswordInput struct {
 	Email         string
 	PlainPassword string
+	IpAddress     string
 }
 
 type LoginWithEmailAndPasswordOutput struct {
-	CustomerId   uuid.UUID
-	CustomerName string
-	AccessToken  string
+	CustomerId           uuid.UUID
+	CustomerName         string
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+	// MfaChallengeToken replaces the tokens above when the customer has two-factor authentication enabled. It is
+	// exchanged for them by CompleteMfaLogin.
+	MfaChallengeToken     string
+	MfaChallengeExpiresAt time.Time
+	// RetryAfter is set when the attempt was refused because of too many failed logins.
+	RetryAfter time.Duration
 }
 
 type ILoginWithEmailAndPassword interface {
@@ -32,51 +37,107 @@ type ILoginWithEmailAndPassword interface {
 }
 
 type LoginWithEmailAndPassword struct {
-	SecretsGateway   gateways.ISecretsGateway
-	CustomersGateway gateways.ICustomersGateway
+	SecretsGateway          gateways.ISecretsGateway
+	SigningKeysSource       *auth.SigningKeysSource
+	CustomersGateway        gateways.ICustomersGateway
+	RefreshTokensRepository repositories.IRefreshTokensRepository
+	AccessTokenTtl          time.Duration
+	RefreshTokenTtl         time.Duration
+	LoginThrottle           LoginThrottle
+	TotpFactorsRepository   repositories.ITotpFactorsRepository
+	MfaChallengeTtl         time.Duration
+	PasswordHasher          account.PasswordHasher
 }
 
+// Execute refuses the attempt without checking the password while the account or the client address is backing off
+// or locked out. Failures count against unknown emails too, so the throttle does not reveal which emails exist. A
+// password hashed with an outdated algorithm or cost is rehashed with the configured one while it is at hand.
 func (l *LoginWithEmailAndPassword) Execute(input LoginWithEmailAndPasswordInput) (LoginWithEmailAndPasswordOutput, error) {
+	now := time.Now().UTC()
+
+	throttleSubjects := l.LoginThrottle.passwordSubjects(input.Email, input.IpAddress)
+
+	retryAfter, err := l.LoginThrottle.retryAfter(throttleSubjects, now)
+	if err != nil {
+		return LoginWithEmailAndPasswordOutput{}, err
+	}
+
+	if retryAfter > 0 {
+		return LoginWithEmailAndPasswordOutput{RetryAfter: retryAfter}, errors.New("too many failed login attempts. Please try again later")
+	}
+
 	customerDTO, err := l.CustomersGateway.FindOneByEmail(input.Email)
 	if err != nil {
 		return LoginWithEmailAndPasswordOutput{}, err
 	}
 
-	if customerDTO == nil {
+	if customerDTO == nil || !account.PasswordMatches(customerDTO.HashedPassword, input.PlainPassword) {
+		err = l.LoginThrottle.recordFailure(throttleSubjects, now)
+		if err != nil {
+			return LoginWithEmailAndPasswordOutput{}, err
+		}
+
 		return LoginWithEmailAndPasswordOutput{}, errors.New("email or password is incorrect")
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(customerDTO.HashedPassword), []byte(input.PlainPassword))
+	err = l.LoginThrottle.reset(throttleSubjects)
 	if err != nil {
-		return LoginWithEmailAndPasswordOutput{}, errors.New("email or password is incorrect")
+		return LoginWithEmailAndPasswordOutput{}, err
 	}
 
-	ONE_MONTH := time.Now().Add(30 * 24 * time.Hour)
+	if l.PasswordHasher.NeedsRehash(customerDTO.HashedPassword) {
+		hashedPassword, err := l.PasswordHasher.Hash(input.PlainPassword)
+		if err != nil {
+			return LoginWithEmailAndPasswordOutput{}, err
+		}
 
-	claims := &JwtClaims{
-		CustomerId: customerDTO.Id,
-		Role:       "CUSTOMER",
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(ONE_MONTH),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+		err = l.CustomersGateway.UpdatePassword(customerDTO.Id, hashedPassword)
+		if err != nil {
+			return LoginWithEmailAndPasswordOutput{}, err
+		}
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	totpFactor, err := l.TotpFactorsRepository.FindOneByCustomerId(customerDTO.Id)
+	if err != nil {
+		return LoginWithEmailAndPasswordOutput{}, err
+	}
+
+	if totpFactor != nil && totpFactor.IsActive() {
+		mfaChallengeExpiresAt := now.Add(l.MfaChallengeTtl)
+
+		mfaChallengeToken, err := signMfaChallengeToken(l.SecretsGateway, customerDTO.Id, uuid.Nil, mfaChallengeExpiresAt)
+		if err != nil {
+			return LoginWithEmailAndPasswordOutput{}, err
+		}
+
+		return LoginWithEmailAndPasswordOutput{
+			MfaChallengeToken:     mfaChallengeToken,
+			MfaChallengeExpiresAt: mfaChallengeExpiresAt,
+		}, nil
+	}
+
+	refreshToken, plainRefreshToken, err := session.NewRefreshToken(customerDTO.Id, l.RefreshTokenTtl)
+	if err != nil {
+		return LoginWithEmailAndPasswordOutput{}, err
+	}
 
-	jwtSigningAccessToken, err := l.SecretsGateway.Get("JWT_SIGNING_ACCESS_TOKEN")
+	err = l.RefreshTokensRepository.Create(refreshToken)
 	if err != nil {
 		return LoginWithEmailAndPasswordOutput{}, err
 	}
 
-	signedToken, err := token.SignedString([]byte(jwtSigningAccessToken))
+	accessTokenExpiresAt := time.Now().UTC().Add(l.AccessTokenTtl)
+
+	signedToken, err := signAccessToken(l.SigningKeysSource, customerDTO.Id, refreshToken.FamilyId, accessTokenExpiresAt)
 	if err != nil {
 		return LoginWithEmailAndPasswordOutput{}, err
 	}
 
 	return LoginWithEmailAndPasswordOutput{
-		CustomerId:   customerDTO.Id,
-		CustomerName: customerDTO.Name,
-		AccessToken:  signedToken,
+		CustomerId:           customerDTO.Id,
+		CustomerName:         customerDTO.Name,
+		AccessToken:          signedToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         plainRefreshToken,
 	}, nil
 }
@@ -1,12 +1,17 @@
 package usecases_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases/mocks"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/bcrypt"
@@ -21,19 +26,52 @@ func (m *SecretsGatewayMock) Get(key string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+// newSigningKeys returns a JWT_SIGNING_KEYS secret holding a single active key.
+func newSigningKeys(s *suite.Suite) (auth.SigningKey, string) {
+	signingKey, err := auth.NewSigningKey("test", "EdDSA", time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys{signingKey})
+	s.Require().NoError(err)
+	return signingKey, string(rawSigningKeys)
+}
+
 type LoginWithEmailAndPasswordSuite struct {
 	suite.Suite
 	secretsGatewayMock        SecretsGatewayMock
 	customersGatewayMock      mocks.CustomersGatewayMock
+	fakeRefreshTokens         repositories.FakeRefreshTokensRepository
+	fakeLoginAttempts         repositories.FakeLoginAttemptsRepository
+	fakeLoginLockouts         repositories.FakeLoginLockoutsRepository
+	fakeTotpFactors           repositories.FakeTotpFactorsRepository
 	loginWithEmailAndPassword usecases.LoginWithEmailAndPassword
 }
 
 func (l *LoginWithEmailAndPasswordSuite) SetupTest() {
 	l.secretsGatewayMock = SecretsGatewayMock{}
 	l.customersGatewayMock = mocks.CustomersGatewayMock{}
+	l.fakeRefreshTokens = repositories.FakeRefreshTokensRepository{}
+	l.fakeLoginAttempts = repositories.FakeLoginAttemptsRepository{}
+	l.fakeLoginLockouts = repositories.FakeLoginLockoutsRepository{}
+	l.fakeTotpFactors = repositories.FakeTotpFactorsRepository{}
+	accountPolicy, err := account.NewLoginThrottlePolicy(3, 5, time.Second, 15*time.Minute)
+	l.Require().NoError(err)
+	ipAddressPolicy, err := account.NewLoginThrottlePolicy(20, 100, time.Second, 15*time.Minute)
+	l.Require().NoError(err)
 	l.loginWithEmailAndPassword = usecases.LoginWithEmailAndPassword{
-		SecretsGateway:   &l.secretsGatewayMock,
-		CustomersGateway: &l.customersGatewayMock,
+		SecretsGateway:          &l.secretsGatewayMock,
+		SigningKeysSource:       &auth.SigningKeysSource{SecretsGateway: &l.secretsGatewayMock},
+		CustomersGateway:        &l.customersGatewayMock,
+		RefreshTokensRepository: &l.fakeRefreshTokens,
+		AccessTokenTtl:          15 * time.Minute,
+		RefreshTokenTtl:         30 * 24 * time.Hour,
+		LoginThrottle: usecases.LoginThrottle{
+			LoginAttemptsRepository: &l.fakeLoginAttempts,
+			LoginLockoutsRepository: &l.fakeLoginLockouts,
+			AccountPolicy:           accountPolicy,
+			IpAddressPolicy:         ipAddressPolicy,
+		},
+		TotpFactorsRepository: &l.fakeTotpFactors,
+		MfaChallengeTtl:       5 * time.Minute,
 	}
 }
 
@@ -48,7 +86,8 @@ func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnCorrectEmailAndPassword_R
 		Name:           customerName,
 		HashedPassword: string(hashedPassword),
 	}
-	l.secretsGatewayMock.On("Get", "JWT_SIGNING_ACCESS_TOKEN").Return("secret", nil)
+	_, rawSigningKeys := newSigningKeys(&l.Suite)
+	l.secretsGatewayMock.On("Get", "JWT_SIGNING_KEYS").Return(rawSigningKeys, nil)
 	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(&customerDTO, nil)
 	input := usecases.LoginWithEmailAndPasswordInput{
 		Email:         "john.doe@gmail.com",
@@ -61,6 +100,81 @@ func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnCorrectEmailAndPassword_R
 	l.Require().NoError(err)
 	l.Equal(customerId, output.CustomerId)
 	l.Equal(customerName, output.CustomerName)
+	l.WithinDuration(time.Now().Add(15*time.Minute), output.AccessTokenExpiresAt, time.Minute)
+	l.Require().Len(l.fakeRefreshTokens.RefreshTokens, 1)
+	l.Equal(customerId, l.fakeRefreshTokens.RefreshTokens[0].CustomerId)
+	l.NotEqual(output.RefreshToken, l.fakeRefreshTokens.RefreshTokens[0].HashedToken)
+}
+
+func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnSuccessAfterFailures_ForgetsTheAccountFailuresOnly() {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("123456"), 12)
+	l.Require().NoError(err)
+	customerDTO := gateways.CustomerDTO{Id: uuid.New(), Name: "John Doe", HashedPassword: string(hashedPassword)}
+	_, rawSigningKeys := newSigningKeys(&l.Suite)
+	l.secretsGatewayMock.On("Get", "JWT_SIGNING_KEYS").Return(rawSigningKeys, nil)
+	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(&customerDTO, nil)
+	l.fakeLoginAttempts.LoginAttempts = []account.LoginAttempts{
+		{Scope: "ACCOUNT", Subject: "john.doe@gmail.com", Failures: 2, LastFailedAt: time.Now()},
+		{Scope: "IP_ADDRESS", Subject: "203.0.113.7", Failures: 2, LastFailedAt: time.Now()},
+	}
+
+	_, err = l.loginWithEmailAndPassword.Execute(usecases.LoginWithEmailAndPasswordInput{
+		Email:         "john.doe@gmail.com",
+		PlainPassword: "123456",
+		IpAddress:     "203.0.113.7",
+	})
+	l.Require().NoError(err)
+
+	l.Require().Len(l.fakeLoginAttempts.LoginAttempts, 1)
+	l.Equal("IP_ADDRESS", l.fakeLoginAttempts.LoginAttempts[0].Scope)
+}
+
+func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnFailuresBeyondTheFreeAttempts_ReturnsRetryAfter() {
+	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(nil, nil)
+	input := usecases.LoginWithEmailAndPasswordInput{
+		Email:         "john.doe@gmail.com",
+		PlainPassword: "123456",
+		IpAddress:     "203.0.113.7",
+	}
+
+	for range 4 {
+		_, err := l.loginWithEmailAndPassword.Execute(input)
+		l.Require().EqualError(err, "email or password is incorrect")
+	}
+
+	output, err := l.loginWithEmailAndPassword.Execute(input)
+
+	l.EqualError(err, "too many failed login attempts. Please try again later")
+	l.InDelta(time.Second, output.RetryAfter, float64(time.Second))
+	l.customersGatewayMock.AssertNumberOfCalls(l.T(), "FindOneByEmail", 4)
+	l.Empty(l.fakeLoginLockouts.LoginLockouts)
+}
+
+func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnReachingTheLockoutThreshold_RecordsALockout() {
+	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(nil, nil)
+	l.fakeLoginAttempts.LoginAttempts = []account.LoginAttempts{
+		{Scope: "ACCOUNT", Subject: "john.doe@gmail.com", Failures: 4, LastFailedAt: time.Now().Add(-time.Minute)},
+	}
+	input := usecases.LoginWithEmailAndPasswordInput{
+		Email:         "john.doe@gmail.com",
+		PlainPassword: "123456",
+		IpAddress:     "203.0.113.7",
+	}
+
+	_, err := l.loginWithEmailAndPassword.Execute(input)
+	l.Require().EqualError(err, "email or password is incorrect")
+
+	l.Require().Len(l.fakeLoginLockouts.LoginLockouts, 1)
+	loginLockout := l.fakeLoginLockouts.LoginLockouts[0]
+	l.Equal("ACCOUNT", loginLockout.Scope)
+	l.Equal("john.doe@gmail.com", loginLockout.Subject)
+	l.Equal(uint32(5), loginLockout.Failures)
+	l.WithinDuration(time.Now().Add(15*time.Minute), loginLockout.LockedUntil, time.Minute)
+
+	output, err := l.loginWithEmailAndPassword.Execute(input)
+
+	l.EqualError(err, "too many failed login attempts. Please try again later")
+	l.InDelta(15*time.Minute, output.RetryAfter, float64(time.Minute))
 }
 
 func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnCorrectEmailButIncorrectPassword_ReturnsError() {
@@ -83,6 +197,46 @@ func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnCorrectEmailButIncorrectP
 	l.EqualError(err, "email or password is incorrect")
 }
 
+func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnOutdatedBcryptCost_RehashesWithTheConfiguredAlgorithm() {
+	passwordHasher, err := account.NewPasswordHasher("ARGON2ID", 12, 19*1024, 2, 1)
+	l.Require().NoError(err)
+	l.loginWithEmailAndPassword.PasswordHasher = passwordHasher
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("123456"), bcrypt.MinCost)
+	l.Require().NoError(err)
+	customerDTO := gateways.CustomerDTO{Id: uuid.New(), Name: "John Doe", HashedPassword: string(hashedPassword)}
+	_, rawSigningKeys := newSigningKeys(&l.Suite)
+	l.secretsGatewayMock.On("Get", "JWT_SIGNING_KEYS").Return(rawSigningKeys, nil)
+	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(&customerDTO, nil)
+	l.customersGatewayMock.On("UpdatePassword", customerDTO.Id, mock.MatchedBy(func(hashedPassword string) bool {
+		return !passwordHasher.NeedsRehash(hashedPassword) && account.PasswordMatches(hashedPassword, "123456")
+	})).Return(nil)
+
+	_, err = l.loginWithEmailAndPassword.Execute(usecases.LoginWithEmailAndPasswordInput{
+		Email:         "john.doe@gmail.com",
+		PlainPassword: "123456",
+	})
+	l.Require().NoError(err)
+
+	l.customersGatewayMock.AssertCalled(l.T(), "UpdatePassword", customerDTO.Id, mock.Anything)
+}
+
+func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnCurrentHash_DoesNotRehash() {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("123456"), 12)
+	l.Require().NoError(err)
+	customerDTO := gateways.CustomerDTO{Id: uuid.New(), Name: "John Doe", HashedPassword: string(hashedPassword)}
+	_, rawSigningKeys := newSigningKeys(&l.Suite)
+	l.secretsGatewayMock.On("Get", "JWT_SIGNING_KEYS").Return(rawSigningKeys, nil)
+	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(&customerDTO, nil)
+
+	_, err = l.loginWithEmailAndPassword.Execute(usecases.LoginWithEmailAndPasswordInput{
+		Email:         "john.doe@gmail.com",
+		PlainPassword: "123456",
+	})
+	l.Require().NoError(err)
+
+	l.customersGatewayMock.AssertNotCalled(l.T(), "UpdatePassword", mock.Anything, mock.Anything)
+}
+
 func (l *LoginWithEmailAndPasswordSuite) TestExecute_OnUnregisteredEmail_ReturnsError() {
 	l.customersGatewayMock.On("FindOneByEmail", "john.doe@gmail.com").Return(nil, nil)
 	input := usecases.LoginWithEmailAndPasswordInput{
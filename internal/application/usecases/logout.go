@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type LogoutInput struct {
+	SessionId uuid.UUID
+}
+
+type ILogout interface {
+	Execute(input LogoutInput) error
+}
+
+type Logout struct {
+	RefreshTokensRepository repositories.IRefreshTokensRepository
+}
+
+func (l *Logout) Execute(input LogoutInput) error {
+	return l.RefreshTokensRepository.RevokeFamily(input.SessionId)
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+)
+
+// MfaChallengeClaims name the user who got the password right and still has to enter a code. The token carries no
+// role and exactly one of the ids is set.
+type MfaChallengeClaims struct {
+	CustomerId  uuid.UUID `json:"customerId"`
+	StaffUserId uuid.UUID `json:"staffUserId"`
+	jwt.RegisteredClaims
+}
+
+func (m *MfaChallengeClaims) setRegisteredClaims(registeredClaims jwt.RegisteredClaims) {
+	m.RegisteredClaims = registeredClaims
+}
+
+func signMfaChallengeToken(secretsGateway gateways.ISecretsGateway, customerId uuid.UUID, staffUserId uuid.UUID,
+	expiresAt time.Time) (string, error) {
+	return signHmacToken(secretsGateway, "MFA_CHALLENGE", &MfaChallengeClaims{
+		CustomerId:  customerId,
+		StaffUserId: staffUserId,
+	}, expiresAt)
+}
+
+func parseMfaChallengeToken(secretsGateway gateways.ISecretsGateway, challengeToken string) (*MfaChallengeClaims, error) {
+	invalidErr := errors.New("mfa challenge is invalid or has expired")
+
+	claims, err := parseHmacToken[MfaChallengeClaims](secretsGateway, "MFA_CHALLENGE", challengeToken, invalidErr)
+	if err != nil {
+		return nil, err
+	}
+
+	if (claims.CustomerId == uuid.Nil) == (claims.StaffUserId == uuid.Nil) {
+		return nil, invalidErr
+	}
+
+	return claims, nil
+}
+
+func findTotpFactor(totpFactorsRepository repositories.ITotpFactorsRepository, customerId uuid.UUID,
+	staffUserId uuid.UUID) (*mfa.TotpFactor, error) {
+	if customerId != uuid.Nil {
+		return totpFactorsRepository.FindOneByCustomerId(customerId)
+	}
+
+	return totpFactorsRepository.FindOneByStaffUserId(staffUserId)
+}
@@ -1,6 +1,9 @@
 package mocks
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
 	"github.com/stretchr/testify/mock"
 )
@@ -24,7 +27,37 @@ func (m *CustomersGatewayMock) FindOneByEmail(email string) (*gateways.CustomerD
 	return args.Get(0).(*gateways.CustomerDTO), args.Error(1)
 }
 
+func (m *CustomersGatewayMock) FindOneById(id uuid.UUID) (*gateways.CustomerDTO, error) {
+	args := m.Called(id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*gateways.CustomerDTO), args.Error(1)
+}
+
 func (m *CustomersGatewayMock) ExistsByEmail(email string) (bool, error) {
 	args := m.Called(email)
 	return args.Bool(0), args.Error(1)
 }
+
+func (m *CustomersGatewayMock) ExistsById(id uuid.UUID) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *CustomersGatewayMock) UpdatePassword(id uuid.UUID, hashedPassword string) error {
+	args := m.Called(id, hashedPassword)
+	return args.Error(0)
+}
+
+func (m *CustomersGatewayMock) MarkEmailVerified(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *CustomersGatewayMock) UpdateVerificationEmailSentAt(id uuid.UUID, sentAt time.Time) error {
+	args := m.Called(id, sentAt)
+	return args.Error(0)
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+// OidcLoginClaims keep what the callback needs to finish a login started by StartOidcLogin. The client holds the
+// token between the redirect to the provider and the callback, so no server-side state is needed; it is HMAC-signed
+// like quote tokens and never accepted as an access token.
+type OidcLoginClaims struct {
+	Provider     string `json:"provider"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+	jwt.RegisteredClaims
+}
+
+func (o *OidcLoginClaims) setRegisteredClaims(registeredClaims jwt.RegisteredClaims) {
+	o.RegisteredClaims = registeredClaims
+}
+
+// findOidcProvider reads the OIDC_PROVIDERS secret on every call so that a provider can be
+// added or its client secret rotated without a restart.
+func findOidcProvider(secretsGateway gateways.ISecretsGateway, name string) (gateways.OidcProviderDTO, error) {
+	rawProviders, err := secretsGateway.Get("OIDC_PROVIDERS")
+	if err != nil {
+		return gateways.OidcProviderDTO{}, err
+	}
+
+	if rawProviders == "" {
+		return gateways.OidcProviderDTO{}, errors.New("identity provider not found")
+	}
+
+	var providers []gateways.OidcProviderDTO
+
+	err = json.Unmarshal([]byte(rawProviders), &providers)
+	if err != nil {
+		return gateways.OidcProviderDTO{}, err
+	}
+
+	for _, provider := range providers {
+		if provider.Name == name {
+			return provider, nil
+		}
+	}
+
+	return gateways.OidcProviderDTO{}, errors.New("identity provider not found")
+}
+
+func signOidcLoginToken(secretsGateway gateways.ISecretsGateway, claims OidcLoginClaims,
+	expiresAt time.Time) (string, error) {
+	return signHmacToken(secretsGateway, "OIDC_LOGIN", &claims, expiresAt)
+}
+
+func parseOidcLoginToken(secretsGateway gateways.ISecretsGateway, loginToken string) (*OidcLoginClaims, error) {
+	return parseHmacToken[OidcLoginClaims](secretsGateway, "OIDC_LOGIN", loginToken,
+		errors.New("oidc login is invalid or has expired"))
+}
+
+// newOidcRandomValue returns 256 random bits encoded with the characters RFC 7636 allows in a code verifier.
+func newOidcRandomValue() (string, error) {
+	random := make([]byte, 32)
+
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+func oidcCodeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
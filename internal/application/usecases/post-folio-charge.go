@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+)
+
+type PostFolioChargeInput struct {
+	BookingId   uuid.UUID
+	Category    string
+	Description string
+	Amount      uint64
+}
+
+type PostFolioChargeOutput struct {
+	ChargeId uuid.UUID
+}
+
+type IPostFolioCharge interface {
+	Execute(input PostFolioChargeInput) (PostFolioChargeOutput, error)
+}
+
+type PostFolioCharge struct {
+	BookingsRepository     repositories.IBookingsRepository
+	FolioEntriesRepository repositories.IFolioEntriesRepository
+}
+
+func (p *PostFolioCharge) Execute(input PostFolioChargeInput) (PostFolioChargeOutput, error) {
+	foundBooking, err := findBookingOpenForFolio(p.BookingsRepository, input.BookingId)
+	if err != nil {
+		return PostFolioChargeOutput{}, err
+	}
+
+	charge, err := folio.NewCharge(foundBooking.Id, input.Category, input.Description, input.Amount)
+	if err != nil {
+		return PostFolioChargeOutput{}, err
+	}
+
+	err = p.FolioEntriesRepository.Create(charge)
+	if err != nil {
+		return PostFolioChargeOutput{}, err
+	}
+
+	return PostFolioChargeOutput{
+		ChargeId: charge.Id,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/stretchr/testify/suite"
+)
+
+type PostFolioChargeSuite struct {
+	suite.Suite
+	bookingId                  uuid.UUID
+	fakeBookingsRepository     repositories.FakeBookingsRepository
+	fakeFolioEntriesRepository repositories.FakeFolioEntriesRepository
+	postFolioCharge            usecases.PostFolioCharge
+}
+
+func (p *PostFolioChargeSuite) SetupTest() {
+	p.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	p.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{{Id: p.bookingId, TotalPrice: 400, Status: "CONFIRMED"}},
+	}
+	p.fakeFolioEntriesRepository = repositories.FakeFolioEntriesRepository{}
+	p.postFolioCharge = usecases.PostFolioCharge{
+		BookingsRepository:     &p.fakeBookingsRepository,
+		FolioEntriesRepository: &p.fakeFolioEntriesRepository,
+	}
+}
+
+func (p *PostFolioChargeSuite) TestExecute_OnNoErrors_PostsCharge() {
+	output, err := p.postFolioCharge.Execute(usecases.PostFolioChargeInput{
+		BookingId:   p.bookingId,
+		Category:    "RESTAURANT",
+		Description: "Dinner for two",
+		Amount:      85,
+	})
+	p.Require().NoError(err)
+
+	p.Len(p.fakeFolioEntriesRepository.FolioEntries, 1)
+	p.Equal(output.ChargeId, p.fakeFolioEntriesRepository.FolioEntries[0].Id)
+	p.Equal("CHARGE", p.fakeFolioEntriesRepository.FolioEntries[0].Type)
+	p.Equal(uint64(85), p.fakeFolioEntriesRepository.FolioEntries[0].Amount)
+}
+
+func (p *PostFolioChargeSuite) TestExecute_OnCancelledBooking_ReturnsError() {
+	p.fakeBookingsRepository.Bookings[0].Status = "CANCELLED"
+
+	_, err := p.postFolioCharge.Execute(usecases.PostFolioChargeInput{
+		BookingId:   p.bookingId,
+		Category:    "RESTAURANT",
+		Description: "Dinner for two",
+		Amount:      85,
+	})
+
+	p.EqualError(err, "folio can only be changed while the booking is confirmed")
+	p.Empty(p.fakeFolioEntriesRepository.FolioEntries)
+}
+
+func (p *PostFolioChargeSuite) TestExecute_OnUnknownBooking_ReturnsError() {
+	_, err := p.postFolioCharge.Execute(usecases.PostFolioChargeInput{
+		BookingId:   uuid.New(),
+		Category:    "RESTAURANT",
+		Description: "Dinner for two",
+		Amount:      85,
+	})
+
+	p.EqualError(err, "booking not found")
+}
+
+func TestPostFolioCharge(t *testing.T) {
+	suite.Run(t, new(PostFolioChargeSuite))
+}
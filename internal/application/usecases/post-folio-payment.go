@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+)
+
+type PostFolioPaymentInput struct {
+	BookingId uuid.UUID
+	Method    string
+	Amount    uint64
+}
+
+type PostFolioPaymentOutput struct {
+	PaymentId uuid.UUID
+}
+
+type IPostFolioPayment interface {
+	Execute(input PostFolioPaymentInput) (PostFolioPaymentOutput, error)
+}
+
+type PostFolioPayment struct {
+	BookingsRepository     repositories.IBookingsRepository
+	FolioEntriesRepository repositories.IFolioEntriesRepository
+}
+
+func (p *PostFolioPayment) Execute(input PostFolioPaymentInput) (PostFolioPaymentOutput, error) {
+	foundBooking, err := findBookingOpenForFolio(p.BookingsRepository, input.BookingId)
+	if err != nil {
+		return PostFolioPaymentOutput{}, err
+	}
+
+	folioPayment, err := folio.NewPayment(foundBooking.Id, input.Method, input.Amount)
+	if err != nil {
+		return PostFolioPaymentOutput{}, err
+	}
+
+	err = p.FolioEntriesRepository.Create(folioPayment)
+	if err != nil {
+		return PostFolioPaymentOutput{}, err
+	}
+
+	return PostFolioPaymentOutput{
+		PaymentId: folioPayment.Id,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type PreviewPricingRuleInput struct {
+	PricingRuleId uuid.UUID
+}
+
+type PreviewPricingRuleOutputDay struct {
+	Date             time.Time
+	OccupancyPercent float64
+	BaseRate         uint64
+	CurrentPrice     uint64
+	PreviewPrice     uint64
+}
+
+type PreviewPricingRuleOutput struct {
+	RoomType string
+	Days     []PreviewPricingRuleOutputDay
+}
+
+type IPreviewPricingRule interface {
+	Execute(input PreviewPricingRuleInput) (PreviewPricingRuleOutput, error)
+}
+
+type PreviewPricingRule struct {
+	RoomsRepository        repositories.IRoomsRepository
+	BookingsRepository     repositories.IBookingsRepository
+	PricingRulesRepository repositories.IPricingRulesRepository
+}
+
+func (p *PreviewPricingRule) Execute(input PreviewPricingRuleInput) (PreviewPricingRuleOutput, error) {
+	rule, err := p.PricingRulesRepository.FindOneById(input.PricingRuleId)
+	if err != nil {
+		return PreviewPricingRuleOutput{}, err
+	}
+
+	if rule == nil {
+		return PreviewPricingRuleOutput{}, errors.New("pricing rule not found")
+	}
+
+	rooms, err := p.RoomsRepository.FindAllByType(rule.RoomType)
+	if err != nil {
+		return PreviewPricingRuleOutput{}, err
+	}
+
+	if len(rooms) == 0 {
+		return PreviewPricingRuleOutput{}, errors.New("there are no rooms of the pricing rule room type")
+	}
+
+	baseRate := rooms[0].Price
+	for _, room := range rooms {
+		baseRate = min(baseRate, room.Price)
+	}
+
+	from := time.Now().UTC().Truncate(24 * time.Hour)
+	to := from.AddDate(0, 0, 90)
+
+	currentEngine, err := loadPricingEngine(p.PricingRulesRepository, p.BookingsRepository, from, to)
+	if err != nil {
+		return PreviewPricingRuleOutput{}, err
+	}
+
+	previewEngine := pricing.PricingEngine{
+		Occupancy: currentEngine.Occupancy,
+	}
+
+	for _, strategy := range currentEngine.Strategies {
+		if enabledRule, ok := strategy.(pricing.OccupancyPricingRule); ok && enabledRule.Id == rule.Id {
+			continue
+		}
+
+		previewEngine.Strategies = append(previewEngine.Strategies, strategy)
+	}
+
+	previewEngine.Strategies = append(previewEngine.Strategies, *rule)
+
+	output := PreviewPricingRuleOutput{
+		RoomType: rule.RoomType,
+	}
+
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		output.Days = append(output.Days, PreviewPricingRuleOutputDay{
+			Date:             date,
+			OccupancyPercent: currentEngine.Occupancy.PercentOn(rule.RoomType, date),
+			BaseRate:         baseRate,
+			CurrentPrice:     currentEngine.NightlyRate(rule.RoomType, baseRate, date),
+			PreviewPrice:     previewEngine.NightlyRate(rule.RoomType, baseRate, date),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,83 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+	"github.com/stretchr/testify/suite"
+)
+
+type PreviewPricingRuleSuite struct {
+	suite.Suite
+	ruleId                     uuid.UUID
+	fakeRoomsRepository        repositories.FakeRoomsRepository
+	fakeBookingsRepository     repositories.FakeBookingsRepository
+	fakePricingRulesRepository repositories.FakePricingRulesRepository
+	previewPricingRule         usecases.PreviewPricingRule
+}
+
+func (p *PreviewPricingRuleSuite) SetupTest() {
+	p.ruleId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	p.fakeRoomsRepository = repositories.FakeRoomsRepository{
+		Rooms: []room.Room{
+			{Id: uuid.New(), Number: "101", Type: "SUITE", Capacity: 2, Price: 300},
+			{Id: uuid.New(), Number: "102", Type: "SUITE", Capacity: 2, Price: 200},
+			{Id: uuid.New(), Number: "103", Type: "SINGLE", Capacity: 1, Price: 100},
+		},
+	}
+	p.fakeBookingsRepository = repositories.FakeBookingsRepository{}
+	p.fakePricingRulesRepository = repositories.FakePricingRulesRepository{
+		Rules: []pricing.OccupancyPricingRule{
+			{Id: p.ruleId, RoomType: "SUITE", OccupancyThreshold: 80, AdjustmentPercent: 15, FloorPrice: 150, CeilingPrice: 400},
+		},
+	}
+	p.previewPricingRule = usecases.PreviewPricingRule{
+		RoomsRepository:        &p.fakeRoomsRepository,
+		BookingsRepository:     &p.fakeBookingsRepository,
+		PricingRulesRepository: &p.fakePricingRulesRepository,
+	}
+}
+
+func (p *PreviewPricingRuleSuite) TestExecute_OnDisabledRule_ReturnsNinetyDayCalendarWithRuleApplied() {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	p.fakeBookingsRepository.Occupancies = []pricing.Occupancy{
+		{RoomType: "SUITE", Date: today.AddDate(0, 0, 1), BookedRooms: 9, TotalRooms: 10},
+	}
+
+	output, err := p.previewPricingRule.Execute(usecases.PreviewPricingRuleInput{PricingRuleId: p.ruleId})
+	p.Require().NoError(err)
+
+	p.Equal("SUITE", output.RoomType)
+	p.Len(output.Days, 90)
+	p.Equal(today, output.Days[0].Date)
+	p.Equal(uint64(200), output.Days[0].BaseRate)
+	p.Equal(uint64(200), output.Days[0].CurrentPrice)
+	p.Equal(uint64(200), output.Days[0].PreviewPrice)
+	p.Equal(float64(90), output.Days[1].OccupancyPercent)
+	p.Equal(uint64(200), output.Days[1].CurrentPrice)
+	p.Equal(uint64(230), output.Days[1].PreviewPrice)
+	p.False(p.fakePricingRulesRepository.Rules[0].Enabled)
+}
+
+func (p *PreviewPricingRuleSuite) TestExecute_OnUnknownRule_ReturnsError() {
+	_, err := p.previewPricingRule.Execute(usecases.PreviewPricingRuleInput{PricingRuleId: uuid.New()})
+
+	p.EqualError(err, "pricing rule not found")
+}
+
+func (p *PreviewPricingRuleSuite) TestExecute_OnNoRoomsOfRuleType_ReturnsError() {
+	p.fakeRoomsRepository.Rooms = []room.Room{}
+
+	_, err := p.previewPricingRule.Execute(usecases.PreviewPricingRuleInput{PricingRuleId: p.ruleId})
+
+	p.EqualError(err, "there are no rooms of the pricing rule room type")
+}
+
+func TestPreviewPricingRule(t *testing.T) {
+	suite.Run(t, new(PreviewPricingRuleSuite))
+}
@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type ProcessPaymentEventInput struct {
+	Payload   []byte
+	Signature string
+}
+
+type ProcessPaymentEventOutput struct {
+	EventId string
+	Status  string
+	Reason  string
+}
+
+type IProcessPaymentEvent interface {
+	Execute(input ProcessPaymentEventInput) (ProcessPaymentEventOutput, error)
+}
+
+type ProcessPaymentEvent struct {
+	SecretsGateway          gateways.ISecretsGateway
+	PaymentsRepository      repositories.IPaymentsRepository
+	BookingsRepository      repositories.IBookingsRepository
+	PaymentEventsRepository repositories.IPaymentEventsRepository
+}
+
+type paymentEventPayload struct {
+	Id            string `json:"id"`
+	Type          string `json:"type"`
+	TransactionId string `json:"transactionId"`
+}
+
+func (p *ProcessPaymentEvent) Execute(input ProcessPaymentEventInput) (ProcessPaymentEventOutput, error) {
+	err := p.verifySignature(input.Payload, input.Signature)
+	if err != nil {
+		return ProcessPaymentEventOutput{}, err
+	}
+
+	var payload paymentEventPayload
+
+	err = json.Unmarshal(input.Payload, &payload)
+	if err != nil {
+		return ProcessPaymentEventOutput{}, errors.New("payment event payload is invalid")
+	}
+
+	paymentEvent, err := payment.NewPaymentEvent(payload.Id, payload.Type, payload.TransactionId, input.Payload)
+	if err != nil {
+		return ProcessPaymentEventOutput{}, err
+	}
+
+	changedPayment, changedBooking, err := p.apply(&paymentEvent)
+	if err != nil {
+		return ProcessPaymentEventOutput{}, err
+	}
+
+	recorded, err := p.PaymentEventsRepository.Record(paymentEvent, changedPayment, changedBooking)
+	if err != nil {
+		return ProcessPaymentEventOutput{}, err
+	}
+
+	if !recorded {
+		return ProcessPaymentEventOutput{
+			EventId: paymentEvent.Id,
+			Status:  "IGNORED",
+			Reason:  "payment event was already received",
+		}, nil
+	}
+
+	return ProcessPaymentEventOutput{
+		EventId: paymentEvent.Id,
+		Status:  paymentEvent.Status,
+		Reason:  paymentEvent.Reason,
+	}, nil
+}
+
+func (p *ProcessPaymentEvent) verifySignature(payload []byte, signature string) error {
+	webhookSecret, err := p.SecretsGateway.Get("PAYMENTS_WEBHOOK_SECRET")
+	if err != nil {
+		return err
+	}
+
+	expectedSignature, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if signature == "" || err != nil {
+		return errors.New("payment event signature is invalid")
+	}
+
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write(payload)
+
+	if !hmac.Equal(mac.Sum(nil), expectedSignature) {
+		return errors.New("payment event signature is invalid")
+	}
+
+	return nil
+}
+
+// apply works out how the event changes its payment and booking without storing anything; the changes are stored
+// together with the event, and only when the event was not received before.
+func (p *ProcessPaymentEvent) apply(paymentEvent *payment.PaymentEvent) (*payment.Payment, *booking.Booking, error) {
+	if paymentEvent.TransactionId == "" {
+		paymentEvent.Ignore("payment event has no transaction id")
+		return nil, nil, nil
+	}
+
+	eventPayment, err := p.PaymentsRepository.FindOneByTransactionId(paymentEvent.TransactionId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if eventPayment == nil {
+		paymentEvent.Ignore("no payment matches the payment event transaction id")
+		return nil, nil, nil
+	}
+
+	eventBooking, err := p.BookingsRepository.FindOneById(eventPayment.BookingId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if eventBooking == nil {
+		paymentEvent.Ignore("no booking matches the payment event")
+		return nil, nil, nil
+	}
+
+	switch paymentEvent.Type {
+	case "payment.succeeded":
+		err = eventPayment.MarkSucceeded()
+	case "payment.failed":
+		err = eventPayment.MarkFailed()
+		eventBooking.MarkPaymentFailed()
+	case "payment.disputed":
+		err = eventPayment.MarkDisputed()
+		eventBooking.MarkPaymentDisputed()
+	default:
+		paymentEvent.Ignore("payment event type is not supported")
+		return nil, nil, nil
+	}
+
+	if err != nil {
+		paymentEvent.Ignore(err.Error())
+		return nil, nil, nil
+	}
+
+	paymentEvent.MarkProcessed()
+
+	return eventPayment, eventBooking, nil
+}
@@ -0,0 +1,176 @@
+package usecases_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProcessPaymentEventSuite struct {
+	suite.Suite
+	bookingId                   uuid.UUID
+	fakeSecretsGateway          gateways.FakeSecretsGateway
+	fakePaymentsRepository      repositories.FakePaymentsRepository
+	fakeBookingsRepository      repositories.FakeBookingsRepository
+	fakePaymentEventsRepository repositories.FakePaymentEventsRepository
+	processPaymentEvent         usecases.ProcessPaymentEvent
+}
+
+func (p *ProcessPaymentEventSuite) SetupTest() {
+	p.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	p.fakeSecretsGateway = gateways.FakeSecretsGateway{
+		Secrets: map[string]string{"PAYMENTS_WEBHOOK_SECRET": "whsec_2f1c0c0d7c9b4e4f"},
+	}
+	p.fakePaymentsRepository = repositories.FakePaymentsRepository{
+		Payments: []payment.Payment{
+			{Id: uuid.New(), BookingId: p.bookingId, Amount: 150, TransactionId: "txn_1", Status: "AUTHORIZED"},
+		},
+	}
+	p.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{{Id: p.bookingId, Status: "CONFIRMED"}},
+	}
+	p.fakePaymentEventsRepository = repositories.FakePaymentEventsRepository{
+		PaymentsRepository: &p.fakePaymentsRepository,
+		BookingsRepository: &p.fakeBookingsRepository,
+	}
+	p.processPaymentEvent = usecases.ProcessPaymentEvent{
+		SecretsGateway:          &p.fakeSecretsGateway,
+		PaymentsRepository:      &p.fakePaymentsRepository,
+		BookingsRepository:      &p.fakeBookingsRepository,
+		PaymentEventsRepository: &p.fakePaymentEventsRepository,
+	}
+}
+
+func (p *ProcessPaymentEventSuite) sign(payload string) string {
+	mac := hmac.New(sha256.New, []byte("whsec_2f1c0c0d7c9b4e4f"))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p *ProcessPaymentEventSuite) execute(payload string) (usecases.ProcessPaymentEventOutput, error) {
+	return p.processPaymentEvent.Execute(usecases.ProcessPaymentEventInput{
+		Payload:   []byte(payload),
+		Signature: p.sign(payload),
+	})
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnSucceededEvent_CapturesPayment() {
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.succeeded", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("PROCESSED", output.Status)
+	p.Equal("CAPTURED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Equal("CONFIRMED", p.fakeBookingsRepository.Bookings[0].Status)
+	p.Equal("evt_1", p.fakePaymentEventsRepository.PaymentEvents[0].Id)
+	p.Equal("PROCESSED", p.fakePaymentEventsRepository.PaymentEvents[0].Status)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnFailedEvent_FailsPaymentAndCancelsBooking() {
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.failed", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("PROCESSED", output.Status)
+	p.Equal("FAILED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Equal("CANCELLED", p.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnFailedEventAfterCheckOut_KeepsBookingCheckedOut() {
+	p.fakeBookingsRepository.Bookings[0].Status = "CHECKED_OUT"
+
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.failed", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("PROCESSED", output.Status)
+	p.Equal("FAILED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Equal("CHECKED_OUT", p.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnDisputedEvent_DisputesPaymentAndBooking() {
+	p.fakePaymentsRepository.Payments[0].Status = "CAPTURED"
+
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.disputed", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("PROCESSED", output.Status)
+	p.Equal("DISPUTED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Equal("PAYMENT_DISPUTED", p.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnReplayedEvent_IgnoresIt() {
+	_, err := p.execute(`{"id": "evt_1", "type": "payment.succeeded", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+	p.fakePaymentsRepository.Payments[0].Status = "AUTHORIZED"
+
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.succeeded", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("IGNORED", output.Status)
+	p.Equal("payment event was already received", output.Reason)
+	p.Equal("AUTHORIZED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Len(p.fakePaymentEventsRepository.PaymentEvents, 1)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnUnknownEventType_IgnoresAndStoresIt() {
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.exploded", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("IGNORED", output.Status)
+	p.Equal("payment event type is not supported", output.Reason)
+	p.Equal("AUTHORIZED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Equal("IGNORED", p.fakePaymentEventsRepository.PaymentEvents[0].Status)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnUnknownTransaction_IgnoresIt() {
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.succeeded", "transactionId": "txn_unknown"}`)
+	p.Require().NoError(err)
+
+	p.Equal("IGNORED", output.Status)
+	p.Equal("no payment matches the payment event transaction id", output.Reason)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnInvalidTransition_IgnoresIt() {
+	output, err := p.execute(`{"id": "evt_1", "type": "payment.disputed", "transactionId": "txn_1"}`)
+	p.Require().NoError(err)
+
+	p.Equal("IGNORED", output.Status)
+	p.Equal("payment cannot be marked as disputed", output.Reason)
+	p.Equal("CONFIRMED", p.fakeBookingsRepository.Bookings[0].Status)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnInvalidSignature_ReturnsError() {
+	_, err := p.processPaymentEvent.Execute(usecases.ProcessPaymentEventInput{
+		Payload:   []byte(`{"id": "evt_1", "type": "payment.failed", "transactionId": "txn_1"}`),
+		Signature: p.sign(`{"id": "evt_1", "type": "payment.succeeded", "transactionId": "txn_1"}`),
+	})
+
+	p.EqualError(err, "payment event signature is invalid")
+	p.Equal("AUTHORIZED", p.fakePaymentsRepository.Payments[0].Status)
+	p.Empty(p.fakePaymentEventsRepository.PaymentEvents)
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnMissingSignature_ReturnsError() {
+	_, err := p.processPaymentEvent.Execute(usecases.ProcessPaymentEventInput{
+		Payload: []byte(`{"id": "evt_1", "type": "payment.failed", "transactionId": "txn_1"}`),
+	})
+
+	p.EqualError(err, "payment event signature is invalid")
+}
+
+func (p *ProcessPaymentEventSuite) TestExecute_OnInvalidPayload_ReturnsError() {
+	_, err := p.execute(`not json`)
+
+	p.EqualError(err, "payment event payload is invalid")
+}
+
+func TestProcessPaymentEvent(t *testing.T) {
+	suite.Run(t, new(ProcessPaymentEventSuite))
+}
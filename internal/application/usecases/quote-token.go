@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/quote"
+)
+
+type QuoteAddOnClaims struct {
+	Code     string `json:"code"`
+	Quantity uint8  `json:"quantity"`
+}
+
+type QuoteClaims struct {
+	RoomIds      []uuid.UUID        `json:"roomIds"`
+	CheckIn      string             `json:"checkIn"`
+	CheckOut     string             `json:"checkOut"`
+	Adults       uint8              `json:"adults"`
+	ChildrenAges []int              `json:"childrenAges"`
+	AddOns       []QuoteAddOnClaims `json:"addOns"`
+	Package      string             `json:"package"`
+	PromoCode    string             `json:"promoCode"`
+	Total        uint64             `json:"total"`
+	jwt.RegisteredClaims
+}
+
+func (q *QuoteClaims) setRegisteredClaims(registeredClaims jwt.RegisteredClaims) {
+	q.RegisteredClaims = registeredClaims
+}
+
+func signQuoteToken(secretsGateway gateways.ISecretsGateway, pricedQuote quote.Quote, expiresAt time.Time) (string, error) {
+	return signHmacToken(secretsGateway, "QUOTE", &QuoteClaims{
+		RoomIds:      pricedQuote.RoomIds,
+		CheckIn:      pricedQuote.CheckIn.Format(time.DateOnly),
+		CheckOut:     pricedQuote.CheckOut.Format(time.DateOnly),
+		Adults:       pricedQuote.Guests.Adults,
+		ChildrenAges: quoteChildrenAges(pricedQuote),
+		AddOns:       quoteAddOns(pricedQuote),
+		Package:      pricedQuote.Package,
+		PromoCode:    pricedQuote.PromoCode,
+		Total:        pricedQuote.Total,
+	}, expiresAt)
+}
+
+func parseQuoteToken(secretsGateway gateways.ISecretsGateway, quoteToken string) (*QuoteClaims, error) {
+	return parseHmacToken[QuoteClaims](secretsGateway, "QUOTE", quoteToken,
+		errors.New("quote has expired or is invalid. Please request a new quote"))
+}
+
+func (q *QuoteClaims) Matches(pricedQuote quote.Quote) bool {
+	if len(q.RoomIds) != len(pricedQuote.RoomIds) {
+		return false
+	}
+
+	for _, roomId := range pricedQuote.RoomIds {
+		if !slices.Contains(q.RoomIds, roomId) {
+			return false
+		}
+	}
+
+	return q.CheckIn == pricedQuote.CheckIn.Format(time.DateOnly) &&
+		q.CheckOut == pricedQuote.CheckOut.Format(time.DateOnly) &&
+		q.Adults == pricedQuote.Guests.Adults &&
+		slices.Equal(q.ChildrenAges, quoteChildrenAges(pricedQuote)) &&
+		slices.Equal(q.AddOns, quoteAddOns(pricedQuote)) &&
+		q.Package == pricedQuote.Package &&
+		q.PromoCode == pricedQuote.PromoCode
+}
+
+func quoteChildrenAges(pricedQuote quote.Quote) []int {
+	childrenAges := []int{}
+	for _, age := range pricedQuote.Guests.ChildrenAges {
+		childrenAges = append(childrenAges, int(age))
+	}
+
+	return childrenAges
+}
+
+func quoteAddOns(pricedQuote quote.Quote) []QuoteAddOnClaims {
+	addOns := []QuoteAddOnClaims{}
+	for _, addOn := range pricedQuote.AddOns {
+		addOns = append(addOns, QuoteAddOnClaims{Code: addOn.Code, Quantity: addOn.Quantity})
+	}
+
+	return addOns
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+)
+
+type RedeemGiftCardInput struct {
+	CustomerId uuid.UUID
+	Code       string
+}
+
+type RedeemGiftCardOutput struct {
+	CreditedAmount uint64
+	Balance        uint64
+}
+
+type IRedeemGiftCard interface {
+	Execute(input RedeemGiftCardInput) (RedeemGiftCardOutput, error)
+}
+
+type RedeemGiftCard struct {
+	GiftCardsRepository repositories.IGiftCardsRepository
+}
+
+func (r *RedeemGiftCard) Execute(input RedeemGiftCardInput) (RedeemGiftCardOutput, error) {
+	giftCard, err := r.GiftCardsRepository.FindOneByCode(credit.NormalizeGiftCardCode(input.Code))
+	if err != nil {
+		return RedeemGiftCardOutput{}, err
+	}
+
+	if giftCard == nil {
+		return RedeemGiftCardOutput{}, errors.New("gift card not found")
+	}
+
+	entry, err := giftCard.Redeem(input.CustomerId, time.Now().UTC())
+	if err != nil {
+		return RedeemGiftCardOutput{}, err
+	}
+
+	entry, err = r.GiftCardsRepository.Redeem(*giftCard, entry)
+	if err != nil {
+		return RedeemGiftCardOutput{}, err
+	}
+
+	return RedeemGiftCardOutput{
+		CreditedAmount: uint64(entry.Amount),
+		Balance:        entry.BalanceAfter,
+	}, nil
+}
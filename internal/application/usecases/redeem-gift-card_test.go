@@ -0,0 +1,75 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/stretchr/testify/suite"
+)
+
+type RedeemGiftCardSuite struct {
+	suite.Suite
+	customerId                  uuid.UUID
+	giftCard                    credit.GiftCard
+	fakeCreditEntriesRepository repositories.FakeCreditEntriesRepository
+	fakeGiftCardsRepository     repositories.FakeGiftCardsRepository
+	redeemGiftCard              usecases.RedeemGiftCard
+}
+
+func (r *RedeemGiftCardSuite) SetupTest() {
+	var err error
+	r.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	r.giftCard, err = credit.NewGiftCard(150, time.Now().UTC().AddDate(0, 6, 0))
+	r.Require().NoError(err)
+	r.fakeCreditEntriesRepository = repositories.FakeCreditEntriesRepository{
+		CreditEntries: []credit.CreditEntry{
+			{Id: uuid.New(), CustomerId: r.customerId, Type: "REFUND", Amount: 50, BalanceAfter: 50},
+		},
+	}
+	r.fakeGiftCardsRepository = repositories.FakeGiftCardsRepository{
+		GiftCards:               []credit.GiftCard{r.giftCard},
+		CreditEntriesRepository: &r.fakeCreditEntriesRepository,
+	}
+	r.redeemGiftCard = usecases.RedeemGiftCard{
+		GiftCardsRepository: &r.fakeGiftCardsRepository,
+	}
+}
+
+func (r *RedeemGiftCardSuite) TestExecute_OnActiveGiftCard_CreditsCustomer() {
+	output, err := r.redeemGiftCard.Execute(usecases.RedeemGiftCardInput{
+		CustomerId: r.customerId,
+		Code:       " " + r.giftCard.Code + " ",
+	})
+	r.Require().NoError(err)
+
+	r.Equal(usecases.RedeemGiftCardOutput{CreditedAmount: 150, Balance: 200}, output)
+	r.Equal("REDEEMED", r.fakeGiftCardsRepository.GiftCards[0].Status)
+	r.Equal(r.customerId, r.fakeGiftCardsRepository.GiftCards[0].RedeemedBy)
+	entry := r.fakeCreditEntriesRepository.CreditEntries[1]
+	r.Equal("GIFT_CARD", entry.Type)
+	r.Equal(r.giftCard.Id, entry.Reference)
+}
+
+func (r *RedeemGiftCardSuite) TestExecute_OnRedeemedGiftCard_ReturnsError() {
+	_, err := r.redeemGiftCard.Execute(usecases.RedeemGiftCardInput{CustomerId: r.customerId, Code: r.giftCard.Code})
+	r.Require().NoError(err)
+
+	_, err = r.redeemGiftCard.Execute(usecases.RedeemGiftCardInput{CustomerId: uuid.New(), Code: r.giftCard.Code})
+
+	r.EqualError(err, "gift card has already been redeemed")
+	r.Len(r.fakeCreditEntriesRepository.CreditEntries, 2)
+}
+
+func (r *RedeemGiftCardSuite) TestExecute_OnUnknownCode_ReturnsError() {
+	_, err := r.redeemGiftCard.Execute(usecases.RedeemGiftCardInput{CustomerId: r.customerId, Code: "AAAA-BBBB-CCCC-DDDD"})
+
+	r.EqualError(err, "gift card not found")
+}
+
+func TestRedeemGiftCard(t *testing.T) {
+	suite.Run(t, new(RedeemGiftCardSuite))
+}
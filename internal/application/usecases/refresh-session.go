@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+)
+
+type RefreshSessionInput struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+}
+
+type IRefreshSession interface {
+	Execute(input RefreshSessionInput) (RefreshSessionOutput, error)
+}
+
+type RefreshSession struct {
+	SigningKeysSource       *auth.SigningKeysSource
+	RefreshTokensRepository repositories.IRefreshTokensRepository
+	StaffUsersRepository    repositories.IStaffUsersRepository
+	AccessTokenTtl          time.Duration
+	RefreshTokenTtl         time.Duration
+}
+
+func (r *RefreshSession) Execute(input RefreshSessionInput) (RefreshSessionOutput, error) {
+	refreshToken, err := r.RefreshTokensRepository.FindOneByHashedToken(session.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	if refreshToken == nil {
+		return RefreshSessionOutput{}, errors.New("refresh token is invalid or has expired")
+	}
+
+	next, plainRefreshToken, err := refreshToken.Rotate(r.RefreshTokenTtl, time.Now().UTC())
+	if err != nil {
+		return RefreshSessionOutput{}, r.rejectReuse(*refreshToken, err)
+	}
+
+	err = r.RefreshTokensRepository.Rotate(*refreshToken, next)
+	if err != nil {
+		return RefreshSessionOutput{}, r.rejectReuse(*refreshToken, err)
+	}
+
+	accessTokenExpiresAt := time.Now().UTC().Add(r.AccessTokenTtl)
+
+	signedToken, err := r.signAccessToken(next, accessTokenExpiresAt)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	return RefreshSessionOutput{
+		AccessToken:          signedToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         plainRefreshToken,
+	}, nil
+}
+
+func (r *RefreshSession) signAccessToken(refreshToken session.RefreshToken, expiresAt time.Time) (string, error) {
+	if !refreshToken.IsStaff() {
+		return signAccessToken(r.SigningKeysSource, refreshToken.CustomerId, refreshToken.FamilyId, expiresAt)
+	}
+
+	staffUser, err := r.StaffUsersRepository.FindOneById(refreshToken.StaffUserId)
+	if err != nil {
+		return "", err
+	}
+
+	if staffUser == nil {
+		return "", errors.New("refresh token is invalid or has expired")
+	}
+
+	return signStaffAccessToken(r.SigningKeysSource, *staffUser, refreshToken.FamilyId, expiresAt)
+}
+
+// rejectReuse revokes the whole family when a rotated or revoked token is presented again, since either the
+// client or an attacker holds a stolen copy and there is no way to tell which one.
+func (r *RefreshSession) rejectReuse(refreshToken session.RefreshToken, err error) error {
+	if err.Error() != "refresh token has already been used" {
+		return err
+	}
+
+	revokeErr := r.RefreshTokensRepository.RevokeFamily(refreshToken.FamilyId)
+	if revokeErr != nil {
+		return revokeErr
+	}
+
+	return errors.New("refresh token has been revoked")
+}
@@ -0,0 +1,97 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshSessionSuite struct {
+	suite.Suite
+	customerId        uuid.UUID
+	refreshToken      session.RefreshToken
+	plainRefreshToken string
+	fakeRefreshTokens repositories.FakeRefreshTokensRepository
+	refreshSession    usecases.RefreshSession
+}
+
+func (r *RefreshSessionSuite) SetupTest() {
+	var err error
+	r.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	r.refreshToken, r.plainRefreshToken, err = session.NewRefreshToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+	r.fakeRefreshTokens = repositories.FakeRefreshTokensRepository{
+		RefreshTokens: []session.RefreshToken{r.refreshToken},
+	}
+	_, rawSigningKeys := newSigningKeys(&r.Suite)
+	r.refreshSession = usecases.RefreshSession{
+		SigningKeysSource: &auth.SigningKeysSource{
+			SecretsGateway: &gateways.FakeSecretsGateway{
+				Secrets: map[string]string{"JWT_SIGNING_KEYS": rawSigningKeys},
+			},
+		},
+		RefreshTokensRepository: &r.fakeRefreshTokens,
+		AccessTokenTtl:          15 * time.Minute,
+		RefreshTokenTtl:         time.Hour,
+	}
+}
+
+func (r *RefreshSessionSuite) TestExecute_OnActiveToken_RotatesToken() {
+	output, err := r.refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: r.plainRefreshToken})
+	r.Require().NoError(err)
+
+	r.NotEmpty(output.AccessToken)
+	r.NotEqual(r.plainRefreshToken, output.RefreshToken)
+	r.Require().Len(r.fakeRefreshTokens.RefreshTokens, 2)
+	r.Equal("ROTATED", r.fakeRefreshTokens.RefreshTokens[0].Status)
+	r.Equal("ACTIVE", r.fakeRefreshTokens.RefreshTokens[1].Status)
+	r.Equal(r.refreshToken.FamilyId, r.fakeRefreshTokens.RefreshTokens[1].FamilyId)
+	r.Equal(session.HashRefreshToken(output.RefreshToken), r.fakeRefreshTokens.RefreshTokens[1].HashedToken)
+}
+
+func (r *RefreshSessionSuite) TestExecute_OnReusedToken_RevokesTheWholeFamily() {
+	_, err := r.refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: r.plainRefreshToken})
+	r.Require().NoError(err)
+
+	_, err = r.refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: r.plainRefreshToken})
+
+	r.EqualError(err, "refresh token has been revoked")
+	r.Equal("ROTATED", r.fakeRefreshTokens.RefreshTokens[0].Status)
+	r.Equal("REVOKED", r.fakeRefreshTokens.RefreshTokens[1].Status)
+}
+
+func (r *RefreshSessionSuite) TestExecute_OnUnknownToken_ReturnsError() {
+	_, err := r.refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: "unknown"})
+
+	r.EqualError(err, "refresh token is invalid or has expired")
+}
+
+func (r *RefreshSessionSuite) TestExecute_OnExpiredToken_ReturnsError() {
+	r.fakeRefreshTokens.RefreshTokens[0].ExpiresAt = time.Now().UTC().Add(-time.Minute)
+
+	_, err := r.refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: r.plainRefreshToken})
+
+	r.EqualError(err, "refresh token is invalid or has expired")
+	r.Equal("ACTIVE", r.fakeRefreshTokens.RefreshTokens[0].Status)
+}
+
+func (r *RefreshSessionSuite) TestExecute_OnLogout_RevokesSession() {
+	logout := usecases.Logout{RefreshTokensRepository: &r.fakeRefreshTokens}
+
+	err := logout.Execute(usecases.LogoutInput{SessionId: r.refreshToken.FamilyId})
+	r.Require().NoError(err)
+
+	_, err = r.refreshSession.Execute(usecases.RefreshSessionInput{RefreshToken: r.plainRefreshToken})
+	r.EqualError(err, "refresh token has been revoked")
+}
+
+func TestRefreshSession(t *testing.T) {
+	suite.Run(t, new(RefreshSessionSuite))
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type RequestPasswordResetInput struct {
+	Email string
+}
+
+type IRequestPasswordReset interface {
+	Execute(input RequestPasswordResetInput) error
+}
+
+type RequestPasswordReset struct {
+	CustomersGateway              gateways.ICustomersGateway
+	PasswordResetTokensRepository repositories.IPasswordResetTokensRepository
+	EmailGateway                  gateways.IEmailGateway
+	TokenTtl                      time.Duration
+	ResetUrl                      string
+}
+
+// Execute emails a reset link when the email belongs to a customer and silently does nothing otherwise, so the
+// response does not tell which emails are registered.
+func (r *RequestPasswordReset) Execute(input RequestPasswordResetInput) error {
+	customerDTO, err := r.CustomersGateway.FindOneByEmail(input.Email)
+	if err != nil {
+		return err
+	}
+
+	if customerDTO == nil {
+		return nil
+	}
+
+	passwordResetToken, plainToken, err := account.NewPasswordResetToken(customerDTO.Id, r.TokenTtl)
+	if err != nil {
+		return err
+	}
+
+	err = r.PasswordResetTokensRepository.Create(passwordResetToken)
+	if err != nil {
+		return err
+	}
+
+	return r.EmailGateway.Send(gateways.EmailDTO{
+		To:      input.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %d minutes and "+
+			"can only be used once.\n\n%s?token=%s\n\nIf you did not ask to reset your password, you can ignore this "+
+			"email.", customerDTO.Name, int(r.TokenTtl.Minutes()), r.ResetUrl, url.QueryEscape(plainToken)),
+	})
+}
@@ -0,0 +1,71 @@
+package usecases_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/stretchr/testify/suite"
+)
+
+type RequestPasswordResetSuite struct {
+	suite.Suite
+	customerId              uuid.UUID
+	fakeCustomersGateway    gateways.FakeCustomersGateway
+	fakePasswordResetTokens repositories.FakePasswordResetTokensRepository
+	fakeEmailGateway        gateways.FakeEmailGateway
+	requestPasswordReset    usecases.RequestPasswordReset
+}
+
+func (r *RequestPasswordResetSuite) SetupTest() {
+	r.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	r.fakeCustomersGateway = gateways.FakeCustomersGateway{
+		CustomersDTO: []gateways.CustomerDTO{{Id: r.customerId, Name: "John Doe", Email: "john.doe@gmail.com"}},
+	}
+	r.fakePasswordResetTokens = repositories.FakePasswordResetTokensRepository{}
+	r.fakeEmailGateway = gateways.FakeEmailGateway{}
+	r.requestPasswordReset = usecases.RequestPasswordReset{
+		CustomersGateway:              &r.fakeCustomersGateway,
+		PasswordResetTokensRepository: &r.fakePasswordResetTokens,
+		EmailGateway:                  &r.fakeEmailGateway,
+		TokenTtl:                      30 * time.Minute,
+		ResetUrl:                      "https://hotel.com/reset-password",
+	}
+}
+
+func (r *RequestPasswordResetSuite) TestExecute_OnRegisteredEmail_EmailsASingleUseLink() {
+	err := r.requestPasswordReset.Execute(usecases.RequestPasswordResetInput{Email: "john.doe@gmail.com"})
+	r.Require().NoError(err)
+
+	r.Require().Len(r.fakePasswordResetTokens.PasswordResetTokens, 1)
+	passwordResetToken := r.fakePasswordResetTokens.PasswordResetTokens[0]
+	r.Equal(r.customerId, passwordResetToken.CustomerId)
+	r.Equal("ACTIVE", passwordResetToken.Status)
+	r.WithinDuration(time.Now().Add(30*time.Minute), passwordResetToken.ExpiresAt, time.Minute)
+
+	r.Require().Len(r.fakeEmailGateway.SentEmails, 1)
+	sentEmail := r.fakeEmailGateway.SentEmails[0]
+	r.Equal("john.doe@gmail.com", sentEmail.To)
+	r.Equal("Reset your password", sentEmail.Subject)
+	_, link, found := strings.Cut(sentEmail.Body, "https://hotel.com/reset-password?token=")
+	r.Require().True(found)
+	plainToken, _, _ := strings.Cut(link, "\n")
+	r.Equal(account.HashToken(plainToken), passwordResetToken.HashedToken)
+}
+
+func (r *RequestPasswordResetSuite) TestExecute_OnUnregisteredEmail_DoesNothing() {
+	err := r.requestPasswordReset.Execute(usecases.RequestPasswordResetInput{Email: "jane.doe@gmail.com"})
+	r.Require().NoError(err)
+
+	r.Empty(r.fakePasswordResetTokens.PasswordResetTokens)
+	r.Empty(r.fakeEmailGateway.SentEmails)
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	suite.Run(t, new(RequestPasswordResetSuite))
+}
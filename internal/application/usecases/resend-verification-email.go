@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+type ResendVerificationEmailInput struct {
+	CustomerId uuid.UUID
+}
+
+type ResendVerificationEmailOutput struct {
+	RetryAfter time.Duration
+}
+
+type IResendVerificationEmail interface {
+	Execute(input ResendVerificationEmailInput) (ResendVerificationEmailOutput, error)
+}
+
+type ResendVerificationEmail struct {
+	SecretsGateway   gateways.ISecretsGateway
+	CustomersGateway gateways.ICustomersGateway
+	EmailGateway     gateways.IEmailGateway
+	TokenTtl         time.Duration
+	VerificationUrl  string
+	ResendInterval   time.Duration
+}
+
+// Execute sends a new verification link at most once per ResendInterval. When called too early, the output tells
+// how long the customer has to wait.
+func (r *ResendVerificationEmail) Execute(input ResendVerificationEmailInput) (ResendVerificationEmailOutput, error) {
+	customerDTO, err := r.CustomersGateway.FindOneById(input.CustomerId)
+	if err != nil {
+		return ResendVerificationEmailOutput{}, err
+	}
+
+	if customerDTO == nil {
+		return ResendVerificationEmailOutput{}, errors.New("customer not found")
+	}
+
+	if customerDTO.Status == "VERIFIED" {
+		return ResendVerificationEmailOutput{}, errors.New("email is already verified")
+	}
+
+	now := time.Now()
+
+	if !customerDTO.VerificationEmailSentAt.IsZero() {
+		nextAllowedAt := customerDTO.VerificationEmailSentAt.Add(r.ResendInterval)
+
+		if now.Before(nextAllowedAt) {
+			return ResendVerificationEmailOutput{RetryAfter: nextAllowedAt.Sub(now)},
+				errors.New("verification email was sent recently. Please try again later")
+		}
+	}
+
+	err = sendVerificationEmail(r.SecretsGateway, r.EmailGateway, r.CustomersGateway, *customerDTO, r.TokenTtl,
+		r.VerificationUrl)
+	if err != nil {
+		return ResendVerificationEmailOutput{}, err
+	}
+
+	return ResendVerificationEmailOutput{}, nil
+}
@@ -0,0 +1,91 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResendVerificationEmailSuite struct {
+	suite.Suite
+	customerId              uuid.UUID
+	fakeCustomersGateway    gateways.FakeCustomersGateway
+	fakeEmailGateway        gateways.FakeEmailGateway
+	resendVerificationEmail usecases.ResendVerificationEmail
+}
+
+func (r *ResendVerificationEmailSuite) SetupTest() {
+	r.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	r.fakeCustomersGateway = gateways.FakeCustomersGateway{
+		CustomersDTO: []gateways.CustomerDTO{
+			{
+				Id:                      r.customerId,
+				Name:                    "John Doe",
+				Email:                   "john.doe@gmail.com",
+				Status:                  "UNVERIFIED",
+				VerificationEmailSentAt: time.Now().Add(-time.Hour),
+			},
+		},
+	}
+	r.fakeEmailGateway = gateways.FakeEmailGateway{}
+	r.resendVerificationEmail = usecases.ResendVerificationEmail{
+		SecretsGateway: &gateways.FakeSecretsGateway{
+			Secrets: map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"},
+		},
+		CustomersGateway: &r.fakeCustomersGateway,
+		EmailGateway:     &r.fakeEmailGateway,
+		TokenTtl:         48 * time.Hour,
+		VerificationUrl:  "https://hotel.com/verify-email",
+		ResendInterval:   5 * time.Minute,
+	}
+}
+
+func (r *ResendVerificationEmailSuite) TestExecute_OnUnverifiedEmail_SendsANewLink() {
+	_, err := r.resendVerificationEmail.Execute(usecases.ResendVerificationEmailInput{CustomerId: r.customerId})
+	r.Require().NoError(err)
+
+	r.Require().Len(r.fakeEmailGateway.SentEmails, 1)
+	r.Equal("john.doe@gmail.com", r.fakeEmailGateway.SentEmails[0].To)
+	r.WithinDuration(time.Now(), r.fakeCustomersGateway.CustomersDTO[0].VerificationEmailSentAt, time.Minute)
+}
+
+func (r *ResendVerificationEmailSuite) TestExecute_OnEmailSentWithinTheInterval_ReturnsRetryAfter() {
+	r.fakeCustomersGateway.CustomersDTO[0].VerificationEmailSentAt = time.Now().Add(-time.Minute)
+
+	output, err := r.resendVerificationEmail.Execute(usecases.ResendVerificationEmailInput{CustomerId: r.customerId})
+
+	r.EqualError(err, "verification email was sent recently. Please try again later")
+	r.InDelta(4*time.Minute, output.RetryAfter, float64(5*time.Second))
+	r.Empty(r.fakeEmailGateway.SentEmails)
+}
+
+func (r *ResendVerificationEmailSuite) TestExecute_OnEmailFailure_KeepsTheCustomerAbleToResend() {
+	r.fakeEmailGateway.SendErr = errors.New("smtp server unavailable")
+
+	_, err := r.resendVerificationEmail.Execute(usecases.ResendVerificationEmailInput{CustomerId: r.customerId})
+
+	r.EqualError(err, "smtp server unavailable")
+	r.fakeEmailGateway.SendErr = nil
+
+	_, err = r.resendVerificationEmail.Execute(usecases.ResendVerificationEmailInput{CustomerId: r.customerId})
+	r.Require().NoError(err)
+	r.Len(r.fakeEmailGateway.SentEmails, 1)
+}
+
+func (r *ResendVerificationEmailSuite) TestExecute_OnVerifiedEmail_ReturnsError() {
+	r.fakeCustomersGateway.CustomersDTO[0].Status = "VERIFIED"
+
+	_, err := r.resendVerificationEmail.Execute(usecases.ResendVerificationEmailInput{CustomerId: r.customerId})
+
+	r.EqualError(err, "email is already verified")
+	r.Empty(r.fakeEmailGateway.SentEmails)
+}
+
+func TestResendVerificationEmail(t *testing.T) {
+	suite.Run(t, new(ResendVerificationEmailSuite))
+}
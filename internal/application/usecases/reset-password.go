@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+type IResetPassword interface {
+	Execute(input ResetPasswordInput) error
+}
+
+type ResetPassword struct {
+	CustomersGateway              gateways.ICustomersGateway
+	PasswordResetTokensRepository repositories.IPasswordResetTokensRepository
+	RefreshTokensRepository       repositories.IRefreshTokensRepository
+	PasswordPolicy                account.PasswordPolicy
+	PasswordHasher                account.PasswordHasher
+}
+
+// Execute sets the new password and revokes every session of the customer, so whoever knew the old password is
+// logged out once their current access token expires.
+func (r *ResetPassword) Execute(input ResetPasswordInput) error {
+	err := r.PasswordPolicy.Validate(input.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	passwordResetToken, err := r.PasswordResetTokensRepository.FindOneByHashedToken(account.HashToken(input.Token))
+	if err != nil {
+		return err
+	}
+
+	if passwordResetToken == nil {
+		return errors.New("password reset token is invalid or has expired")
+	}
+
+	err = passwordResetToken.Use(time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	err = r.PasswordResetTokensRepository.Use(*passwordResetToken)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := r.PasswordHasher.Hash(input.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	err = r.CustomersGateway.UpdatePassword(passwordResetToken.CustomerId, hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	return r.RefreshTokensRepository.RevokeAllByCustomerId(passwordResetToken.CustomerId)
+}
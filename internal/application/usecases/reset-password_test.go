@@ -0,0 +1,99 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ResetPasswordSuite struct {
+	suite.Suite
+	customerId              uuid.UUID
+	plainToken              string
+	fakeCustomersGateway    gateways.FakeCustomersGateway
+	fakePasswordResetTokens repositories.FakePasswordResetTokensRepository
+	fakeRefreshTokens       repositories.FakeRefreshTokensRepository
+	resetPassword           usecases.ResetPassword
+}
+
+func (r *ResetPasswordSuite) SetupTest() {
+	r.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	passwordPolicy, err := account.NewPasswordPolicy(6, 0, nil)
+	r.Require().NoError(err)
+	passwordResetToken, plainToken, err := account.NewPasswordResetToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+	r.plainToken = plainToken
+	refreshToken, _, err := session.NewRefreshToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+	otherRefreshToken, _, err := session.NewRefreshToken(uuid.New(), time.Hour)
+	r.Require().NoError(err)
+	r.fakeCustomersGateway = gateways.FakeCustomersGateway{
+		CustomersDTO: []gateways.CustomerDTO{{Id: r.customerId, Email: "john.doe@gmail.com", HashedPassword: "old"}},
+	}
+	r.fakePasswordResetTokens = repositories.FakePasswordResetTokensRepository{
+		PasswordResetTokens: []account.PasswordResetToken{passwordResetToken},
+	}
+	r.fakeRefreshTokens = repositories.FakeRefreshTokensRepository{
+		RefreshTokens: []session.RefreshToken{refreshToken, otherRefreshToken},
+	}
+	r.resetPassword = usecases.ResetPassword{
+		CustomersGateway:              &r.fakeCustomersGateway,
+		PasswordResetTokensRepository: &r.fakePasswordResetTokens,
+		RefreshTokensRepository:       &r.fakeRefreshTokens,
+		PasswordPolicy:                passwordPolicy,
+	}
+}
+
+func (r *ResetPasswordSuite) TestExecute_OnValidToken_SetsPasswordAndRevokesSessions() {
+	err := r.resetPassword.Execute(usecases.ResetPasswordInput{Token: r.plainToken, NewPassword: "new-password"})
+	r.Require().NoError(err)
+
+	hashedPassword := r.fakeCustomersGateway.CustomersDTO[0].HashedPassword
+	r.NoError(bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte("new-password")))
+	r.Equal("USED", r.fakePasswordResetTokens.PasswordResetTokens[0].Status)
+	r.Equal("REVOKED", r.fakeRefreshTokens.RefreshTokens[0].Status)
+	r.Equal("ACTIVE", r.fakeRefreshTokens.RefreshTokens[1].Status)
+}
+
+func (r *ResetPasswordSuite) TestExecute_OnTokenUsedTwice_ReturnsError() {
+	err := r.resetPassword.Execute(usecases.ResetPasswordInput{Token: r.plainToken, NewPassword: "new-password"})
+	r.Require().NoError(err)
+
+	err = r.resetPassword.Execute(usecases.ResetPasswordInput{Token: r.plainToken, NewPassword: "other-password"})
+
+	r.EqualError(err, "password reset token is invalid or has expired")
+}
+
+func (r *ResetPasswordSuite) TestExecute_OnExpiredToken_ReturnsError() {
+	r.fakePasswordResetTokens.PasswordResetTokens[0].ExpiresAt = time.Now().Add(-time.Minute)
+
+	err := r.resetPassword.Execute(usecases.ResetPasswordInput{Token: r.plainToken, NewPassword: "new-password"})
+
+	r.EqualError(err, "password reset token is invalid or has expired")
+	r.Equal("old", r.fakeCustomersGateway.CustomersDTO[0].HashedPassword)
+}
+
+func (r *ResetPasswordSuite) TestExecute_OnUnknownToken_ReturnsError() {
+	err := r.resetPassword.Execute(usecases.ResetPasswordInput{Token: "abc", NewPassword: "new-password"})
+
+	r.EqualError(err, "password reset token is invalid or has expired")
+}
+
+func (r *ResetPasswordSuite) TestExecute_OnShortPassword_ReturnsError() {
+	err := r.resetPassword.Execute(usecases.ResetPasswordInput{Token: r.plainToken, NewPassword: "12345"})
+
+	r.EqualError(err, "password must be at least 6 characters long")
+	r.Equal("ACTIVE", r.fakePasswordResetTokens.PasswordResetTokens[0].Status)
+}
+
+func TestResetPassword(t *testing.T) {
+	suite.Run(t, new(ResetPasswordSuite))
+}
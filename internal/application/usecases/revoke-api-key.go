@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type RevokeApiKeyInput struct {
+	ApiKeyId uuid.UUID
+}
+
+type IRevokeApiKey interface {
+	Execute(input RevokeApiKeyInput) error
+}
+
+type RevokeApiKey struct {
+	ApiKeysRepository repositories.IApiKeysRepository
+}
+
+// Execute takes effect on the next request made with the key, since keys are looked up on every request.
+func (r *RevokeApiKey) Execute(input RevokeApiKeyInput) error {
+	apiKey, err := r.ApiKeysRepository.FindOneById(input.ApiKeyId)
+	if err != nil {
+		return err
+	}
+
+	if apiKey == nil {
+		return errors.New("api key not found")
+	}
+
+	err = apiKey.Revoke(time.Now())
+	if err != nil {
+		return err
+	}
+
+	return r.ApiKeysRepository.Revoke(*apiKey)
+}
@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type scheduledChargeSettlement struct {
+	paymentsGateway            gateways.IPaymentsGateway
+	scheduledChargesRepository repositories.IScheduledChargesRepository
+	refundsRepository          repositories.IRefundsRepository
+}
+
+// settle refunds every paid scheduled charge of a booking except the part retainedAmount keeps of what was paid for it,
+// the same way depositSettlement settles a captured deposit.
+func (s scheduledChargeSettlement) settle(bookingId uuid.UUID, retainedAmount func(paidAmount uint64) uint64,
+	refundType string) (depositSettlementResult, error) {
+	scheduledCharges, err := s.scheduledChargesRepository.FindAllByBookingId(bookingId)
+	if err != nil {
+		return depositSettlementResult{}, err
+	}
+
+	refunds, err := s.refundsRepository.FindAllByBookingId(bookingId)
+	if err != nil {
+		return depositSettlementResult{}, err
+	}
+
+	result := depositSettlementResult{}
+
+	for _, scheduledCharge := range scheduledCharges {
+		var refundedAmount uint64
+		for _, refund := range refunds {
+			if refund.ScheduledChargeId == scheduledCharge.Id && refund.CountsTowardsRefundedAmount() {
+				refundedAmount += refund.Amount
+			}
+		}
+
+		refundableAmount := scheduledCharge.RefundableAmount(refundedAmount)
+		keptAmount := retainedAmount(scheduledCharge.Amount)
+		if refundableAmount <= keptAmount {
+			continue
+		}
+
+		refund, err := s.refund(scheduledCharge, refundableAmount-keptAmount, refundType)
+		if err != nil {
+			return depositSettlementResult{}, err
+		}
+
+		result = result.combine(depositSettlementResult{RefundAmount: refund.Amount, RefundStatus: refund.Status})
+	}
+
+	return result, nil
+}
+
+func (s scheduledChargeSettlement) refund(scheduledCharge payment.ScheduledCharge, amount uint64,
+	refundType string) (payment.Refund, error) {
+	refund, err := payment.NewScheduledChargeRefund(scheduledCharge.BookingId, scheduledCharge.Id, amount, refundType)
+	if err != nil {
+		return payment.Refund{}, err
+	}
+
+	err = s.refundsRepository.Reserve(refund)
+	if err != nil {
+		return payment.Refund{}, err
+	}
+
+	result, err := s.paymentsGateway.Refund(scheduledCharge.TransactionId, amount)
+	if err != nil {
+		refund.RecordFailure(err.Error())
+	} else {
+		refund.RecordResult(result.TransactionId, result.Approved, result.DeclineReason)
+	}
+
+	return refund, s.refundsRepository.Update(refund)
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+)
+
+type SetExtraGuestRateInput struct {
+	RoomType        string
+	BaseOccupancy   uint8
+	ExtraAdultPrice uint64
+	ExtraChildPrice uint64
+	ChildMaxAge     uint8
+}
+
+type ISetExtraGuestRate interface {
+	Execute(input SetExtraGuestRateInput) error
+}
+
+type SetExtraGuestRate struct {
+	ExtraGuestRatesRepository repositories.IExtraGuestRatesRepository
+}
+
+func (s *SetExtraGuestRate) Execute(input SetExtraGuestRateInput) error {
+	rate, err := pricing.NewExtraGuestRate(input.RoomType, input.BaseOccupancy, input.ExtraAdultPrice, input.ExtraChildPrice,
+		input.ChildMaxAge)
+	if err != nil {
+		return err
+	}
+
+	return s.ExtraGuestRatesRepository.Save(rate)
+}
@@ -0,0 +1,59 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/stretchr/testify/suite"
+)
+
+type SetExtraGuestRateSuite struct {
+	suite.Suite
+	fakeExtraGuestRatesRepository repositories.FakeExtraGuestRatesRepository
+	setExtraGuestRate             usecases.SetExtraGuestRate
+}
+
+func (s *SetExtraGuestRateSuite) SetupTest() {
+	s.fakeExtraGuestRatesRepository = repositories.FakeExtraGuestRatesRepository{
+		Rates: []pricing.ExtraGuestRate{
+			{RoomType: "SUITE", BaseOccupancy: 2, ExtraAdultPrice: 40, ExtraChildPrice: 20, ChildMaxAge: 11},
+		},
+	}
+	s.setExtraGuestRate = usecases.SetExtraGuestRate{
+		ExtraGuestRatesRepository: &s.fakeExtraGuestRatesRepository,
+	}
+}
+
+func (s *SetExtraGuestRateSuite) TestExecute_OnExistingRoomType_ReplacesRate() {
+	err := s.setExtraGuestRate.Execute(usecases.SetExtraGuestRateInput{
+		RoomType:        "SUITE",
+		BaseOccupancy:   3,
+		ExtraAdultPrice: 50,
+		ExtraChildPrice: 25,
+		ChildMaxAge:     12,
+	})
+	s.Require().NoError(err)
+
+	s.Equal([]pricing.ExtraGuestRate{
+		{RoomType: "SUITE", BaseOccupancy: 3, ExtraAdultPrice: 50, ExtraChildPrice: 25, ChildMaxAge: 12},
+	}, s.fakeExtraGuestRatesRepository.Rates)
+}
+
+func (s *SetExtraGuestRateSuite) TestExecute_OnInvalidBaseOccupancy_ReturnsError() {
+	err := s.setExtraGuestRate.Execute(usecases.SetExtraGuestRateInput{
+		RoomType:        "DOUBLE",
+		BaseOccupancy:   0,
+		ExtraAdultPrice: 50,
+		ExtraChildPrice: 25,
+		ChildMaxAge:     12,
+	})
+
+	s.EqualError(err, "base occupancy must be at least one")
+	s.Len(s.fakeExtraGuestRatesRepository.Rates, 1)
+}
+
+func TestSetExtraGuestRate(t *testing.T) {
+	suite.Run(t, new(SetExtraGuestRateSuite))
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+)
+
+type SetStayRestrictionsInput struct {
+	RoomType          string
+	From              time.Time
+	To                time.Time
+	MinStayNights     uint8
+	ClosedToArrival   bool
+	ClosedToDeparture bool
+}
+
+type ISetStayRestrictions interface {
+	Execute(input SetStayRestrictionsInput) error
+}
+
+type SetStayRestrictions struct {
+	CalendarRepository repositories.ICalendarRepository
+}
+
+func (s *SetStayRestrictions) Execute(input SetStayRestrictionsInput) error {
+	restrictions, err := calendar.NewStayRestrictions(input.RoomType, input.From, input.To, input.MinStayNights,
+		input.ClosedToArrival, input.ClosedToDeparture)
+	if err != nil {
+		return err
+	}
+
+	return s.CalendarRepository.SaveRestrictions(restrictions)
+}
@@ -0,0 +1,66 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+	"github.com/stretchr/testify/suite"
+)
+
+type SetStayRestrictionsSuite struct {
+	suite.Suite
+	from                   time.Time
+	fakeCalendarRepository repositories.FakeCalendarRepository
+	setStayRestrictions    usecases.SetStayRestrictions
+}
+
+func (s *SetStayRestrictionsSuite) SetupTest() {
+	s.from = time.Date(2030, 12, 24, 0, 0, 0, 0, time.UTC)
+	s.fakeCalendarRepository = repositories.FakeCalendarRepository{
+		Restrictions: []calendar.StayRestriction{
+			{RoomType: "SUITE", Date: s.from, MinStayNights: 1},
+		},
+	}
+	s.setStayRestrictions = usecases.SetStayRestrictions{
+		CalendarRepository: &s.fakeCalendarRepository,
+	}
+}
+
+func (s *SetStayRestrictionsSuite) TestExecute_OnNoErrors_SavesOneRestrictionPerDate() {
+	err := s.setStayRestrictions.Execute(usecases.SetStayRestrictionsInput{
+		RoomType:          "SUITE",
+		From:              s.from,
+		To:                s.from.AddDate(0, 0, 2),
+		MinStayNights:     3,
+		ClosedToArrival:   false,
+		ClosedToDeparture: true,
+	})
+	s.Require().NoError(err)
+
+	s.Len(s.fakeCalendarRepository.Restrictions, 3)
+	s.Equal(calendar.StayRestriction{
+		RoomType:          "SUITE",
+		Date:              s.from,
+		MinStayNights:     3,
+		ClosedToDeparture: true,
+	}, s.fakeCalendarRepository.Restrictions[0])
+}
+
+func (s *SetStayRestrictionsSuite) TestExecute_OnToBeforeFrom_ReturnsError() {
+	err := s.setStayRestrictions.Execute(usecases.SetStayRestrictionsInput{
+		RoomType:      "SUITE",
+		From:          s.from,
+		To:            s.from.AddDate(0, 0, -1),
+		MinStayNights: 1,
+	})
+
+	s.EqualError(err, "to date must be on or after from date")
+	s.Len(s.fakeCalendarRepository.Restrictions, 1)
+}
+
+func TestSetStayRestrictions(t *testing.T) {
+	suite.Run(t, new(SetStayRestrictionsSuite))
+}
@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type ShortenBookingInput struct {
+	BookingId uuid.UUID
+	Principal auth.Principal
+	CheckOut  time.Time
+}
+
+type ShortenBookingOutput struct {
+	TotalPrice   uint64
+	RefundAmount uint64
+	RefundStatus string
+}
+
+type IShortenBooking interface {
+	Execute(input ShortenBookingInput) (ShortenBookingOutput, error)
+}
+
+type ShortenBooking struct {
+	PaymentsGateway            gateways.IPaymentsGateway
+	BookingsRepository         repositories.IBookingsRepository
+	PaymentsRepository         repositories.IPaymentsRepository
+	RefundsRepository          repositories.IRefundsRepository
+	ScheduledChargesRepository repositories.IScheduledChargesRepository
+	RoomsRepository            repositories.IRoomsRepository
+	PromoCodesRepository       repositories.IPromoCodesRepository
+	PricingRulesRepository     repositories.IPricingRulesRepository
+	AddOnsRepository           repositories.IAddOnsRepository
+	ExtraGuestRatesRepository  repositories.IExtraGuestRatesRepository
+	PackagesRepository         repositories.IPackagesRepository
+}
+
+func (s *ShortenBooking) Execute(input ShortenBookingInput) (ShortenBookingOutput, error) {
+	foundBooking, err := s.BookingsRepository.FindOneById(input.BookingId)
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	if foundBooking == nil || !input.Principal.MayAccess(foundBooking.CustomerId, "bookings:write:any") {
+		return ShortenBookingOutput{}, errors.New("booking not found")
+	}
+
+	previousTotalPrice := foundBooking.TotalPrice
+
+	pricer := quotePricer{
+		roomsRepository:           s.RoomsRepository,
+		bookingsRepository:        s.BookingsRepository,
+		promoCodesRepository:      s.PromoCodesRepository,
+		pricingRulesRepository:    s.PricingRulesRepository,
+		addOnsRepository:          s.AddOnsRepository,
+		extraGuestRatesRepository: s.ExtraGuestRatesRepository,
+		packagesRepository:        s.PackagesRepository,
+	}
+
+	fullStayPrice, err := pricer.reprice(*foundBooking, foundBooking.CheckOut)
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	shortenedStayPrice, err := pricer.reprice(*foundBooking, input.CheckOut)
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	err = foundBooking.Shorten(input.CheckOut, shortenedStayPrice, fullStayPrice)
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	err = s.BookingsRepository.Update(*foundBooking)
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	if previousTotalPrice == 0 {
+		return ShortenBookingOutput{TotalPrice: foundBooking.TotalPrice}, nil
+	}
+
+	retainedAmount := func(paidAmount uint64) uint64 {
+		return paidAmount * foundBooking.TotalPrice / previousTotalPrice
+	}
+
+	settlement := depositSettlementResult{}
+
+	deposit, err := s.PaymentsRepository.FindOneByBookingId(foundBooking.Id)
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	if deposit != nil {
+		paidAmount := deposit.Amount
+		if deposit.Status == "CAPTURED" {
+			paidAmount = deposit.CapturedAmount
+		}
+
+		settlement, err = depositSettlement{
+			paymentsGateway:    s.PaymentsGateway,
+			paymentsRepository: s.PaymentsRepository,
+			refundsRepository:  s.RefundsRepository,
+		}.settle(*deposit, retainedAmount(paidAmount), false, "MODIFICATION")
+		if err != nil {
+			return ShortenBookingOutput{}, err
+		}
+	}
+
+	chargesSettlement, err := scheduledChargeSettlement{
+		paymentsGateway:            s.PaymentsGateway,
+		scheduledChargesRepository: s.ScheduledChargesRepository,
+		refundsRepository:          s.RefundsRepository,
+	}.settle(foundBooking.Id, retainedAmount, "MODIFICATION")
+	if err != nil {
+		return ShortenBookingOutput{}, err
+	}
+
+	settlement = settlement.combine(chargesSettlement)
+
+	return ShortenBookingOutput{
+		TotalPrice:   foundBooking.TotalPrice,
+		RefundAmount: settlement.RefundAmount,
+		RefundStatus: settlement.RefundStatus,
+	}, nil
+}
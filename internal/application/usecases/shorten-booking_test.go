@@ -0,0 +1,191 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShortenBookingSuite struct {
+	suite.Suite
+	bookingId                      uuid.UUID
+	checkIn                        time.Time
+	principal                      auth.Principal
+	fakePaymentsGateway            gateways.FakePaymentsGateway
+	fakeBookingsRepository         repositories.FakeBookingsRepository
+	fakePaymentsRepository         repositories.FakePaymentsRepository
+	fakeRefundsRepository          repositories.FakeRefundsRepository
+	fakeScheduledChargesRepository repositories.FakeScheduledChargesRepository
+	fakeRoomsRepository            repositories.FakeRoomsRepository
+	fakeAddOnsRepository           repositories.FakeAddOnsRepository
+	shortenBooking                 usecases.ShortenBooking
+}
+
+func (s *ShortenBookingSuite) SetupTest() {
+	s.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	s.checkIn = time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 10)
+	s.principal = auth.Principal{StaffUserId: uuid.New(), Role: "FRONT_DESK", Permissions: []string{"bookings:write:any"}}
+	s.fakePaymentsGateway = gateways.FakePaymentsGateway{}
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	s.fakeRoomsRepository = repositories.FakeRoomsRepository{
+		Rooms: []room.Room{{Id: roomId, Number: "101", Type: "SUITE", Capacity: 2, Price: 200}},
+	}
+	s.fakeAddOnsRepository = repositories.FakeAddOnsRepository{}
+	s.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{
+			{Id: s.bookingId, RoomIds: []uuid.UUID{roomId}, CheckIn: s.checkIn, CheckOut: s.checkIn.AddDate(0, 0, 4),
+				Guests: guests.Guests{Adults: 2}, TotalPrice: 800, Status: "CONFIRMED"},
+		},
+	}
+	s.fakePaymentsRepository = repositories.FakePaymentsRepository{}
+	s.fakeRefundsRepository = repositories.FakeRefundsRepository{CapturedAmounts: map[uuid.UUID]uint64{}}
+	s.fakeScheduledChargesRepository = repositories.FakeScheduledChargesRepository{}
+	s.shortenBooking = usecases.ShortenBooking{
+		PaymentsGateway:            &s.fakePaymentsGateway,
+		BookingsRepository:         &s.fakeBookingsRepository,
+		PaymentsRepository:         &s.fakePaymentsRepository,
+		RefundsRepository:          &s.fakeRefundsRepository,
+		ScheduledChargesRepository: &s.fakeScheduledChargesRepository,
+		RoomsRepository:            &s.fakeRoomsRepository,
+		PromoCodesRepository:       &repositories.FakePromoCodesRepository{},
+		PricingRulesRepository:     &repositories.FakePricingRulesRepository{},
+		AddOnsRepository:           &s.fakeAddOnsRepository,
+		ExtraGuestRatesRepository:  &repositories.FakeExtraGuestRatesRepository{},
+		PackagesRepository:         &repositories.FakePackagesRepository{},
+	}
+}
+
+func (s *ShortenBookingSuite) givenCapturedDeposit(amount uint64) payment.Payment {
+	result, err := s.fakePaymentsGateway.Authorize(s.bookingId, "tok_visa", amount)
+	s.Require().NoError(err)
+	_, err = s.fakePaymentsGateway.Capture(result.TransactionId, amount)
+	s.Require().NoError(err)
+	deposit := payment.Payment{Id: uuid.New(), BookingId: s.bookingId, Amount: amount, CapturedAmount: amount,
+		TransactionId: result.TransactionId, Status: "CAPTURED"}
+	s.fakePaymentsRepository.Payments = []payment.Payment{deposit}
+	s.fakeRefundsRepository.CapturedAmounts[deposit.Id] = amount
+
+	return deposit
+}
+
+func (s *ShortenBookingSuite) TestExecute_OnCapturedDeposit_RefundsTheRemovedNights() {
+	s.givenCapturedDeposit(240)
+
+	output, err := s.shortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: s.bookingId,
+		Principal: s.principal,
+		CheckOut:  s.checkIn.AddDate(0, 0, 1),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(uint64(200), output.TotalPrice)
+	s.Equal(uint64(180), output.RefundAmount)
+	s.Equal("APPROVED", output.RefundStatus)
+	s.Equal(s.checkIn.AddDate(0, 0, 1), s.fakeBookingsRepository.Bookings[0].CheckOut)
+	s.Equal("MODIFICATION", s.fakeRefundsRepository.Refunds[0].Type)
+}
+
+func (s *ShortenBookingSuite) TestExecute_OnPerStayAddOn_KeepsItInTheShortenedPrice() {
+	s.fakeAddOnsRepository.AddOns = []addon.AddOn{
+		{Id: uuid.New(), Code: "AIRPORT_TRANSFER", Name: "Airport transfer", Price: 100, Unit: "PER_STAY"},
+	}
+	s.fakeBookingsRepository.Bookings[0].AddOns = []booking.BookingAddOn{{Code: "AIRPORT_TRANSFER", Quantity: 1}}
+	s.fakeBookingsRepository.Bookings[0].TotalPrice = 900
+	s.givenCapturedDeposit(270)
+
+	output, err := s.shortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: s.bookingId,
+		Principal: s.principal,
+		CheckOut:  s.checkIn.AddDate(0, 0, 1),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(uint64(300), output.TotalPrice)
+	s.Equal(uint64(180), output.RefundAmount)
+}
+
+func (s *ShortenBookingSuite) TestExecute_OnPaidScheduledCharge_RefundsTheRemovedNights() {
+	s.givenCapturedDeposit(240)
+	result, err := s.fakePaymentsGateway.Authorize(s.bookingId, "tok_visa", 400)
+	s.Require().NoError(err)
+	_, err = s.fakePaymentsGateway.Capture(result.TransactionId, 400)
+	s.Require().NoError(err)
+	paidCharge := payment.ScheduledCharge{Id: uuid.New(), BookingId: s.bookingId, Amount: 400,
+		TransactionId: result.TransactionId, Status: "PAID"}
+	s.fakeScheduledChargesRepository.ScheduledCharges = []payment.ScheduledCharge{paidCharge}
+	s.fakeRefundsRepository.CapturedAmounts[paidCharge.Id] = 400
+
+	output, err := s.shortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: s.bookingId,
+		Principal: s.principal,
+		CheckOut:  s.checkIn.AddDate(0, 0, 1),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(uint64(480), output.RefundAmount)
+	s.Equal(paidCharge.Id, s.fakeRefundsRepository.Refunds[1].ScheduledChargeId)
+	s.Equal(uint64(300), s.fakeRefundsRepository.Refunds[1].Amount)
+	s.Equal(uint64(300), s.fakePaymentsGateway.Transactions[1].RefundedAmount)
+}
+
+func (s *ShortenBookingSuite) TestExecute_OnAuthorizedDeposit_ReducesTheAuthorizedAmount() {
+	result, err := s.fakePaymentsGateway.Authorize(s.bookingId, "tok_visa", 240)
+	s.Require().NoError(err)
+	s.fakePaymentsRepository.Payments = []payment.Payment{
+		{Id: uuid.New(), BookingId: s.bookingId, Amount: 240, TransactionId: result.TransactionId, Status: "AUTHORIZED"},
+	}
+
+	output, err := s.shortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: s.bookingId,
+		Principal: s.principal,
+		CheckOut:  s.checkIn.AddDate(0, 0, 2),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(uint64(120), output.RefundAmount)
+	s.Equal("RELEASED", output.RefundStatus)
+	s.Equal(uint64(120), s.fakePaymentsRepository.Payments[0].Amount)
+	s.Equal("AUTHORIZED", s.fakePaymentsRepository.Payments[0].Status)
+}
+
+func (s *ShortenBookingSuite) TestExecute_OnPreviousManualRefund_NeverRefundsMoreThanCaptured() {
+	deposit := s.givenCapturedDeposit(240)
+	s.fakeRefundsRepository.Refunds = []payment.Refund{
+		{Id: uuid.New(), BookingId: s.bookingId, PaymentId: deposit.Id, Amount: 200, Type: "MANUAL", Status: "APPROVED"},
+	}
+
+	output, err := s.shortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: s.bookingId,
+		Principal: s.principal,
+		CheckOut:  s.checkIn.AddDate(0, 0, 1),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(uint64(0), output.RefundAmount)
+	s.Len(s.fakeRefundsRepository.Refunds, 1)
+}
+
+func (s *ShortenBookingSuite) TestExecute_OnLaterCheckOut_ReturnsError() {
+	_, err := s.shortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: s.bookingId,
+		Principal: s.principal,
+		CheckOut:  s.checkIn.AddDate(0, 0, 5),
+	})
+
+	s.EqualError(err, "new check-out date must be before the current check-out date")
+}
+
+func TestShortenBooking(t *testing.T) {
+	suite.Run(t, new(ShortenBookingSuite))
+}
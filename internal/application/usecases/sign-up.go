@@ -3,10 +3,11 @@ package usecases
 import (
 	"errors"
 	"regexp"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
 )
 
 type SignUpInput struct {
@@ -15,55 +16,81 @@ type SignUpInput struct {
 	Password string
 }
 
+type SignUpOutput struct {
+	// VerificationEmailFailure is why the verification email could not be sent. The customer is created anyway and
+	// can ask for it again through ResendVerificationEmail.
+	VerificationEmailFailure string
+}
+
 type ISignUp interface {
-	Execute(input SignUpInput) error
+	Execute(input SignUpInput) (SignUpOutput, error)
 }
 
 type SignUp struct {
-	CustomersGateway gateways.ICustomersGateway
+	SecretsGateway       gateways.ISecretsGateway
+	CustomersGateway     gateways.ICustomersGateway
+	EmailGateway         gateways.IEmailGateway
+	VerificationTokenTtl time.Duration
+	VerificationUrl      string
+	PasswordPolicy       account.PasswordPolicy
+	PasswordHasher       account.PasswordHasher
 }
 
-func (s *SignUp) Execute(input SignUpInput) error {
+// Execute creates the customer as UNVERIFIED and emails a signed link to POST /api/email/verify with. The customer
+// can sign in right away; whether an unverified customer may book is decided by CreateBooking.
+func (s *SignUp) Execute(input SignUpInput) (SignUpOutput, error) {
 	if len(input.Name) < 3 {
-		return errors.New("name must be at least 3 characters long")
+		return SignUpOutput{}, errors.New("name must be at least 3 characters long")
 	}
 
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
 	if !emailRegex.MatchString(input.Email) {
-		return errors.New("email is invalid")
+		return SignUpOutput{}, errors.New("email is invalid")
 	}
 
-	if len(input.Password) < 6 {
-		return errors.New("password must be at least 6 characters long")
+	err := s.PasswordPolicy.Validate(input.Password)
+
+	if err != nil {
+		return SignUpOutput{}, err
 	}
 
 	exists, err := s.CustomersGateway.ExistsByEmail(input.Email)
 
 	if err != nil {
-		return err
+		return SignUpOutput{}, err
 	}
 
 	if exists {
-		return errors.New("email address is already associated with another account")
+		return SignUpOutput{}, errors.New("email address is already associated with another account")
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), 12)
+	hashedPassword, err := s.PasswordHasher.Hash(input.Password)
 
 	if err != nil {
-		return err
+		return SignUpOutput{}, err
 	}
 
-	err = s.CustomersGateway.Create(gateways.CustomerDTO{
+	customerDTO := gateways.CustomerDTO{
 		Id:             uuid.New(),
 		Name:           input.Name,
 		Email:          input.Email,
-		HashedPassword: string(hashedPassword),
-	})
+		HashedPassword: hashedPassword,
+		Status:         "UNVERIFIED",
+	}
+
+	err = s.CustomersGateway.Create(customerDTO)
+
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	err = sendVerificationEmail(s.SecretsGateway, s.EmailGateway, s.CustomersGateway, customerDTO,
+		s.VerificationTokenTtl, s.VerificationUrl)
 
 	if err != nil {
-		return err
+		return SignUpOutput{VerificationEmailFailure: err.Error()}, nil
 	}
 
-	return nil
+	return SignUpOutput{}, nil
 }
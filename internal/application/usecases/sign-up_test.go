@@ -1,11 +1,15 @@
 package usecases_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -13,33 +17,71 @@ import (
 type SignUpSuite struct {
 	suite.Suite
 	customersGatewayFake gateways.FakeCustomersGateway
+	emailGatewayFake     gateways.FakeEmailGateway
 	signUp               usecases.SignUp
 }
 
 func (s *SignUpSuite) SetupTest() {
+	passwordPolicy, err := account.NewPasswordPolicy(6, 0, []string{"password1"})
+	s.Require().NoError(err)
 	s.customersGatewayFake = gateways.FakeCustomersGateway{}
+	s.emailGatewayFake = gateways.FakeEmailGateway{}
 	s.signUp = usecases.SignUp{
-		CustomersGateway: &s.customersGatewayFake,
+		SecretsGateway: &gateways.FakeSecretsGateway{
+			Secrets: map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"},
+		},
+		CustomersGateway:     &s.customersGatewayFake,
+		EmailGateway:         &s.emailGatewayFake,
+		VerificationTokenTtl: 48 * time.Hour,
+		VerificationUrl:      "https://hotel.com/verify-email",
+		PasswordPolicy:       passwordPolicy,
 	}
 }
 
 func (s *SignUpSuite) TestExecute_OnValidNameEmailPassword_ReturnsNil() {
-	err := s.signUp.Execute(usecases.SignUpInput{
+	signUpOutput, err := s.signUp.Execute(usecases.SignUpInput{
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123456",
 	})
 	s.NoError(err)
+	s.Empty(signUpOutput.VerificationEmailFailure)
 
 	createdCustomerDTO := s.customersGatewayFake.CustomersDTO[0]
 	s.Equal("John Doe", createdCustomerDTO.Name)
 	s.Equal("john.doe@gmail.com", createdCustomerDTO.Email)
 	err = bcrypt.CompareHashAndPassword([]byte(createdCustomerDTO.HashedPassword), []byte("123456"))
 	s.NoError(err)
+	s.Equal("UNVERIFIED", createdCustomerDTO.Status)
+	s.WithinDuration(time.Now(), createdCustomerDTO.VerificationEmailSentAt, time.Minute)
+
+	s.Require().Len(s.emailGatewayFake.SentEmails, 1)
+	sentEmail := s.emailGatewayFake.SentEmails[0]
+	s.Equal("john.doe@gmail.com", sentEmail.To)
+	s.Equal("Verify your email", sentEmail.Subject)
+	s.True(strings.Contains(sentEmail.Body, "https://hotel.com/verify-email?token="))
+}
+
+func (s *SignUpSuite) TestExecute_OnVerificationEmailFailure_CreatesTheCustomerAndReportsIt() {
+	s.emailGatewayFake.SendErr = errors.New("smtp server unavailable")
+
+	signUpOutput, err := s.signUp.Execute(usecases.SignUpInput{
+		Name:     "John Doe",
+		Email:    "john.doe@gmail.com",
+		Password: "123456",
+	})
+	s.NoError(err)
+	s.Equal("smtp server unavailable", signUpOutput.VerificationEmailFailure)
+
+	s.Require().Len(s.customersGatewayFake.CustomersDTO, 1)
+	createdCustomerDTO := s.customersGatewayFake.CustomersDTO[0]
+	s.Equal("UNVERIFIED", createdCustomerDTO.Status)
+	s.True(createdCustomerDTO.VerificationEmailSentAt.IsZero())
+	s.Empty(s.emailGatewayFake.SentEmails)
 }
 
 func (s *SignUpSuite) TestExecute_OnInvalidName_ReturnsError() {
-	err := s.signUp.Execute(usecases.SignUpInput{
+	_, err := s.signUp.Execute(usecases.SignUpInput{
 		Name:     "J",
 		Email:    "john.doe@gmail.com",
 		Password: "123456",
@@ -49,7 +91,7 @@ func (s *SignUpSuite) TestExecute_OnInvalidName_ReturnsError() {
 }
 
 func (s *SignUpSuite) TestExecute_OnInvalidEmail_ReturnsError() {
-	err := s.signUp.Execute(usecases.SignUpInput{
+	_, err := s.signUp.Execute(usecases.SignUpInput{
 		Name:     "John Doe",
 		Email:    "j.gmail.com",
 		Password: "123456",
@@ -59,7 +101,7 @@ func (s *SignUpSuite) TestExecute_OnInvalidEmail_ReturnsError() {
 }
 
 func (s *SignUpSuite) TestExecute_OnInvalidPassword_ReturnsError() {
-	err := s.signUp.Execute(usecases.SignUpInput{
+	_, err := s.signUp.Execute(usecases.SignUpInput{
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123",
@@ -68,6 +110,34 @@ func (s *SignUpSuite) TestExecute_OnInvalidPassword_ReturnsError() {
 	s.EqualError(err, "password must be at least 6 characters long")
 }
 
+func (s *SignUpSuite) TestExecute_OnBreachedPassword_ReturnsError() {
+	_, err := s.signUp.Execute(usecases.SignUpInput{
+		Name:     "John Doe",
+		Email:    "john.doe@gmail.com",
+		Password: "Password1",
+	})
+
+	s.EqualError(err, "password is too common or has appeared in a data breach. Please choose another one")
+	s.Empty(s.customersGatewayFake.CustomersDTO)
+}
+
+func (s *SignUpSuite) TestExecute_OnArgon2idHasher_StoresAnArgon2idHash() {
+	passwordHasher, err := account.NewPasswordHasher("ARGON2ID", 12, 19*1024, 2, 1)
+	s.Require().NoError(err)
+	s.signUp.PasswordHasher = passwordHasher
+
+	_, err = s.signUp.Execute(usecases.SignUpInput{
+		Name:     "John Doe",
+		Email:    "john.doe@gmail.com",
+		Password: "123456",
+	})
+	s.Require().NoError(err)
+
+	hashedPassword := s.customersGatewayFake.CustomersDTO[0].HashedPassword
+	s.True(strings.HasPrefix(hashedPassword, "$argon2id$"))
+	s.True(account.PasswordMatches(hashedPassword, "123456"))
+}
+
 func (s *SignUpSuite) TestExecute_OnEmailAddressAlreadyAssociatedWithAnotherAccount_ReturnsError() {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("123456"), 12)
 	s.Require().NoError(err)
@@ -80,7 +150,7 @@ func (s *SignUpSuite) TestExecute_OnEmailAddressAlreadyAssociatedWithAnotherAcco
 		},
 	}
 
-	err = s.signUp.Execute(usecases.SignUpInput{
+	_, err = s.signUp.Execute(usecases.SignUpInput{
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123456",
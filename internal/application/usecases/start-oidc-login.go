@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+type StartOidcLoginInput struct {
+	Provider string
+}
+
+type StartOidcLoginOutput struct {
+	AuthorizationUrl string
+	// LoginToken must be kept by the client and sent back with the code and state the provider redirects with.
+	LoginToken          string
+	LoginTokenExpiresAt time.Time
+}
+
+type IStartOidcLogin interface {
+	Execute(input StartOidcLoginInput) (StartOidcLoginOutput, error)
+}
+
+type StartOidcLogin struct {
+	SecretsGateway gateways.ISecretsGateway
+	OidcGateway    gateways.IOidcGateway
+	LoginTokenTtl  time.Duration
+}
+
+// Execute builds the URL the customer is sent to at the provider. The state, the nonce and the PKCE code verifier are
+// fresh for every login and only the S256 challenge of the verifier leaves through the URL.
+func (s *StartOidcLogin) Execute(input StartOidcLoginInput) (StartOidcLoginOutput, error) {
+	provider, err := findOidcProvider(s.SecretsGateway, input.Provider)
+	if err != nil {
+		return StartOidcLoginOutput{}, err
+	}
+
+	claims := OidcLoginClaims{Provider: provider.Name}
+
+	for _, value := range []*string{&claims.State, &claims.Nonce, &claims.CodeVerifier} {
+		*value, err = newOidcRandomValue()
+		if err != nil {
+			return StartOidcLoginOutput{}, err
+		}
+	}
+
+	authorizationUrl, err := s.OidcGateway.AuthorizationUrl(provider, gateways.OidcAuthorizationRequestDTO{
+		State:         claims.State,
+		Nonce:         claims.Nonce,
+		CodeChallenge: oidcCodeChallenge(claims.CodeVerifier),
+	})
+	if err != nil {
+		return StartOidcLoginOutput{}, err
+	}
+
+	loginTokenExpiresAt := time.Now().UTC().Add(s.LoginTokenTtl)
+
+	loginToken, err := signOidcLoginToken(s.SecretsGateway, claims, loginTokenExpiresAt)
+	if err != nil {
+		return StartOidcLoginOutput{}, err
+	}
+
+	return StartOidcLoginOutput{
+		AuthorizationUrl:    authorizationUrl,
+		LoginToken:          loginToken,
+		LoginTokenExpiresAt: loginTokenExpiresAt,
+	}, nil
+}
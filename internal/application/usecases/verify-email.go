@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+type VerifyEmailInput struct {
+	Token string
+}
+
+type IVerifyEmail interface {
+	Execute(input VerifyEmailInput) error
+}
+
+type VerifyEmail struct {
+	SecretsGateway   gateways.ISecretsGateway
+	CustomersGateway gateways.ICustomersGateway
+}
+
+// Execute marks the email of the customer named in the token as verified. Opening the same link twice succeeds, so
+// a customer who clicks it again is not shown an error.
+func (v *VerifyEmail) Execute(input VerifyEmailInput) error {
+	claims, err := parseEmailVerificationToken(v.SecretsGateway, input.Token)
+	if err != nil {
+		return err
+	}
+
+	customerDTO, err := v.CustomersGateway.FindOneById(claims.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if customerDTO == nil || customerDTO.Email != claims.Email {
+		return errors.New("email verification token is invalid or has expired")
+	}
+
+	if customerDTO.Status == "VERIFIED" {
+		return nil
+	}
+
+	return v.CustomersGateway.MarkEmailVerified(customerDTO.Id)
+}
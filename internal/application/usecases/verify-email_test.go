@@ -0,0 +1,90 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/stretchr/testify/suite"
+)
+
+type VerifyEmailSuite struct {
+	suite.Suite
+	customerId           uuid.UUID
+	fakeCustomersGateway gateways.FakeCustomersGateway
+	verifyEmail          usecases.VerifyEmail
+}
+
+func (v *VerifyEmailSuite) SetupTest() {
+	v.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	v.fakeCustomersGateway = gateways.FakeCustomersGateway{
+		CustomersDTO: []gateways.CustomerDTO{
+			{Id: v.customerId, Name: "John Doe", Email: "john.doe@gmail.com", Status: "UNVERIFIED"},
+		},
+	}
+	v.verifyEmail = usecases.VerifyEmail{
+		SecretsGateway: &gateways.FakeSecretsGateway{
+			Secrets: map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"},
+		},
+		CustomersGateway: &v.fakeCustomersGateway,
+	}
+}
+
+func (v *VerifyEmailSuite) signToken(subject string, email string, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &usecases.EmailVerificationClaims{
+		CustomerId: v.customerId,
+		Email:      email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}).SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
+	v.Require().NoError(err)
+
+	return token
+}
+
+func (v *VerifyEmailSuite) TestExecute_OnValidToken_MarksTheEmailAsVerified() {
+	token := v.signToken("EMAIL_VERIFICATION", "john.doe@gmail.com", time.Now().Add(time.Hour))
+
+	err := v.verifyEmail.Execute(usecases.VerifyEmailInput{Token: token})
+	v.Require().NoError(err)
+
+	v.Equal("VERIFIED", v.fakeCustomersGateway.CustomersDTO[0].Status)
+
+	err = v.verifyEmail.Execute(usecases.VerifyEmailInput{Token: token})
+	v.NoError(err)
+}
+
+func (v *VerifyEmailSuite) TestExecute_OnExpiredToken_ReturnsError() {
+	token := v.signToken("EMAIL_VERIFICATION", "john.doe@gmail.com", time.Now().Add(-time.Minute))
+
+	err := v.verifyEmail.Execute(usecases.VerifyEmailInput{Token: token})
+
+	v.EqualError(err, "email verification token is invalid or has expired")
+	v.Equal("UNVERIFIED", v.fakeCustomersGateway.CustomersDTO[0].Status)
+}
+
+func (v *VerifyEmailSuite) TestExecute_OnQuoteToken_ReturnsError() {
+	token := v.signToken("QUOTE", "john.doe@gmail.com", time.Now().Add(time.Hour))
+
+	err := v.verifyEmail.Execute(usecases.VerifyEmailInput{Token: token})
+
+	v.EqualError(err, "email verification token is invalid or has expired")
+}
+
+func (v *VerifyEmailSuite) TestExecute_OnTokenForAnotherEmail_ReturnsError() {
+	token := v.signToken("EMAIL_VERIFICATION", "john@old-domain.com", time.Now().Add(time.Hour))
+
+	err := v.verifyEmail.Execute(usecases.VerifyEmailInput{Token: token})
+
+	v.EqualError(err, "email verification token is invalid or has expired")
+	v.Equal("UNVERIFIED", v.fakeCustomersGateway.CustomersDTO[0].Status)
+}
+
+func TestVerifyEmail(t *testing.T) {
+	suite.Run(t, new(VerifyEmailSuite))
+}
@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+)
+
+type VoidFolioChargeInput struct {
+	BookingId uuid.UUID
+	ChargeId  uuid.UUID
+	Reason    string
+}
+
+type VoidFolioChargeOutput struct {
+	ChargeId uuid.UUID
+	Status   string
+}
+
+type IVoidFolioCharge interface {
+	Execute(input VoidFolioChargeInput) (VoidFolioChargeOutput, error)
+}
+
+type VoidFolioCharge struct {
+	BookingsRepository     repositories.IBookingsRepository
+	FolioEntriesRepository repositories.IFolioEntriesRepository
+}
+
+func (v *VoidFolioCharge) Execute(input VoidFolioChargeInput) (VoidFolioChargeOutput, error) {
+	foundBooking, err := findBookingOpenForFolio(v.BookingsRepository, input.BookingId)
+	if err != nil {
+		return VoidFolioChargeOutput{}, err
+	}
+
+	charge, err := v.FolioEntriesRepository.FindOneById(input.ChargeId)
+	if err != nil {
+		return VoidFolioChargeOutput{}, err
+	}
+
+	if charge == nil || charge.BookingId != foundBooking.Id || charge.Type != "CHARGE" {
+		return VoidFolioChargeOutput{}, errors.New("charge not found")
+	}
+
+	err = charge.Void(input.Reason)
+	if err != nil {
+		return VoidFolioChargeOutput{}, err
+	}
+
+	err = v.FolioEntriesRepository.Update(*charge)
+	if err != nil {
+		return VoidFolioChargeOutput{}, err
+	}
+
+	return VoidFolioChargeOutput{
+		ChargeId: charge.Id,
+		Status:   charge.Status,
+	}, nil
+}
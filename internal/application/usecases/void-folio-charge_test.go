@@ -0,0 +1,81 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/stretchr/testify/suite"
+)
+
+type VoidFolioChargeSuite struct {
+	suite.Suite
+	bookingId                  uuid.UUID
+	chargeId                   uuid.UUID
+	fakeBookingsRepository     repositories.FakeBookingsRepository
+	fakeFolioEntriesRepository repositories.FakeFolioEntriesRepository
+	voidFolioCharge            usecases.VoidFolioCharge
+}
+
+func (v *VoidFolioChargeSuite) SetupTest() {
+	v.bookingId = uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	v.chargeId = uuid.MustParse("5b1d2c3e-4f50-4a6b-8c7d-9e0f1a2b3c4d")
+	v.fakeBookingsRepository = repositories.FakeBookingsRepository{
+		Bookings: []booking.Booking{{Id: v.bookingId, TotalPrice: 400, Status: "CONFIRMED"}},
+	}
+	v.fakeFolioEntriesRepository = repositories.FakeFolioEntriesRepository{
+		FolioEntries: []folio.FolioEntry{
+			{Id: v.chargeId, BookingId: v.bookingId, Type: "CHARGE", Category: "SPA", Amount: 80, Status: "POSTED"},
+		},
+	}
+	v.voidFolioCharge = usecases.VoidFolioCharge{
+		BookingsRepository:     &v.fakeBookingsRepository,
+		FolioEntriesRepository: &v.fakeFolioEntriesRepository,
+	}
+}
+
+func (v *VoidFolioChargeSuite) TestExecute_OnPostedCharge_VoidsIt() {
+	output, err := v.voidFolioCharge.Execute(usecases.VoidFolioChargeInput{
+		BookingId: v.bookingId,
+		ChargeId:  v.chargeId,
+		Reason:    "posted to the wrong room",
+	})
+	v.Require().NoError(err)
+
+	v.Equal("VOIDED", output.Status)
+	v.Equal("VOIDED", v.fakeFolioEntriesRepository.FolioEntries[0].Status)
+	v.Equal("posted to the wrong room", v.fakeFolioEntriesRepository.FolioEntries[0].VoidReason)
+}
+
+func (v *VoidFolioChargeSuite) TestExecute_OnChargeOfAnotherBooking_ReturnsError() {
+	v.fakeBookingsRepository.Bookings = append(v.fakeBookingsRepository.Bookings,
+		booking.Booking{Id: uuid.MustParse("c7a1f8e2-3b4d-4e5f-a6b7-c8d9e0f1a2b3"), Status: "CONFIRMED"})
+
+	_, err := v.voidFolioCharge.Execute(usecases.VoidFolioChargeInput{
+		BookingId: uuid.MustParse("c7a1f8e2-3b4d-4e5f-a6b7-c8d9e0f1a2b3"),
+		ChargeId:  v.chargeId,
+		Reason:    "posted to the wrong room",
+	})
+
+	v.EqualError(err, "charge not found")
+	v.Equal("POSTED", v.fakeFolioEntriesRepository.FolioEntries[0].Status)
+}
+
+func (v *VoidFolioChargeSuite) TestExecute_OnPayment_ReturnsError() {
+	v.fakeFolioEntriesRepository.FolioEntries[0].Type = "PAYMENT"
+
+	_, err := v.voidFolioCharge.Execute(usecases.VoidFolioChargeInput{
+		BookingId: v.bookingId,
+		ChargeId:  v.chargeId,
+		Reason:    "posted to the wrong room",
+	})
+
+	v.EqualError(err, "charge not found")
+}
+
+func TestVoidFolioCharge(t *testing.T) {
+	suite.Run(t, new(VoidFolioChargeSuite))
+}
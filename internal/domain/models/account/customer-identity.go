@@ -0,0 +1,40 @@
+package account
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomerIdentity links an account at an external identity provider to a customer. The provider subject is stable
+// for the lifetime of that account, unlike its email address, so it is what a returning customer is recognized by.
+type CustomerIdentity struct {
+	Id         uuid.UUID
+	CustomerId uuid.UUID
+	Provider   string
+	Subject    string
+	CreatedAt  time.Time
+}
+
+func NewCustomerIdentity(customerId uuid.UUID, provider string, subject string) (CustomerIdentity, error) {
+	if customerId == uuid.Nil {
+		return CustomerIdentity{}, errors.New("customer identity must belong to a customer")
+	}
+
+	if provider == "" {
+		return CustomerIdentity{}, errors.New("customer identity provider must not be empty")
+	}
+
+	if subject == "" {
+		return CustomerIdentity{}, errors.New("customer identity subject must not be empty")
+	}
+
+	return CustomerIdentity{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Provider:   provider,
+		Subject:    subject,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package account
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginAttempts counts the consecutive failed logins of one subject: an email address when the scope is ACCOUNT or
+// STAFF_ACCOUNT, or the client address when it is IP_ADDRESS.
+type LoginAttempts struct {
+	Scope        string
+	Subject      string
+	Failures     uint32
+	LastFailedAt time.Time
+}
+
+// LoginLockout is the audit record written every time a subject gets locked out.
+type LoginLockout struct {
+	Id          uuid.UUID
+	Scope       string
+	Subject     string
+	Failures    uint32
+	LockedUntil time.Time
+	CreatedAt   time.Time
+}
+
+// LoginThrottlePolicy lets FreeAttempts failures through, then makes the subject wait BaseDelay, doubled on every
+// further failure, and locks it out for LockoutDuration from LockoutThreshold failures on. Failures are forgotten
+// ResetAfter the last one.
+type LoginThrottlePolicy struct {
+	FreeAttempts     uint32
+	LockoutThreshold uint32
+	BaseDelay        time.Duration
+	LockoutDuration  time.Duration
+	ResetAfter       time.Duration
+}
+
+func NewLoginThrottlePolicy(freeAttempts uint32, lockoutThreshold uint32, baseDelay time.Duration,
+	lockoutDuration time.Duration) (LoginThrottlePolicy, error) {
+	if lockoutThreshold <= freeAttempts {
+		return LoginThrottlePolicy{}, errors.New("lockout threshold must be greater than the free attempts")
+	}
+
+	if baseDelay <= 0 || lockoutDuration < baseDelay {
+		return LoginThrottlePolicy{}, errors.New("lockout duration must be at least the base delay")
+	}
+
+	return LoginThrottlePolicy{
+		FreeAttempts:     freeAttempts,
+		LockoutThreshold: lockoutThreshold,
+		BaseDelay:        baseDelay,
+		LockoutDuration:  lockoutDuration,
+		ResetAfter:       max(24*time.Hour, 2*lockoutDuration),
+	}, nil
+}
+
+// Delay is how long the subject has to wait after its last failure before it may try again.
+func (l LoginThrottlePolicy) Delay(failures uint32) time.Duration {
+	if l.IsLockedOut(failures) {
+		return l.LockoutDuration
+	}
+
+	if failures <= l.FreeAttempts {
+		return 0
+	}
+
+	delay := l.BaseDelay
+	for range failures - l.FreeAttempts - 1 {
+		delay *= 2
+
+		if delay >= l.LockoutDuration {
+			return l.LockoutDuration
+		}
+	}
+
+	return delay
+}
+
+func (l LoginThrottlePolicy) IsLockedOut(failures uint32) bool {
+	return failures >= l.LockoutThreshold
+}
+
+// RetryAfter returns how long the subject still has to wait, or zero when it may try again now.
+func (l LoginThrottlePolicy) RetryAfter(loginAttempts LoginAttempts, now time.Time) time.Duration {
+	if now.Sub(loginAttempts.LastFailedAt) >= l.ResetAfter {
+		return 0
+	}
+
+	return max(loginAttempts.LastFailedAt.Add(l.Delay(loginAttempts.Failures)).Sub(now), 0)
+}
+
+// ResetBefore is the moment before which a previous failure no longer counts towards a new one.
+func (l LoginThrottlePolicy) ResetBefore(now time.Time) time.Time {
+	return now.Add(-l.ResetAfter)
+}
+
+func NewLoginLockout(loginAttempts LoginAttempts, lockoutDuration time.Duration) LoginLockout {
+	return LoginLockout{
+		Id:          uuid.New(),
+		Scope:       loginAttempts.Scope,
+		Subject:     loginAttempts.Subject,
+		Failures:    loginAttempts.Failures,
+		LockedUntil: loginAttempts.LastFailedAt.Add(lockoutDuration),
+		CreatedAt:   time.Now().UTC(),
+	}
+}
@@ -0,0 +1,56 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginThrottlePolicySuite struct {
+	suite.Suite
+	now    time.Time
+	policy account.LoginThrottlePolicy
+}
+
+func (l *LoginThrottlePolicySuite) SetupTest() {
+	l.now = time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	policy, err := account.NewLoginThrottlePolicy(3, 10, time.Second, 15*time.Minute)
+	l.Require().NoError(err)
+	l.policy = policy
+}
+
+func (l *LoginThrottlePolicySuite) TestDelay_OnIncreasingFailures_BacksOffExponentiallyUntilLockout() {
+	l.Equal(time.Duration(0), l.policy.Delay(3))
+	l.Equal(time.Second, l.policy.Delay(4))
+	l.Equal(2*time.Second, l.policy.Delay(5))
+	l.Equal(4*time.Second, l.policy.Delay(6))
+	l.Equal(32*time.Second, l.policy.Delay(9))
+	l.Equal(15*time.Minute, l.policy.Delay(10))
+	l.Equal(15*time.Minute, l.policy.Delay(4000000000))
+}
+
+func (l *LoginThrottlePolicySuite) TestRetryAfter_OnLockedOutSubject_ReturnsTheRemainingLockout() {
+	loginAttempts := account.LoginAttempts{Failures: 10, LastFailedAt: l.now.Add(-5 * time.Minute)}
+
+	l.Equal(10*time.Minute, l.policy.RetryAfter(loginAttempts, l.now))
+	l.Equal(time.Duration(0), l.policy.RetryAfter(loginAttempts, l.now.Add(10*time.Minute)))
+}
+
+func (l *LoginThrottlePolicySuite) TestRetryAfter_OnFailuresOlderThanResetAfter_ReturnsZero() {
+	loginAttempts := account.LoginAttempts{Failures: 50, LastFailedAt: l.now.Add(-25 * time.Hour)}
+
+	l.Equal(time.Duration(0), l.policy.RetryAfter(loginAttempts, l.now))
+}
+
+func (l *LoginThrottlePolicySuite) TestNewLoginThrottlePolicy_OnThresholdNotAboveFreeAttempts_ReturnsError() {
+	_, err := account.NewLoginThrottlePolicy(5, 5, time.Second, 15*time.Minute)
+
+	l.EqualError(err, "lockout threshold must be greater than the free attempts")
+}
+
+func TestLoginThrottlePolicy(t *testing.T) {
+	suite.Run(t, new(LoginThrottlePolicySuite))
+}
@@ -0,0 +1,152 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordHasher hashes new passwords with the configured algorithm, BCRYPT or ARGON2ID. The zero value hashes with
+// bcrypt at cost 12, the cost passwords have always been hashed with here.
+type PasswordHasher struct {
+	Algorithm  string
+	BcryptCost int
+	// Argon2Memory is in KiB.
+	Argon2Memory      uint32
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+func NewPasswordHasher(algorithm string, bcryptCost int, argon2Memory uint32, argon2Iterations uint32,
+	argon2Parallelism uint8) (PasswordHasher, error) {
+	if algorithm != "BCRYPT" && algorithm != "ARGON2ID" {
+		return PasswordHasher{}, errors.New("password hash algorithm must be BCRYPT or ARGON2ID")
+	}
+
+	if bcryptCost < 10 || bcryptCost > bcrypt.MaxCost {
+		return PasswordHasher{}, errors.New("bcrypt cost must be between 10 and 31")
+	}
+
+	// 19 MiB with 2 iterations is the least OWASP recommends for argon2id.
+	if argon2Memory < 19*1024 || argon2Iterations < 2 || argon2Parallelism < 1 {
+		return PasswordHasher{}, errors.New("argon2id parameters must be at least 19456 KiB of memory, 2 iterations and a parallelism of 1")
+	}
+
+	return PasswordHasher{
+		Algorithm:         algorithm,
+		BcryptCost:        bcryptCost,
+		Argon2Memory:      argon2Memory,
+		Argon2Iterations:  argon2Iterations,
+		Argon2Parallelism: argon2Parallelism,
+	}, nil
+}
+
+func (p PasswordHasher) Hash(plainPassword string) (string, error) {
+	if p.Algorithm != "ARGON2ID" {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(plainPassword), p.bcryptCost())
+		if err != nil {
+			return "", err
+		}
+
+		return string(hashedPassword), nil
+	}
+
+	salt := make([]byte, 16)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(plainPassword), salt, p.Argon2Iterations, p.Argon2Memory, p.Argon2Parallelism, 32)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Argon2Memory, p.Argon2Iterations,
+		p.Argon2Parallelism, base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// NeedsRehash reports whether a hash that just matched should be replaced: it was made with another algorithm, or
+// with weaker parameters than the configured ones. Hashes are never downgraded when the parameters are lowered.
+func (p PasswordHasher) NeedsRehash(hashedPassword string) bool {
+	if p.Algorithm != "ARGON2ID" {
+		cost, err := bcrypt.Cost([]byte(hashedPassword))
+		return err != nil || cost < p.bcryptCost()
+	}
+
+	params, err := parseArgon2Hash(hashedPassword)
+	if err != nil {
+		return true
+	}
+
+	return params.memory < p.Argon2Memory || params.iterations < p.Argon2Iterations ||
+		params.parallelism < p.Argon2Parallelism
+}
+
+func (p PasswordHasher) bcryptCost() int {
+	if p.BcryptCost == 0 {
+		return 12
+	}
+
+	return p.BcryptCost
+}
+
+// PasswordMatches checks a password against a hash made with any of the supported algorithms, so passwords keep
+// working while they are being moved to another one.
+func PasswordMatches(hashedPassword string, plainPassword string) bool {
+	if !strings.HasPrefix(hashedPassword, "$argon2id$") {
+		return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(plainPassword)) == nil
+	}
+
+	params, err := parseArgon2Hash(hashedPassword)
+	if err != nil {
+		return false
+	}
+
+	key := argon2.IDKey([]byte(plainPassword), params.salt, params.iterations, params.memory, params.parallelism,
+		uint32(len(params.key)))
+
+	return subtle.ConstantTimeCompare(key, params.key) == 1
+}
+
+func parseArgon2Hash(hashedPassword string) (argon2Params, error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return argon2Params{}, errors.New("password hash is not an argon2id hash")
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return argon2Params{}, errors.New("argon2id hash version is not supported")
+	}
+
+	var params argon2Params
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism)
+	if err != nil {
+		return argon2Params{}, errors.New("argon2id hash parameters are invalid")
+	}
+
+	params.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2Params{}, errors.New("argon2id hash salt is invalid")
+	}
+
+	params.key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(params.key) == 0 {
+		return argon2Params{}, errors.New("argon2id hash key is invalid")
+	}
+
+	return params, nil
+}
@@ -0,0 +1,92 @@
+package account_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type PasswordHasherSuite struct {
+	suite.Suite
+	bcryptHasher   account.PasswordHasher
+	argon2idHasher account.PasswordHasher
+}
+
+func (p *PasswordHasherSuite) SetupTest() {
+	var err error
+	p.bcryptHasher, err = account.NewPasswordHasher("BCRYPT", 10, 19*1024, 2, 1)
+	p.Require().NoError(err)
+	p.argon2idHasher, err = account.NewPasswordHasher("ARGON2ID", 10, 19*1024, 2, 1)
+	p.Require().NoError(err)
+}
+
+func (p *PasswordHasherSuite) TestHash_OnArgon2id_ProducesAMatchingPhcString() {
+	hashedPassword, err := p.argon2idHasher.Hash("s3cret-password")
+	p.Require().NoError(err)
+
+	p.True(strings.HasPrefix(hashedPassword, "$argon2id$v=19$m=19456,t=2,p=1$"))
+	p.True(account.PasswordMatches(hashedPassword, "s3cret-password"))
+	p.False(account.PasswordMatches(hashedPassword, "another-password"))
+	p.False(p.argon2idHasher.NeedsRehash(hashedPassword))
+}
+
+func (p *PasswordHasherSuite) TestHash_OnZeroValue_UsesBcryptWithCost12() {
+	hashedPassword, err := account.PasswordHasher{}.Hash("s3cret-password")
+	p.Require().NoError(err)
+
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	p.Require().NoError(err)
+	p.Equal(12, cost)
+	p.True(account.PasswordMatches(hashedPassword, "s3cret-password"))
+}
+
+func (p *PasswordHasherSuite) TestNeedsRehash_OnLowerBcryptCost_ReturnsTrue() {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("s3cret-password"), bcrypt.MinCost)
+	p.Require().NoError(err)
+
+	p.True(p.bcryptHasher.NeedsRehash(string(hashedPassword)))
+}
+
+func (p *PasswordHasherSuite) TestNeedsRehash_OnHigherBcryptCost_ReturnsFalse() {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("s3cret-password"), 11)
+	p.Require().NoError(err)
+
+	p.False(p.bcryptHasher.NeedsRehash(string(hashedPassword)))
+}
+
+func (p *PasswordHasherSuite) TestNeedsRehash_OnAnotherAlgorithm_ReturnsTrue() {
+	bcryptHash, err := p.bcryptHasher.Hash("s3cret-password")
+	p.Require().NoError(err)
+	argon2idHash, err := p.argon2idHasher.Hash("s3cret-password")
+	p.Require().NoError(err)
+
+	p.True(p.argon2idHasher.NeedsRehash(bcryptHash))
+	p.True(p.bcryptHasher.NeedsRehash(argon2idHash))
+}
+
+func (p *PasswordHasherSuite) TestNeedsRehash_OnWeakerArgon2idParameters_ReturnsTrue() {
+	hashedPassword, err := p.argon2idHasher.Hash("s3cret-password")
+	p.Require().NoError(err)
+
+	strongerHasher, err := account.NewPasswordHasher("ARGON2ID", 10, 19*1024, 3, 1)
+	p.Require().NoError(err)
+	p.True(strongerHasher.NeedsRehash(hashedPassword))
+}
+
+func (p *PasswordHasherSuite) TestPasswordMatches_OnMalformedArgon2idHash_ReturnsFalse() {
+	p.False(account.PasswordMatches("$argon2id$v=19$m=19456,t=2,p=1$not-base64!$", "s3cret-password"))
+	p.False(account.PasswordMatches("", "s3cret-password"))
+}
+
+func (p *PasswordHasherSuite) TestNewPasswordHasher_OnUnknownAlgorithm_ReturnsError() {
+	_, err := account.NewPasswordHasher("SCRYPT", 12, 19*1024, 2, 1)
+
+	p.EqualError(err, "password hash algorithm must be BCRYPT or ARGON2ID")
+}
+
+func TestPasswordHasher(t *testing.T) {
+	suite.Run(t, new(PasswordHasherSuite))
+}
@@ -0,0 +1,94 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxPasswordBytes is the most bcrypt can hash. It applies whatever the hash algorithm, so switching algorithms
+// never turns a password that was accepted into one that can no longer be hashed.
+const maxPasswordBytes = 72
+
+// PasswordPolicy decides which passwords customers and staff users may choose. RequiredClasses is how many of the
+// four character classes (lowercase letters, uppercase letters, digits and symbols) a password must mix.
+// BreachedPasswords holds known leaked or common passwords, lowercased, which are refused regardless of case.
+type PasswordPolicy struct {
+	MinLength         uint8
+	RequiredClasses   uint8
+	BreachedPasswords map[string]struct{}
+}
+
+func NewPasswordPolicy(minLength uint8, requiredClasses uint8, breachedPasswords []string) (PasswordPolicy, error) {
+	if minLength < 6 || minLength > maxPasswordBytes {
+		return PasswordPolicy{}, errors.New("password minimum length must be between 6 and 72 characters")
+	}
+
+	if requiredClasses > 4 {
+		return PasswordPolicy{}, errors.New("password required character classes must be between 0 and 4")
+	}
+
+	passwords := map[string]struct{}{}
+	for _, breachedPassword := range breachedPasswords {
+		breachedPassword = strings.ToLower(strings.TrimSpace(breachedPassword))
+
+		if breachedPassword != "" {
+			passwords[breachedPassword] = struct{}{}
+		}
+	}
+
+	return PasswordPolicy{
+		MinLength:         minLength,
+		RequiredClasses:   requiredClasses,
+		BreachedPasswords: passwords,
+	}, nil
+}
+
+func (p PasswordPolicy) Validate(plainPassword string) error {
+	if utf8.RuneCountInString(plainPassword) < int(p.MinLength) {
+		return fmt.Errorf("password must be at least %d characters long", p.MinLength)
+	}
+
+	if len(plainPassword) > maxPasswordBytes {
+		return errors.New("password must be at most 72 bytes long")
+	}
+
+	if countCharacterClasses(plainPassword) < int(p.RequiredClasses) {
+		return fmt.Errorf("password must contain at least %d of the following: lowercase letters, uppercase letters, digits and symbols",
+			p.RequiredClasses)
+	}
+
+	if _, breached := p.BreachedPasswords[strings.ToLower(plainPassword)]; breached {
+		return errors.New("password is too common or has appeared in a data breach. Please choose another one")
+	}
+
+	return nil
+}
+
+func countCharacterClasses(plainPassword string) int {
+	var lower, upper, digit, symbol bool
+
+	for _, character := range plainPassword {
+		switch {
+		case unicode.IsLower(character):
+			lower = true
+		case unicode.IsUpper(character):
+			upper = true
+		case unicode.IsDigit(character):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			count++
+		}
+	}
+
+	return count
+}
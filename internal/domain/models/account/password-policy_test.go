@@ -0,0 +1,57 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordPolicySuite struct {
+	suite.Suite
+	policy account.PasswordPolicy
+}
+
+func (p *PasswordPolicySuite) SetupTest() {
+	policy, err := account.NewPasswordPolicy(10, 3, []string{"Password123!", " qwertyuiop1A ", ""})
+	p.Require().NoError(err)
+	p.policy = policy
+}
+
+func (p *PasswordPolicySuite) TestValidate_OnStrongPassword_ReturnsNil() {
+	p.NoError(p.policy.Validate("correct-Horse-battery"))
+	p.NoError(p.policy.Validate("Ünïcödé-pässwörd"))
+}
+
+func (p *PasswordPolicySuite) TestValidate_OnShortPassword_ReturnsError() {
+	p.EqualError(p.policy.Validate("Sh0rt-pw"), "password must be at least 10 characters long")
+}
+
+func (p *PasswordPolicySuite) TestValidate_OnPasswordLongerThan72Bytes_ReturnsError() {
+	p.EqualError(p.policy.Validate("Aa1-"+string(make([]byte, 69))), "password must be at most 72 bytes long")
+}
+
+func (p *PasswordPolicySuite) TestValidate_OnTooFewCharacterClasses_ReturnsError() {
+	p.EqualError(p.policy.Validate("onlylowercase123"),
+		"password must contain at least 3 of the following: lowercase letters, uppercase letters, digits and symbols")
+}
+
+func (p *PasswordPolicySuite) TestValidate_OnBreachedPasswordInAnyCase_ReturnsError() {
+	p.EqualError(p.policy.Validate("PASSWORD123!"),
+		"password is too common or has appeared in a data breach. Please choose another one")
+	p.EqualError(p.policy.Validate("QwertyUiop1a"),
+		"password is too common or has appeared in a data breach. Please choose another one")
+	p.Len(p.policy.BreachedPasswords, 2)
+}
+
+func (p *PasswordPolicySuite) TestNewPasswordPolicy_OnInvalidSettings_ReturnsError() {
+	_, err := account.NewPasswordPolicy(5, 0, nil)
+	p.EqualError(err, "password minimum length must be between 6 and 72 characters")
+
+	_, err = account.NewPasswordPolicy(8, 5, nil)
+	p.EqualError(err, "password required character classes must be between 0 and 4")
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	suite.Run(t, new(PasswordPolicySuite))
+}
@@ -0,0 +1,70 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken lets a customer who forgot their password choose a new one. Only the hash is stored; the plain
+// value travels in the reset link and can be used once before it expires.
+type PasswordResetToken struct {
+	Id          uuid.UUID
+	CustomerId  uuid.UUID
+	HashedToken string
+	Status      string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func NewPasswordResetToken(customerId uuid.UUID, ttl time.Duration) (PasswordResetToken, string, error) {
+	if ttl <= 0 {
+		return PasswordResetToken{}, "", errors.New("password reset token ttl must be greater than zero")
+	}
+
+	plainToken, err := newPlainToken()
+	if err != nil {
+		return PasswordResetToken{}, "", err
+	}
+
+	now := time.Now().UTC()
+
+	return PasswordResetToken{
+		Id:          uuid.New(),
+		CustomerId:  customerId,
+		HashedToken: HashToken(plainToken),
+		Status:      "ACTIVE",
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+	}, plainToken, nil
+}
+
+func (p *PasswordResetToken) Use(now time.Time) error {
+	if p.Status != "ACTIVE" || !p.ExpiresAt.After(now) {
+		return errors.New("password reset token is invalid or has expired")
+	}
+
+	p.Status = "USED"
+
+	return nil
+}
+
+func HashToken(plainToken string) string {
+	hash := sha256.Sum256([]byte(plainToken))
+	return hex.EncodeToString(hash[:])
+}
+
+func newPlainToken() (string, error) {
+	random := make([]byte, 32)
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
@@ -0,0 +1,68 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordResetTokenSuite struct {
+	suite.Suite
+	customerId uuid.UUID
+}
+
+func (p *PasswordResetTokenSuite) SetupTest() {
+	p.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+}
+
+func (p *PasswordResetTokenSuite) TestNewPasswordResetToken_OnNoErrors_StoresOnlyTheHash() {
+	passwordResetToken, plainToken, err := account.NewPasswordResetToken(p.customerId, time.Hour)
+	p.Require().NoError(err)
+
+	p.Len(plainToken, 43)
+	p.Equal(account.HashToken(plainToken), passwordResetToken.HashedToken)
+	p.Equal("ACTIVE", passwordResetToken.Status)
+	p.Equal(p.customerId, passwordResetToken.CustomerId)
+}
+
+func (p *PasswordResetTokenSuite) TestNewPasswordResetToken_OnZeroTtl_ReturnsError() {
+	_, _, err := account.NewPasswordResetToken(p.customerId, 0)
+	p.EqualError(err, "password reset token ttl must be greater than zero")
+}
+
+func (p *PasswordResetTokenSuite) TestUse_OnActiveToken_MarksItUsed() {
+	passwordResetToken, _, err := account.NewPasswordResetToken(p.customerId, time.Hour)
+	p.Require().NoError(err)
+
+	err = passwordResetToken.Use(time.Now().UTC())
+	p.Require().NoError(err)
+
+	p.Equal("USED", passwordResetToken.Status)
+}
+
+func (p *PasswordResetTokenSuite) TestUse_OnUsedToken_ReturnsError() {
+	passwordResetToken, _, err := account.NewPasswordResetToken(p.customerId, time.Hour)
+	p.Require().NoError(err)
+	p.Require().NoError(passwordResetToken.Use(time.Now().UTC()))
+
+	err = passwordResetToken.Use(time.Now().UTC())
+
+	p.EqualError(err, "password reset token is invalid or has expired")
+}
+
+func (p *PasswordResetTokenSuite) TestUse_OnExpiredToken_ReturnsError() {
+	passwordResetToken, _, err := account.NewPasswordResetToken(p.customerId, time.Hour)
+	p.Require().NoError(err)
+
+	err = passwordResetToken.Use(time.Now().UTC().Add(2 * time.Hour))
+
+	p.EqualError(err, "password reset token is invalid or has expired")
+	p.Equal("ACTIVE", passwordResetToken.Status)
+}
+
+func TestPasswordResetToken(t *testing.T) {
+	suite.Run(t, new(PasswordResetTokenSuite))
+}
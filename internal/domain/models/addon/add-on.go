@@ -0,0 +1,53 @@
+package addon
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+type AddOn struct {
+	Id    uuid.UUID
+	Code  string
+	Name  string
+	Price uint64
+	Unit  string
+}
+
+func NewAddOn(code string, name string, price uint64, unit string) (AddOn, error) {
+	if !regexp.MustCompile(`^[A-Z0-9_]{2,50}$`).MatchString(code) {
+		return AddOn{}, errors.New("add-on code must have 2 to 50 uppercase letters, digits or underscores (e.g. LATE_CHECK_OUT)")
+	}
+
+	if len(name) < 3 {
+		return AddOn{}, errors.New("add-on name must be at least 3 characters long")
+	}
+
+	if price <= 0 {
+		return AddOn{}, errors.New("invalid add-on price. Please enter a value greater than zero")
+	}
+
+	if unit != "PER_STAY" && unit != "PER_NIGHT" && unit != "PER_PERSON_PER_NIGHT" {
+		return AddOn{}, errors.New("add-on unit must be PER_STAY, PER_NIGHT or PER_PERSON_PER_NIGHT")
+	}
+
+	return AddOn{
+		Id:    uuid.New(),
+		Code:  code,
+		Name:  name,
+		Price: price,
+		Unit:  unit,
+	}, nil
+}
+
+func (a AddOn) Charge(quantity uint8, nights int, persons int) uint64 {
+	switch a.Unit {
+	case "PER_NIGHT":
+		return a.Price * uint64(quantity) * uint64(nights)
+	case "PER_PERSON_PER_NIGHT":
+		return a.Price * uint64(quantity) * uint64(nights) * uint64(persons)
+	default:
+		return a.Price * uint64(quantity)
+	}
+}
@@ -0,0 +1,63 @@
+package addon_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddOnSuite struct {
+	suite.Suite
+}
+
+func (a *AddOnSuite) TestNewAddOn_OnNoErrors_ReturnsAddOn() {
+	newAddOn, err := addon.NewAddOn("BREAKFAST", "Breakfast", 20, "PER_PERSON_PER_NIGHT")
+	a.Require().NoError(err)
+
+	a.Equal("BREAKFAST", newAddOn.Code)
+	a.Equal("Breakfast", newAddOn.Name)
+	a.Equal(uint64(20), newAddOn.Price)
+	a.Equal("PER_PERSON_PER_NIGHT", newAddOn.Unit)
+}
+
+func (a *AddOnSuite) TestNewAddOn_OnInvalidCode_ReturnsError() {
+	codes := []string{"", "a", "breakfast", "LATE CHECK OUT"}
+
+	for _, code := range codes {
+		_, err := addon.NewAddOn(code, "Breakfast", 20, "PER_STAY")
+		a.EqualError(err, "add-on code must have 2 to 50 uppercase letters, digits or underscores (e.g. LATE_CHECK_OUT)")
+	}
+}
+
+func (a *AddOnSuite) TestNewAddOn_OnInvalidName_ReturnsError() {
+	_, err := addon.NewAddOn("BREAKFAST", "B", 20, "PER_STAY")
+
+	a.EqualError(err, "add-on name must be at least 3 characters long")
+}
+
+func (a *AddOnSuite) TestNewAddOn_OnInvalidPrice_ReturnsError() {
+	_, err := addon.NewAddOn("BREAKFAST", "Breakfast", 0, "PER_STAY")
+
+	a.EqualError(err, "invalid add-on price. Please enter a value greater than zero")
+}
+
+func (a *AddOnSuite) TestNewAddOn_OnInvalidUnit_ReturnsError() {
+	_, err := addon.NewAddOn("BREAKFAST", "Breakfast", 20, "PER_WEEK")
+
+	a.EqualError(err, "add-on unit must be PER_STAY, PER_NIGHT or PER_PERSON_PER_NIGHT")
+}
+
+func (a *AddOnSuite) TestCharge_OnEachUnit_ReturnsTotalForTheStay() {
+	perStay := addon.AddOn{Code: "LATE_CHECK_OUT", Price: 30, Unit: "PER_STAY"}
+	perNight := addon.AddOn{Code: "PARKING", Price: 10, Unit: "PER_NIGHT"}
+	perPersonPerNight := addon.AddOn{Code: "BREAKFAST", Price: 15, Unit: "PER_PERSON_PER_NIGHT"}
+
+	a.Equal(uint64(30), perStay.Charge(1, 3, 2))
+	a.Equal(uint64(60), perNight.Charge(2, 3, 2))
+	a.Equal(uint64(90), perPersonPerNight.Charge(1, 3, 2))
+}
+
+func TestAddOn(t *testing.T) {
+	suite.Run(t, new(AddOnSuite))
+}
@@ -0,0 +1,127 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const keyPrefix = "hbk_"
+
+const prefixLength = 12
+
+// lastUsedPrecision bounds how often a key in constant use writes its last-used timestamp.
+const lastUsedPrecision = time.Minute
+
+// ApiKey lets a partner system call the API without a user. The plain key is "hbk_<prefix>_<secret>": the prefix is
+// stored as is so the key can be found and recognized in listings, while only a hash of the secret is kept.
+// Scopes are permissions, granted directly instead of through a role.
+type ApiKey struct {
+	Id                   uuid.UUID
+	Name                 string
+	Prefix               string
+	HashedSecret         string
+	Scopes               []string
+	CreatedByStaffUserId uuid.UUID
+	// ExpiresAt, LastUsedAt and RevokedAt are zero when the key never expires, was never used or is not revoked.
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+	CreatedAt  time.Time
+}
+
+func NewApiKey(name string, scopes []string, expiresAt time.Time, createdByStaffUserId uuid.UUID) (ApiKey, string, error) {
+	name = strings.TrimSpace(name)
+
+	if len(name) < 3 || len(name) > 100 {
+		return ApiKey{}, "", errors.New("api key name must be between 3 and 100 characters long")
+	}
+
+	if len(scopes) == 0 {
+		return ApiKey{}, "", errors.New("api key must have at least one scope")
+	}
+
+	now := time.Now().UTC()
+
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return ApiKey{}, "", errors.New("api key expiry must be in the future")
+	}
+
+	random := make([]byte, prefixLength/2+32)
+	_, err := rand.Read(random)
+	if err != nil {
+		return ApiKey{}, "", err
+	}
+
+	prefix := hex.EncodeToString(random[:prefixLength/2])
+	secret := base64.RawURLEncoding.EncodeToString(random[prefixLength/2:])
+
+	sortedScopes := slices.Clone(scopes)
+	slices.Sort(sortedScopes)
+
+	return ApiKey{
+		Id:                   uuid.New(),
+		Name:                 name,
+		Prefix:               prefix,
+		HashedSecret:         HashApiKeySecret(secret),
+		Scopes:               slices.Compact(sortedScopes),
+		CreatedByStaffUserId: createdByStaffUserId,
+		ExpiresAt:            expiresAt.UTC(),
+		CreatedAt:            now,
+	}, keyPrefix + prefix + "_" + secret, nil
+}
+
+// SplitApiKey returns the prefix and the secret of a plain key, or false when the value is not shaped like one.
+func SplitApiKey(plainKey string) (string, string, bool) {
+	rest, found := strings.CutPrefix(plainKey, keyPrefix)
+	if !found || len(rest) < prefixLength+2 || rest[prefixLength] != '_' {
+		return "", "", false
+	}
+
+	return rest[:prefixLength], rest[prefixLength+1:], true
+}
+
+func HashApiKeySecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// Verify checks the secret in constant time and that the key is still usable.
+func (a ApiKey) Verify(secret string, now time.Time) error {
+	if subtle.ConstantTimeCompare([]byte(HashApiKeySecret(secret)), []byte(a.HashedSecret)) != 1 ||
+		a.IsRevoked() || a.IsExpired(now) {
+		return errors.New("api key is invalid, expired or revoked")
+	}
+
+	return nil
+}
+
+func (a ApiKey) IsRevoked() bool {
+	return !a.RevokedAt.IsZero()
+}
+
+func (a ApiKey) IsExpired(now time.Time) bool {
+	return !a.ExpiresAt.IsZero() && !a.ExpiresAt.After(now)
+}
+
+func (a *ApiKey) Revoke(now time.Time) error {
+	if a.IsRevoked() {
+		return errors.New("api key is already revoked")
+	}
+
+	a.RevokedAt = now.UTC()
+	return nil
+}
+
+// ShouldRecordUse reports whether the last-used timestamp is stale enough to be written again.
+func (a ApiKey) ShouldRecordUse(now time.Time) bool {
+	return a.LastUsedAt.IsZero() || now.Sub(a.LastUsedAt) >= lastUsedPrecision
+}
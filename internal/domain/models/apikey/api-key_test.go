@@ -0,0 +1,76 @@
+package apikey_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+	"github.com/stretchr/testify/suite"
+)
+
+type ApiKeySuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (a *ApiKeySuite) SetupTest() {
+	a.now = time.Now().UTC()
+}
+
+func (a *ApiKeySuite) TestNewApiKey_OnNoErrors_ReturnsKeyThatVerifiesItsOwnSecret() {
+	apiKey, plainKey, err := apikey.NewApiKey("Channel manager", []string{"rooms:read", "bookings:read:any",
+		"rooms:read"}, a.now.Add(time.Hour), uuid.New())
+	a.Require().NoError(err)
+
+	prefix, secret, ok := apikey.SplitApiKey(plainKey)
+	a.Require().True(ok)
+	a.Equal(apiKey.Prefix, prefix)
+	a.NotContains(apiKey.HashedSecret, secret)
+	a.Equal([]string{"bookings:read:any", "rooms:read"}, apiKey.Scopes)
+	a.NoError(apiKey.Verify(secret, a.now))
+	a.EqualError(apiKey.Verify(secret+"x", a.now), "api key is invalid, expired or revoked")
+}
+
+func (a *ApiKeySuite) TestNewApiKey_OnPastExpiry_ReturnsError() {
+	_, _, err := apikey.NewApiKey("Channel manager", []string{"rooms:read"}, a.now.Add(-time.Minute), uuid.New())
+
+	a.EqualError(err, "api key expiry must be in the future")
+}
+
+func (a *ApiKeySuite) TestNewApiKey_OnNoScopes_ReturnsError() {
+	_, _, err := apikey.NewApiKey("Channel manager", nil, time.Time{}, uuid.New())
+
+	a.EqualError(err, "api key must have at least one scope")
+}
+
+func (a *ApiKeySuite) TestVerify_OnExpiredOrRevokedKey_ReturnsError() {
+	apiKey, plainKey, err := apikey.NewApiKey("Channel manager", []string{"rooms:read"}, a.now.Add(time.Hour), uuid.New())
+	a.Require().NoError(err)
+	_, secret, _ := apikey.SplitApiKey(plainKey)
+
+	a.EqualError(apiKey.Verify(secret, a.now.Add(time.Hour)), "api key is invalid, expired or revoked")
+
+	a.Require().NoError(apiKey.Revoke(a.now))
+	a.EqualError(apiKey.Verify(secret, a.now), "api key is invalid, expired or revoked")
+	a.EqualError(apiKey.Revoke(a.now), "api key is already revoked")
+}
+
+func (a *ApiKeySuite) TestSplitApiKey_OnMalformedKey_ReturnsFalse() {
+	for _, plainKey := range []string{"", "hbk_", "hbk_0123456789ab", "hbk_0123456789abXsecret", "abc_0123456789ab_secret"} {
+		_, _, ok := apikey.SplitApiKey(plainKey)
+		a.False(ok, plainKey)
+	}
+}
+
+func (a *ApiKeySuite) TestShouldRecordUse_OnRecentUse_ReturnsFalse() {
+	apiKey := apikey.ApiKey{LastUsedAt: a.now.Add(-30 * time.Second)}
+
+	a.False(apiKey.ShouldRecordUse(a.now))
+	a.True(apiKey.ShouldRecordUse(a.now.Add(time.Minute)))
+	a.True(apikey.ApiKey{}.ShouldRecordUse(a.now))
+}
+
+func TestApiKey(t *testing.T) {
+	suite.Run(t, new(ApiKeySuite))
+}
@@ -0,0 +1,126 @@
+package booking
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+)
+
+type BookingAddOn struct {
+	Code     string
+	Quantity uint8
+	Price    uint64
+}
+
+type Booking struct {
+	Id         uuid.UUID
+	CustomerId uuid.UUID
+	RoomIds    []uuid.UUID
+	CheckIn    time.Time
+	CheckOut   time.Time
+	Guests     guests.Guests
+	AddOns     []BookingAddOn
+	PackageId  uuid.UUID
+	PromoCode  string
+	TotalPrice uint64
+	Status     string
+}
+
+func NewBooking(customerId uuid.UUID, roomIds []uuid.UUID, checkIn time.Time, checkOut time.Time, stayGuests guests.Guests,
+	addOns []BookingAddOn, packageId uuid.UUID, promoCode string, totalPrice uint64) (Booking, error) {
+	if len(roomIds) == 0 {
+		return Booking{}, errors.New("at least one room must be selected")
+	}
+
+	if !checkOut.After(checkIn) {
+		return Booking{}, errors.New("check-out date must be after check-in date")
+	}
+
+	if stayGuests.Adults <= 0 {
+		return Booking{}, errors.New("at least one adult is required")
+	}
+
+	return Booking{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		RoomIds:    roomIds,
+		CheckIn:    checkIn,
+		CheckOut:   checkOut,
+		Guests:     stayGuests,
+		AddOns:     addOns,
+		PackageId:  packageId,
+		PromoCode:  promoCode,
+		TotalPrice: totalPrice,
+		Status:     "CONFIRMED",
+	}, nil
+}
+
+func (b *Booking) Cancel() {
+	b.Status = "CANCELLED"
+}
+
+func (b *Booking) RequestCancellation() error {
+	if b.Status != "CONFIRMED" {
+		return errors.New("only confirmed bookings can be cancelled")
+	}
+
+	b.Status = "CANCELLED"
+
+	return nil
+}
+
+// Shorten moves the check-out earlier and scales the total price by how the current prices of the shortened and the
+// full stay compare, so that nights priced differently and per-stay charges are accounted for while any discount the
+// stay was booked with is kept. Without current prices the total is prorated by nights.
+func (b *Booking) Shorten(newCheckOut time.Time, shortenedStayPrice uint64, fullStayPrice uint64) error {
+	if b.Status != "CONFIRMED" {
+		return errors.New("only confirmed bookings can be shortened")
+	}
+
+	if !newCheckOut.After(b.CheckIn) {
+		return errors.New("check-out date must be after check-in date")
+	}
+
+	if !newCheckOut.Before(b.CheckOut) {
+		return errors.New("new check-out date must be before the current check-out date")
+	}
+
+	if fullStayPrice == 0 || shortenedStayPrice > fullStayPrice {
+		fullStayPrice = uint64(b.CheckOut.Sub(b.CheckIn).Hours() / 24)
+		shortenedStayPrice = uint64(newCheckOut.Sub(b.CheckIn).Hours() / 24)
+	}
+
+	b.TotalPrice = b.TotalPrice * shortenedStayPrice / fullStayPrice
+	b.CheckOut = newCheckOut
+
+	return nil
+}
+
+func (b *Booking) CheckOutStay() error {
+	if b.Status != "CONFIRMED" {
+		return errors.New("only confirmed bookings can be checked out")
+	}
+
+	b.Status = "CHECKED_OUT"
+
+	return nil
+}
+
+// MarkPaymentFailed cancels a confirmed booking whose payment failed; stays that already ended are left as they are.
+func (b *Booking) MarkPaymentFailed() {
+	if b.Status != "CONFIRMED" {
+		return
+	}
+
+	b.Status = "CANCELLED"
+}
+
+func (b *Booking) MarkPaymentDisputed() {
+	if b.Status == "CANCELLED" {
+		return
+	}
+
+	b.Status = "PAYMENT_DISPUTED"
+}
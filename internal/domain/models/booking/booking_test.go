@@ -0,0 +1,139 @@
+package booking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/stretchr/testify/suite"
+)
+
+type BookingSuite struct {
+	suite.Suite
+	customerId uuid.UUID
+	roomIds    []uuid.UUID
+	checkIn    time.Time
+	checkOut   time.Time
+	guests     guests.Guests
+}
+
+func (b *BookingSuite) SetupTest() {
+	b.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	b.roomIds = []uuid.UUID{uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")}
+	b.checkIn = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	b.checkOut = time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)
+	b.guests = guests.Guests{Adults: 2, ChildrenAges: []uint8{5}}
+}
+
+func (b *BookingSuite) TestNewBooking_OnNoErrors_ReturnsConfirmedBooking() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, b.guests,
+		[]booking.BookingAddOn{{Code: "BREAKFAST", Quantity: 1, Price: 90}}, uuid.Nil, "SUMMER", 450)
+	b.Require().NoError(err)
+
+	b.NotEqual(uuid.Nil, newBooking.Id)
+	b.Equal(b.customerId, newBooking.CustomerId)
+	b.Equal(b.roomIds, newBooking.RoomIds)
+	b.Equal(b.checkIn, newBooking.CheckIn)
+	b.Equal(b.checkOut, newBooking.CheckOut)
+	b.Equal(b.guests, newBooking.Guests)
+	b.Equal([]booking.BookingAddOn{{Code: "BREAKFAST", Quantity: 1, Price: 90}}, newBooking.AddOns)
+	b.Equal("SUMMER", newBooking.PromoCode)
+	b.Equal(uint64(450), newBooking.TotalPrice)
+	b.Equal("CONFIRMED", newBooking.Status)
+}
+
+func (b *BookingSuite) TestNewBooking_OnNoRooms_ReturnsError() {
+	_, err := booking.NewBooking(b.customerId, []uuid.UUID{}, b.checkIn, b.checkOut, b.guests, nil, uuid.Nil, "", 450)
+
+	b.EqualError(err, "at least one room must be selected")
+}
+
+func (b *BookingSuite) TestNewBooking_OnCheckOutNotAfterCheckIn_ReturnsError() {
+	_, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkIn, b.guests, nil, uuid.Nil, "", 450)
+
+	b.EqualError(err, "check-out date must be after check-in date")
+}
+
+func (b *BookingSuite) TestNewBooking_OnNoAdults_ReturnsError() {
+	_, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, guests.Guests{}, nil, uuid.Nil, "", 450)
+
+	b.EqualError(err, "at least one adult is required")
+}
+
+func (b *BookingSuite) TestMarkPaymentDisputed_OnCancelledBooking_KeepsItCancelled() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, b.guests, nil, uuid.Nil, "", 450)
+	b.Require().NoError(err)
+
+	newBooking.MarkPaymentDisputed()
+	b.Equal("PAYMENT_DISPUTED", newBooking.Status)
+
+	newBooking.Cancel()
+	newBooking.MarkPaymentDisputed()
+	b.Equal("CANCELLED", newBooking.Status)
+}
+
+func (b *BookingSuite) TestMarkPaymentFailed_OnCheckedOutBooking_KeepsItCheckedOut() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, b.guests, nil, uuid.Nil, "", 450)
+	b.Require().NoError(err)
+	b.Require().NoError(newBooking.CheckOutStay())
+
+	newBooking.MarkPaymentFailed()
+	b.Equal("CHECKED_OUT", newBooking.Status)
+}
+
+func (b *BookingSuite) TestRequestCancellation_OnCancelledBooking_ReturnsError() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, b.guests, nil, uuid.Nil, "", 450)
+	b.Require().NoError(err)
+
+	b.Require().NoError(newBooking.RequestCancellation())
+	b.Equal("CANCELLED", newBooking.Status)
+
+	b.EqualError(newBooking.RequestCancellation(), "only confirmed bookings can be cancelled")
+}
+
+func (b *BookingSuite) TestShorten_OnEarlierCheckOut_ScalesTotalPriceByCurrentPrices() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkIn.AddDate(0, 0, 4), b.guests, nil,
+		uuid.Nil, "", 720)
+	b.Require().NoError(err)
+
+	err = newBooking.Shorten(b.checkIn.AddDate(0, 0, 1), 300, 900)
+	b.Require().NoError(err)
+
+	b.Equal(b.checkIn.AddDate(0, 0, 1), newBooking.CheckOut)
+	b.Equal(uint64(240), newBooking.TotalPrice)
+}
+
+func (b *BookingSuite) TestShorten_WithoutCurrentPrices_ProratesTotalPriceByNights() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkIn.AddDate(0, 0, 4), b.guests, nil,
+		uuid.Nil, "", 800)
+	b.Require().NoError(err)
+
+	err = newBooking.Shorten(b.checkIn.AddDate(0, 0, 1), 0, 0)
+	b.Require().NoError(err)
+
+	b.Equal(uint64(200), newBooking.TotalPrice)
+}
+
+func (b *BookingSuite) TestShorten_OnInvalidCheckOut_ReturnsError() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, b.guests, nil, uuid.Nil, "", 450)
+	b.Require().NoError(err)
+
+	b.EqualError(newBooking.Shorten(b.checkOut, 0, 0), "new check-out date must be before the current check-out date")
+	b.EqualError(newBooking.Shorten(b.checkIn, 0, 0), "check-out date must be after check-in date")
+}
+
+func (b *BookingSuite) TestCheckOutStay_OnConfirmedBooking_ChecksOut() {
+	newBooking, err := booking.NewBooking(b.customerId, b.roomIds, b.checkIn, b.checkOut, b.guests, nil, uuid.Nil, "", 450)
+	b.Require().NoError(err)
+
+	b.Require().NoError(newBooking.CheckOutStay())
+	b.Equal("CHECKED_OUT", newBooking.Status)
+
+	b.EqualError(newBooking.CheckOutStay(), "only confirmed bookings can be checked out")
+}
+
+func TestBooking(t *testing.T) {
+	suite.Run(t, new(BookingSuite))
+}
@@ -0,0 +1,99 @@
+package bundle
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PackageComponent struct {
+	AddOnCode string
+	Quantity  uint8
+}
+
+type Package struct {
+	Id            uuid.UUID
+	Code          string
+	Name          string
+	RoomType      string
+	Components    []PackageComponent
+	PricePerNight uint64
+	ValidFrom     time.Time
+	ValidUntil    time.Time
+	MinStayNights uint8
+	Active        bool
+}
+
+func NewPackage(code string, name string, roomType string, components []PackageComponent, pricePerNight uint64,
+	validFrom time.Time, validUntil time.Time, minStayNights uint8) (Package, error) {
+	if !regexp.MustCompile(`^[A-Z0-9_]{2,50}$`).MatchString(code) {
+		return Package{}, errors.New("package code must have 2 to 50 uppercase letters, digits or underscores (e.g. ROMANTIC_WEEKEND)")
+	}
+
+	if len(name) < 3 {
+		return Package{}, errors.New("package name must be at least 3 characters long")
+	}
+
+	if roomType != "SINGLE" && roomType != "DOUBLE" && roomType != "TWIN" && roomType != "SUITE" {
+		return Package{}, errors.New("room type must be SINGLE, DOUBLE, TWIN or SUITE")
+	}
+
+	if len(components) == 0 {
+		return Package{}, errors.New("a package must include at least one add-on")
+	}
+
+	for i, component := range components {
+		if component.Quantity <= 0 {
+			return Package{}, errors.New("package add-on quantity must be at least one")
+		}
+
+		for _, other := range components[:i] {
+			if other.AddOnCode == component.AddOnCode {
+				return Package{}, errors.New("each add-on can only be included once in a package")
+			}
+		}
+	}
+
+	if pricePerNight <= 0 {
+		return Package{}, errors.New("invalid package price. Please enter a value greater than zero")
+	}
+
+	if validUntil.Before(validFrom) {
+		return Package{}, errors.New("package valid until date must be on or after valid from date")
+	}
+
+	if minStayNights <= 0 {
+		return Package{}, errors.New("minimum stay must be at least one night")
+	}
+
+	return Package{
+		Id:            uuid.New(),
+		Code:          code,
+		Name:          name,
+		RoomType:      roomType,
+		Components:    components,
+		PricePerNight: pricePerNight,
+		ValidFrom:     validFrom,
+		ValidUntil:    validUntil,
+		MinStayNights: minStayNights,
+		Active:        true,
+	}, nil
+}
+
+func (p Package) CanBeBooked(checkIn time.Time, nights int) error {
+	if !p.Active || checkIn.Before(p.ValidFrom) || checkIn.After(p.ValidUntil) {
+		return errors.New("package is not available for the selected dates")
+	}
+
+	if nights < int(p.MinStayNights) {
+		return errors.New("the selected stay is shorter than the package minimum stay")
+	}
+
+	return nil
+}
+
+func (p *Package) Deactivate() {
+	p.Active = false
+}
@@ -0,0 +1,90 @@
+package bundle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/stretchr/testify/suite"
+)
+
+type PackageSuite struct {
+	suite.Suite
+	validFrom  time.Time
+	validUntil time.Time
+	components []bundle.PackageComponent
+}
+
+func (p *PackageSuite) SetupTest() {
+	p.validFrom = time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+	p.validUntil = time.Date(2030, 2, 28, 0, 0, 0, 0, time.UTC)
+	p.components = []bundle.PackageComponent{
+		{AddOnCode: "DINNER", Quantity: 1},
+		{AddOnCode: "SPA", Quantity: 2},
+	}
+}
+
+func (p *PackageSuite) TestNewPackage_OnNoErrors_ReturnsActivePackage() {
+	newPackage, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", p.components, 400, p.validFrom,
+		p.validUntil, 2)
+	p.Require().NoError(err)
+
+	p.Equal("ROMANTIC_WEEKEND", newPackage.Code)
+	p.Equal("Romantic weekend", newPackage.Name)
+	p.Equal("SUITE", newPackage.RoomType)
+	p.Equal(p.components, newPackage.Components)
+	p.Equal(uint64(400), newPackage.PricePerNight)
+	p.Equal(uint8(2), newPackage.MinStayNights)
+	p.True(newPackage.Active)
+}
+
+func (p *PackageSuite) TestNewPackage_OnNoComponents_ReturnsError() {
+	_, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", nil, 400, p.validFrom, p.validUntil, 2)
+
+	p.EqualError(err, "a package must include at least one add-on")
+}
+
+func (p *PackageSuite) TestNewPackage_OnRepeatedComponent_ReturnsError() {
+	components := append(p.components, bundle.PackageComponent{AddOnCode: "DINNER", Quantity: 1})
+
+	_, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", components, 400, p.validFrom, p.validUntil, 2)
+
+	p.EqualError(err, "each add-on can only be included once in a package")
+}
+
+func (p *PackageSuite) TestNewPackage_OnValidUntilBeforeValidFrom_ReturnsError() {
+	_, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", p.components, 400, p.validUntil, p.validFrom, 2)
+
+	p.EqualError(err, "package valid until date must be on or after valid from date")
+}
+
+func (p *PackageSuite) TestCanBeBooked_OnCheckInOutsideValidity_ReturnsError() {
+	newPackage, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", p.components, 400, p.validFrom,
+		p.validUntil, 2)
+	p.Require().NoError(err)
+
+	p.NoError(newPackage.CanBeBooked(p.validUntil, 2))
+	p.EqualError(newPackage.CanBeBooked(p.validUntil.AddDate(0, 0, 1), 2), "package is not available for the selected dates")
+}
+
+func (p *PackageSuite) TestCanBeBooked_OnStayShorterThanMinimum_ReturnsError() {
+	newPackage, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", p.components, 400, p.validFrom,
+		p.validUntil, 2)
+	p.Require().NoError(err)
+
+	p.EqualError(newPackage.CanBeBooked(p.validFrom, 1), "the selected stay is shorter than the package minimum stay")
+}
+
+func (p *PackageSuite) TestCanBeBooked_OnDeactivatedPackage_ReturnsError() {
+	newPackage, err := bundle.NewPackage("ROMANTIC_WEEKEND", "Romantic weekend", "SUITE", p.components, 400, p.validFrom,
+		p.validUntil, 2)
+	p.Require().NoError(err)
+
+	newPackage.Deactivate()
+
+	p.EqualError(newPackage.CanBeBooked(p.validFrom, 2), "package is not available for the selected dates")
+}
+
+func TestPackage(t *testing.T) {
+	suite.Run(t, new(PackageSuite))
+}
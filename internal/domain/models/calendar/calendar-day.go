@@ -0,0 +1,32 @@
+package calendar
+
+import (
+	"errors"
+	"time"
+)
+
+const MAX_RANGE = 366 * 24 * time.Hour
+
+type CalendarDay struct {
+	Date           time.Time
+	TotalRooms     int
+	AvailableRooms int
+	LowestBaseRate uint64
+	Restriction    StayRestriction
+}
+
+func ValidateRange(roomType string, from time.Time, to time.Time) error {
+	if roomType != "SINGLE" && roomType != "DOUBLE" && roomType != "TWIN" && roomType != "SUITE" {
+		return errors.New("room type must be SINGLE, DOUBLE, TWIN or SUITE")
+	}
+
+	if to.Before(from) {
+		return errors.New("to date must be on or after from date")
+	}
+
+	if to.Sub(from) >= MAX_RANGE {
+		return errors.New("date range cannot exceed 366 days")
+	}
+
+	return nil
+}
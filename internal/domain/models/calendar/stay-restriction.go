@@ -0,0 +1,39 @@
+package calendar
+
+import (
+	"errors"
+	"time"
+)
+
+type StayRestriction struct {
+	RoomType          string
+	Date              time.Time
+	MinStayNights     uint8
+	ClosedToArrival   bool
+	ClosedToDeparture bool
+}
+
+func NewStayRestrictions(roomType string, from time.Time, to time.Time, minStayNights uint8, closedToArrival bool,
+	closedToDeparture bool) ([]StayRestriction, error) {
+	err := ValidateRange(roomType, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if minStayNights <= 0 {
+		return nil, errors.New("minimum stay must be at least one night")
+	}
+
+	restrictions := []StayRestriction{}
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		restrictions = append(restrictions, StayRestriction{
+			RoomType:          roomType,
+			Date:              date,
+			MinStayNights:     minStayNights,
+			ClosedToArrival:   closedToArrival,
+			ClosedToDeparture: closedToDeparture,
+		})
+	}
+
+	return restrictions, nil
+}
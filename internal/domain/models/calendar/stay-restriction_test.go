@@ -0,0 +1,60 @@
+package calendar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+	"github.com/stretchr/testify/suite"
+)
+
+type StayRestrictionSuite struct {
+	suite.Suite
+	from time.Time
+}
+
+func (s *StayRestrictionSuite) SetupTest() {
+	s.from = time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *StayRestrictionSuite) TestNewStayRestrictions_OnNoErrors_ReturnsOneRestrictionPerDate() {
+	restrictions, err := calendar.NewStayRestrictions("SUITE", s.from, s.from.AddDate(0, 0, 2), 3, true, false)
+	s.Require().NoError(err)
+
+	s.Len(restrictions, 3)
+	s.Equal(calendar.StayRestriction{
+		RoomType:          "SUITE",
+		Date:              s.from.AddDate(0, 0, 2),
+		MinStayNights:     3,
+		ClosedToArrival:   true,
+		ClosedToDeparture: false,
+	}, restrictions[2])
+}
+
+func (s *StayRestrictionSuite) TestNewStayRestrictions_OnInvalidRoomType_ReturnsError() {
+	_, err := calendar.NewStayRestrictions("PENTHOUSE", s.from, s.from, 1, false, false)
+
+	s.EqualError(err, "room type must be SINGLE, DOUBLE, TWIN or SUITE")
+}
+
+func (s *StayRestrictionSuite) TestNewStayRestrictions_OnToBeforeFrom_ReturnsError() {
+	_, err := calendar.NewStayRestrictions("SUITE", s.from, s.from.AddDate(0, 0, -1), 1, false, false)
+
+	s.EqualError(err, "to date must be on or after from date")
+}
+
+func (s *StayRestrictionSuite) TestNewStayRestrictions_OnRangeAboveOneYear_ReturnsError() {
+	_, err := calendar.NewStayRestrictions("SUITE", s.from, s.from.AddDate(0, 0, 366), 1, false, false)
+
+	s.EqualError(err, "date range cannot exceed 366 days")
+}
+
+func (s *StayRestrictionSuite) TestNewStayRestrictions_OnNoMinimumStay_ReturnsError() {
+	_, err := calendar.NewStayRestrictions("SUITE", s.from, s.from, 0, false, false)
+
+	s.EqualError(err, "minimum stay must be at least one night")
+}
+
+func TestStayRestriction(t *testing.T) {
+	suite.Run(t, new(StayRestrictionSuite))
+}
@@ -0,0 +1,76 @@
+package credit
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreditEntry struct {
+	Id           uuid.UUID
+	CustomerId   uuid.UUID
+	Type         string
+	Amount       int64
+	BalanceAfter uint64
+	Reference    uuid.UUID
+	Description  string
+	CreatedAt    time.Time
+}
+
+// NewCredit adds money to the customer credit balance, either from a redeemed gift card, a refund issued as
+// credit or a manual adjustment.
+func NewCredit(customerId uuid.UUID, creditType string, amount uint64, reference uuid.UUID, description string) (CreditEntry,
+	error) {
+	if creditType != "GIFT_CARD" && creditType != "REFUND" && creditType != "ADJUSTMENT" {
+		return CreditEntry{}, errors.New("credit type must be GIFT_CARD, REFUND or ADJUSTMENT")
+	}
+
+	return newCreditEntry(customerId, creditType, int64(amount), reference, description)
+}
+
+// NewDebit takes money from the customer credit balance to pay a booking.
+func NewDebit(customerId uuid.UUID, amount uint64, bookingId uuid.UUID) (CreditEntry, error) {
+	return newCreditEntry(customerId, "BOOKING_PAYMENT", -int64(amount), bookingId, "Booking payment")
+}
+
+func newCreditEntry(customerId uuid.UUID, entryType string, amount int64, reference uuid.UUID, description string) (CreditEntry,
+	error) {
+	if amount == 0 {
+		return CreditEntry{}, errors.New("credit amount must be greater than zero")
+	}
+
+	if len(strings.TrimSpace(description)) < 3 {
+		return CreditEntry{}, errors.New("credit description must be at least 3 characters long")
+	}
+
+	return CreditEntry{
+		Id:          uuid.New(),
+		CustomerId:  customerId,
+		Type:        entryType,
+		Amount:      amount,
+		Reference:   reference,
+		Description: strings.TrimSpace(description),
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// Apply appends the entry after the given balance. The ledger never goes negative.
+func (c *CreditEntry) Apply(balance uint64) error {
+	if c.Amount < 0 && uint64(-c.Amount) > balance {
+		return errors.New("insufficient credit balance")
+	}
+
+	c.BalanceAfter = uint64(int64(balance) + c.Amount)
+
+	return nil
+}
+
+func Balance(entries []CreditEntry) uint64 {
+	if len(entries) == 0 {
+		return 0
+	}
+
+	return entries[len(entries)-1].BalanceAfter
+}
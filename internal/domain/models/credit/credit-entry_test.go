@@ -0,0 +1,69 @@
+package credit_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreditEntrySuite struct {
+	suite.Suite
+	customerId uuid.UUID
+}
+
+func (c *CreditEntrySuite) SetupTest() {
+	c.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+}
+
+func (c *CreditEntrySuite) TestNewCredit_OnNoErrors_AddsToBalance() {
+	entry, err := credit.NewCredit(c.customerId, "REFUND", 120, uuid.Nil, " Late check-in compensation ")
+	c.Require().NoError(err)
+
+	c.Require().NoError(entry.Apply(30))
+	c.Equal(int64(120), entry.Amount)
+	c.Equal(uint64(150), entry.BalanceAfter)
+	c.Equal("Late check-in compensation", entry.Description)
+}
+
+func (c *CreditEntrySuite) TestNewCredit_OnInvalidType_ReturnsError() {
+	_, err := credit.NewCredit(c.customerId, "BOOKING_PAYMENT", 120, uuid.Nil, "Compensation")
+
+	c.EqualError(err, "credit type must be GIFT_CARD, REFUND or ADJUSTMENT")
+}
+
+func (c *CreditEntrySuite) TestNewCredit_OnZeroAmount_ReturnsError() {
+	_, err := credit.NewCredit(c.customerId, "ADJUSTMENT", 0, uuid.Nil, "Compensation")
+
+	c.EqualError(err, "credit amount must be greater than zero")
+}
+
+func (c *CreditEntrySuite) TestNewDebit_OnEnoughBalance_SubtractsFromBalance() {
+	bookingId := uuid.New()
+
+	entry, err := credit.NewDebit(c.customerId, 80, bookingId)
+	c.Require().NoError(err)
+
+	c.Require().NoError(entry.Apply(100))
+	c.Equal("BOOKING_PAYMENT", entry.Type)
+	c.Equal(int64(-80), entry.Amount)
+	c.Equal(uint64(20), entry.BalanceAfter)
+	c.Equal(bookingId, entry.Reference)
+}
+
+func (c *CreditEntrySuite) TestApply_OnInsufficientBalance_ReturnsError() {
+	entry, err := credit.NewDebit(c.customerId, 101, uuid.New())
+	c.Require().NoError(err)
+
+	c.EqualError(entry.Apply(100), "insufficient credit balance")
+}
+
+func (c *CreditEntrySuite) TestBalance_OnEntries_ReturnsLastBalance() {
+	c.Equal(uint64(0), credit.Balance(nil))
+	c.Equal(uint64(70), credit.Balance([]credit.CreditEntry{{BalanceAfter: 100}, {BalanceAfter: 70}}))
+}
+
+func TestCreditEntry(t *testing.T) {
+	suite.Run(t, new(CreditEntrySuite))
+}
@@ -0,0 +1,94 @@
+package credit
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// giftCardAlphabet leaves out characters that are easily confused when a code is typed from a printed card.
+const giftCardAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+type GiftCard struct {
+	Id         uuid.UUID
+	Code       string
+	Amount     uint64
+	Balance    uint64
+	Status     string
+	ExpiresAt  time.Time
+	RedeemedBy uuid.UUID
+	RedeemedAt time.Time
+	CreatedAt  time.Time
+}
+
+func NewGiftCard(amount uint64, expiresAt time.Time) (GiftCard, error) {
+	if amount <= 0 {
+		return GiftCard{}, errors.New("gift card amount must be greater than zero")
+	}
+
+	now := time.Now().UTC()
+
+	if !expiresAt.After(now) {
+		return GiftCard{}, errors.New("gift card expiry must be in the future")
+	}
+
+	return GiftCard{
+		Id:        uuid.New(),
+		Code:      newGiftCardCode(),
+		Amount:    amount,
+		Balance:   amount,
+		Status:    "ACTIVE",
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, nil
+}
+
+// Redeem moves the whole gift card balance into the customer credit ledger.
+func (g *GiftCard) Redeem(customerId uuid.UUID, now time.Time) (CreditEntry, error) {
+	if g.Status != "ACTIVE" {
+		return CreditEntry{}, errors.New("gift card has already been redeemed")
+	}
+
+	if !g.ExpiresAt.After(now) {
+		return CreditEntry{}, errors.New("gift card has expired")
+	}
+
+	entry, err := NewCredit(customerId, "GIFT_CARD", g.Balance, g.Id, "Gift card "+g.MaskedCode())
+	if err != nil {
+		return CreditEntry{}, err
+	}
+
+	g.Balance = 0
+	g.Status = "REDEEMED"
+	g.RedeemedBy = customerId
+	g.RedeemedAt = now
+
+	return entry, nil
+}
+
+func (g GiftCard) MaskedCode() string {
+	return "****-" + g.Code[len(g.Code)-4:]
+}
+
+func NormalizeGiftCardCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func newGiftCardCode() string {
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	var code strings.Builder
+	for i, value := range random {
+		if i > 0 && i%4 == 0 {
+			code.WriteByte('-')
+		}
+
+		code.WriteByte(giftCardAlphabet[int(value)%len(giftCardAlphabet)])
+	}
+
+	return code.String()
+}
@@ -0,0 +1,74 @@
+package credit_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/stretchr/testify/suite"
+)
+
+type GiftCardSuite struct {
+	suite.Suite
+	giftCard   credit.GiftCard
+	customerId uuid.UUID
+}
+
+func (g *GiftCardSuite) SetupTest() {
+	var err error
+	g.giftCard, err = credit.NewGiftCard(200, time.Now().UTC().AddDate(1, 0, 0))
+	g.Require().NoError(err)
+	g.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+}
+
+func (g *GiftCardSuite) TestNewGiftCard_OnNoErrors_ReturnsActiveGiftCard() {
+	g.Regexp(regexp.MustCompile(`^[A-Z2-9]{4}-[A-Z2-9]{4}-[A-Z2-9]{4}-[A-Z2-9]{4}$`), g.giftCard.Code)
+	g.Equal(uint64(200), g.giftCard.Balance)
+	g.Equal("ACTIVE", g.giftCard.Status)
+	g.Equal("****-"+g.giftCard.Code[15:], g.giftCard.MaskedCode())
+}
+
+func (g *GiftCardSuite) TestNewGiftCard_OnInvalidValues_ReturnsError() {
+	_, err := credit.NewGiftCard(0, time.Now().UTC().AddDate(1, 0, 0))
+	g.EqualError(err, "gift card amount must be greater than zero")
+
+	_, err = credit.NewGiftCard(100, time.Now().UTC().Add(-time.Minute))
+	g.EqualError(err, "gift card expiry must be in the future")
+}
+
+func (g *GiftCardSuite) TestRedeem_OnActiveGiftCard_ReturnsCredit() {
+	now := time.Now().UTC()
+
+	entry, err := g.giftCard.Redeem(g.customerId, now)
+	g.Require().NoError(err)
+
+	g.Equal("GIFT_CARD", entry.Type)
+	g.Equal(int64(200), entry.Amount)
+	g.Equal(g.giftCard.Id, entry.Reference)
+	g.Equal(g.customerId, entry.CustomerId)
+	g.Equal("REDEEMED", g.giftCard.Status)
+	g.Equal(uint64(0), g.giftCard.Balance)
+	g.Equal(g.customerId, g.giftCard.RedeemedBy)
+	g.Equal(now, g.giftCard.RedeemedAt)
+}
+
+func (g *GiftCardSuite) TestRedeem_OnRedeemedGiftCard_ReturnsError() {
+	_, err := g.giftCard.Redeem(g.customerId, time.Now().UTC())
+	g.Require().NoError(err)
+
+	_, err = g.giftCard.Redeem(uuid.New(), time.Now().UTC())
+
+	g.EqualError(err, "gift card has already been redeemed")
+}
+
+func (g *GiftCardSuite) TestRedeem_OnExpiredGiftCard_ReturnsError() {
+	_, err := g.giftCard.Redeem(g.customerId, g.giftCard.ExpiresAt)
+
+	g.EqualError(err, "gift card has expired")
+}
+
+func TestGiftCard(t *testing.T) {
+	suite.Run(t, new(GiftCardSuite))
+}
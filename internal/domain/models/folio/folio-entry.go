@@ -0,0 +1,104 @@
+package folio
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var chargeCategories = []string{"MINIBAR", "RESTAURANT", "SPA", "LAUNDRY", "OTHER"}
+var paymentMethods = []string{"CASH", "CARD", "BANK_TRANSFER"}
+
+type FolioEntry struct {
+	Id          uuid.UUID
+	BookingId   uuid.UUID
+	Type        string
+	Category    string
+	Description string
+	Amount      uint64
+	Status      string
+	VoidReason  string
+	PostedAt    time.Time
+}
+
+func NewCharge(bookingId uuid.UUID, category string, description string, amount uint64) (FolioEntry, error) {
+	if !slices.Contains(chargeCategories, category) {
+		return FolioEntry{}, errors.New("charge category must be MINIBAR, RESTAURANT, SPA, LAUNDRY or OTHER")
+	}
+
+	if strings.TrimSpace(description) == "" {
+		return FolioEntry{}, errors.New("charge description is required")
+	}
+
+	if amount <= 0 {
+		return FolioEntry{}, errors.New("charge amount must be greater than zero")
+	}
+
+	return FolioEntry{
+		Id:          uuid.New(),
+		BookingId:   bookingId,
+		Type:        "CHARGE",
+		Category:    category,
+		Description: strings.TrimSpace(description),
+		Amount:      amount,
+		Status:      "POSTED",
+		PostedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func NewPayment(bookingId uuid.UUID, method string, amount uint64) (FolioEntry, error) {
+	if !slices.Contains(paymentMethods, method) {
+		return FolioEntry{}, errors.New("payment method must be CASH, CARD or BANK_TRANSFER")
+	}
+
+	if amount <= 0 {
+		return FolioEntry{}, errors.New("payment amount must be greater than zero")
+	}
+
+	return FolioEntry{
+		Id:          uuid.New(),
+		BookingId:   bookingId,
+		Type:        "PAYMENT",
+		Category:    method,
+		Description: "Payment at front desk",
+		Amount:      amount,
+		Status:      "POSTED",
+		PostedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// NewCreditPayment records the part of the stay the customer paid from their stored credit balance.
+func NewCreditPayment(bookingId uuid.UUID, amount uint64) (FolioEntry, error) {
+	if amount <= 0 {
+		return FolioEntry{}, errors.New("payment amount must be greater than zero")
+	}
+
+	return FolioEntry{
+		Id:          uuid.New(),
+		BookingId:   bookingId,
+		Type:        "PAYMENT",
+		Category:    "CREDIT",
+		Description: "Paid with stored credit",
+		Amount:      amount,
+		Status:      "POSTED",
+		PostedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func (f *FolioEntry) Void(reason string) error {
+	if f.Status == "VOIDED" {
+		return errors.New("charge is already voided")
+	}
+
+	if len(strings.TrimSpace(reason)) < 3 {
+		return errors.New("voiding a charge requires a reason of at least 3 characters")
+	}
+
+	f.Status = "VOIDED"
+	f.VoidReason = strings.TrimSpace(reason)
+
+	return nil
+}
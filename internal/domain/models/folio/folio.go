@@ -0,0 +1,144 @@
+package folio
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type FolioLine struct {
+	EntryId     uuid.UUID
+	Type        string
+	Category    string
+	Description string
+	Amount      uint64
+	Status      string
+	PostedAt    time.Time
+	Balance     int64
+}
+
+type Folio struct {
+	BookingId     uuid.UUID
+	Lines         []FolioLine
+	TotalCharges  uint64
+	TotalPayments uint64
+	TotalRefunds  uint64
+	Balance       int64
+}
+
+// NewFolio builds the guest ledger of a stay. The room stay is the first charge, the captured deposit, paid
+// scheduled charges and front desk payments reduce the balance, approved refunds give money back and voided
+// entries are listed without affecting it.
+func NewFolio(stay booking.Booking, deposit *payment.Payment, scheduledCharges []payment.ScheduledCharge,
+	refunds []payment.Refund, entries []FolioEntry) Folio {
+	lines := []FolioLine{{
+		EntryId:     stay.Id,
+		Type:        "CHARGE",
+		Category:    "ROOM",
+		Description: "Room stay",
+		Amount:      stay.TotalPrice,
+		Status:      "POSTED",
+		PostedAt:    stay.CheckIn,
+	}}
+
+	if deposit != nil && deposit.CapturedAmount > 0 {
+		lines = append(lines, FolioLine{
+			EntryId:     deposit.Id,
+			Type:        "PAYMENT",
+			Category:    "DEPOSIT",
+			Description: "Deposit",
+			Amount:      deposit.CapturedAmount,
+			Status:      "POSTED",
+			PostedAt:    capturedAt(*deposit),
+		})
+	}
+
+	for _, scheduledCharge := range scheduledCharges {
+		if scheduledCharge.Status != "PAID" {
+			continue
+		}
+
+		lines = append(lines, FolioLine{
+			EntryId:     scheduledCharge.Id,
+			Type:        "PAYMENT",
+			Category:    "SCHEDULED_CHARGE",
+			Description: fmt.Sprintf("Scheduled charge #%d", scheduledCharge.Sequence),
+			Amount:      scheduledCharge.Amount,
+			Status:      "POSTED",
+			PostedAt:    scheduledCharge.DueOn,
+		})
+	}
+
+	for _, refund := range refunds {
+		if refund.Status != "APPROVED" {
+			continue
+		}
+
+		lines = append(lines, FolioLine{
+			EntryId:     refund.Id,
+			Type:        "REFUND",
+			Category:    refund.Type,
+			Description: refund.Reason,
+			Amount:      refund.Amount,
+			Status:      "POSTED",
+			PostedAt:    refund.CreatedAt,
+		})
+	}
+
+	for _, entry := range entries {
+		lines = append(lines, FolioLine{
+			EntryId:     entry.Id,
+			Type:        entry.Type,
+			Category:    entry.Category,
+			Description: entry.Description,
+			Amount:      entry.Amount,
+			Status:      entry.Status,
+			PostedAt:    entry.PostedAt,
+		})
+	}
+
+	slices.SortStableFunc(lines, func(a FolioLine, b FolioLine) int {
+		return a.PostedAt.Compare(b.PostedAt)
+	})
+
+	newFolio := Folio{BookingId: stay.Id}
+
+	for _, line := range lines {
+		if line.Status == "POSTED" {
+			switch line.Type {
+			case "CHARGE":
+				newFolio.TotalCharges += line.Amount
+				newFolio.Balance += int64(line.Amount)
+			case "PAYMENT":
+				newFolio.TotalPayments += line.Amount
+				newFolio.Balance -= int64(line.Amount)
+			case "REFUND":
+				newFolio.TotalRefunds += line.Amount
+				newFolio.Balance += int64(line.Amount)
+			}
+		}
+
+		line.Balance = newFolio.Balance
+		newFolio.Lines = append(newFolio.Lines, line)
+	}
+
+	return newFolio
+}
+
+func (f Folio) IsSettled() bool {
+	return f.Balance == 0
+}
+
+func capturedAt(deposit payment.Payment) time.Time {
+	for i := len(deposit.Attempts) - 1; i >= 0; i-- {
+		if deposit.Attempts[i].Operation == "CAPTURE" && deposit.Attempts[i].Status == "APPROVED" {
+			return deposit.Attempts[i].CreatedAt
+		}
+	}
+
+	return deposit.CaptureOn
+}
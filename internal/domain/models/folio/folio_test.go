@@ -0,0 +1,146 @@
+package folio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type FolioSuite struct {
+	suite.Suite
+	stay booking.Booking
+}
+
+func (f *FolioSuite) SetupTest() {
+	f.stay = booking.Booking{
+		Id:         uuid.MustParse("3f0c6d1b-2a4e-4c8f-9b7a-6d5e4c3b2a19"),
+		CheckIn:    time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 1, 12, 0, 0, 0, 0, time.UTC),
+		TotalPrice: 400,
+		Status:     "CONFIRMED",
+	}
+}
+
+func (f *FolioSuite) TestNewCharge_OnNoErrors_ReturnsPostedCharge() {
+	charge, err := folio.NewCharge(f.stay.Id, "MINIBAR", " Two sodas ", 12)
+	f.Require().NoError(err)
+
+	f.Equal(f.stay.Id, charge.BookingId)
+	f.Equal("CHARGE", charge.Type)
+	f.Equal("MINIBAR", charge.Category)
+	f.Equal("Two sodas", charge.Description)
+	f.Equal(uint64(12), charge.Amount)
+	f.Equal("POSTED", charge.Status)
+}
+
+func (f *FolioSuite) TestNewCharge_OnInvalidInput_ReturnsError() {
+	_, err := folio.NewCharge(f.stay.Id, "CASINO", "Chips", 12)
+	f.EqualError(err, "charge category must be MINIBAR, RESTAURANT, SPA, LAUNDRY or OTHER")
+
+	_, err = folio.NewCharge(f.stay.Id, "SPA", " ", 12)
+	f.EqualError(err, "charge description is required")
+
+	_, err = folio.NewCharge(f.stay.Id, "SPA", "Massage", 0)
+	f.EqualError(err, "charge amount must be greater than zero")
+}
+
+func (f *FolioSuite) TestNewPayment_OnInvalidMethod_ReturnsError() {
+	_, err := folio.NewPayment(f.stay.Id, "CHEQUE", 10)
+
+	f.EqualError(err, "payment method must be CASH, CARD or BANK_TRANSFER")
+}
+
+func (f *FolioSuite) TestNewCreditPayment_OnNoErrors_ReturnsPostedPayment() {
+	creditPayment, err := folio.NewCreditPayment(f.stay.Id, 150)
+	f.Require().NoError(err)
+
+	f.Equal("PAYMENT", creditPayment.Type)
+	f.Equal("CREDIT", creditPayment.Category)
+	f.Equal(uint64(150), creditPayment.Amount)
+	f.Equal("POSTED", creditPayment.Status)
+}
+
+func (f *FolioSuite) TestVoid_OnVoidedCharge_ReturnsError() {
+	charge, err := folio.NewCharge(f.stay.Id, "SPA", "Massage", 80)
+	f.Require().NoError(err)
+
+	f.EqualError(charge.Void(""), "voiding a charge requires a reason of at least 3 characters")
+	f.Require().NoError(charge.Void("posted to the wrong room"))
+	f.Equal("VOIDED", charge.Status)
+	f.Equal("posted to the wrong room", charge.VoidReason)
+	f.EqualError(charge.Void("again"), "charge is already voided")
+}
+
+func (f *FolioSuite) TestNewFolio_OnChargesPaymentsAndRefunds_ComputesRunningBalance() {
+	deposit := payment.Payment{
+		Id:             uuid.MustParse("a1b2c3d4-1111-4222-8333-444455556666"),
+		CapturedAmount: 100,
+		CaptureOn:      time.Date(2030, 1, 3, 0, 0, 0, 0, time.UTC),
+		Status:         "CAPTURED",
+	}
+	refunds := []payment.Refund{
+		{Id: uuid.New(), Amount: 20, Type: "MANUAL", Reason: "noisy room", Status: "APPROVED",
+			CreatedAt: time.Date(2030, 1, 11, 8, 0, 0, 0, time.UTC)},
+		{Id: uuid.New(), Amount: 30, Type: "MANUAL", Reason: "declined", Status: "DECLINED",
+			CreatedAt: time.Date(2030, 1, 11, 9, 0, 0, 0, time.UTC)},
+	}
+	minibar := folio.FolioEntry{Id: uuid.New(), Type: "CHARGE", Category: "MINIBAR", Description: "Sodas", Amount: 12,
+		Status: "POSTED", PostedAt: time.Date(2030, 1, 10, 20, 0, 0, 0, time.UTC)}
+	voidedSpa := folio.FolioEntry{Id: uuid.New(), Type: "CHARGE", Category: "SPA", Description: "Massage", Amount: 80,
+		Status: "VOIDED", PostedAt: time.Date(2030, 1, 11, 10, 0, 0, 0, time.UTC)}
+	cash := folio.FolioEntry{Id: uuid.New(), Type: "PAYMENT", Category: "CASH", Description: "Payment at front desk",
+		Amount: 200, Status: "POSTED", PostedAt: time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC)}
+
+	guestFolio := folio.NewFolio(f.stay, &deposit, nil, refunds, []folio.FolioEntry{cash, voidedSpa, minibar})
+
+	f.Len(guestFolio.Lines, 6)
+	f.Equal("DEPOSIT", guestFolio.Lines[0].Category)
+	f.Equal(int64(-100), guestFolio.Lines[0].Balance)
+	f.Equal("ROOM", guestFolio.Lines[1].Category)
+	f.Equal(int64(300), guestFolio.Lines[1].Balance)
+	f.Equal("MINIBAR", guestFolio.Lines[2].Category)
+	f.Equal(int64(312), guestFolio.Lines[2].Balance)
+	f.Equal("REFUND", guestFolio.Lines[3].Type)
+	f.Equal(int64(332), guestFolio.Lines[3].Balance)
+	f.Equal("VOIDED", guestFolio.Lines[4].Status)
+	f.Equal(int64(332), guestFolio.Lines[4].Balance)
+	f.Equal(int64(132), guestFolio.Lines[5].Balance)
+	f.Equal(uint64(412), guestFolio.TotalCharges)
+	f.Equal(uint64(300), guestFolio.TotalPayments)
+	f.Equal(uint64(20), guestFolio.TotalRefunds)
+	f.Equal(int64(132), guestFolio.Balance)
+	f.False(guestFolio.IsSettled())
+}
+
+func (f *FolioSuite) TestNewFolio_OnFullyPaidStay_IsSettled() {
+	cash := folio.FolioEntry{Id: uuid.New(), Type: "PAYMENT", Category: "CARD", Amount: 400, Status: "POSTED",
+		PostedAt: time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC)}
+
+	guestFolio := folio.NewFolio(f.stay, nil, nil, nil, []folio.FolioEntry{cash})
+
+	f.True(guestFolio.IsSettled())
+}
+
+func (f *FolioSuite) TestNewFolio_OnPaidScheduledCharges_PostsThemAsPayments() {
+	scheduledCharges := []payment.ScheduledCharge{
+		{Id: uuid.New(), Sequence: 1, Amount: 100, DueOn: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Status: "PAID"},
+		{Id: uuid.New(), Sequence: 2, Amount: 300, DueOn: time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), Status: "SCHEDULED"},
+	}
+
+	guestFolio := folio.NewFolio(f.stay, nil, scheduledCharges, nil, nil)
+
+	f.Len(guestFolio.Lines, 2)
+	f.Equal("SCHEDULED_CHARGE", guestFolio.Lines[0].Category)
+	f.Equal("Scheduled charge #1", guestFolio.Lines[0].Description)
+	f.Equal(uint64(100), guestFolio.TotalPayments)
+	f.Equal(int64(300), guestFolio.Balance)
+}
+
+func TestFolio(t *testing.T) {
+	suite.Run(t, new(FolioSuite))
+}
@@ -0,0 +1,37 @@
+package guests
+
+import "errors"
+
+type Guests struct {
+	Adults       uint8
+	ChildrenAges []uint8
+}
+
+func NewGuests(adults uint8, childrenAges []uint8) (Guests, error) {
+	if adults <= 0 {
+		return Guests{}, errors.New("at least one adult is required")
+	}
+
+	for _, age := range childrenAges {
+		if age >= 18 {
+			return Guests{}, errors.New("children ages must be between 0 and 17")
+		}
+	}
+
+	if childrenAges == nil {
+		childrenAges = []uint8{}
+	}
+
+	return Guests{
+		Adults:       adults,
+		ChildrenAges: childrenAges,
+	}, nil
+}
+
+func (g Guests) Total() int {
+	return int(g.Adults) + len(g.ChildrenAges)
+}
+
+func (g Guests) Children() int {
+	return len(g.ChildrenAges)
+}
@@ -0,0 +1,46 @@
+package guests_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/stretchr/testify/suite"
+)
+
+type GuestsSuite struct {
+	suite.Suite
+}
+
+func (g *GuestsSuite) TestNewGuests_OnNoErrors_ReturnsGuests() {
+	newGuests, err := guests.NewGuests(2, []uint8{4, 12})
+	g.Require().NoError(err)
+
+	g.Equal(uint8(2), newGuests.Adults)
+	g.Equal([]uint8{4, 12}, newGuests.ChildrenAges)
+	g.Equal(2, newGuests.Children())
+	g.Equal(4, newGuests.Total())
+}
+
+func (g *GuestsSuite) TestNewGuests_OnNoChildren_ReturnsEmptyChildrenAges() {
+	newGuests, err := guests.NewGuests(1, nil)
+	g.Require().NoError(err)
+
+	g.Equal([]uint8{}, newGuests.ChildrenAges)
+	g.Equal(1, newGuests.Total())
+}
+
+func (g *GuestsSuite) TestNewGuests_OnNoAdults_ReturnsError() {
+	_, err := guests.NewGuests(0, []uint8{4})
+
+	g.EqualError(err, "at least one adult is required")
+}
+
+func (g *GuestsSuite) TestNewGuests_OnChildAgedEighteenOrMore_ReturnsError() {
+	_, err := guests.NewGuests(1, []uint8{4, 18})
+
+	g.EqualError(err, "children ages must be between 0 and 17")
+}
+
+func TestGuests(t *testing.T) {
+	suite.Run(t, new(GuestsSuite))
+}
@@ -0,0 +1,201 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+)
+
+type BillingDetails struct {
+	Name    string
+	TaxId   string
+	Address string
+	Email   string
+}
+
+type InvoiceLine struct {
+	Category    string
+	Description string
+	TaxRate     uint8
+	NetAmount   int64
+	TaxAmount   int64
+	GrossAmount int64
+}
+
+type TaxBreakdown struct {
+	TaxRate     uint8
+	NetAmount   int64
+	TaxAmount   int64
+	GrossAmount int64
+}
+
+type Invoice struct {
+	Id                uuid.UUID
+	Number            string
+	PropertyCode      string
+	Type              string
+	BookingId         uuid.UUID
+	CorrectsInvoiceId uuid.UUID
+	Reason            string
+	BillTo            BillingDetails
+	Lines             []InvoiceLine
+	TaxBreakdown      []TaxBreakdown
+	NetTotal          int64
+	TaxTotal          int64
+	GrossTotal        int64
+	AmountPaid        int64
+	AmountDue         int64
+	IssuedAt          time.Time
+}
+
+// NewInvoice bills the posted charges of a closed folio. Prices are tax inclusive, so each line is split into
+// its net and tax parts using the rate of its category.
+func NewInvoice(propertyCode string, guestFolio folio.Folio, billTo BillingDetails, taxRates TaxRates) (Invoice, error) {
+	if strings.TrimSpace(billTo.Name) == "" {
+		return Invoice{}, errors.New("billing name is required")
+	}
+
+	lines := []InvoiceLine{}
+	for _, line := range guestFolio.Lines {
+		if line.Type != "CHARGE" || line.Status != "POSTED" {
+			continue
+		}
+
+		taxRate := taxRates.RateFor(line.Category)
+		grossAmount := int64(line.Amount)
+		netAmount := (grossAmount*100 + int64(100+taxRate)/2) / int64(100+taxRate)
+
+		lines = append(lines, InvoiceLine{
+			Category:    line.Category,
+			Description: line.Description,
+			TaxRate:     taxRate,
+			NetAmount:   netAmount,
+			TaxAmount:   grossAmount - netAmount,
+			GrossAmount: grossAmount,
+		})
+	}
+
+	newInvoice := Invoice{
+		Id:           uuid.New(),
+		PropertyCode: propertyCode,
+		Type:         "INVOICE",
+		BookingId:    guestFolio.BookingId,
+		BillTo: BillingDetails{
+			Name:    strings.TrimSpace(billTo.Name),
+			TaxId:   strings.TrimSpace(billTo.TaxId),
+			Address: strings.TrimSpace(billTo.Address),
+			Email:   strings.TrimSpace(billTo.Email),
+		},
+		Lines:      lines,
+		AmountPaid: int64(guestFolio.TotalPayments) - int64(guestFolio.TotalRefunds),
+		AmountDue:  guestFolio.Balance,
+		IssuedAt:   time.Now().UTC(),
+	}
+	newInvoice.computeTotals()
+
+	return newInvoice, nil
+}
+
+// NewCreditNote fully reverses an issued invoice. A corrected invoice can then be issued for the same booking.
+func NewCreditNote(original Invoice, reason string) (Invoice, error) {
+	if original.Type != "INVOICE" {
+		return Invoice{}, errors.New("only invoices can be credited")
+	}
+
+	if len(strings.TrimSpace(reason)) < 3 {
+		return Invoice{}, errors.New("credit notes require a reason of at least 3 characters")
+	}
+
+	lines := []InvoiceLine{}
+	for _, line := range original.Lines {
+		lines = append(lines, InvoiceLine{
+			Category:    line.Category,
+			Description: line.Description,
+			TaxRate:     line.TaxRate,
+			NetAmount:   -line.NetAmount,
+			TaxAmount:   -line.TaxAmount,
+			GrossAmount: -line.GrossAmount,
+		})
+	}
+
+	creditNote := Invoice{
+		Id:                uuid.New(),
+		PropertyCode:      original.PropertyCode,
+		Type:              "CREDIT_NOTE",
+		BookingId:         original.BookingId,
+		CorrectsInvoiceId: original.Id,
+		Reason:            strings.TrimSpace(reason),
+		BillTo:            original.BillTo,
+		Lines:             lines,
+		AmountPaid:        -original.AmountPaid,
+		AmountDue:         -original.AmountDue,
+		IssuedAt:          time.Now().UTC(),
+	}
+	creditNote.computeTotals()
+
+	return creditNote, nil
+}
+
+func (i *Invoice) AssignNumber(sequence uint64) {
+	prefix := "INV"
+	if i.Type == "CREDIT_NOTE" {
+		prefix = "CN"
+	}
+
+	i.Number = fmt.Sprintf("%s-%s-%06d", i.PropertyCode, prefix, sequence)
+}
+
+func (i *Invoice) computeTotals() {
+	breakdown := map[uint8]*TaxBreakdown{}
+
+	for _, line := range i.Lines {
+		if breakdown[line.TaxRate] == nil {
+			breakdown[line.TaxRate] = &TaxBreakdown{TaxRate: line.TaxRate}
+		}
+
+		breakdown[line.TaxRate].NetAmount += line.NetAmount
+		breakdown[line.TaxRate].TaxAmount += line.TaxAmount
+		breakdown[line.TaxRate].GrossAmount += line.GrossAmount
+		i.NetTotal += line.NetAmount
+		i.TaxTotal += line.TaxAmount
+		i.GrossTotal += line.GrossAmount
+	}
+
+	i.TaxBreakdown = []TaxBreakdown{}
+	for _, taxBreakdown := range breakdown {
+		i.TaxBreakdown = append(i.TaxBreakdown, *taxBreakdown)
+	}
+
+	slices.SortFunc(i.TaxBreakdown, func(a TaxBreakdown, b TaxBreakdown) int {
+		return int(a.TaxRate) - int(b.TaxRate)
+	})
+}
+
+// ActiveInvoice returns the invoice of a booking that has not been reversed by a credit note, if any.
+func ActiveInvoice(documents []Invoice) *Invoice {
+	credited := map[uuid.UUID]bool{}
+	for _, document := range documents {
+		if document.Type == "CREDIT_NOTE" {
+			credited[document.CorrectsInvoiceId] = true
+		}
+	}
+
+	for i := len(documents) - 1; i >= 0; i-- {
+		if documents[i].Type == "INVOICE" && !credited[documents[i].Id] {
+			return &documents[i]
+		}
+	}
+
+	return nil
+}
+
+func IsCredited(documents []Invoice, invoiceId uuid.UUID) bool {
+	return slices.ContainsFunc(documents, func(document Invoice) bool {
+		return document.Type == "CREDIT_NOTE" && document.CorrectsInvoiceId == invoiceId
+	})
+}
@@ -0,0 +1,117 @@
+package invoice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvoiceSuite struct {
+	suite.Suite
+	guestFolio folio.Folio
+	billTo     invoice.BillingDetails
+	taxRates   invoice.TaxRates
+}
+
+func (i *InvoiceSuite) SetupTest() {
+	i.guestFolio = folio.Folio{
+		BookingId: uuid.MustParse("3f0c6d1b-2a4e-4c8f-9b7a-6d5e4c3b2a19"),
+		Lines: []folio.FolioLine{
+			{Type: "CHARGE", Category: "ROOM", Description: "Room stay", Amount: 440, Status: "POSTED",
+				PostedAt: time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)},
+			{Type: "CHARGE", Category: "MINIBAR", Description: "Sodas", Amount: 24, Status: "POSTED",
+				PostedAt: time.Date(2030, 1, 10, 20, 0, 0, 0, time.UTC)},
+			{Type: "CHARGE", Category: "SPA", Description: "Massage", Amount: 80, Status: "VOIDED",
+				PostedAt: time.Date(2030, 1, 11, 10, 0, 0, 0, time.UTC)},
+			{Type: "PAYMENT", Category: "CARD", Description: "Payment at front desk", Amount: 464, Status: "POSTED",
+				PostedAt: time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC)},
+		},
+		TotalCharges:  464,
+		TotalPayments: 464,
+	}
+	i.billTo = invoice.BillingDetails{Name: " Acme Corp ", TaxId: "12.345.678/0001-90", Address: "1 Main St"}
+	i.taxRates = invoice.TaxRates{AccommodationPercent: 10, ServicesPercent: 20}
+}
+
+func (i *InvoiceSuite) TestNewInvoice_OnClosedFolio_SplitsTaxPerRate() {
+	newInvoice, err := invoice.NewInvoice("MAIN", i.guestFolio, i.billTo, i.taxRates)
+	i.Require().NoError(err)
+
+	i.Equal("INVOICE", newInvoice.Type)
+	i.Equal("Acme Corp", newInvoice.BillTo.Name)
+	i.Len(newInvoice.Lines, 2)
+	i.Equal(int64(400), newInvoice.Lines[0].NetAmount)
+	i.Equal(int64(40), newInvoice.Lines[0].TaxAmount)
+	i.Equal(int64(20), newInvoice.Lines[1].NetAmount)
+	i.Equal(int64(4), newInvoice.Lines[1].TaxAmount)
+	i.Equal([]invoice.TaxBreakdown{
+		{TaxRate: 10, NetAmount: 400, TaxAmount: 40, GrossAmount: 440},
+		{TaxRate: 20, NetAmount: 20, TaxAmount: 4, GrossAmount: 24},
+	}, newInvoice.TaxBreakdown)
+	i.Equal(int64(420), newInvoice.NetTotal)
+	i.Equal(int64(44), newInvoice.TaxTotal)
+	i.Equal(int64(464), newInvoice.GrossTotal)
+	i.Equal(int64(464), newInvoice.AmountPaid)
+	i.Equal(int64(0), newInvoice.AmountDue)
+}
+
+func (i *InvoiceSuite) TestNewInvoice_OnMissingBillingName_ReturnsError() {
+	_, err := invoice.NewInvoice("MAIN", i.guestFolio, invoice.BillingDetails{Name: " "}, i.taxRates)
+
+	i.EqualError(err, "billing name is required")
+}
+
+func (i *InvoiceSuite) TestNewCreditNote_OnInvoice_ReversesIt() {
+	newInvoice, err := invoice.NewInvoice("MAIN", i.guestFolio, i.billTo, i.taxRates)
+	i.Require().NoError(err)
+
+	creditNote, err := invoice.NewCreditNote(newInvoice, "wrong company name")
+	i.Require().NoError(err)
+
+	i.Equal("CREDIT_NOTE", creditNote.Type)
+	i.Equal(newInvoice.Id, creditNote.CorrectsInvoiceId)
+	i.Equal(int64(-464), creditNote.GrossTotal)
+	i.Equal(int64(-44), creditNote.TaxTotal)
+	i.Equal(int64(-40), creditNote.Lines[0].TaxAmount)
+
+	_, err = invoice.NewCreditNote(creditNote, "again")
+	i.EqualError(err, "only invoices can be credited")
+}
+
+func (i *InvoiceSuite) TestAssignNumber_OnSequence_FormatsNumberPerType() {
+	newInvoice, err := invoice.NewInvoice("MAIN", i.guestFolio, i.billTo, i.taxRates)
+	i.Require().NoError(err)
+	creditNote, err := invoice.NewCreditNote(newInvoice, "wrong company name")
+	i.Require().NoError(err)
+
+	newInvoice.AssignNumber(7)
+	creditNote.AssignNumber(8)
+
+	i.Equal("MAIN-INV-000007", newInvoice.Number)
+	i.Equal("MAIN-CN-000008", creditNote.Number)
+}
+
+func (i *InvoiceSuite) TestActiveInvoice_OnCreditedInvoice_ReturnsCorrectedOne() {
+	original, err := invoice.NewInvoice("MAIN", i.guestFolio, i.billTo, i.taxRates)
+	i.Require().NoError(err)
+	creditNote, err := invoice.NewCreditNote(original, "wrong company name")
+	i.Require().NoError(err)
+
+	i.Nil(invoice.ActiveInvoice([]invoice.Invoice{original, creditNote}))
+	i.True(invoice.IsCredited([]invoice.Invoice{original, creditNote}, original.Id))
+
+	corrected, err := invoice.NewInvoice("MAIN", i.guestFolio, invoice.BillingDetails{Name: "Acme Inc"}, i.taxRates)
+	i.Require().NoError(err)
+
+	active := invoice.ActiveInvoice([]invoice.Invoice{original, creditNote, corrected})
+	i.Require().NotNil(active)
+	i.Equal(corrected.Id, active.Id)
+}
+
+func TestInvoice(t *testing.T) {
+	suite.Run(t, new(InvoiceSuite))
+}
@@ -0,0 +1,27 @@
+package invoice
+
+import "errors"
+
+type TaxRates struct {
+	AccommodationPercent uint8
+	ServicesPercent      uint8
+}
+
+func NewTaxRates(accommodationPercent uint8, servicesPercent uint8) (TaxRates, error) {
+	if accommodationPercent > 100 || servicesPercent > 100 {
+		return TaxRates{}, errors.New("tax percent must be between 0 and 100")
+	}
+
+	return TaxRates{
+		AccommodationPercent: accommodationPercent,
+		ServicesPercent:      servicesPercent,
+	}, nil
+}
+
+func (t TaxRates) RateFor(category string) uint8 {
+	if category == "ROOM" {
+		return t.AccommodationPercent
+	}
+
+	return t.ServicesPercent
+}
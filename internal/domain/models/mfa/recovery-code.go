@@ -0,0 +1,56 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCode lets a user in when their authenticator app is lost. Only the hash is stored and each code works once.
+type RecoveryCode struct {
+	Id           uuid.UUID
+	TotpFactorId uuid.UUID
+	HashedCode   string
+	CreatedAt    time.Time
+}
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func NewRecoveryCodes(totpFactorId uuid.UUID, count int) ([]RecoveryCode, []string, error) {
+	recoveryCodes := []RecoveryCode{}
+	plainCodes := []string{}
+	now := time.Now().UTC()
+
+	for range count {
+		random := make([]byte, 5)
+		_, err := rand.Read(random)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		encoded := strings.ToLower(recoveryCodeEncoding.EncodeToString(random))
+		plainCode := encoded[:4] + "-" + encoded[4:]
+
+		recoveryCodes = append(recoveryCodes, RecoveryCode{
+			Id:           uuid.New(),
+			TotpFactorId: totpFactorId,
+			HashedCode:   HashRecoveryCode(plainCode),
+			CreatedAt:    now,
+		})
+		plainCodes = append(plainCodes, plainCode)
+	}
+
+	return recoveryCodes, plainCodes, nil
+}
+
+// HashRecoveryCode ignores case, spaces and dashes, so a code typed as "ABCD EFGH" matches "abcd-efgh".
+func HashRecoveryCode(plainCode string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(plainCode))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,96 @@
+package mfa_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+	"github.com/stretchr/testify/suite"
+)
+
+type TotpFactorSuite struct {
+	suite.Suite
+	customerId uuid.UUID
+	now        time.Time
+}
+
+func (t *TotpFactorSuite) SetupTest() {
+	t.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	t.now = time.Date(2030, 6, 1, 12, 0, 10, 0, time.UTC)
+}
+
+func (t *TotpFactorSuite) TestGenerateTotpCode_OnRfc6238Vectors_ReturnsTheLastSixDigits() {
+	secret := []byte("12345678901234567890")
+
+	t.Equal("287082", mfa.GenerateTotpCode(secret, mfa.CurrentTotpStep(time.Unix(59, 0))))
+	t.Equal("081804", mfa.GenerateTotpCode(secret, mfa.CurrentTotpStep(time.Unix(1111111109, 0))))
+	t.Equal("050471", mfa.GenerateTotpCode(secret, mfa.CurrentTotpStep(time.Unix(1111111111, 0))))
+}
+
+func (t *TotpFactorSuite) TestNewTotpFactor_OnBothOwners_ReturnsError() {
+	_, err := mfa.NewTotpFactor(t.customerId, uuid.New())
+
+	t.EqualError(err, "totp factor must belong to either a customer or a staff user")
+}
+
+func (t *TotpFactorSuite) TestProvisioningUri_OnNoErrors_ReturnsOtpauthUri() {
+	totpFactor, err := mfa.NewTotpFactor(t.customerId, uuid.Nil)
+	t.Require().NoError(err)
+
+	provisioningUri, err := url.Parse(totpFactor.ProvisioningUri("Hotel Booking", "john.doe@gmail.com"))
+	t.Require().NoError(err)
+
+	t.Equal("otpauth", provisioningUri.Scheme)
+	t.Equal("totp", provisioningUri.Host)
+	t.Equal("/Hotel Booking:john.doe@gmail.com", provisioningUri.Path)
+	t.Equal(totpFactor.EncodedSecret(), provisioningUri.Query().Get("secret"))
+	t.Equal("Hotel Booking", provisioningUri.Query().Get("issuer"))
+	t.Len(totpFactor.EncodedSecret(), 32)
+	t.Len(totpFactor.ManualEntryKey(), 39)
+}
+
+func (t *TotpFactorSuite) TestVerify_OnCodeOfTheAdjacentStep_ReturnsTheStep() {
+	totpFactor, err := mfa.NewTotpFactor(t.customerId, uuid.Nil)
+	t.Require().NoError(err)
+	previousStep := mfa.CurrentTotpStep(t.now) - 1
+
+	step, err := totpFactor.Verify(mfa.GenerateTotpCode(totpFactor.Secret, previousStep), t.now)
+
+	t.Require().NoError(err)
+	t.Equal(previousStep, step)
+}
+
+func (t *TotpFactorSuite) TestVerify_OnAlreadyUsedStep_ReturnsError() {
+	totpFactor, err := mfa.NewTotpFactor(t.customerId, uuid.Nil)
+	t.Require().NoError(err)
+	totpFactor.LastUsedStep = mfa.CurrentTotpStep(t.now)
+
+	_, err = totpFactor.Verify(mfa.GenerateTotpCode(totpFactor.Secret, totpFactor.LastUsedStep), t.now)
+
+	t.EqualError(err, "verification code is invalid")
+}
+
+func (t *TotpFactorSuite) TestVerify_OnCodeOutsideTheWindow_ReturnsError() {
+	totpFactor, err := mfa.NewTotpFactor(t.customerId, uuid.Nil)
+	t.Require().NoError(err)
+
+	_, err = totpFactor.Verify(mfa.GenerateTotpCode(totpFactor.Secret, mfa.CurrentTotpStep(t.now)-2), t.now)
+
+	t.EqualError(err, "verification code is invalid")
+}
+
+func (t *TotpFactorSuite) TestHashRecoveryCode_OnDifferentFormatting_ReturnsTheSameHash() {
+	recoveryCodes, plainCodes, err := mfa.NewRecoveryCodes(uuid.New(), 10)
+	t.Require().NoError(err)
+
+	t.Len(recoveryCodes, 10)
+	t.Regexp(`^[a-z2-7]{4}-[a-z2-7]{4}$`, plainCodes[0])
+	t.Equal(recoveryCodes[0].HashedCode, mfa.HashRecoveryCode(plainCodes[0]))
+	t.Equal(mfa.HashRecoveryCode("abcd-efgh"), mfa.HashRecoveryCode(" ABCD EFGH "))
+}
+
+func TestTotpFactor(t *testing.T) {
+	suite.Run(t, new(TotpFactorSuite))
+}
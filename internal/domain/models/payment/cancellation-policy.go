@@ -0,0 +1,36 @@
+package payment
+
+import (
+	"errors"
+	"time"
+)
+
+type CancellationPolicy struct {
+	FreeCancellationDaysBeforeCheckIn uint16
+	LateCancellationRefundPercent     uint8
+}
+
+func NewCancellationPolicy(freeCancellationDaysBeforeCheckIn uint16, lateCancellationRefundPercent uint8) (CancellationPolicy, error) {
+	if lateCancellationRefundPercent > 100 {
+		return CancellationPolicy{}, errors.New("late cancellation refund percent must be between 0 and 100")
+	}
+
+	return CancellationPolicy{
+		FreeCancellationDaysBeforeCheckIn: freeCancellationDaysBeforeCheckIn,
+		LateCancellationRefundPercent:     lateCancellationRefundPercent,
+	}, nil
+}
+
+func (c CancellationPolicy) RefundPercent(checkIn time.Time, cancelledAt time.Time) uint8 {
+	freeCancellationDeadline := checkIn.AddDate(0, 0, -int(c.FreeCancellationDaysBeforeCheckIn))
+
+	if cancelledAt.Before(freeCancellationDeadline) {
+		return 100
+	}
+
+	return c.LateCancellationRefundPercent
+}
+
+func (c CancellationPolicy) RefundAmount(paidAmount uint64, checkIn time.Time, cancelledAt time.Time) uint64 {
+	return paidAmount * uint64(c.RefundPercent(checkIn, cancelledAt)) / 100
+}
@@ -0,0 +1,34 @@
+package payment
+
+import (
+	"errors"
+	"time"
+)
+
+type DepositPolicy struct {
+	Percent                  uint8
+	CaptureDaysBeforeCheckIn uint16
+}
+
+func NewDepositPolicy(percent uint8, captureDaysBeforeCheckIn uint16) (DepositPolicy, error) {
+	if percent > 100 {
+		return DepositPolicy{}, errors.New("deposit percent must be between 0 and 100")
+	}
+
+	return DepositPolicy{
+		Percent:                  percent,
+		CaptureDaysBeforeCheckIn: captureDaysBeforeCheckIn,
+	}, nil
+}
+
+func (d DepositPolicy) Required() bool {
+	return d.Percent > 0
+}
+
+func (d DepositPolicy) Amount(totalPrice uint64) uint64 {
+	return (totalPrice*uint64(d.Percent) + 99) / 100
+}
+
+func (d DepositPolicy) CaptureOn(checkIn time.Time) time.Time {
+	return checkIn.AddDate(0, 0, -int(d.CaptureDaysBeforeCheckIn))
+}
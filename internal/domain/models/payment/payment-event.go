@@ -0,0 +1,45 @@
+package payment
+
+import (
+	"errors"
+	"time"
+)
+
+type PaymentEvent struct {
+	Id            string
+	Type          string
+	TransactionId string
+	Payload       []byte
+	Status        string
+	Reason        string
+	ReceivedAt    time.Time
+}
+
+func NewPaymentEvent(id string, eventType string, transactionId string, payload []byte) (PaymentEvent, error) {
+	if id == "" {
+		return PaymentEvent{}, errors.New("payment event id is required")
+	}
+
+	if eventType == "" {
+		return PaymentEvent{}, errors.New("payment event type is required")
+	}
+
+	return PaymentEvent{
+		Id:            id,
+		Type:          eventType,
+		TransactionId: transactionId,
+		Payload:       payload,
+		Status:        "RECEIVED",
+		ReceivedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func (p *PaymentEvent) MarkProcessed() {
+	p.Status = "PROCESSED"
+	p.Reason = ""
+}
+
+func (p *PaymentEvent) Ignore(reason string) {
+	p.Status = "IGNORED"
+	p.Reason = reason
+}
@@ -0,0 +1,106 @@
+package payment
+
+import (
+	"errors"
+	"time"
+)
+
+type Installment struct {
+	Percent           uint8
+	Due               string
+	DaysBeforeArrival uint16
+}
+
+type PaymentSchedule struct {
+	Installments []Installment
+}
+
+type PlannedCharge struct {
+	Sequence  uint8
+	Amount    uint64
+	DueOn     time.Time
+	Due       string
+	Mandatory bool
+}
+
+func NewPaymentSchedule(installments []Installment) (PaymentSchedule, error) {
+	var totalPercent uint16
+
+	for _, installment := range installments {
+		if installment.Percent == 0 || installment.Percent > 100 {
+			return PaymentSchedule{}, errors.New("installment percent must be between 1 and 100")
+		}
+
+		if installment.Due != "AT_BOOKING" && installment.Due != "BEFORE_ARRIVAL" && installment.Due != "AT_CHECK_IN" {
+			return PaymentSchedule{}, errors.New("installment due must be AT_BOOKING, BEFORE_ARRIVAL or AT_CHECK_IN")
+		}
+
+		if installment.Due == "BEFORE_ARRIVAL" && installment.DaysBeforeArrival == 0 {
+			return PaymentSchedule{}, errors.New("installments due before arrival require at least one day before arrival")
+		}
+
+		totalPercent += uint16(installment.Percent)
+	}
+
+	if totalPercent > 100 {
+		return PaymentSchedule{}, errors.New("payment schedule percentages cannot exceed 100")
+	}
+
+	return PaymentSchedule{
+		Installments: installments,
+	}, nil
+}
+
+func (p PaymentSchedule) RequiresPaymentMethod() bool {
+	return len(p.Installments) > 0
+}
+
+// Plan splits the total into charges. When the percentages add up to 100 the last installment takes the
+// rounding remainder so the whole stay is collected. Installments before arrival are mandatory prepayments; a
+// charge due before it is created is moved to the booking date.
+func (p PaymentSchedule) Plan(total uint64, bookedOn time.Time, checkIn time.Time) []PlannedCharge {
+	charges := []PlannedCharge{}
+
+	var totalPercent uint16
+	var plannedAmount uint64
+	for _, installment := range p.Installments {
+		totalPercent += uint16(installment.Percent)
+	}
+
+	for i, installment := range p.Installments {
+		amount := total * uint64(installment.Percent) / 100
+		if totalPercent == 100 && i == len(p.Installments)-1 {
+			amount = total - plannedAmount
+		}
+
+		plannedAmount += amount
+
+		if amount == 0 {
+			continue
+		}
+
+		dueOn := checkIn
+		switch installment.Due {
+		case "AT_BOOKING":
+			dueOn = bookedOn
+		case "BEFORE_ARRIVAL":
+			dueOn = checkIn.AddDate(0, 0, -int(installment.DaysBeforeArrival))
+		}
+
+		due := installment.Due
+		if dueOn.Before(bookedOn) {
+			dueOn = bookedOn
+			due = "AT_BOOKING"
+		}
+
+		charges = append(charges, PlannedCharge{
+			Sequence:  uint8(i + 1),
+			Amount:    amount,
+			DueOn:     dueOn,
+			Due:       due,
+			Mandatory: installment.Due != "AT_CHECK_IN",
+		})
+	}
+
+	return charges
+}
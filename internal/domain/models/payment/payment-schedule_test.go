@@ -0,0 +1,75 @@
+package payment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentScheduleSuite struct {
+	suite.Suite
+	bookedOn time.Time
+	checkIn  time.Time
+}
+
+func (p *PaymentScheduleSuite) SetupTest() {
+	p.bookedOn = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	p.checkIn = time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (p *PaymentScheduleSuite) TestNewPaymentSchedule_OnInvalidInstallments_ReturnsError() {
+	_, err := payment.NewPaymentSchedule([]payment.Installment{{Percent: 0, Due: "AT_BOOKING"}})
+	p.EqualError(err, "installment percent must be between 1 and 100")
+
+	_, err = payment.NewPaymentSchedule([]payment.Installment{{Percent: 30, Due: "LATER"}})
+	p.EqualError(err, "installment due must be AT_BOOKING, BEFORE_ARRIVAL or AT_CHECK_IN")
+
+	_, err = payment.NewPaymentSchedule([]payment.Installment{{Percent: 30, Due: "BEFORE_ARRIVAL"}})
+	p.EqualError(err, "installments due before arrival require at least one day before arrival")
+
+	_, err = payment.NewPaymentSchedule([]payment.Installment{{Percent: 60, Due: "AT_BOOKING"}, {Percent: 50, Due: "AT_CHECK_IN"}})
+	p.EqualError(err, "payment schedule percentages cannot exceed 100")
+}
+
+func (p *PaymentScheduleSuite) TestPlan_OnDepositAndBalance_SplitsTotalWithRemainderOnLastCharge() {
+	schedule, err := payment.NewPaymentSchedule([]payment.Installment{
+		{Percent: 30, Due: "AT_BOOKING"},
+		{Percent: 70, Due: "BEFORE_ARRIVAL", DaysBeforeArrival: 7},
+	})
+	p.Require().NoError(err)
+
+	charges := schedule.Plan(333, p.bookedOn, p.checkIn)
+
+	p.Equal([]payment.PlannedCharge{
+		{Sequence: 1, Amount: 99, DueOn: p.bookedOn, Due: "AT_BOOKING", Mandatory: true},
+		{Sequence: 2, Amount: 234, DueOn: time.Date(2030, 1, 25, 0, 0, 0, 0, time.UTC), Due: "BEFORE_ARRIVAL", Mandatory: true},
+	}, charges)
+}
+
+func (p *PaymentScheduleSuite) TestPlan_OnLastMinuteBooking_MovesPastDueChargesToBookingDate() {
+	schedule, err := payment.NewPaymentSchedule([]payment.Installment{{Percent: 100, Due: "BEFORE_ARRIVAL", DaysBeforeArrival: 7}})
+	p.Require().NoError(err)
+
+	charges := schedule.Plan(200, p.checkIn.AddDate(0, 0, -2), p.checkIn)
+
+	p.Len(charges, 1)
+	p.Equal("AT_BOOKING", charges[0].Due)
+	p.Equal(p.checkIn.AddDate(0, 0, -2), charges[0].DueOn)
+}
+
+func (p *PaymentScheduleSuite) TestPlan_OnPayAtCheckIn_ReturnsOptionalCharge() {
+	schedule, err := payment.NewPaymentSchedule([]payment.Installment{{Percent: 100, Due: "AT_CHECK_IN"}})
+	p.Require().NoError(err)
+
+	charges := schedule.Plan(200, p.bookedOn, p.checkIn)
+
+	p.Len(charges, 1)
+	p.Equal(p.checkIn, charges[0].DueOn)
+	p.False(charges[0].Mandatory)
+}
+
+func TestPaymentSchedule(t *testing.T) {
+	suite.Run(t, new(PaymentScheduleSuite))
+}
@@ -0,0 +1,186 @@
+package payment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentAttempt struct {
+	Id            uuid.UUID
+	Operation     string
+	Amount        uint64
+	TransactionId string
+	Status        string
+	FailureReason string
+	CreatedAt     time.Time
+}
+
+type Payment struct {
+	Id             uuid.UUID
+	BookingId      uuid.UUID
+	Amount         uint64
+	CapturedAmount uint64
+	CaptureOn      time.Time
+	TransactionId  string
+	Status         string
+	Attempts       []PaymentAttempt
+}
+
+func NewDepositPayment(bookingId uuid.UUID, amount uint64, captureOn time.Time) (Payment, error) {
+	if amount <= 0 {
+		return Payment{}, errors.New("deposit amount must be greater than zero")
+	}
+
+	return Payment{
+		Id:        uuid.New(),
+		BookingId: bookingId,
+		Amount:    amount,
+		CaptureOn: captureOn,
+		Status:    "PENDING",
+		Attempts:  []PaymentAttempt{},
+	}, nil
+}
+
+func (p *Payment) RecordAuthorization(transactionId string, approved bool, declineReason string) error {
+	if p.Status != "PENDING" {
+		return errors.New("only pending payments can be authorized")
+	}
+
+	p.addAttempt("AUTHORIZE", p.Amount, transactionId, approved, declineReason)
+
+	if approved {
+		p.TransactionId = transactionId
+		p.Status = "AUTHORIZED"
+		return nil
+	}
+
+	p.Status = "DECLINED"
+	return nil
+}
+
+func (p *Payment) RecordCapture(amount uint64, approved bool, declineReason string) error {
+	if p.Status != "AUTHORIZED" {
+		return errors.New("only authorized payments can be captured")
+	}
+
+	if amount <= 0 || amount > p.Amount {
+		return errors.New("capture amount must be greater than zero and cannot exceed the authorized amount")
+	}
+
+	p.addAttempt("CAPTURE", amount, p.TransactionId, approved, declineReason)
+
+	if approved {
+		p.CapturedAmount = amount
+		p.Status = "CAPTURED"
+	}
+
+	return nil
+}
+
+func (p *Payment) ReduceAmount(amount uint64) error {
+	if p.Status != "AUTHORIZED" {
+		return errors.New("only authorized payments can be reduced")
+	}
+
+	if amount <= 0 || amount > p.Amount {
+		return errors.New("reduced amount must be greater than zero and cannot exceed the authorized amount")
+	}
+
+	p.Amount = amount
+
+	return nil
+}
+
+func (p *Payment) RefundableAmount(refundedAmount uint64) uint64 {
+	if p.Status != "CAPTURED" || refundedAmount >= p.CapturedAmount {
+		return 0
+	}
+
+	return p.CapturedAmount - refundedAmount
+}
+
+func (p *Payment) RecordVoid(approved bool, declineReason string) error {
+	if p.Status != "AUTHORIZED" {
+		return errors.New("only authorized payments can be voided")
+	}
+
+	p.addAttempt("VOID", p.Amount, p.TransactionId, approved, declineReason)
+
+	if approved {
+		p.Status = "VOIDED"
+	}
+
+	return nil
+}
+
+func (p *Payment) RecordFailure(operation string, failureReason string) {
+	p.Attempts = append(p.Attempts, PaymentAttempt{
+		Id:            uuid.New(),
+		Operation:     operation,
+		Amount:        p.Amount,
+		TransactionId: p.TransactionId,
+		Status:        "FAILED",
+		FailureReason: failureReason,
+		CreatedAt:     time.Now().UTC(),
+	})
+
+	if operation == "AUTHORIZE" && p.Status == "PENDING" {
+		p.Status = "FAILED"
+	}
+}
+
+func (p *Payment) addAttempt(operation string, amount uint64, transactionId string, approved bool, declineReason string) {
+	status := "APPROVED"
+	if !approved {
+		status = "DECLINED"
+	}
+
+	p.Attempts = append(p.Attempts, PaymentAttempt{
+		Id:            uuid.New(),
+		Operation:     operation,
+		Amount:        amount,
+		TransactionId: transactionId,
+		Status:        status,
+		FailureReason: declineReason,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (p *Payment) MarkSucceeded() error {
+	switch p.Status {
+	case "CAPTURED":
+		return nil
+	case "AUTHORIZED":
+		p.CapturedAmount = p.Amount
+		p.Status = "CAPTURED"
+		return nil
+	}
+
+	return errors.New("payment cannot be marked as succeeded")
+}
+
+func (p *Payment) MarkFailed() error {
+	switch p.Status {
+	case "FAILED":
+		return nil
+	case "PENDING", "AUTHORIZED":
+		p.Status = "FAILED"
+		return nil
+	}
+
+	return errors.New("payment cannot be marked as failed")
+}
+
+func (p *Payment) MarkDisputed() error {
+	switch p.Status {
+	case "DISPUTED":
+		return nil
+	case "CAPTURED":
+		p.Status = "DISPUTED"
+		return nil
+	}
+
+	return errors.New("payment cannot be marked as disputed")
+}
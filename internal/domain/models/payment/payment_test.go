@@ -0,0 +1,228 @@
+package payment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentSuite struct {
+	suite.Suite
+	bookingId uuid.UUID
+	captureOn time.Time
+}
+
+func (p *PaymentSuite) SetupTest() {
+	p.bookingId = uuid.MustParse("7a1c1d8e-6f0b-4b43-9a67-0f4b7f0d2c11")
+	p.captureOn = time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+}
+
+func (p *PaymentSuite) TestNewDepositPayment_OnNoErrors_ReturnsPendingPayment() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+
+	p.Equal(p.bookingId, newPayment.BookingId)
+	p.Equal(uint64(150), newPayment.Amount)
+	p.Equal(p.captureOn, newPayment.CaptureOn)
+	p.Equal("PENDING", newPayment.Status)
+	p.Empty(newPayment.Attempts)
+}
+
+func (p *PaymentSuite) TestNewDepositPayment_OnZeroAmount_ReturnsError() {
+	_, err := payment.NewDepositPayment(p.bookingId, 0, p.captureOn)
+
+	p.EqualError(err, "deposit amount must be greater than zero")
+}
+
+func (p *PaymentSuite) TestRecordAuthorization_OnApproved_AuthorizesPayment() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+
+	err = newPayment.RecordAuthorization("txn_1", true, "")
+	p.Require().NoError(err)
+
+	p.Equal("AUTHORIZED", newPayment.Status)
+	p.Equal("txn_1", newPayment.TransactionId)
+	p.Len(newPayment.Attempts, 1)
+	p.Equal("AUTHORIZE", newPayment.Attempts[0].Operation)
+	p.Equal("APPROVED", newPayment.Attempts[0].Status)
+	p.Equal(uint64(150), newPayment.Attempts[0].Amount)
+}
+
+func (p *PaymentSuite) TestRecordAuthorization_OnDeclined_DeclinesPayment() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+
+	err = newPayment.RecordAuthorization("", false, "card declined")
+	p.Require().NoError(err)
+
+	p.Equal("DECLINED", newPayment.Status)
+	p.Equal("DECLINED", newPayment.Attempts[0].Status)
+	p.Equal("card declined", newPayment.Attempts[0].FailureReason)
+}
+
+func (p *PaymentSuite) TestRecordCapture_OnAuthorizedPayment_CapturesPayment() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+	p.Require().NoError(newPayment.RecordAuthorization("txn_1", true, ""))
+
+	err = newPayment.RecordCapture(150, true, "")
+	p.Require().NoError(err)
+
+	p.Equal("CAPTURED", newPayment.Status)
+	p.Equal(uint64(150), newPayment.CapturedAmount)
+	p.Len(newPayment.Attempts, 2)
+	p.Equal("CAPTURE", newPayment.Attempts[1].Operation)
+	p.Equal("txn_1", newPayment.Attempts[1].TransactionId)
+}
+
+func (p *PaymentSuite) TestRecordCapture_OnDeclined_KeepsPaymentAuthorized() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+	p.Require().NoError(newPayment.RecordAuthorization("txn_1", true, ""))
+
+	err = newPayment.RecordCapture(150, false, "authorization expired")
+	p.Require().NoError(err)
+
+	p.Equal("AUTHORIZED", newPayment.Status)
+	p.Equal("DECLINED", newPayment.Attempts[1].Status)
+}
+
+func (p *PaymentSuite) TestRecordCapture_OnPaymentNotAuthorized_ReturnsError() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+
+	err = newPayment.RecordCapture(150, true, "")
+
+	p.EqualError(err, "only authorized payments can be captured")
+}
+
+func (p *PaymentSuite) TestRecordVoid_OnAuthorizedPayment_VoidsPayment() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+	p.Require().NoError(newPayment.RecordAuthorization("txn_1", true, ""))
+
+	err = newPayment.RecordVoid(true, "")
+	p.Require().NoError(err)
+
+	p.Equal("VOIDED", newPayment.Status)
+}
+
+func (p *PaymentSuite) TestRecordFailure_OnAuthorize_FailsPayment() {
+	newPayment, err := payment.NewDepositPayment(p.bookingId, 150, p.captureOn)
+	p.Require().NoError(err)
+
+	newPayment.RecordFailure("AUTHORIZE", "connection refused")
+
+	p.Equal("FAILED", newPayment.Status)
+	p.Equal("FAILED", newPayment.Attempts[0].Status)
+	p.Equal("connection refused", newPayment.Attempts[0].FailureReason)
+}
+
+func (p *PaymentSuite) TestMarkSucceeded_OnAuthorizedPayment_CapturesPayment() {
+	newPayment := payment.Payment{Status: "AUTHORIZED"}
+
+	p.Require().NoError(newPayment.MarkSucceeded())
+	p.Equal("CAPTURED", newPayment.Status)
+	p.Require().NoError(newPayment.MarkSucceeded())
+	p.Equal("CAPTURED", newPayment.Status)
+}
+
+func (p *PaymentSuite) TestMarkSucceeded_OnVoidedPayment_ReturnsError() {
+	newPayment := payment.Payment{Status: "VOIDED"}
+
+	p.EqualError(newPayment.MarkSucceeded(), "payment cannot be marked as succeeded")
+}
+
+func (p *PaymentSuite) TestMarkFailed_OnCapturedPayment_ReturnsError() {
+	newPayment := payment.Payment{Status: "CAPTURED"}
+
+	p.EqualError(newPayment.MarkFailed(), "payment cannot be marked as failed")
+}
+
+func (p *PaymentSuite) TestMarkDisputed_OnCapturedPayment_DisputesPayment() {
+	newPayment := payment.Payment{Status: "CAPTURED"}
+
+	p.Require().NoError(newPayment.MarkDisputed())
+	p.Equal("DISPUTED", newPayment.Status)
+}
+
+func (p *PaymentSuite) TestNewPaymentEvent_OnMissingId_ReturnsError() {
+	_, err := payment.NewPaymentEvent("", "payment.succeeded", "txn_1", nil)
+
+	p.EqualError(err, "payment event id is required")
+}
+
+func (p *PaymentSuite) TestDepositPolicy_OnPercent_ReturnsRoundedUpAmountAndCaptureDate() {
+	depositPolicy, err := payment.NewDepositPolicy(30, 2)
+	p.Require().NoError(err)
+
+	p.True(depositPolicy.Required())
+	p.Equal(uint64(150), depositPolicy.Amount(500))
+	p.Equal(uint64(1), depositPolicy.Amount(1))
+	p.Equal(time.Date(2030, 1, 8, 0, 0, 0, 0, time.UTC), depositPolicy.CaptureOn(p.captureOn))
+}
+
+func (p *PaymentSuite) TestNewDepositPolicy_OnInvalidPercent_ReturnsError() {
+	_, err := payment.NewDepositPolicy(101, 0)
+
+	p.EqualError(err, "deposit percent must be between 0 and 100")
+}
+
+func (p *PaymentSuite) TestRefundableAmount_OnCapturedPayment_SubtractsRefundedAmount() {
+	capturedPayment := payment.Payment{Status: "CAPTURED", Amount: 150, CapturedAmount: 150}
+
+	p.Equal(uint64(100), capturedPayment.RefundableAmount(50))
+	p.Equal(uint64(0), capturedPayment.RefundableAmount(150))
+	authorizedPayment := payment.Payment{Status: "AUTHORIZED", Amount: 150}
+	p.Equal(uint64(0), authorizedPayment.RefundableAmount(0))
+}
+
+func (p *PaymentSuite) TestReduceAmount_OnAuthorizedPayment_ReducesAmount() {
+	authorizedPayment := payment.Payment{Status: "AUTHORIZED", Amount: 150}
+
+	p.Require().NoError(authorizedPayment.ReduceAmount(90))
+	p.Equal(uint64(90), authorizedPayment.Amount)
+	p.EqualError(authorizedPayment.ReduceAmount(120),
+		"reduced amount must be greater than zero and cannot exceed the authorized amount")
+}
+
+func (p *PaymentSuite) TestNewRefund_OnManualRefundWithoutReason_ReturnsError() {
+	_, err := payment.NewRefund(p.bookingId, uuid.New(), 50, "MANUAL", " ")
+
+	p.EqualError(err, "manual refunds require a reason of at least 3 characters")
+}
+
+func (p *PaymentSuite) TestNewRefund_OnZeroAmount_ReturnsError() {
+	_, err := payment.NewRefund(p.bookingId, uuid.New(), 0, "CANCELLATION", "")
+
+	p.EqualError(err, "refund amount must be greater than zero")
+}
+
+func (p *PaymentSuite) TestRefund_OnDeclined_NoLongerCountsTowardsRefundedAmount() {
+	refund, err := payment.NewRefund(p.bookingId, uuid.New(), 50, "MANUAL", "room was not cleaned")
+	p.Require().NoError(err)
+	p.True(refund.CountsTowardsRefundedAmount())
+
+	refund.RecordResult("txn_2", false, "amount exceeds refundable amount")
+
+	p.Equal("DECLINED", refund.Status)
+	p.False(refund.CountsTowardsRefundedAmount())
+}
+
+func (p *PaymentSuite) TestCancellationPolicy_OnLateCancellation_ReturnsPartialRefund() {
+	cancellationPolicy, err := payment.NewCancellationPolicy(7, 50)
+	p.Require().NoError(err)
+	checkIn := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	p.Equal(uint64(150), cancellationPolicy.RefundAmount(150, checkIn, checkIn.AddDate(0, 0, -8)))
+	p.Equal(uint64(75), cancellationPolicy.RefundAmount(150, checkIn, checkIn.AddDate(0, 0, -7)))
+	p.Equal(uint64(75), cancellationPolicy.RefundAmount(150, checkIn, checkIn.AddDate(0, 0, -1)))
+}
+
+func TestPayment(t *testing.T) {
+	suite.Run(t, new(PaymentSuite))
+}
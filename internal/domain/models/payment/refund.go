@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Refund struct {
+	Id                uuid.UUID
+	BookingId         uuid.UUID
+	PaymentId         uuid.UUID
+	ScheduledChargeId uuid.UUID
+	Amount            uint64
+	Type              string
+	Reason            string
+	TransactionId     string
+	Status            string
+	FailureReason     string
+	CreatedAt         time.Time
+}
+
+func NewRefund(bookingId uuid.UUID, paymentId uuid.UUID, amount uint64, refundType string, reason string) (Refund, error) {
+	if amount <= 0 {
+		return Refund{}, errors.New("refund amount must be greater than zero")
+	}
+
+	if refundType != "CANCELLATION" && refundType != "MODIFICATION" && refundType != "MANUAL" {
+		return Refund{}, errors.New("refund type must be CANCELLATION, MODIFICATION or MANUAL")
+	}
+
+	if refundType == "MANUAL" && len(strings.TrimSpace(reason)) < 3 {
+		return Refund{}, errors.New("manual refunds require a reason of at least 3 characters")
+	}
+
+	return Refund{
+		Id:        uuid.New(),
+		BookingId: bookingId,
+		PaymentId: paymentId,
+		Amount:    amount,
+		Type:      refundType,
+		Reason:    strings.TrimSpace(reason),
+		Status:    "PENDING",
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// NewScheduledChargeRefund gives back part of a paid scheduled charge instead of the deposit.
+func NewScheduledChargeRefund(bookingId uuid.UUID, scheduledChargeId uuid.UUID, amount uint64, refundType string) (Refund,
+	error) {
+	refund, err := NewRefund(bookingId, uuid.Nil, amount, refundType, "")
+	if err != nil {
+		return Refund{}, err
+	}
+
+	refund.ScheduledChargeId = scheduledChargeId
+
+	return refund, nil
+}
+
+// RefundedChargeId returns the id of the deposit or scheduled charge the refund is taken from.
+func (r *Refund) RefundedChargeId() uuid.UUID {
+	if r.ScheduledChargeId != uuid.Nil {
+		return r.ScheduledChargeId
+	}
+
+	return r.PaymentId
+}
+
+func (r *Refund) RecordResult(transactionId string, approved bool, declineReason string) {
+	r.TransactionId = transactionId
+
+	if approved {
+		r.Status = "APPROVED"
+		return
+	}
+
+	r.Status = "DECLINED"
+	r.FailureReason = declineReason
+}
+
+func (r *Refund) RecordFailure(failureReason string) {
+	r.Status = "FAILED"
+	r.FailureReason = failureReason
+}
+
+func (r *Refund) CountsTowardsRefundedAmount() bool {
+	return r.Status == "PENDING" || r.Status == "APPROVED"
+}
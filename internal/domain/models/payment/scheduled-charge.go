@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduledCharge struct {
+	Id                uuid.UUID
+	BookingId         uuid.UUID
+	Sequence          uint8
+	Amount            uint64
+	DueOn             time.Time
+	Mandatory         bool
+	PaymentToken      string
+	TransactionId     string
+	Status            string
+	Attempts          uint8
+	NextAttemptAt     time.Time
+	LastFailureReason string
+}
+
+func NewScheduledCharge(bookingId uuid.UUID, plannedCharge PlannedCharge, paymentToken string) (ScheduledCharge, error) {
+	if plannedCharge.Amount <= 0 {
+		return ScheduledCharge{}, errors.New("scheduled charge amount must be greater than zero")
+	}
+
+	return ScheduledCharge{
+		Id:            uuid.New(),
+		BookingId:     bookingId,
+		Sequence:      plannedCharge.Sequence,
+		Amount:        plannedCharge.Amount,
+		DueOn:         plannedCharge.DueOn,
+		Mandatory:     plannedCharge.Mandatory,
+		PaymentToken:  paymentToken,
+		Status:        "SCHEDULED",
+		NextAttemptAt: plannedCharge.DueOn,
+	}, nil
+}
+
+func (s *ScheduledCharge) IsDue(now time.Time) bool {
+	return s.Status == "SCHEDULED" && !s.NextAttemptAt.After(now)
+}
+
+func (s *ScheduledCharge) RecordSuccess(transactionId string) error {
+	if s.Status != "SCHEDULED" {
+		return errors.New("only scheduled charges can be paid")
+	}
+
+	s.Attempts++
+	s.TransactionId = transactionId
+	s.Status = "PAID"
+	s.LastFailureReason = ""
+
+	return nil
+}
+
+// RecordFailure schedules another attempt after retryInterval until maxAttempts is reached, then fails the charge.
+func (s *ScheduledCharge) RecordFailure(reason string, now time.Time, maxAttempts uint8, retryInterval time.Duration) error {
+	if s.Status != "SCHEDULED" {
+		return errors.New("only scheduled charges can fail")
+	}
+
+	s.Attempts++
+	s.LastFailureReason = reason
+
+	if s.Attempts >= maxAttempts {
+		s.Status = "FAILED"
+		return nil
+	}
+
+	s.NextAttemptAt = now.Add(retryInterval)
+
+	return nil
+}
+
+func (s *ScheduledCharge) RefundableAmount(refundedAmount uint64) uint64 {
+	if s.Status != "PAID" || refundedAmount >= s.Amount {
+		return 0
+	}
+
+	return s.Amount - refundedAmount
+}
+
+func (s *ScheduledCharge) Cancel() {
+	if s.Status == "SCHEDULED" {
+		s.Status = "CANCELLED"
+	}
+}
@@ -0,0 +1,63 @@
+package payment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduledChargeSuite struct {
+	suite.Suite
+	charge payment.ScheduledCharge
+	now    time.Time
+}
+
+func (s *ScheduledChargeSuite) SetupTest() {
+	var err error
+	s.now = time.Date(2030, 1, 25, 0, 0, 0, 0, time.UTC)
+	s.charge, err = payment.NewScheduledCharge(uuid.MustParse("7a1c1d8e-6f0b-4b43-9a67-0f4b7f0d2c11"),
+		payment.PlannedCharge{Sequence: 2, Amount: 234, DueOn: s.now, Mandatory: true}, "tok_visa")
+	s.Require().NoError(err)
+}
+
+func (s *ScheduledChargeSuite) TestRecordFailure_OnRetriesLeft_SchedulesNextAttempt() {
+	s.Require().NoError(s.charge.RecordFailure("card declined", s.now, 3, 24*time.Hour))
+
+	s.Equal("SCHEDULED", s.charge.Status)
+	s.Equal(uint8(1), s.charge.Attempts)
+	s.Equal(s.now.Add(24*time.Hour), s.charge.NextAttemptAt)
+	s.False(s.charge.IsDue(s.now))
+	s.True(s.charge.IsDue(s.now.Add(24 * time.Hour)))
+}
+
+func (s *ScheduledChargeSuite) TestRecordFailure_OnLastAttempt_FailsCharge() {
+	s.Require().NoError(s.charge.RecordFailure("card declined", s.now, 2, time.Hour))
+	s.Require().NoError(s.charge.RecordFailure("card declined", s.now.Add(time.Hour), 2, time.Hour))
+
+	s.Equal("FAILED", s.charge.Status)
+	s.Equal("card declined", s.charge.LastFailureReason)
+	s.EqualError(s.charge.RecordFailure("card declined", s.now, 2, time.Hour), "only scheduled charges can fail")
+}
+
+func (s *ScheduledChargeSuite) TestRecordSuccess_OnScheduledCharge_MarksItPaid() {
+	s.Require().NoError(s.charge.RecordSuccess("txn_1"))
+
+	s.Equal("PAID", s.charge.Status)
+	s.Equal("txn_1", s.charge.TransactionId)
+	s.EqualError(s.charge.RecordSuccess("txn_2"), "only scheduled charges can be paid")
+}
+
+func (s *ScheduledChargeSuite) TestRefundableAmount_OnPaidCharge_SubtractsRefundedAmount() {
+	s.Equal(uint64(0), s.charge.RefundableAmount(0))
+	s.Require().NoError(s.charge.RecordSuccess("txn_1"))
+
+	s.Equal(uint64(200), s.charge.RefundableAmount(34))
+	s.Equal(uint64(0), s.charge.RefundableAmount(234))
+}
+
+func TestScheduledCharge(t *testing.T) {
+	suite.Run(t, new(ScheduledChargeSuite))
+}
@@ -0,0 +1,34 @@
+package pricing
+
+import "errors"
+
+type ExtraGuestRate struct {
+	RoomType        string
+	BaseOccupancy   uint8
+	ExtraAdultPrice uint64
+	ExtraChildPrice uint64
+	ChildMaxAge     uint8
+}
+
+func NewExtraGuestRate(roomType string, baseOccupancy uint8, extraAdultPrice uint64, extraChildPrice uint64,
+	childMaxAge uint8) (ExtraGuestRate, error) {
+	if roomType != "SINGLE" && roomType != "DOUBLE" && roomType != "TWIN" && roomType != "SUITE" {
+		return ExtraGuestRate{}, errors.New("room type must be SINGLE, DOUBLE, TWIN or SUITE")
+	}
+
+	if baseOccupancy <= 0 {
+		return ExtraGuestRate{}, errors.New("base occupancy must be at least one")
+	}
+
+	if childMaxAge >= 18 {
+		return ExtraGuestRate{}, errors.New("child max age must be between 0 and 17")
+	}
+
+	return ExtraGuestRate{
+		RoomType:        roomType,
+		BaseOccupancy:   baseOccupancy,
+		ExtraAdultPrice: extraAdultPrice,
+		ExtraChildPrice: extraChildPrice,
+		ChildMaxAge:     childMaxAge,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExtraGuestRateSuite struct {
+	suite.Suite
+}
+
+func (e *ExtraGuestRateSuite) TestNewExtraGuestRate_OnNoErrors_ReturnsRate() {
+	rate, err := pricing.NewExtraGuestRate("DOUBLE", 2, 40, 0, 11)
+	e.Require().NoError(err)
+
+	e.Equal(pricing.ExtraGuestRate{
+		RoomType:        "DOUBLE",
+		BaseOccupancy:   2,
+		ExtraAdultPrice: 40,
+		ExtraChildPrice: 0,
+		ChildMaxAge:     11,
+	}, rate)
+}
+
+func (e *ExtraGuestRateSuite) TestNewExtraGuestRate_OnInvalidRoomType_ReturnsError() {
+	_, err := pricing.NewExtraGuestRate("PENTHOUSE", 2, 40, 20, 11)
+
+	e.EqualError(err, "room type must be SINGLE, DOUBLE, TWIN or SUITE")
+}
+
+func (e *ExtraGuestRateSuite) TestNewExtraGuestRate_OnChildMaxAgeOfAnAdult_ReturnsError() {
+	_, err := pricing.NewExtraGuestRate("DOUBLE", 2, 40, 20, 18)
+
+	e.EqualError(err, "child max age must be between 0 and 17")
+}
+
+func TestExtraGuestRate(t *testing.T) {
+	suite.Run(t, new(ExtraGuestRateSuite))
+}
@@ -0,0 +1,70 @@
+package pricing
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type OccupancyPricingRule struct {
+	Id                 uuid.UUID
+	RoomType           string
+	OccupancyThreshold uint8
+	AdjustmentPercent  uint8
+	FloorPrice         uint64
+	CeilingPrice       uint64
+	Enabled            bool
+}
+
+func NewOccupancyPricingRule(roomType string, occupancyThreshold uint8, adjustmentPercent uint8, floorPrice uint64,
+	ceilingPrice uint64) (OccupancyPricingRule, error) {
+	if roomType != "SINGLE" && roomType != "DOUBLE" && roomType != "TWIN" && roomType != "SUITE" {
+		return OccupancyPricingRule{}, errors.New("room type must be SINGLE, DOUBLE, TWIN or SUITE")
+	}
+
+	if occupancyThreshold >= 100 {
+		return OccupancyPricingRule{}, errors.New("occupancy threshold must be less than 100")
+	}
+
+	if adjustmentPercent <= 0 {
+		return OccupancyPricingRule{}, errors.New("adjustment percent must be greater than zero")
+	}
+
+	if ceilingPrice < floorPrice {
+		return OccupancyPricingRule{}, errors.New("ceiling price must be greater than or equal to floor price")
+	}
+
+	return OccupancyPricingRule{
+		Id:                 uuid.New(),
+		RoomType:           roomType,
+		OccupancyThreshold: occupancyThreshold,
+		AdjustmentPercent:  adjustmentPercent,
+		FloorPrice:         floorPrice,
+		CeilingPrice:       ceilingPrice,
+		Enabled:            false,
+	}, nil
+}
+
+func (o *OccupancyPricingRule) Enable() {
+	o.Enabled = true
+}
+
+// Apply raises the price of the nights above the occupancy threshold, capped at the ceiling. Nights it does not raise
+// keep their price; the floor only applies when the ceiling cuts a price below what it was.
+func (o OccupancyPricingRule) Apply(night Night, price uint64) uint64 {
+	if night.RoomType != o.RoomType || night.OccupancyPercent <= float64(o.OccupancyThreshold) {
+		return price
+	}
+
+	adjustedPrice := price * (100 + uint64(o.AdjustmentPercent)) / 100
+
+	if o.CeilingPrice > 0 && adjustedPrice > o.CeilingPrice {
+		adjustedPrice = o.CeilingPrice
+	}
+
+	if adjustedPrice < price && o.FloorPrice > 0 && adjustedPrice < o.FloorPrice {
+		return o.FloorPrice
+	}
+
+	return adjustedPrice
+}
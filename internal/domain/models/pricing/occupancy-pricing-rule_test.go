@@ -0,0 +1,98 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/stretchr/testify/suite"
+)
+
+type OccupancyPricingRuleSuite struct {
+	suite.Suite
+}
+
+func (o *OccupancyPricingRuleSuite) TestNewOccupancyPricingRule_OnNoErrors_ReturnsDisabledRule() {
+	rule, err := pricing.NewOccupancyPricingRule("SUITE", 80, 15, 200, 400)
+	o.Require().NoError(err)
+
+	o.Equal("SUITE", rule.RoomType)
+	o.Equal(uint8(80), rule.OccupancyThreshold)
+	o.Equal(uint8(15), rule.AdjustmentPercent)
+	o.Equal(uint64(200), rule.FloorPrice)
+	o.Equal(uint64(400), rule.CeilingPrice)
+	o.False(rule.Enabled)
+}
+
+func (o *OccupancyPricingRuleSuite) TestNewOccupancyPricingRule_OnInvalidRoomType_ReturnsError() {
+	_, err := pricing.NewOccupancyPricingRule("abc", 80, 15, 200, 400)
+
+	o.EqualError(err, "room type must be SINGLE, DOUBLE, TWIN or SUITE")
+}
+
+func (o *OccupancyPricingRuleSuite) TestNewOccupancyPricingRule_OnInvalidThreshold_ReturnsError() {
+	_, err := pricing.NewOccupancyPricingRule("SUITE", 100, 15, 200, 400)
+
+	o.EqualError(err, "occupancy threshold must be less than 100")
+}
+
+func (o *OccupancyPricingRuleSuite) TestNewOccupancyPricingRule_OnInvalidAdjustment_ReturnsError() {
+	_, err := pricing.NewOccupancyPricingRule("SUITE", 80, 0, 200, 400)
+
+	o.EqualError(err, "adjustment percent must be greater than zero")
+}
+
+func (o *OccupancyPricingRuleSuite) TestNewOccupancyPricingRule_OnCeilingBelowFloor_ReturnsError() {
+	_, err := pricing.NewOccupancyPricingRule("SUITE", 80, 15, 400, 200)
+
+	o.EqualError(err, "ceiling price must be greater than or equal to floor price")
+}
+
+func (o *OccupancyPricingRuleSuite) TestApply_OnOccupancyAboveThreshold_IncreasesPrice() {
+	rule, err := pricing.NewOccupancyPricingRule("SUITE", 80, 15, 100, 1000)
+	o.Require().NoError(err)
+
+	price := rule.Apply(pricing.Night{RoomType: "SUITE", Date: time.Now(), OccupancyPercent: 85}, 200)
+
+	o.Equal(uint64(230), price)
+}
+
+func (o *OccupancyPricingRuleSuite) TestApply_OnOccupancyAtOrBelowThreshold_KeepsPrice() {
+	rule, err := pricing.NewOccupancyPricingRule("SUITE", 80, 15, 100, 1000)
+	o.Require().NoError(err)
+
+	price := rule.Apply(pricing.Night{RoomType: "SUITE", Date: time.Now(), OccupancyPercent: 80}, 200)
+
+	o.Equal(uint64(200), price)
+}
+
+func (o *OccupancyPricingRuleSuite) TestApply_OnAdjustedPriceAboveCeiling_ClampsPrice() {
+	rule, err := pricing.NewOccupancyPricingRule("SUITE", 80, 50, 250, 280)
+	o.Require().NoError(err)
+
+	price := rule.Apply(pricing.Night{RoomType: "SUITE", OccupancyPercent: 90}, 200)
+
+	o.Equal(uint64(280), price)
+}
+
+func (o *OccupancyPricingRuleSuite) TestApply_OnUnadjustedPriceBelowFloor_KeepsPrice() {
+	rule, err := pricing.NewOccupancyPricingRule("SUITE", 80, 50, 250, 280)
+	o.Require().NoError(err)
+
+	price := rule.Apply(pricing.Night{RoomType: "SUITE", OccupancyPercent: 10}, 200)
+
+	o.Equal(uint64(200), price)
+}
+
+func (o *OccupancyPricingRuleSuite) TestApply_OnDifferentRoomType_KeepsPrice() {
+	rule, err := pricing.NewOccupancyPricingRule("SUITE", 80, 50, 250, 280)
+	o.Require().NoError(err)
+
+	price := rule.Apply(pricing.Night{RoomType: "SINGLE", OccupancyPercent: 90}, 200)
+
+	o.Equal(uint64(200), price)
+}
+
+func TestOccupancyPricingRule(t *testing.T) {
+	suite.Run(t, new(OccupancyPricingRuleSuite))
+}
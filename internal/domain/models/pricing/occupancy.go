@@ -0,0 +1,38 @@
+package pricing
+
+import "time"
+
+type Occupancy struct {
+	RoomType    string
+	Date        time.Time
+	BookedRooms int
+	TotalRooms  int
+}
+
+func (o Occupancy) Percent() float64 {
+	if o.TotalRooms == 0 {
+		return 0
+	}
+
+	return float64(o.BookedRooms) * 100 / float64(o.TotalRooms)
+}
+
+type OccupancyCalendar map[string]map[string]Occupancy
+
+func NewOccupancyCalendar(occupancies []Occupancy) OccupancyCalendar {
+	calendar := OccupancyCalendar{}
+
+	for _, occupancy := range occupancies {
+		if _, ok := calendar[occupancy.RoomType]; !ok {
+			calendar[occupancy.RoomType] = map[string]Occupancy{}
+		}
+
+		calendar[occupancy.RoomType][occupancy.Date.Format(time.DateOnly)] = occupancy
+	}
+
+	return calendar
+}
+
+func (o OccupancyCalendar) PercentOn(roomType string, date time.Time) float64 {
+	return o[roomType][date.Format(time.DateOnly)].Percent()
+}
@@ -0,0 +1,33 @@
+package pricing
+
+import "time"
+
+type PricingEngine struct {
+	Strategies      []IPricingStrategy
+	Occupancy       OccupancyCalendar
+	ExtraGuestRates map[string]ExtraGuestRate
+}
+
+func (p PricingEngine) NightlyRate(roomType string, baseRate uint64, date time.Time) uint64 {
+	night := Night{
+		RoomType:         roomType,
+		Date:             date,
+		OccupancyPercent: p.Occupancy.PercentOn(roomType, date),
+	}
+
+	price := baseRate
+	for _, strategy := range p.Strategies {
+		price = strategy.Apply(night, price)
+	}
+
+	return price
+}
+
+func (p PricingEngine) ExtraGuestRateFor(roomType string, capacity uint8) ExtraGuestRate {
+	rate, ok := p.ExtraGuestRates[roomType]
+	if !ok || rate.BaseOccupancy > capacity {
+		return ExtraGuestRate{RoomType: roomType, BaseOccupancy: capacity}
+	}
+
+	return rate
+}
@@ -0,0 +1,49 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/stretchr/testify/suite"
+)
+
+type PricingEngineSuite struct {
+	suite.Suite
+}
+
+func (p *PricingEngineSuite) TestNightlyRate_OnNoStrategies_ReturnsBaseRate() {
+	pricingEngine := pricing.PricingEngine{}
+
+	price := pricingEngine.NightlyRate("SUITE", 250, time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	p.Equal(uint64(250), price)
+}
+
+func (p *PricingEngineSuite) TestNightlyRate_OnStrategies_AppliesThemInOrderWithNightOccupancy() {
+	date := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	pricingEngine := pricing.PricingEngine{
+		Strategies: []pricing.IPricingStrategy{
+			pricing.OccupancyPricingRule{RoomType: "SUITE", OccupancyThreshold: 50, AdjustmentPercent: 10},
+			pricing.OccupancyPricingRule{RoomType: "SUITE", OccupancyThreshold: 70, AdjustmentPercent: 10},
+		},
+		Occupancy: pricing.NewOccupancyCalendar([]pricing.Occupancy{
+			{RoomType: "SUITE", Date: date, BookedRooms: 3, TotalRooms: 5},
+		}),
+	}
+
+	p.Equal(uint64(220), pricingEngine.NightlyRate("SUITE", 200, date))
+	p.Equal(uint64(200), pricingEngine.NightlyRate("SUITE", 200, date.AddDate(0, 0, 1)))
+}
+
+func (p *PricingEngineSuite) TestExtraGuestRateFor_OnUnknownRoomType_UsesCapacityAsBaseOccupancy() {
+	pricingEngine := pricing.PricingEngine{}
+
+	rate := pricingEngine.ExtraGuestRateFor("SUITE", 4)
+
+	p.Equal(uint8(4), rate.BaseOccupancy)
+}
+
+func TestPricingEngine(t *testing.T) {
+	suite.Run(t, new(PricingEngineSuite))
+}
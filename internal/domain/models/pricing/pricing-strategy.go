@@ -0,0 +1,13 @@
+package pricing
+
+import "time"
+
+type Night struct {
+	RoomType         string
+	Date             time.Time
+	OccupancyPercent float64
+}
+
+type IPricingStrategy interface {
+	Apply(night Night, price uint64) uint64
+}
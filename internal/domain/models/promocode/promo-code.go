@@ -0,0 +1,22 @@
+package promocode
+
+import "time"
+
+type PromoCode struct {
+	Code            string
+	DiscountPercent uint8
+	ValidFrom       time.Time
+	ValidUntil      time.Time
+}
+
+func (p PromoCode) IsValidOn(date time.Time) bool {
+	return !date.Before(p.ValidFrom) && !date.After(p.ValidUntil)
+}
+
+func (p PromoCode) Discount(amount uint64) uint64 {
+	if p.DiscountPercent >= 100 {
+		return amount
+	}
+
+	return amount * uint64(p.DiscountPercent) / 100
+}
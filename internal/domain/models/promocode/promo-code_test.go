@@ -0,0 +1,53 @@
+package promocode_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromoCodeSuite struct {
+	suite.Suite
+}
+
+func (p *PromoCodeSuite) TestIsValidOn_OnDateWithinValidity_ReturnsTrue() {
+	promoCode := promocode.PromoCode{
+		Code:            "SUMMER",
+		DiscountPercent: 10,
+		ValidFrom:       time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		ValidUntil:      time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	dates := []time.Time{
+		time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, date := range dates {
+		p.True(promoCode.IsValidOn(date))
+	}
+}
+
+func (p *PromoCodeSuite) TestIsValidOn_OnDateOutsideValidity_ReturnsFalse() {
+	promoCode := promocode.PromoCode{
+		Code:            "SUMMER",
+		DiscountPercent: 10,
+		ValidFrom:       time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		ValidUntil:      time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	p.False(promoCode.IsValidOn(time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)))
+	p.False(promoCode.IsValidOn(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func (p *PromoCodeSuite) TestDiscount_OnAmount_ReturnsPercentageOfAmount() {
+	p.Equal(uint64(50), promocode.PromoCode{DiscountPercent: 10}.Discount(500))
+	p.Equal(uint64(500), promocode.PromoCode{DiscountPercent: 120}.Discount(500))
+}
+
+func TestPromoCode(t *testing.T) {
+	suite.Run(t, new(PromoCodeSuite))
+}
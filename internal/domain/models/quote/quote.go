@@ -0,0 +1,265 @@
+package quote
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+)
+
+type QuoteItem struct {
+	Type        string
+	RoomId      uuid.UUID
+	Date        time.Time
+	Description string
+	Price       uint64
+}
+
+type SelectedAddOn struct {
+	AddOn    addon.AddOn
+	Quantity uint8
+}
+
+type SelectedPackage struct {
+	Package    bundle.Package
+	Components []SelectedAddOn
+}
+
+type QuoteAddOn struct {
+	Code     string
+	Quantity uint8
+	Price    uint64
+}
+
+type Quote struct {
+	RoomIds   []uuid.UUID
+	CheckIn   time.Time
+	CheckOut  time.Time
+	Guests    guests.Guests
+	AddOns    []QuoteAddOn
+	PackageId uuid.UUID
+	Package   string
+	PromoCode string
+	Items     []QuoteItem
+	Subtotal  uint64
+	Discount  uint64
+	Total     uint64
+}
+
+func NewQuote(rooms []room.Room, checkIn time.Time, checkOut time.Time, stayGuests guests.Guests, addOns []SelectedAddOn,
+	selectedPackage *SelectedPackage, promoCode *promocode.PromoCode, pricingEngine pricing.PricingEngine) (Quote, error) {
+	if len(rooms) == 0 {
+		return Quote{}, errors.New("at least one room must be selected")
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	if checkIn.Before(today) {
+		return Quote{}, errors.New("check-in date cannot be in the past")
+	}
+
+	if !checkOut.After(checkIn) {
+		return Quote{}, errors.New("check-out date must be after check-in date")
+	}
+
+	if stayGuests.Adults <= 0 {
+		return Quote{}, errors.New("at least one adult is required")
+	}
+
+	totalCapacity := 0
+	for _, room := range rooms {
+		totalCapacity += int(room.Capacity)
+	}
+
+	if stayGuests.Total() > totalCapacity {
+		return Quote{}, errors.New("the selected rooms cannot accommodate the number of guests")
+	}
+
+	for _, selected := range addOns {
+		if selected.Quantity <= 0 {
+			return Quote{}, errors.New("add-on quantity must be at least one")
+		}
+	}
+
+	if promoCode != nil && !promoCode.IsValidOn(checkIn) {
+		return Quote{}, errors.New("promo code is invalid or has expired")
+	}
+
+	if selectedPackage != nil {
+		nights := int(checkOut.Sub(checkIn).Hours() / 24)
+		err := selectedPackage.Package.CanBeBooked(checkIn, nights)
+		if err != nil {
+			return Quote{}, err
+		}
+
+		for _, room := range rooms {
+			if room.Type != selectedPackage.Package.RoomType {
+				return Quote{}, errors.New("the selected rooms do not match the package room type")
+			}
+		}
+
+		if promoCode != nil {
+			return Quote{}, errors.New("promo codes cannot be combined with packages")
+		}
+	}
+
+	return RepriceStay(rooms, checkIn, checkOut, stayGuests, addOns, selectedPackage, promoCode, pricingEngine), nil
+}
+
+// RepriceStay prices a stay that was already booked with the prices in effect now. Unlike NewQuote it does not check
+// whether the stay could still be booked, so a stay in progress or one with an expired promo code can be priced.
+func RepriceStay(rooms []room.Room, checkIn time.Time, checkOut time.Time, stayGuests guests.Guests, addOns []SelectedAddOn,
+	selectedPackage *SelectedPackage, promoCode *promocode.PromoCode, pricingEngine pricing.PricingEngine) Quote {
+	newQuote := Quote{
+		CheckIn:  checkIn,
+		CheckOut: checkOut,
+		Guests:   stayGuests,
+	}
+
+	if selectedPackage != nil {
+		newQuote.PackageId = selectedPackage.Package.Id
+		newQuote.Package = selectedPackage.Package.Code
+	}
+
+	extraGuests := allocateExtraGuests(rooms, stayGuests, pricingEngine)
+
+	for i, room := range rooms {
+		newQuote.RoomIds = append(newQuote.RoomIds, room.Id)
+
+		for date := checkIn; date.Before(checkOut); date = date.AddDate(0, 0, 1) {
+			if selectedPackage != nil {
+				newQuote.addItem(QuoteItem{
+					Type:        "PACKAGE_NIGHT",
+					RoomId:      room.Id,
+					Date:        date,
+					Description: fmt.Sprintf("%s - room %s", selectedPackage.Package.Name, room.Number),
+					Price:       selectedPackage.Package.PricePerNight,
+				})
+			} else {
+				newQuote.addItem(QuoteItem{
+					Type:        "ROOM_NIGHT",
+					RoomId:      room.Id,
+					Date:        date,
+					Description: fmt.Sprintf("Room %s", room.Number),
+					Price:       pricingEngine.NightlyRate(room.Type, room.Price, date),
+				})
+			}
+
+			if extraGuests[i].adults > 0 {
+				newQuote.addItem(QuoteItem{
+					Type:        "EXTRA_ADULT",
+					RoomId:      room.Id,
+					Date:        date,
+					Description: fmt.Sprintf("%d extra adult(s) in room %s", extraGuests[i].adults, room.Number),
+					Price:       extraGuests[i].rate.ExtraAdultPrice * uint64(extraGuests[i].adults),
+				})
+			}
+
+			if extraGuests[i].children > 0 {
+				newQuote.addItem(QuoteItem{
+					Type:        "EXTRA_CHILD",
+					RoomId:      room.Id,
+					Date:        date,
+					Description: fmt.Sprintf("%d extra child(ren) in room %s", extraGuests[i].children, room.Number),
+					Price:       extraGuests[i].rate.ExtraChildPrice * uint64(extraGuests[i].children),
+				})
+			}
+		}
+	}
+
+	if selectedPackage != nil {
+		for _, component := range selectedPackage.Components {
+			newQuote.addItem(QuoteItem{
+				Type: "PACKAGE_COMPONENT",
+				Description: fmt.Sprintf("%s x%d (included in %s)", component.AddOn.Name, int(component.Quantity)*len(rooms),
+					selectedPackage.Package.Name),
+				Price: 0,
+			})
+		}
+	}
+
+	for _, selected := range addOns {
+		price := selected.AddOn.Charge(selected.Quantity, newQuote.Nights(), stayGuests.Total())
+		newQuote.AddOns = append(newQuote.AddOns, QuoteAddOn{
+			Code:     selected.AddOn.Code,
+			Quantity: selected.Quantity,
+			Price:    price,
+		})
+		newQuote.addItem(QuoteItem{
+			Type:        "ADD_ON",
+			Description: fmt.Sprintf("%s x%d", selected.AddOn.Name, selected.Quantity),
+			Price:       price,
+		})
+	}
+
+	if promoCode != nil {
+		newQuote.PromoCode = promoCode.Code
+		newQuote.Discount = promoCode.Discount(newQuote.Subtotal)
+	}
+
+	newQuote.Total = newQuote.Subtotal - newQuote.Discount
+
+	return newQuote
+}
+
+func (q Quote) Nights() int {
+	return int(q.CheckOut.Sub(q.CheckIn).Hours() / 24)
+}
+
+func (q *Quote) addItem(item QuoteItem) {
+	q.Items = append(q.Items, item)
+	q.Subtotal += item.Price
+}
+
+type roomExtraGuests struct {
+	rate     pricing.ExtraGuestRate
+	adults   int
+	children int
+}
+
+// allocateExtraGuests fills the base occupancy of every room first, adults before children, and places whoever is
+// left in the remaining beds. Children older than the room type child max age are charged as adults.
+func allocateExtraGuests(rooms []room.Room, stayGuests guests.Guests, pricingEngine pricing.PricingEngine) []roomExtraGuests {
+	extraGuests := make([]roomExtraGuests, len(rooms))
+
+	remainingAdults := int(stayGuests.Adults)
+	remainingChildren := stayGuests.ChildrenAges
+
+	for i, room := range rooms {
+		extraGuests[i].rate = pricingEngine.ExtraGuestRateFor(room.Type, room.Capacity)
+
+		baseSlots := int(extraGuests[i].rate.BaseOccupancy)
+		adults := min(baseSlots, remainingAdults)
+		remainingAdults -= adults
+
+		children := min(baseSlots-adults, len(remainingChildren))
+		remainingChildren = remainingChildren[children:]
+	}
+
+	for i, room := range rooms {
+		spareSlots := int(room.Capacity) - int(extraGuests[i].rate.BaseOccupancy)
+
+		adults := min(spareSlots, remainingAdults)
+		remainingAdults -= adults
+		extraGuests[i].adults = adults
+
+		children := min(spareSlots-adults, len(remainingChildren))
+		for _, age := range remainingChildren[:children] {
+			if age > extraGuests[i].rate.ChildMaxAge {
+				extraGuests[i].adults++
+			} else {
+				extraGuests[i].children++
+			}
+		}
+		remainingChildren = remainingChildren[children:]
+	}
+
+	return extraGuests
+}
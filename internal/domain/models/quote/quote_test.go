@@ -0,0 +1,283 @@
+package quote_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/quote"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
+	"github.com/stretchr/testify/suite"
+)
+
+type QuoteSuite struct {
+	suite.Suite
+	checkIn  time.Time
+	checkOut time.Time
+	rooms    []room.Room
+}
+
+func (q *QuoteSuite) SetupTest() {
+	q.checkIn = time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 10)
+	q.checkOut = q.checkIn.AddDate(0, 0, 2)
+	q.rooms = []room.Room{
+		{
+			Id:       uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca"),
+			Number:   "101",
+			Type:     "SUITE",
+			Capacity: 2,
+			Price:    250,
+		},
+		{
+			Id:       uuid.MustParse("57dba1c3-0421-4f24-a7c3-2a0b6c13063d"),
+			Number:   "102",
+			Type:     "SINGLE",
+			Capacity: 1,
+			Price:    100,
+		},
+	}
+}
+
+func (q *QuoteSuite) TestNewQuote_OnNoErrors_ReturnsItemizedQuote() {
+	newQuote, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 3}, nil, nil, nil, pricing.PricingEngine{})
+	q.Require().NoError(err)
+
+	q.Equal(2, newQuote.Nights())
+	q.Len(newQuote.Items, 4)
+	q.Equal("ROOM_NIGHT", newQuote.Items[0].Type)
+	q.Equal("Room 101", newQuote.Items[0].Description)
+	q.Equal(q.rooms[0].Id, newQuote.Items[0].RoomId)
+	q.Equal(q.checkIn, newQuote.Items[0].Date)
+	q.Equal(uint64(250), newQuote.Items[0].Price)
+	q.Equal(q.checkIn.AddDate(0, 0, 1), newQuote.Items[1].Date)
+	q.Equal(uint64(700), newQuote.Subtotal)
+	q.Equal(uint64(0), newQuote.Discount)
+	q.Equal(uint64(700), newQuote.Total)
+}
+
+func (q *QuoteSuite) TestNewQuote_OnValidPromoCode_AppliesDiscount() {
+	promoCode := promocode.PromoCode{
+		Code:            "SUMMER",
+		DiscountPercent: 10,
+		ValidFrom:       q.checkIn.AddDate(0, 0, -1),
+		ValidUntil:      q.checkIn.AddDate(0, 0, 1),
+	}
+
+	newQuote, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, nil, &promoCode, pricing.PricingEngine{})
+	q.Require().NoError(err)
+
+	q.Equal("SUMMER", newQuote.PromoCode)
+	q.Equal(uint64(700), newQuote.Subtotal)
+	q.Equal(uint64(70), newQuote.Discount)
+	q.Equal(uint64(630), newQuote.Total)
+}
+
+func (q *QuoteSuite) TestNewQuote_OnPricingStrategies_AppliesNightlyRates() {
+	rule := pricing.OccupancyPricingRule{
+		RoomType:           "SUITE",
+		OccupancyThreshold: 80,
+		AdjustmentPercent:  20,
+		FloorPrice:         100,
+		CeilingPrice:       1000,
+		Enabled:            true,
+	}
+	pricingEngine := pricing.PricingEngine{
+		Strategies: []pricing.IPricingStrategy{rule},
+		Occupancy: pricing.NewOccupancyCalendar([]pricing.Occupancy{
+			{RoomType: "SUITE", Date: q.checkIn, BookedRooms: 9, TotalRooms: 10},
+		}),
+	}
+
+	newQuote, err := quote.NewQuote(q.rooms[:1], q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, nil, nil, pricingEngine)
+	q.Require().NoError(err)
+
+	q.Equal(uint64(300), newQuote.Items[0].Price)
+	q.Equal(uint64(250), newQuote.Items[1].Price)
+	q.Equal(uint64(550), newQuote.Total)
+}
+
+func (q *QuoteSuite) TestNewQuote_OnExpiredPromoCode_ReturnsError() {
+	promoCode := promocode.PromoCode{
+		Code:            "SUMMER",
+		DiscountPercent: 10,
+		ValidFrom:       q.checkIn.AddDate(0, 0, -10),
+		ValidUntil:      q.checkIn.AddDate(0, 0, -1),
+	}
+
+	_, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, nil, &promoCode, pricing.PricingEngine{})
+
+	q.EqualError(err, "promo code is invalid or has expired")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnNoRooms_ReturnsError() {
+	_, err := quote.NewQuote([]room.Room{}, q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, nil, nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "at least one room must be selected")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnCheckInInThePast_ReturnsError() {
+	_, err := quote.NewQuote(q.rooms, q.checkIn.AddDate(0, 0, -20), q.checkOut, guests.Guests{Adults: 2}, nil, nil, nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "check-in date cannot be in the past")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnCheckOutNotAfterCheckIn_ReturnsError() {
+	_, err := quote.NewQuote(q.rooms, q.checkIn, q.checkIn, guests.Guests{Adults: 2}, nil, nil, nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "check-out date must be after check-in date")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnNoAdults_ReturnsError() {
+	_, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{}, nil, nil, nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "at least one adult is required")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnGuestsAboveCapacity_ReturnsError() {
+	_, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 4}, nil, nil, nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "the selected rooms cannot accommodate the number of guests")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnGuestsAboveBaseOccupancy_ChargesExtraAdultsAndChildren() {
+	rooms := []room.Room{
+		{
+			Id:       uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca"),
+			Number:   "101",
+			Type:     "SUITE",
+			Capacity: 4,
+			Price:    250,
+		},
+	}
+	pricingEngine := pricing.PricingEngine{
+		ExtraGuestRates: map[string]pricing.ExtraGuestRate{
+			"SUITE": {RoomType: "SUITE", BaseOccupancy: 1, ExtraAdultPrice: 40, ExtraChildPrice: 15, ChildMaxAge: 11},
+		},
+	}
+
+	newQuote, err := quote.NewQuote(rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 2, ChildrenAges: []uint8{4, 14}}, nil,
+		nil, nil, pricingEngine)
+	q.Require().NoError(err)
+
+	q.Len(newQuote.Items, 6)
+	q.Equal("ROOM_NIGHT", newQuote.Items[0].Type)
+	q.Equal("EXTRA_ADULT", newQuote.Items[1].Type)
+	q.Equal("2 extra adult(s) in room 101", newQuote.Items[1].Description)
+	q.Equal(uint64(80), newQuote.Items[1].Price)
+	q.Equal("EXTRA_CHILD", newQuote.Items[2].Type)
+	q.Equal("1 extra child(ren) in room 101", newQuote.Items[2].Description)
+	q.Equal(uint64(15), newQuote.Items[2].Price)
+	q.Equal(uint64(690), newQuote.Total)
+}
+
+func (q *QuoteSuite) TestNewQuote_OnAddOns_ChargesAddOnsByUnit() {
+	addOns := []quote.SelectedAddOn{
+		{AddOn: addon.AddOn{Code: "BREAKFAST", Name: "Breakfast", Price: 15, Unit: "PER_PERSON_PER_NIGHT"}, Quantity: 1},
+		{AddOn: addon.AddOn{Code: "PARKING", Name: "Parking", Price: 10, Unit: "PER_NIGHT"}, Quantity: 1},
+		{AddOn: addon.AddOn{Code: "LATE_CHECK_OUT", Name: "Late check-out", Price: 30, Unit: "PER_STAY"}, Quantity: 1},
+	}
+
+	newQuote, err := quote.NewQuote(q.rooms[:1], q.checkIn, q.checkOut, guests.Guests{Adults: 2}, addOns, nil, nil,
+		pricing.PricingEngine{})
+	q.Require().NoError(err)
+
+	q.Equal([]quote.QuoteAddOn{
+		{Code: "BREAKFAST", Quantity: 1, Price: 60},
+		{Code: "PARKING", Quantity: 1, Price: 20},
+		{Code: "LATE_CHECK_OUT", Quantity: 1, Price: 30},
+	}, newQuote.AddOns)
+	q.Equal("ADD_ON", newQuote.Items[2].Type)
+	q.Equal("Breakfast x1", newQuote.Items[2].Description)
+	q.Equal(uint64(610), newQuote.Total)
+}
+
+func (q *QuoteSuite) TestNewQuote_OnAddOnWithoutQuantity_ReturnsError() {
+	addOns := []quote.SelectedAddOn{
+		{AddOn: addon.AddOn{Code: "PARKING", Name: "Parking", Price: 10, Unit: "PER_NIGHT"}, Quantity: 0},
+	}
+
+	_, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 2}, addOns, nil, nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "add-on quantity must be at least one")
+}
+
+func (q *QuoteSuite) newSelectedPackage() quote.SelectedPackage {
+	return quote.SelectedPackage{
+		Package: bundle.Package{
+			Id:            uuid.MustParse("5b0e3c8c-5f5e-4d8f-9d0c-3f0d3c1a7b11"),
+			Code:          "ROMANTIC_WEEKEND",
+			Name:          "Romantic weekend",
+			RoomType:      "SUITE",
+			Components:    []bundle.PackageComponent{{AddOnCode: "DINNER", Quantity: 1}},
+			PricePerNight: 400,
+			ValidFrom:     q.checkIn.AddDate(0, 0, -1),
+			ValidUntil:    q.checkIn.AddDate(0, 0, 1),
+			MinStayNights: 2,
+			Active:        true,
+		},
+		Components: []quote.SelectedAddOn{
+			{AddOn: addon.AddOn{Code: "DINNER", Name: "Dinner", Price: 80, Unit: "PER_STAY"}, Quantity: 1},
+		},
+	}
+}
+
+func (q *QuoteSuite) TestNewQuote_OnPackage_ReturnsBundledPriceWithComponents() {
+	selectedPackage := q.newSelectedPackage()
+
+	newQuote, err := quote.NewQuote(q.rooms[:1], q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, &selectedPackage, nil,
+		pricing.PricingEngine{})
+	q.Require().NoError(err)
+
+	q.Equal(selectedPackage.Package.Id, newQuote.PackageId)
+	q.Equal("ROMANTIC_WEEKEND", newQuote.Package)
+	q.Len(newQuote.Items, 3)
+	q.Equal("PACKAGE_NIGHT", newQuote.Items[0].Type)
+	q.Equal("Romantic weekend - room 101", newQuote.Items[0].Description)
+	q.Equal(uint64(400), newQuote.Items[0].Price)
+	q.Equal("PACKAGE_COMPONENT", newQuote.Items[2].Type)
+	q.Equal("Dinner x1 (included in Romantic weekend)", newQuote.Items[2].Description)
+	q.Equal(uint64(0), newQuote.Items[2].Price)
+	q.Equal(uint64(800), newQuote.Total)
+}
+
+func (q *QuoteSuite) TestNewQuote_OnPackageForAnotherRoomType_ReturnsError() {
+	selectedPackage := q.newSelectedPackage()
+
+	_, err := quote.NewQuote(q.rooms, q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, &selectedPackage, nil,
+		pricing.PricingEngine{})
+
+	q.EqualError(err, "the selected rooms do not match the package room type")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnPackageWithPromoCode_ReturnsError() {
+	selectedPackage := q.newSelectedPackage()
+	promoCode := promocode.PromoCode{
+		Code:            "SUMMER",
+		DiscountPercent: 10,
+		ValidFrom:       q.checkIn.AddDate(0, 0, -1),
+		ValidUntil:      q.checkIn.AddDate(0, 0, 1),
+	}
+
+	_, err := quote.NewQuote(q.rooms[:1], q.checkIn, q.checkOut, guests.Guests{Adults: 2}, nil, &selectedPackage, &promoCode,
+		pricing.PricingEngine{})
+
+	q.EqualError(err, "promo codes cannot be combined with packages")
+}
+
+func (q *QuoteSuite) TestNewQuote_OnPackageStayBelowMinimum_ReturnsError() {
+	selectedPackage := q.newSelectedPackage()
+
+	_, err := quote.NewQuote(q.rooms[:1], q.checkIn, q.checkIn.AddDate(0, 0, 1), guests.Guests{Adults: 2}, nil, &selectedPackage,
+		nil, pricing.PricingEngine{})
+
+	q.EqualError(err, "the selected stay is shorter than the package minimum stay")
+}
+
+func TestQuote(t *testing.T) {
+	suite.Run(t, new(QuoteSuite))
+}
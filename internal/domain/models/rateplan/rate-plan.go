@@ -0,0 +1,33 @@
+package rateplan
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+)
+
+type RatePlan struct {
+	Id       uuid.UUID
+	Code     string
+	Name     string
+	Schedule payment.PaymentSchedule
+}
+
+func NewRatePlan(code string, name string, schedule payment.PaymentSchedule) (RatePlan, error) {
+	if !regexp.MustCompile(`^[A-Z0-9_]{2,50}$`).MatchString(code) {
+		return RatePlan{}, errors.New("rate plan code must have 2 to 50 uppercase letters, digits or underscores (e.g. NON_REFUNDABLE)")
+	}
+
+	if len(name) < 3 {
+		return RatePlan{}, errors.New("rate plan name must be at least 3 characters long")
+	}
+
+	return RatePlan{
+		Id:       uuid.New(),
+		Code:     code,
+		Name:     name,
+		Schedule: schedule,
+	}, nil
+}
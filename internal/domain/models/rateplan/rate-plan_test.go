@@ -0,0 +1,43 @@
+package rateplan_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+	"github.com/stretchr/testify/suite"
+)
+
+type RatePlanSuite struct {
+	suite.Suite
+}
+
+func (r *RatePlanSuite) TestNewRatePlan_OnNoErrors_ReturnsRatePlan() {
+	schedule := payment.PaymentSchedule{Installments: []payment.Installment{{Percent: 100, Due: "AT_BOOKING"}}}
+
+	newRatePlan, err := rateplan.NewRatePlan("NON_REFUNDABLE", "Non refundable", schedule)
+	r.Require().NoError(err)
+
+	r.Equal("NON_REFUNDABLE", newRatePlan.Code)
+	r.Equal("Non refundable", newRatePlan.Name)
+	r.Equal(schedule, newRatePlan.Schedule)
+}
+
+func (r *RatePlanSuite) TestNewRatePlan_OnInvalidCode_ReturnsError() {
+	codes := []string{"", "a", "non_refundable", "NON REFUNDABLE"}
+
+	for _, code := range codes {
+		_, err := rateplan.NewRatePlan(code, "Non refundable", payment.PaymentSchedule{})
+		r.EqualError(err, "rate plan code must have 2 to 50 uppercase letters, digits or underscores (e.g. NON_REFUNDABLE)")
+	}
+}
+
+func (r *RatePlanSuite) TestNewRatePlan_OnInvalidName_ReturnsError() {
+	_, err := rateplan.NewRatePlan("NON_REFUNDABLE", "NR", payment.PaymentSchedule{})
+
+	r.EqualError(err, "rate plan name must be at least 3 characters long")
+}
+
+func TestRatePlan(t *testing.T) {
+	suite.Run(t, new(RatePlanSuite))
+}
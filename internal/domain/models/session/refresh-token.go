@@ -0,0 +1,94 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is one link of a session. Every refresh rotates the token into a new one of the same family,
+// so presenting a token that was already rotated means it leaked and the whole family must be revoked.
+type RefreshToken struct {
+	Id          uuid.UUID
+	FamilyId    uuid.UUID
+	CustomerId  uuid.UUID
+	StaffUserId uuid.UUID
+	HashedToken string
+	Status      string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// NewRefreshToken starts a new session family and returns the token together with its plain value,
+// which is handed to the client once and never stored.
+func NewRefreshToken(customerId uuid.UUID, ttl time.Duration) (RefreshToken, string, error) {
+	return newRefreshToken(uuid.New(), customerId, uuid.Nil, ttl)
+}
+
+func NewStaffRefreshToken(staffUserId uuid.UUID, ttl time.Duration) (RefreshToken, string, error) {
+	return newRefreshToken(uuid.New(), uuid.Nil, staffUserId, ttl)
+}
+
+func (r *RefreshToken) Rotate(ttl time.Duration, now time.Time) (RefreshToken, string, error) {
+	if r.Status != "ACTIVE" {
+		return RefreshToken{}, "", errors.New("refresh token has already been used")
+	}
+
+	if r.IsExpired(now) {
+		return RefreshToken{}, "", errors.New("refresh token is invalid or has expired")
+	}
+
+	next, plainToken, err := newRefreshToken(r.FamilyId, r.CustomerId, r.StaffUserId, ttl)
+	if err != nil {
+		return RefreshToken{}, "", err
+	}
+
+	r.Status = "ROTATED"
+
+	return next, plainToken, nil
+}
+
+func (r RefreshToken) IsStaff() bool {
+	return r.StaffUserId != uuid.Nil
+}
+
+func (r RefreshToken) IsExpired(now time.Time) bool {
+	return !r.ExpiresAt.After(now)
+}
+
+func HashRefreshToken(plainToken string) string {
+	hash := sha256.Sum256([]byte(plainToken))
+	return hex.EncodeToString(hash[:])
+}
+
+func newRefreshToken(familyId uuid.UUID, customerId uuid.UUID, staffUserId uuid.UUID,
+	ttl time.Duration) (RefreshToken, string, error) {
+	if ttl <= 0 {
+		return RefreshToken{}, "", errors.New("refresh token ttl must be greater than zero")
+	}
+
+	random := make([]byte, 32)
+	_, err := rand.Read(random)
+	if err != nil {
+		return RefreshToken{}, "", err
+	}
+
+	plainToken := base64.RawURLEncoding.EncodeToString(random)
+	now := time.Now().UTC()
+
+	return RefreshToken{
+		Id:          uuid.New(),
+		FamilyId:    familyId,
+		CustomerId:  customerId,
+		StaffUserId: staffUserId,
+		HashedToken: HashRefreshToken(plainToken),
+		Status:      "ACTIVE",
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+	}, plainToken, nil
+}
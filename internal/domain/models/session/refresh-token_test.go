@@ -0,0 +1,72 @@
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshTokenSuite struct {
+	suite.Suite
+	customerId uuid.UUID
+}
+
+func (r *RefreshTokenSuite) SetupTest() {
+	r.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+}
+
+func (r *RefreshTokenSuite) TestNewRefreshToken_OnNoErrors_StoresOnlyTheHash() {
+	refreshToken, plainToken, err := session.NewRefreshToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+
+	r.Len(plainToken, 43)
+	r.NotEqual(plainToken, refreshToken.HashedToken)
+	r.Equal(session.HashRefreshToken(plainToken), refreshToken.HashedToken)
+	r.Equal("ACTIVE", refreshToken.Status)
+	r.Equal(r.customerId, refreshToken.CustomerId)
+	r.NotEqual(uuid.Nil, refreshToken.FamilyId)
+}
+
+func (r *RefreshTokenSuite) TestNewRefreshToken_OnZeroTtl_ReturnsError() {
+	_, _, err := session.NewRefreshToken(r.customerId, 0)
+	r.EqualError(err, "refresh token ttl must be greater than zero")
+}
+
+func (r *RefreshTokenSuite) TestRotate_OnActiveToken_ReturnsNextTokenOfTheSameFamily() {
+	refreshToken, plainToken, err := session.NewRefreshToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+
+	next, nextPlainToken, err := refreshToken.Rotate(time.Hour, time.Now().UTC())
+	r.Require().NoError(err)
+
+	r.Equal("ROTATED", refreshToken.Status)
+	r.Equal("ACTIVE", next.Status)
+	r.Equal(refreshToken.FamilyId, next.FamilyId)
+	r.NotEqual(refreshToken.Id, next.Id)
+	r.NotEqual(plainToken, nextPlainToken)
+}
+
+func (r *RefreshTokenSuite) TestRotate_OnRotatedToken_ReturnsError() {
+	refreshToken, _, err := session.NewRefreshToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+	_, _, err = refreshToken.Rotate(time.Hour, time.Now().UTC())
+	r.Require().NoError(err)
+
+	_, _, err = refreshToken.Rotate(time.Hour, time.Now().UTC())
+	r.EqualError(err, "refresh token has already been used")
+}
+
+func (r *RefreshTokenSuite) TestRotate_OnExpiredToken_ReturnsError() {
+	refreshToken, _, err := session.NewRefreshToken(r.customerId, time.Hour)
+	r.Require().NoError(err)
+
+	_, _, err = refreshToken.Rotate(time.Hour, time.Now().UTC().Add(2*time.Hour))
+	r.EqualError(err, "refresh token is invalid or has expired")
+}
+
+func TestRefreshToken(t *testing.T) {
+	suite.Run(t, new(RefreshTokenSuite))
+}
@@ -0,0 +1,66 @@
+package staff
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+type StaffUser struct {
+	Id             uuid.UUID
+	Name           string
+	Email          string
+	HashedPassword string
+	Role           string
+	CreatedAt      time.Time
+}
+
+func NewStaffUser(name string, email string, plainPassword string, role string,
+	passwordHasher account.PasswordHasher) (StaffUser, error) {
+	name = strings.TrimSpace(name)
+	email = NormalizeEmail(email)
+
+	if len(name) < 3 {
+		return StaffUser{}, errors.New("staff name must be at least 3 characters long")
+	}
+
+	if !emailRegex.MatchString(email) {
+		return StaffUser{}, errors.New("staff email is invalid")
+	}
+
+	if len(plainPassword) < 8 {
+		return StaffUser{}, errors.New("staff password must be at least 8 characters long")
+	}
+
+	if role == "" || role == "CUSTOMER" || role == "PARTNER" {
+		return StaffUser{}, errors.New("staff role must be a staff role")
+	}
+
+	hashedPassword, err := passwordHasher.Hash(plainPassword)
+	if err != nil {
+		return StaffUser{}, err
+	}
+
+	return StaffUser{
+		Id:             uuid.New(),
+		Name:           name,
+		Email:          email,
+		HashedPassword: hashedPassword,
+		Role:           role,
+		CreatedAt:      time.Now().UTC(),
+	}, nil
+}
+
+func (s StaffUser) PasswordMatches(plainPassword string) bool {
+	return account.PasswordMatches(s.HashedPassword, plainPassword)
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,46 @@
+package staff_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+	"github.com/stretchr/testify/suite"
+)
+
+type StaffUserSuite struct {
+	suite.Suite
+}
+
+func (s *StaffUserSuite) TestNewStaffUser_OnNoErrors_HashesPassword() {
+	staffUser, err := staff.NewStaffUser(" Jane Roe ", " Jane.Roe@Hotel.com ", "s3cret-password", "ADMIN", account.PasswordHasher{})
+	s.Require().NoError(err)
+
+	s.Equal("Jane Roe", staffUser.Name)
+	s.Equal("jane.roe@hotel.com", staffUser.Email)
+	s.Equal("ADMIN", staffUser.Role)
+	s.NotEqual("s3cret-password", staffUser.HashedPassword)
+	s.True(staffUser.PasswordMatches("s3cret-password"))
+	s.False(staffUser.PasswordMatches("wrong-password"))
+}
+
+func (s *StaffUserSuite) TestNewStaffUser_OnInvalidValues_ReturnsError() {
+	_, err := staff.NewStaffUser("Jo", "jane.roe@hotel.com", "s3cret-password", "ADMIN", account.PasswordHasher{})
+	s.EqualError(err, "staff name must be at least 3 characters long")
+
+	_, err = staff.NewStaffUser("Jane Roe", "jane.roe", "s3cret-password", "ADMIN", account.PasswordHasher{})
+	s.EqualError(err, "staff email is invalid")
+
+	_, err = staff.NewStaffUser("Jane Roe", "jane.roe@hotel.com", "short", "ADMIN", account.PasswordHasher{})
+	s.EqualError(err, "staff password must be at least 8 characters long")
+
+	_, err = staff.NewStaffUser("Jane Roe", "jane.roe@hotel.com", "s3cret-password", "CUSTOMER", account.PasswordHasher{})
+	s.EqualError(err, "staff role must be a staff role")
+
+	_, err = staff.NewStaffUser("Jane Roe", "jane.roe@hotel.com", "s3cret-password", "PARTNER", account.PasswordHasher{})
+	s.EqualError(err, "staff role must be a staff role")
+}
+
+func TestStaffUser(t *testing.T) {
+	suite.Run(t, new(StaffUserSuite))
+}
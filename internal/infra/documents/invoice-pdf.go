@@ -0,0 +1,142 @@
+package documents
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+)
+
+const linesPerPage = 48
+
+// RenderInvoicePdf writes the invoice as a plain text PDF using the standard Helvetica font, which every reader
+// ships, so no font has to be embedded.
+func RenderInvoicePdf(document usecases.GetInvoiceOutput) []byte {
+	text := invoiceText(document)
+
+	pages := [][]string{}
+	for start := 0; start < len(text); start += linesPerPage {
+		pages = append(pages, text[start:min(start+linesPerPage, len(text))])
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	}
+
+	kids := []string{}
+	for _, page := range pages {
+		var content bytes.Buffer
+		content.WriteString("BT\n/F1 10 Tf\n14 TL\n50 800 Td\n")
+
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) '\n", escapePdfText(line))
+		}
+
+		content.WriteString("ET")
+
+		objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+		objects = append(objects, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] "+
+			"/Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", len(objects)))
+		kids = append(kids, fmt.Sprintf("%d 0 R", len(objects)))
+	}
+
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+
+	offsets := []int{}
+	for i, object := range objects {
+		offsets = append(offsets, pdf.Len())
+		fmt.Fprintf(&pdf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := pdf.Len()
+	fmt.Fprintf(&pdf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&pdf, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&pdf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return pdf.Bytes()
+}
+
+func invoiceText(document usecases.GetInvoiceOutput) []string {
+	text := []string{
+		"INVOICE " + document.Number,
+		"Issued at: " + document.IssuedAt.Format(time.DateOnly),
+		"Booking: " + document.BookingId.String(),
+		"",
+		"Bill to: " + document.BillingName,
+	}
+
+	if document.BillingTaxId != "" {
+		text = append(text, "Tax id: "+document.BillingTaxId)
+	}
+
+	if document.BillingAddress != "" {
+		text = append(text, "Address: "+document.BillingAddress)
+	}
+
+	if document.BillingEmail != "" {
+		text = append(text, "Email: "+document.BillingEmail)
+	}
+
+	text = append(text, "", fmt.Sprintf("%-40s %6s %10s %10s %10s", "Description", "Tax %", "Net", "Tax", "Total"))
+
+	for _, line := range document.Lines {
+		text = append(text, fmt.Sprintf("%-40s %6d %10d %10d %10d", truncate(line.Description, 40), line.TaxRate,
+			line.NetAmount, line.TaxAmount, line.GrossAmount))
+	}
+
+	text = append(text, "", "Tax breakdown")
+
+	for _, tax := range document.TaxBreakdown {
+		text = append(text, fmt.Sprintf("%-40s %6d %10d %10d %10d", "", tax.TaxRate, tax.NetAmount, tax.TaxAmount,
+			tax.GrossAmount))
+	}
+
+	text = append(text,
+		"",
+		fmt.Sprintf("Net total: %d", document.NetTotal),
+		fmt.Sprintf("Tax total: %d", document.TaxTotal),
+		fmt.Sprintf("Total: %d", document.GrossTotal),
+		fmt.Sprintf("Amount paid: %d", document.AmountPaid),
+		fmt.Sprintf("Amount due: %d", document.AmountDue),
+	)
+
+	return text
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+
+	return string(runes[:length-3]) + "..."
+}
+
+func escapePdfText(value string) string {
+	var escaped strings.Builder
+
+	for _, r := range value {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case r < 32 || r > 126:
+			escaped.WriteRune('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
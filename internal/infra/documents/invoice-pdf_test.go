@@ -0,0 +1,69 @@
+package documents_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/documents"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvoicePdfSuite struct {
+	suite.Suite
+	document usecases.GetInvoiceOutput
+}
+
+func (i *InvoicePdfSuite) SetupTest() {
+	i.document = usecases.GetInvoiceOutput{
+		InvoiceId:   uuid.MustParse("0c8f9a1e-8b7d-4c6e-a5f4-3e2d1c0b9a87"),
+		Number:      "MAIN-INV-000001",
+		BookingId:   uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+		BillingName: "Acme (Brazil) Corp",
+		Lines: []usecases.GetInvoiceOutputLine{
+			{Category: "ROOM", Description: "Room stay", TaxRate: 10, NetAmount: 400, TaxAmount: 40, GrossAmount: 440},
+		},
+		TaxBreakdown: []usecases.GetInvoiceOutputTax{{TaxRate: 10, NetAmount: 400, TaxAmount: 40, GrossAmount: 440}},
+		NetTotal:     400,
+		TaxTotal:     40,
+		GrossTotal:   440,
+		IssuedAt:     time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func (i *InvoicePdfSuite) TestRenderInvoicePdf_OnInvoice_WritesValidPdf() {
+	pdf := documents.RenderInvoicePdf(i.document)
+
+	i.True(bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	i.True(bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+	i.Contains(string(pdf), "(INVOICE MAIN-INV-000001) '")
+	i.Contains(string(pdf), `(Bill to: Acme \(Brazil\) Corp) '`)
+	i.Contains(string(pdf), "/Count 1")
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	i.Require().NotNil(startxref)
+	offset, err := strconv.Atoi(string(startxref[1]))
+	i.Require().NoError(err)
+	i.True(bytes.HasPrefix(pdf[offset:], []byte("xref\n")))
+}
+
+func (i *InvoicePdfSuite) TestRenderInvoicePdf_OnManyLines_SplitsPages() {
+	for line := range 60 {
+		i.document.Lines = append(i.document.Lines, usecases.GetInvoiceOutputLine{
+			Category: "MINIBAR", Description: fmt.Sprintf("Soda %d", line), TaxRate: 20, GrossAmount: 6,
+		})
+	}
+
+	pdf := documents.RenderInvoicePdf(i.document)
+
+	i.Contains(string(pdf), "/Count 2")
+}
+
+func TestInvoicePdf(t *testing.T) {
+	suite.Run(t, new(InvoicePdfSuite))
+}
@@ -2,19 +2,22 @@ package gateways
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type CustomersGateway struct {
-	Conn *pgx.Conn
+	Pool *pgxpool.Pool
 }
 
 func (c *CustomersGateway) Create(customerDTO gateways.CustomerDTO) error {
-	_, err := c.Conn.Exec(context.Background(), "INSERT INTO customers (id, name, email, password) VALUES ($1, $2, $3, $4)",
-		customerDTO.Id.String(), customerDTO.Name, customerDTO.Email, customerDTO.HashedPassword)
+	_, err := c.Pool.Exec(context.Background(), `INSERT INTO customers
+		(id, name, email, password, status, verification_email_sent_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+		customerDTO.Id.String(), customerDTO.Name, customerDTO.Email, customerDTO.HashedPassword, customerDTO.Status,
+		nullableTime(customerDTO.VerificationEmailSentAt))
 
 	if err != nil {
 		return err
@@ -24,14 +27,26 @@ func (c *CustomersGateway) Create(customerDTO gateways.CustomerDTO) error {
 }
 
 func (c *CustomersGateway) FindOneByEmail(email string) (*gateways.CustomerDTO, error) {
+	return c.findOne("email = $1", email)
+}
+
+func (c *CustomersGateway) FindOneById(id uuid.UUID) (*gateways.CustomerDTO, error) {
+	return c.findOne("id = $1", id.String())
+}
+
+func (c *CustomersGateway) findOne(condition string, value string) (*gateways.CustomerDTO, error) {
 	schema := struct {
-		Id       uuid.UUID
-		Name     string
-		Password string
+		Id                      uuid.UUID
+		Name                    string
+		Email                   string
+		Password                string
+		Status                  string
+		VerificationEmailSentAt *time.Time
 	}{}
 
-	err := c.Conn.QueryRow(context.Background(), "SELECT id, name, password FROM customers WHERE email = $1", email).
-		Scan(&schema.Id, &schema.Name, &schema.Password)
+	err := c.Pool.QueryRow(context.Background(), `SELECT id, name, email, password, status, verification_email_sent_at
+		FROM customers WHERE `+condition, value).
+		Scan(&schema.Id, &schema.Name, &schema.Email, &schema.Password, &schema.Status, &schema.VerificationEmailSentAt)
 
 	if err != nil {
 		if err.Error() == "no rows in result set" {
@@ -44,7 +59,13 @@ func (c *CustomersGateway) FindOneByEmail(email string) (*gateways.CustomerDTO,
 	customerDTO := gateways.CustomerDTO{
 		Id:             schema.Id,
 		Name:           schema.Name,
+		Email:          schema.Email,
 		HashedPassword: schema.Password,
+		Status:         schema.Status,
+	}
+
+	if schema.VerificationEmailSentAt != nil {
+		customerDTO.VerificationEmailSentAt = *schema.VerificationEmailSentAt
 	}
 
 	return &customerDTO, nil
@@ -52,7 +73,22 @@ func (c *CustomersGateway) FindOneByEmail(email string) (*gateways.CustomerDTO,
 
 func (c *CustomersGateway) ExistsByEmail(email string) (bool, error) {
 	var customerId uuid.UUID
-	err := c.Conn.QueryRow(context.Background(), "SELECT id FROM customers WHERE email = $1", email).Scan(&customerId)
+	err := c.Pool.QueryRow(context.Background(), "SELECT id FROM customers WHERE email = $1", email).Scan(&customerId)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *CustomersGateway) ExistsById(id uuid.UUID) (bool, error) {
+	var customerId uuid.UUID
+	err := c.Pool.QueryRow(context.Background(), "SELECT id FROM customers WHERE id = $1", id.String()).Scan(&customerId)
 
 	if err != nil {
 		if err.Error() == "no rows in result set" {
@@ -64,3 +100,43 @@ func (c *CustomersGateway) ExistsByEmail(email string) (bool, error) {
 
 	return true, nil
 }
+
+func (c *CustomersGateway) UpdatePassword(id uuid.UUID, hashedPassword string) error {
+	_, err := c.Pool.Exec(context.Background(), "UPDATE customers SET password = $1 WHERE id = $2", hashedPassword,
+		id.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CustomersGateway) MarkEmailVerified(id uuid.UUID) error {
+	_, err := c.Pool.Exec(context.Background(), "UPDATE customers SET status = 'VERIFIED' WHERE id = $1", id.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CustomersGateway) UpdateVerificationEmailSentAt(id uuid.UUID, sentAt time.Time) error {
+	_, err := c.Pool.Exec(context.Background(), "UPDATE customers SET verification_email_sent_at = $1 WHERE id = $2",
+		sentAt, id.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func nullableTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+
+	return &value
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/google/uuid"
 	applicationgateway "github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/gateways"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -19,7 +19,7 @@ import (
 
 type CustomersGatewaySuite struct {
 	suite.Suite
-	conn              *pgx.Conn
+	pool              *pgxpool.Pool
 	postgresContainer testcontainers.Container
 	customersGateway  gateways.CustomersGateway
 }
@@ -56,12 +56,12 @@ func (c *CustomersGatewaySuite) SetupSuite() {
 		host = "host.docker.internal"
 	}
 
-	conn, err := pgx.Connect(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
 	c.Require().NoError(err)
 
-	c.conn = conn
+	c.pool = pool
 	c.customersGateway = gateways.CustomersGateway{
-		Conn: conn,
+		Pool: pool,
 	}
 
 	os.Setenv("PGUSER", "postgres")
@@ -77,7 +77,7 @@ func (c *CustomersGatewaySuite) SetupSuite() {
 
 func (c *CustomersGatewaySuite) SetupTest() {
 	ctx := context.Background()
-	_, err := c.conn.Exec(ctx, "TRUNCATE TABLE customers")
+	_, err := c.pool.Exec(ctx, "TRUNCATE TABLE customers CASCADE")
 	c.Require().NoError(err)
 }
 
@@ -87,8 +87,7 @@ func (c *CustomersGatewaySuite) TearDownSuite() {
 	err := c.postgresContainer.Terminate(ctx)
 	c.Require().NoError(err)
 
-	err = c.conn.Close(ctx)
-	c.Require().NoError(err)
+	c.pool.Close()
 }
 
 func (c *CustomersGatewaySuite) TestCreate_OnNoErrors_ReturnsNil() {
@@ -99,6 +98,7 @@ func (c *CustomersGatewaySuite) TestCreate_OnNoErrors_ReturnsNil() {
 		Name     string
 		Email    string
 		Password string
+		Status   string
 	}
 
 	err = c.customersGateway.Create(applicationgateway.CustomerDTO{
@@ -106,21 +106,23 @@ func (c *CustomersGatewaySuite) TestCreate_OnNoErrors_ReturnsNil() {
 		Name:           "John Doe",
 		Email:          "john.doe@gmail.com",
 		HashedPassword: "$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu",
+		Status:         "UNVERIFIED",
 	})
 	c.Require().NoError(err)
 
 	var customerSchema CustomerSchema
-	err = c.conn.QueryRow(context.Background(), `SELECT id, name, email, password FROM customers WHERE id = $1`, customerId).
-		Scan(&customerSchema.Id, &customerSchema.Name, &customerSchema.Email, &customerSchema.Password)
+	err = c.pool.QueryRow(context.Background(), `SELECT id, name, email, password, status FROM customers WHERE id = $1`, customerId).
+		Scan(&customerSchema.Id, &customerSchema.Name, &customerSchema.Email, &customerSchema.Password, &customerSchema.Status)
 	c.Require().NoError(err)
 	c.Equal("620d8a0f-abc2-4f80-a1bc-407a037bd920", customerSchema.Id.String())
 	c.Equal("John Doe", customerSchema.Name)
 	c.Equal("john.doe@gmail.com", customerSchema.Email)
 	c.Equal("$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu", customerSchema.Password)
+	c.Equal("UNVERIFIED", customerSchema.Status)
 }
 
 func (c *CustomersGatewaySuite) TestFindOneByEmail_OnFound_ReturnsCustomer() {
-	_, err := c.conn.Exec(context.Background(), `INSERT INTO customers (id, name, email, password) 
+	_, err := c.pool.Exec(context.Background(), `INSERT INTO customers (id, name, email, password) 
 		VALUES ('620d8a0f-abc2-4f80-a1bc-407a037bd920', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
 	c.Require().NoError(err)
 
@@ -129,7 +131,31 @@ func (c *CustomersGatewaySuite) TestFindOneByEmail_OnFound_ReturnsCustomer() {
 
 	c.Equal("620d8a0f-abc2-4f80-a1bc-407a037bd920", customerDTO.Id.String())
 	c.Equal("John Doe", customerDTO.Name)
+	c.Equal("john.doe@gmail.com", customerDTO.Email)
 	c.Equal("$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu", customerDTO.HashedPassword)
+	c.Equal("UNVERIFIED", customerDTO.Status)
+	c.True(customerDTO.VerificationEmailSentAt.IsZero())
+}
+
+func (c *CustomersGatewaySuite) TestFindOneById_OnFound_ReturnsCustomer() {
+	_, err := c.pool.Exec(context.Background(), `INSERT INTO customers (id, name, email, password, status, verification_email_sent_at)
+		VALUES ('620d8a0f-abc2-4f80-a1bc-407a037bd920', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu',
+		'VERIFIED', '2030-06-01 12:00:00')`)
+	c.Require().NoError(err)
+
+	customerDTO, err := c.customersGateway.FindOneById(uuid.MustParse("620d8a0f-abc2-4f80-a1bc-407a037bd920"))
+	c.Require().NoError(err)
+
+	c.Equal("john.doe@gmail.com", customerDTO.Email)
+	c.Equal("VERIFIED", customerDTO.Status)
+	c.Equal(time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC), customerDTO.VerificationEmailSentAt)
+}
+
+func (c *CustomersGatewaySuite) TestFindOneById_OnNotFound_ReturnsNil() {
+	customerDTO, err := c.customersGateway.FindOneById(uuid.MustParse("620d8a0f-abc2-4f80-a1bc-407a037bd920"))
+	c.Require().NoError(err)
+
+	c.Nil(customerDTO)
 }
 
 func (c *CustomersGatewaySuite) TestFindOneByEmail_OnNotFound_ReturnsNil() {
@@ -140,7 +166,7 @@ func (c *CustomersGatewaySuite) TestFindOneByEmail_OnNotFound_ReturnsNil() {
 }
 
 func (c *CustomersGatewaySuite) TestExistsByEmail_OnExists_ReturnsTrue() {
-	_, err := c.conn.Exec(context.Background(), `INSERT INTO customers (id, name, email, password) 
+	_, err := c.pool.Exec(context.Background(), `INSERT INTO customers (id, name, email, password) 
 		VALUES ('620d8a0f-abc2-4f80-a1bc-407a037bd920', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
 	c.Require().NoError(err)
 
@@ -157,6 +183,32 @@ func (c *CustomersGatewaySuite) TestExistsByEmail_OnNotExists_ReturnsFalse() {
 	c.False(exists)
 }
 
+func (c *CustomersGatewaySuite) TestUpdatePassword_OnExists_ReplacesTheHashedPassword() {
+	_, err := c.pool.Exec(context.Background(), `INSERT INTO customers (id, name, email, password) 
+		VALUES ('620d8a0f-abc2-4f80-a1bc-407a037bd920', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	c.Require().NoError(err)
+
+	err = c.customersGateway.UpdatePassword(uuid.MustParse("620d8a0f-abc2-4f80-a1bc-407a037bd920"), "new_hashed_password")
+	c.Require().NoError(err)
+
+	customerDTO, err := c.customersGateway.FindOneByEmail("john.doe@gmail.com")
+	c.Require().NoError(err)
+	c.Equal("new_hashed_password", customerDTO.HashedPassword)
+}
+
+func (c *CustomersGatewaySuite) TestMarkEmailVerified_OnUnverified_SetsTheStatusToVerified() {
+	_, err := c.pool.Exec(context.Background(), `INSERT INTO customers (id, name, email, password) 
+		VALUES ('620d8a0f-abc2-4f80-a1bc-407a037bd920', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	c.Require().NoError(err)
+
+	err = c.customersGateway.MarkEmailVerified(uuid.MustParse("620d8a0f-abc2-4f80-a1bc-407a037bd920"))
+	c.Require().NoError(err)
+
+	customerDTO, err := c.customersGateway.FindOneByEmail("john.doe@gmail.com")
+	c.Require().NoError(err)
+	c.Equal("VERIFIED", customerDTO.Status)
+}
+
 func TestCustomersGateway(t *testing.T) {
 	suite.Run(t, new(CustomersGatewaySuite))
 }
@@ -0,0 +1,59 @@
+package gateways
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+// HttpEmailGateway hands emails over to a transactional email provider through its HTTP API.
+type HttpEmailGateway struct {
+	BaseUrl    string
+	ApiKey     string
+	From       string
+	HttpClient *http.Client
+}
+
+func (h *HttpEmailGateway) Send(email gateways.EmailDTO) error {
+	payload, err := json.Marshal(map[string]any{
+		"from":    h.From,
+		"to":      email.To,
+		"subject": email.Subject,
+		"text":    email.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, h.BaseUrl+"/emails", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+h.ApiKey)
+
+	response, err := h.client().Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("email gateway responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func (h *HttpEmailGateway) client() *http.Client {
+	if h.HttpClient != nil {
+		return h.HttpClient
+	}
+
+	return http.DefaultClient
+}
@@ -0,0 +1,73 @@
+package gateways_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	applicationgateway "github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/gateways"
+	"github.com/stretchr/testify/suite"
+)
+
+type HttpEmailGatewaySuite struct {
+	suite.Suite
+	stubServer       *httptest.Server
+	requests         []*http.Request
+	requestBodies    []map[string]any
+	responseStatus   int
+	httpEmailGateway gateways.HttpEmailGateway
+}
+
+func (h *HttpEmailGatewaySuite) SetupTest() {
+	h.requests = []*http.Request{}
+	h.requestBodies = []map[string]any{}
+	h.responseStatus = http.StatusAccepted
+	h.stubServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		h.requests = append(h.requests, r)
+		h.requestBodies = append(h.requestBodies, body)
+		w.WriteHeader(h.responseStatus)
+	}))
+	h.httpEmailGateway = gateways.HttpEmailGateway{
+		BaseUrl: h.stubServer.URL,
+		ApiKey:  "key_test_123",
+		From:    "no-reply@hotel.com",
+	}
+}
+
+func (h *HttpEmailGatewaySuite) TearDownTest() {
+	h.stubServer.Close()
+}
+
+func (h *HttpEmailGatewaySuite) TestSend_OnAccepted_PostsTheEmail() {
+	err := h.httpEmailGateway.Send(applicationgateway.EmailDTO{
+		To:      "john.doe@gmail.com",
+		Subject: "Reset your password",
+		Body:    "any_body",
+	})
+	h.Require().NoError(err)
+
+	h.Equal("/emails", h.requests[0].URL.Path)
+	h.Equal("Bearer key_test_123", h.requests[0].Header.Get("Authorization"))
+	h.Equal(map[string]any{
+		"from":    "no-reply@hotel.com",
+		"to":      "john.doe@gmail.com",
+		"subject": "Reset your password",
+		"text":    "any_body",
+	}, h.requestBodies[0])
+}
+
+func (h *HttpEmailGatewaySuite) TestSend_OnServerError_ReturnsError() {
+	h.responseStatus = http.StatusBadGateway
+
+	err := h.httpEmailGateway.Send(applicationgateway.EmailDTO{To: "john.doe@gmail.com"})
+
+	h.EqualError(err, "email gateway responded with status 502")
+}
+
+func TestHttpEmailGateway(t *testing.T) {
+	suite.Run(t, new(HttpEmailGatewaySuite))
+}
@@ -0,0 +1,99 @@
+package gateways
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+type HttpPaymentsGateway struct {
+	BaseUrl    string
+	ApiKey     string
+	HttpClient *http.Client
+}
+
+type httpPaymentsGatewayResponse struct {
+	Id            string `json:"id"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"declineReason"`
+}
+
+func (h *HttpPaymentsGateway) Authorize(reference uuid.UUID, paymentToken string, amount uint64) (gateways.PaymentResultDTO, error) {
+	return h.post("/authorizations", map[string]any{
+		"reference":    reference.String(),
+		"paymentToken": paymentToken,
+		"amount":       amount,
+	})
+}
+
+func (h *HttpPaymentsGateway) Capture(transactionId string, amount uint64) (gateways.PaymentResultDTO, error) {
+	return h.post("/authorizations/"+url.PathEscape(transactionId)+"/capture", map[string]any{
+		"amount": amount,
+	})
+}
+
+func (h *HttpPaymentsGateway) Void(transactionId string) (gateways.PaymentResultDTO, error) {
+	return h.post("/authorizations/"+url.PathEscape(transactionId)+"/void", map[string]any{})
+}
+
+func (h *HttpPaymentsGateway) Refund(transactionId string, amount uint64) (gateways.PaymentResultDTO, error) {
+	return h.post("/refunds", map[string]any{
+		"transactionId": transactionId,
+		"amount":        amount,
+	})
+}
+
+func (h *HttpPaymentsGateway) post(path string, body map[string]any) (gateways.PaymentResultDTO, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return gateways.PaymentResultDTO{}, err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, h.BaseUrl+path, bytes.NewReader(payload))
+	if err != nil {
+		return gateways.PaymentResultDTO{}, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+h.ApiKey)
+
+	response, err := h.client().Do(request)
+	if err != nil {
+		return gateways.PaymentResultDTO{}, err
+	}
+
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return gateways.PaymentResultDTO{}, fmt.Errorf("payments gateway responded with status %d", response.StatusCode)
+	}
+
+	var result httpPaymentsGatewayResponse
+
+	err = json.NewDecoder(response.Body).Decode(&result)
+	if err != nil {
+		return gateways.PaymentResultDTO{}, err
+	}
+
+	switch result.Status {
+	case "APPROVED":
+		return gateways.PaymentResultDTO{TransactionId: result.Id, Approved: true}, nil
+	case "DECLINED":
+		return gateways.PaymentResultDTO{TransactionId: result.Id, DeclineReason: result.DeclineReason}, nil
+	}
+
+	return gateways.PaymentResultDTO{}, fmt.Errorf("payments gateway responded with unknown status %s", result.Status)
+}
+
+func (h *HttpPaymentsGateway) client() *http.Client {
+	if h.HttpClient != nil {
+		return h.HttpClient
+	}
+
+	return http.DefaultClient
+}
@@ -0,0 +1,99 @@
+package gateways_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/gateways"
+	"github.com/stretchr/testify/suite"
+)
+
+type HttpPaymentsGatewaySuite struct {
+	suite.Suite
+	stubServer          *httptest.Server
+	requests            []*http.Request
+	requestBodies       []map[string]any
+	responseStatus      int
+	responseBody        string
+	httpPaymentsGateway gateways.HttpPaymentsGateway
+}
+
+func (h *HttpPaymentsGatewaySuite) SetupTest() {
+	h.requests = []*http.Request{}
+	h.requestBodies = []map[string]any{}
+	h.responseStatus = http.StatusOK
+	h.responseBody = `{"id": "txn_1", "status": "APPROVED"}`
+	h.stubServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		h.requests = append(h.requests, r)
+		h.requestBodies = append(h.requestBodies, body)
+		w.WriteHeader(h.responseStatus)
+		_, _ = w.Write([]byte(h.responseBody))
+	}))
+	h.httpPaymentsGateway = gateways.HttpPaymentsGateway{
+		BaseUrl: h.stubServer.URL,
+		ApiKey:  "sk_test_123",
+	}
+}
+
+func (h *HttpPaymentsGatewaySuite) TearDownTest() {
+	h.stubServer.Close()
+}
+
+func (h *HttpPaymentsGatewaySuite) TestAuthorize_OnApproved_ReturnsTransaction() {
+	reference := uuid.MustParse("7a1c1d8e-6f0b-4b43-9a67-0f4b7f0d2c11")
+
+	result, err := h.httpPaymentsGateway.Authorize(reference, "tok_visa", 150)
+	h.Require().NoError(err)
+
+	h.True(result.Approved)
+	h.Equal("txn_1", result.TransactionId)
+	h.Equal("/authorizations", h.requests[0].URL.Path)
+	h.Equal("Bearer sk_test_123", h.requests[0].Header.Get("Authorization"))
+	h.Equal(map[string]any{
+		"reference":    "7a1c1d8e-6f0b-4b43-9a67-0f4b7f0d2c11",
+		"paymentToken": "tok_visa",
+		"amount":       float64(150),
+	}, h.requestBodies[0])
+}
+
+func (h *HttpPaymentsGatewaySuite) TestAuthorize_OnDeclined_ReturnsDeclineReason() {
+	h.responseBody = `{"id": "txn_2", "status": "DECLINED", "declineReason": "insufficient funds"}`
+
+	result, err := h.httpPaymentsGateway.Authorize(uuid.New(), "tok_visa", 150)
+	h.Require().NoError(err)
+
+	h.False(result.Approved)
+	h.Equal("insufficient funds", result.DeclineReason)
+}
+
+func (h *HttpPaymentsGatewaySuite) TestCaptureVoidAndRefund_OnApproved_CallExpectedEndpoints() {
+	_, err := h.httpPaymentsGateway.Capture("txn_1", 150)
+	h.Require().NoError(err)
+	_, err = h.httpPaymentsGateway.Void("txn_1")
+	h.Require().NoError(err)
+	_, err = h.httpPaymentsGateway.Refund("txn_1", 50)
+	h.Require().NoError(err)
+
+	h.Equal("/authorizations/txn_1/capture", h.requests[0].URL.Path)
+	h.Equal(map[string]any{"amount": float64(150)}, h.requestBodies[0])
+	h.Equal("/authorizations/txn_1/void", h.requests[1].URL.Path)
+	h.Equal("/refunds", h.requests[2].URL.Path)
+	h.Equal(map[string]any{"transactionId": "txn_1", "amount": float64(50)}, h.requestBodies[2])
+}
+
+func (h *HttpPaymentsGatewaySuite) TestAuthorize_OnServerError_ReturnsError() {
+	h.responseStatus = http.StatusBadGateway
+
+	_, err := h.httpPaymentsGateway.Authorize(uuid.New(), "tok_visa", 150)
+
+	h.EqualError(err, "payments gateway responded with status 502")
+}
+
+func TestHttpPaymentsGateway(t *testing.T) {
+	suite.Run(t, new(HttpPaymentsGatewaySuite))
+}
@@ -26,10 +26,10 @@ func (l *LocalSecretsGateway) Get(key string) (string, error) {
 			continue
 		}
 
-		parts := strings.Split(variable, "=")
+		name, value, _ := strings.Cut(variable, "=")
 
-		if parts[0] == key {
-			return parts[1], nil
+		if name == key {
+			return value, nil
 		}
 	}
 
@@ -62,6 +62,16 @@ func (l *LocalSecretsGatewaySuite) TestGet_OnSecretFound3_ReturnsError() {
 	l.Equal("postgres_url", postgresUrl)
 }
 
+func (l *LocalSecretsGatewaySuite) TestGet_OnValueContainingEqualsSign_ReturnsTheWholeValue() {
+	err := os.WriteFile(".env.test", []byte(`JWT_SIGNING_KEYS=[{"kid":"2030-06","privateKey":"MC4CAQA=\n"}]`), 0644)
+	l.Require().NoError(err)
+
+	signingKeys, err := l.localSecretsGateway.Get("JWT_SIGNING_KEYS")
+	l.Require().NoError(err)
+
+	l.Equal(`[{"kid":"2030-06","privateKey":"MC4CAQA=\n"}]`, signingKeys)
+}
+
 func (l *LocalSecretsGatewaySuite) TestGet_OnSecretNotFound_ReturnsError() {
 	err := os.WriteFile(".env.test", []byte(""), 0644)
 	l.Require().NoError(err)
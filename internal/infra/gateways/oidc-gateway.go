@@ -0,0 +1,297 @@
+package gateways
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+)
+
+// OidcGateway talks to OpenID Connect providers over HTTP. The discovery document and the key set are fetched on
+// every login, so a provider rotating its keys never makes a login fail.
+type OidcGateway struct {
+	HttpClient *http.Client
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type oidcJwk struct {
+	KeyType  string `json:"kty"`
+	Id       string `json:"kid"`
+	Curve    string `json:"crv"`
+	X        string `json:"x"`
+	Y        string `json:"y"`
+	Modulus  string `json:"n"`
+	Exponent string `json:"e"`
+}
+
+type oidcIdTokenClaims struct {
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+	Nonce         string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+func (o *OidcGateway) AuthorizationUrl(provider gateways.OidcProviderDTO,
+	request gateways.OidcAuthorizationRequestDTO) (string, error) {
+	discovery, err := o.discover(provider.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationUrl, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := provider.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	query := authorizationUrl.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.ClientId)
+	query.Set("redirect_uri", provider.RedirectUrl)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", request.State)
+	query.Set("nonce", request.Nonce)
+	query.Set("code_challenge", request.CodeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authorizationUrl.RawQuery = query.Encode()
+
+	return authorizationUrl.String(), nil
+}
+
+func (o *OidcGateway) ExchangeCode(provider gateways.OidcProviderDTO, code string,
+	codeVerifier string) (gateways.OidcIdentityDTO, error) {
+	discovery, err := o.discover(provider.Issuer)
+	if err != nil {
+		return gateways.OidcIdentityDTO{}, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {provider.RedirectUrl},
+		"code_verifier": {codeVerifier},
+		"client_id":     {provider.ClientId},
+	}
+
+	request, err := http.NewRequest(http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return gateways.OidcIdentityDTO{}, err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	if provider.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(provider.ClientId), url.QueryEscape(provider.ClientSecret))
+	}
+
+	response, err := o.client().Do(request)
+	if err != nil {
+		return gateways.OidcIdentityDTO{}, err
+	}
+
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusUnauthorized {
+		return gateways.OidcIdentityDTO{}, errors.New("identity provider rejected the authorization code")
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return gateways.OidcIdentityDTO{}, fmt.Errorf("identity provider responded with status %d", response.StatusCode)
+	}
+
+	var tokenResponse struct {
+		IdToken string `json:"id_token"`
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&tokenResponse)
+	if err != nil {
+		return gateways.OidcIdentityDTO{}, err
+	}
+
+	return o.verifyIdToken(provider, discovery, tokenResponse.IdToken)
+}
+
+func (o *OidcGateway) verifyIdToken(provider gateways.OidcProviderDTO, discovery oidcDiscovery,
+	idToken string) (gateways.OidcIdentityDTO, error) {
+	keys, err := o.fetchKeys(discovery.JwksUri)
+	if err != nil {
+		return gateways.OidcIdentityDTO{}, err
+	}
+
+	claims := &oidcIdTokenClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		id, _ := token.Header["kid"].(string)
+
+		if id == "" && len(keys) == 1 {
+			for _, key := range keys {
+				return key, nil
+			}
+		}
+
+		key, ok := keys[id]
+		if !ok {
+			return nil, fmt.Errorf("no key with id %q", id)
+		}
+
+		return key, nil
+	}, jwt.WithValidMethods([]string{"RS256", "ES256", "ES384", "ES512", "EdDSA"}), jwt.WithIssuer(provider.Issuer),
+		jwt.WithAudience(provider.ClientId), jwt.WithExpirationRequired(), jwt.WithLeeway(time.Minute))
+
+	if err != nil || claims.Subject == "" {
+		return gateways.OidcIdentityDTO{}, errors.New("identity provider returned an invalid id token")
+	}
+
+	return gateways.OidcIdentityDTO{
+		Subject: claims.Subject,
+		Email:   claims.Email,
+		// Some providers send email_verified as a string.
+		EmailVerified: claims.EmailVerified == true || claims.EmailVerified == "true",
+		Name:          claims.Name,
+		Nonce:         claims.Nonce,
+	}, nil
+}
+
+func (o *OidcGateway) discover(issuer string) (oidcDiscovery, error) {
+	var discovery oidcDiscovery
+
+	err := o.getJson(strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &discovery)
+	if err != nil {
+		return oidcDiscovery{}, err
+	}
+
+	if discovery.Issuer != issuer {
+		return oidcDiscovery{}, fmt.Errorf("identity provider discovery document is for issuer %q", discovery.Issuer)
+	}
+
+	return discovery, nil
+}
+
+func (o *OidcGateway) fetchKeys(jwksUri string) (map[string]any, error) {
+	var jwks struct {
+		Keys []oidcJwk `json:"keys"`
+	}
+
+	err := o.getJson(jwksUri, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]any{}
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Keys of unsupported types are skipped, so they cannot break logins signed with the supported ones.
+			continue
+		}
+
+		keys[jwk.Id] = key
+	}
+
+	return keys, nil
+}
+
+func (o *OidcGateway) getJson(url string, value any) error {
+	response, err := o.client().Get(url)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("identity provider responded with status %d", response.StatusCode)
+	}
+
+	return json.NewDecoder(response.Body).Decode(value)
+}
+
+func (o *OidcGateway) client() *http.Client {
+	if o.HttpClient != nil {
+		return o.HttpClient
+	}
+
+	return http.DefaultClient
+}
+
+func (j oidcJwk) publicKey() (any, error) {
+	switch j.KeyType {
+	case "RSA":
+		modulus, err := base64.RawURLEncoding.DecodeString(j.Modulus)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent, err := base64.RawURLEncoding.DecodeString(j.Exponent)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(modulus), E: int(new(big.Int).SetBytes(exponent).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+
+		switch j.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Curve)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(j.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if j.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", j.Curve)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+
+		return ed25519.PublicKey(x), nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %q", j.KeyType)
+}
@@ -0,0 +1,231 @@
+package gateways_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	applicationgateway "github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/gateways"
+	"github.com/stretchr/testify/suite"
+)
+
+// mockOidcServer is a minimal OpenID Connect provider: it serves discovery and a key set, hands out a code on
+// /authorize and redeems it on /token only with the client secret and the PKCE verifier of the challenge it got.
+type mockOidcServer struct {
+	server        *httptest.Server
+	signingKey    *rsa.PrivateKey
+	codeChallenge string
+	nonce         string
+	idTokenClaims jwt.MapClaims
+	keyId         string
+}
+
+func newMockOidcServer() (*mockOidcServer, error) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &mockOidcServer{signingKey: signingKey, keyId: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(m.signingKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.signingKey.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		m.codeChallenge = query.Get("code_challenge")
+		m.nonce = query.Get("nonce")
+		redirectUrl := query.Get("redirect_uri") + "?" + url.Values{
+			"code":  {"authorization_code"},
+			"state": {query.Get("state")},
+		}.Encode()
+		http.Redirect(w, r, redirectUrl, http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientId, clientSecret, _ := r.BasicAuth()
+		hash := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+
+		if clientId != "hotel-booking" || clientSecret != "any_client_secret" ||
+			r.PostFormValue("code") != "authorization_code" ||
+			base64.RawURLEncoding.EncodeToString(hash[:]) != m.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		claims := jwt.MapClaims{
+			"iss":            m.server.URL,
+			"sub":            "248289761001",
+			"aud":            "hotel-booking",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          m.nonce,
+			"email":          "jane.doe@gmail.com",
+			"email_verified": true,
+			"name":           "Jane Doe",
+		}
+		for key, value := range m.idTokenClaims {
+			claims[key] = value
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = m.keyId
+		idToken, _ := token.SignedString(m.signingKey)
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "any", "id_token": idToken})
+	})
+	m.server = httptest.NewServer(mux)
+
+	return m, nil
+}
+
+type OidcGatewaySuite struct {
+	suite.Suite
+	mockServer  *mockOidcServer
+	provider    applicationgateway.OidcProviderDTO
+	oidcGateway gateways.OidcGateway
+}
+
+func (o *OidcGatewaySuite) SetupTest() {
+	var err error
+	o.mockServer, err = newMockOidcServer()
+	o.Require().NoError(err)
+	o.provider = applicationgateway.OidcProviderDTO{
+		Name:         "acme",
+		Issuer:       o.mockServer.server.URL,
+		ClientId:     "hotel-booking",
+		ClientSecret: "any_client_secret",
+		RedirectUrl:  "http://localhost:3000/oidc/acme/callback",
+	}
+	o.oidcGateway = gateways.OidcGateway{}
+}
+
+func (o *OidcGatewaySuite) TearDownTest() {
+	o.mockServer.server.Close()
+}
+
+// authorize follows the authorization URL the way a browser would and returns the query of the redirect back.
+func (o *OidcGatewaySuite) authorize(codeVerifier string) url.Values {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	authorizationUrl, err := o.oidcGateway.AuthorizationUrl(o.provider, applicationgateway.OidcAuthorizationRequestDTO{
+		State:         "any_state",
+		Nonce:         "any_nonce",
+		CodeChallenge: base64.RawURLEncoding.EncodeToString(hash[:]),
+	})
+	o.Require().NoError(err)
+
+	client := http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	response, err := client.Get(authorizationUrl)
+	o.Require().NoError(err)
+	defer func() { _ = response.Body.Close() }()
+
+	location, err := url.Parse(response.Header.Get("Location"))
+	o.Require().NoError(err)
+	return location.Query()
+}
+
+func (o *OidcGatewaySuite) TestAuthorizationUrl_OnNoErrors_RequestsCodeWithPkce() {
+	authorizationUrl, err := o.oidcGateway.AuthorizationUrl(o.provider, applicationgateway.OidcAuthorizationRequestDTO{
+		State:         "any_state",
+		Nonce:         "any_nonce",
+		CodeChallenge: "any_challenge",
+	})
+	o.Require().NoError(err)
+
+	parsedUrl, err := url.Parse(authorizationUrl)
+	o.Require().NoError(err)
+	o.Equal("/authorize", parsedUrl.Path)
+	o.Equal(url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"hotel-booking"},
+		"redirect_uri":          {"http://localhost:3000/oidc/acme/callback"},
+		"scope":                 {"openid email profile"},
+		"state":                 {"any_state"},
+		"nonce":                 {"any_nonce"},
+		"code_challenge":        {"any_challenge"},
+		"code_challenge_method": {"S256"},
+	}, parsedUrl.Query())
+}
+
+func (o *OidcGatewaySuite) TestExchangeCode_OnMatchingVerifier_ReturnsVerifiedIdentity() {
+	redirect := o.authorize("any_code_verifier_that_is_long_enough_for_pkce")
+	o.Equal("any_state", redirect.Get("state"))
+
+	identity, err := o.oidcGateway.ExchangeCode(o.provider, redirect.Get("code"),
+		"any_code_verifier_that_is_long_enough_for_pkce")
+	o.Require().NoError(err)
+
+	o.Equal(applicationgateway.OidcIdentityDTO{
+		Subject:       "248289761001",
+		Email:         "jane.doe@gmail.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+		Nonce:         "any_nonce",
+	}, identity)
+}
+
+func (o *OidcGatewaySuite) TestExchangeCode_OnAnotherVerifier_ReturnsError() {
+	redirect := o.authorize("any_code_verifier_that_is_long_enough_for_pkce")
+
+	_, err := o.oidcGateway.ExchangeCode(o.provider, redirect.Get("code"),
+		"another_code_verifier_that_is_long_enough_too")
+
+	o.EqualError(err, "identity provider rejected the authorization code")
+}
+
+func (o *OidcGatewaySuite) TestExchangeCode_OnIdTokenForAnotherClient_ReturnsError() {
+	o.mockServer.idTokenClaims = jwt.MapClaims{"aud": "another-client"}
+	redirect := o.authorize("any_code_verifier_that_is_long_enough_for_pkce")
+
+	_, err := o.oidcGateway.ExchangeCode(o.provider, redirect.Get("code"),
+		"any_code_verifier_that_is_long_enough_for_pkce")
+
+	o.EqualError(err, "identity provider returned an invalid id token")
+}
+
+func (o *OidcGatewaySuite) TestExchangeCode_OnExpiredIdToken_ReturnsError() {
+	o.mockServer.idTokenClaims = jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}
+	redirect := o.authorize("any_code_verifier_that_is_long_enough_for_pkce")
+
+	_, err := o.oidcGateway.ExchangeCode(o.provider, redirect.Get("code"),
+		"any_code_verifier_that_is_long_enough_for_pkce")
+
+	o.EqualError(err, "identity provider returned an invalid id token")
+}
+
+func (o *OidcGatewaySuite) TestExchangeCode_OnUnpublishedKey_ReturnsError() {
+	o.mockServer.keyId = "key-2"
+	redirect := o.authorize("any_code_verifier_that_is_long_enough_for_pkce")
+
+	_, err := o.oidcGateway.ExchangeCode(o.provider, redirect.Get("code"),
+		"any_code_verifier_that_is_long_enough_for_pkce")
+
+	o.EqualError(err, "identity provider returned an invalid id token")
+}
+
+func TestOidcGateway(t *testing.T) {
+	suite.Run(t, new(OidcGatewaySuite))
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ActivateTotpHandlerInput struct {
+	Code any `validate:"required,string,notEmpty,lt=16"`
+}
+
+type ActivateTotpHandlerOutput struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type ActivateTotpHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	ActivateTotp  usecases.IActivateTotp
+}
+
+func (at *ActivateTotpHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	var input ActivateTotpHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(at.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, at.HttpValidator.Validate(input))
+	}
+
+	output, err := at.ActivateTotp.Execute(usecases.ActivateTotpInput{
+		Principal: principal,
+		Code:      input.Code.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "verification code is invalid":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "two-factor enrollment not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "two-factor authentication is already enabled":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		at.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, ActivateTotpHandlerOutput{
+		RecoveryCodes: output.RecoveryCodes,
+	})
+}
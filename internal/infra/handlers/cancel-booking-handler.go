@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CancelBookingHandlerOutput struct {
+	RefundAmount       uint64 `json:"refundAmount"`
+	RefundStatus       string `json:"refundStatus"`
+	CreditRefundAmount uint64 `json:"creditRefundAmount"`
+}
+
+type CancelBookingHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	CancelBooking usecases.ICancelBooking
+}
+
+func (cb *CancelBookingHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	output, err := cb.CancelBooking.Execute(usecases.CancelBookingInput{
+		BookingId: bookingId,
+		Principal: principal,
+	})
+
+	if err != nil {
+		return handleBookingChangeError(c, cb.HttpLogger, err)
+	}
+
+	return webhttp.NewOk(c, CancelBookingHandlerOutput{
+		RefundAmount:       output.RefundAmount,
+		RefundStatus:       output.RefundStatus,
+		CreditRefundAmount: output.CreditRefundAmount,
+	})
+}
+
+func handleBookingChangeError(c echo.Context, httpLogger webhttp.HttpLogger, err error) error {
+	switch err.Error() {
+	case "check-out date must be after check-in date",
+		"new check-out date must be before the current check-out date":
+		return webhttp.NewBadRequest(c, err.Error())
+	case "booking not found":
+		return webhttp.NewNotFound(c, err.Error())
+	case "only confirmed bookings can be cancelled",
+		"only confirmed bookings can be shortened",
+		"refund amount exceeds the refundable amount":
+		return webhttp.NewConflict(c, err.Error())
+	}
+
+	httpLogger.Log(c, err)
+	return webhttp.NewInternalServerError(c)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CheckOutBookingHandlerInput struct {
+	Override any `validate:"omitempty,boolean"`
+}
+
+type CheckOutBookingHandlerOutput struct {
+	Balance    int64 `json:"balance"`
+	Overridden bool  `json:"overridden"`
+}
+
+type CheckOutBookingHandler struct {
+	HttpLogger      webhttp.HttpLogger
+	HttpValidator   webhttp.HttpValidator
+	CheckOutBooking usecases.ICheckOutBooking
+}
+
+func (cb *CheckOutBookingHandler) Handle(c echo.Context) error {
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	var input CheckOutBookingHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cb.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cb.HttpValidator.Validate(input))
+	}
+
+	override, _ := input.Override.(bool)
+
+	// Checking out with an unsettled folio is an admin decision; front desk staff may only check out settled stays.
+	if override {
+		principal, ok := webhttp.GetPrincipal(c)
+
+		if !ok || !principal.HasPermission("bookings:check-out:override") {
+			return webhttp.NewForbidden(c, "you do not have permission to check out a booking with an unsettled folio")
+		}
+	}
+
+	output, err := cb.CheckOutBooking.Execute(usecases.CheckOutBookingInput{
+		BookingId: bookingId,
+		Override:  override,
+	})
+
+	if err != nil {
+		return handleFolioError(c, cb.HttpLogger, err)
+	}
+
+	return webhttp.NewOk(c, CheckOutBookingHandlerOutput{
+		Balance:    output.Balance,
+		Overridden: output.Overridden,
+	})
+}
@@ -0,0 +1,131 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCheckOutBooking struct {
+	mock.Mock
+}
+
+func (m *MockCheckOutBooking) Execute(input usecases.CheckOutBookingInput) (usecases.CheckOutBookingOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CheckOutBookingOutput), args.Error(1)
+}
+
+type CheckOutBookingHandlerSuite struct {
+	suite.Suite
+	mockCheckOutBooking    MockCheckOutBooking
+	checkOutBookingHandler handlers.CheckOutBookingHandler
+	principal              auth.Principal
+}
+
+func (cb *CheckOutBookingHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cb.Require().NoError(err)
+
+	cb.mockCheckOutBooking = MockCheckOutBooking{}
+	cb.checkOutBookingHandler = handlers.CheckOutBookingHandler{
+		HttpLogger:      webhttp.NewHttpLogger(),
+		HttpValidator:   httpValidator,
+		CheckOutBooking: &cb.mockCheckOutBooking,
+	}
+	cb.principal = auth.Principal{
+		StaffUserId: uuid.New(),
+		Role:        "ADMIN",
+		Permissions: []string{"bookings:check-out", "bookings:check-out:override"},
+	}
+}
+
+func (cb *CheckOutBookingHandlerSuite) newContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	c.SetParamNames("id")
+	c.SetParamValues("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70")
+	webhttp.SetPrincipal(c, cb.principal)
+	return c, recorder
+}
+
+func (cb *CheckOutBookingHandlerSuite) TestHandle_OnOverride_ReturnsOk() {
+	cb.mockCheckOutBooking.On("Execute", usecases.CheckOutBookingInput{
+		BookingId: uuid.MustParse("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70"),
+		Override:  true,
+	}).Return(usecases.CheckOutBookingOutput{Balance: 35, Overridden: true}, nil)
+	c, recorder := cb.newContext(`{"override": true}`)
+
+	err := cb.checkOutBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(200, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"balance": 35,
+				"overridden": true
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CheckOutBookingHandlerSuite) TestHandle_OnOutstandingBalance_ReturnsConflict() {
+	cb.mockCheckOutBooking.On("Execute", usecases.CheckOutBookingInput{
+		BookingId: uuid.MustParse("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70"),
+	}).Return(usecases.CheckOutBookingOutput{}, errors.New("folio balance must be settled before check-out"))
+	c, recorder := cb.newContext(`{}`)
+
+	err := cb.checkOutBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(409, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "folio balance must be settled before check-out"
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CheckOutBookingHandlerSuite) TestHandle_OnOverrideByFrontDesk_ReturnsForbidden() {
+	cb.principal = auth.Principal{
+		StaffUserId: uuid.New(),
+		Role:        "FRONT_DESK",
+		Permissions: []string{"bookings:check-out"},
+	}
+	c, recorder := cb.newContext(`{"override": true}`)
+
+	err := cb.checkOutBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(403, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 403,
+			"statusText": "FORBIDDEN",
+			"error": "you do not have permission to check out a booking with an unsettled folio"
+		}
+	`, recorder.Body.String())
+	cb.mockCheckOutBooking.AssertNotCalled(cb.T(), "Execute", mock.Anything)
+}
+
+func TestCheckOutBookingHandler(t *testing.T) {
+	suite.Run(t, new(CheckOutBookingHandlerSuite))
+}
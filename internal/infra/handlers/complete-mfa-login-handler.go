@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CompleteMfaLoginHandlerInput struct {
+	ChallengeToken any `validate:"required,string,notEmpty,lt=4096"`
+	Code           any `validate:"omitempty,string,notEmpty,lt=16"`
+	RecoveryCode   any `validate:"omitempty,string,notEmpty,lt=64"`
+}
+
+type CompleteMfaLoginHandlerOutput struct {
+	CustomerId           *uuid.UUID `json:"customerId,omitempty"`
+	StaffUserId          *uuid.UUID `json:"staffUserId,omitempty"`
+	Name                 string     `json:"name"`
+	Role                 string     `json:"role"`
+	AccessToken          string     `json:"accessToken"`
+	AccessTokenExpiresAt string     `json:"accessTokenExpiresAt"`
+	RefreshToken         string     `json:"refreshToken"`
+}
+
+// MfaChallengeHandlerOutput is returned by the login handlers instead of the tokens when the account has two-factor
+// authentication enabled. The challenge token is exchanged for the tokens at the MFA login route.
+type MfaChallengeHandlerOutput struct {
+	MfaRequired           bool   `json:"mfaRequired"`
+	MfaChallengeToken     string `json:"mfaChallengeToken"`
+	MfaChallengeExpiresAt string `json:"mfaChallengeExpiresAt"`
+}
+
+type CompleteMfaLoginHandler struct {
+	HttpLogger       webhttp.HttpLogger
+	HttpValidator    webhttp.HttpValidator
+	CompleteMfaLogin usecases.ICompleteMfaLogin
+}
+
+func (cm *CompleteMfaLoginHandler) Handle(c echo.Context) error {
+	var input CompleteMfaLoginHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cm.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cm.HttpValidator.Validate(input))
+	}
+
+	code, _ := input.Code.(string)
+	recoveryCode, _ := input.RecoveryCode.(string)
+
+	output, err := cm.CompleteMfaLogin.Execute(usecases.CompleteMfaLoginInput{
+		ChallengeToken: input.ChallengeToken.(string),
+		Code:           code,
+		RecoveryCode:   recoveryCode,
+		IpAddress:      c.RealIP(),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "a verification code or a recovery code is required":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "mfa challenge is invalid or has expired",
+			"verification code is invalid":
+			return webhttp.NewUnauthorized(c, err.Error())
+		case "too many failed login attempts. Please try again later":
+			return webhttp.NewTooManyRequests(c, output.RetryAfter, err.Error())
+		}
+
+		cm.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	handlerOutput := CompleteMfaLoginHandlerOutput{
+		Name:                 output.Name,
+		Role:                 output.Role,
+		AccessToken:          output.AccessToken,
+		AccessTokenExpiresAt: output.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshToken:         output.RefreshToken,
+	}
+
+	if output.CustomerId != uuid.Nil {
+		handlerOutput.CustomerId = &output.CustomerId
+	} else {
+		handlerOutput.StaffUserId = &output.StaffUserId
+	}
+
+	return webhttp.NewOk(c, handlerOutput)
+}
+
+func newMfaChallengeHandlerOutput(challengeToken string, expiresAt time.Time) MfaChallengeHandlerOutput {
+	return MfaChallengeHandlerOutput{
+		MfaRequired:           true,
+		MfaChallengeToken:     challengeToken,
+		MfaChallengeExpiresAt: expiresAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,134 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCompleteMfaLogin struct {
+	mock.Mock
+}
+
+func (m *MockCompleteMfaLogin) Execute(input usecases.CompleteMfaLoginInput) (usecases.CompleteMfaLoginOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CompleteMfaLoginOutput), args.Error(1)
+}
+
+type CompleteMfaLoginHandlerSuite struct {
+	suite.Suite
+	mockCompleteMfaLogin    MockCompleteMfaLogin
+	completeMfaLoginHandler handlers.CompleteMfaLoginHandler
+}
+
+func (cm *CompleteMfaLoginHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cm.Require().NoError(err)
+
+	cm.mockCompleteMfaLogin = MockCompleteMfaLogin{}
+	cm.completeMfaLoginHandler = handlers.CompleteMfaLoginHandler{
+		HttpLogger:       webhttp.NewHttpLogger(),
+		HttpValidator:    httpValidator,
+		CompleteMfaLogin: &cm.mockCompleteMfaLogin,
+	}
+}
+
+func (cm *CompleteMfaLoginHandlerSuite) newContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.RemoteAddr = "192.0.2.1:51234"
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	return e.NewContext(request, recorder), recorder
+}
+
+func (cm *CompleteMfaLoginHandlerSuite) TestHandle_OnValidCode_ReturnsOk() {
+	cm.mockCompleteMfaLogin.On("Execute", usecases.CompleteMfaLoginInput{
+		ChallengeToken: "any_challenge_token",
+		Code:           "123456",
+		IpAddress:      "192.0.2.1",
+	}).Return(usecases.CompleteMfaLoginOutput{
+		StaffUserId:          uuid.MustParse("3c0b7a52-0f5e-4a55-8d61-2f0b7f6f1a10"),
+		Name:                 "Jane Roe",
+		Role:                 "ADMIN",
+		AccessToken:          "any_access_token",
+		AccessTokenExpiresAt: time.Date(2030, 1, 10, 9, 15, 0, 0, time.UTC),
+		RefreshToken:         "any_refresh_token",
+	}, nil)
+	c, recorder := cm.newContext(`{"challengeToken": "any_challenge_token", "code": "123456"}`)
+
+	err := cm.completeMfaLoginHandler.Handle(c)
+	cm.Require().NoError(err)
+
+	cm.Equal(200, recorder.Code)
+	cm.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"staffUserId": "3c0b7a52-0f5e-4a55-8d61-2f0b7f6f1a10",
+				"name": "Jane Roe",
+				"role": "ADMIN",
+				"accessToken": "any_access_token",
+				"accessTokenExpiresAt": "2030-01-10T09:15:00Z",
+				"refreshToken": "any_refresh_token"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cm *CompleteMfaLoginHandlerSuite) TestHandle_OnInvalidCode_ReturnsUnauthorized() {
+	cm.mockCompleteMfaLogin.On("Execute", mock.Anything).
+		Return(usecases.CompleteMfaLoginOutput{}, errors.New("verification code is invalid"))
+	c, recorder := cm.newContext(`{"challengeToken": "any_challenge_token", "recoveryCode": "abcd-efgh"}`)
+
+	err := cm.completeMfaLoginHandler.Handle(c)
+	cm.Require().NoError(err)
+
+	cm.Equal(401, recorder.Code)
+	cm.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "verification code is invalid"
+		}
+	`, recorder.Body.String())
+}
+
+func (cm *CompleteMfaLoginHandlerSuite) TestHandle_OnTooManyAttempts_ReturnsTooManyRequests() {
+	cm.mockCompleteMfaLogin.On("Execute", mock.Anything).
+		Return(usecases.CompleteMfaLoginOutput{RetryAfter: 90 * time.Second},
+			errors.New("too many failed login attempts. Please try again later"))
+	c, recorder := cm.newContext(`{"challengeToken": "any_challenge_token", "code": "123456"}`)
+
+	err := cm.completeMfaLoginHandler.Handle(c)
+	cm.Require().NoError(err)
+
+	cm.Equal(429, recorder.Code)
+	cm.Equal("90", recorder.Header().Get("Retry-After"))
+}
+
+func (cm *CompleteMfaLoginHandlerSuite) TestHandle_OnMissingChallengeToken_ReturnsBadRequest() {
+	c, recorder := cm.newContext(`{"code": "123456"}`)
+
+	err := cm.completeMfaLoginHandler.Handle(c)
+	cm.Require().NoError(err)
+
+	cm.Equal(400, recorder.Code)
+	cm.mockCompleteMfaLogin.AssertNotCalled(cm.T(), "Execute", mock.Anything)
+}
+
+func TestCompleteMfaLoginHandler(t *testing.T) {
+	suite.Run(t, new(CompleteMfaLoginHandlerSuite))
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CompleteOidcLoginHandlerInput struct {
+	Code       any `validate:"required,string,notEmpty,lt=2048"`
+	State      any `validate:"required,string,notEmpty,lt=256"`
+	LoginToken any `validate:"required,string,notEmpty,lt=4096"`
+}
+
+type CompleteOidcLoginHandlerOutput struct {
+	CustomerId           uuid.UUID `json:"customerId"`
+	CustomerName         string    `json:"customerName"`
+	AccessToken          string    `json:"accessToken"`
+	AccessTokenExpiresAt string    `json:"accessTokenExpiresAt"`
+	RefreshToken         string    `json:"refreshToken"`
+	CustomerCreated      bool      `json:"customerCreated"`
+}
+
+type CompleteOidcLoginHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	HttpValidator     webhttp.HttpValidator
+	CompleteOidcLogin usecases.ICompleteOidcLogin
+}
+
+func (co *CompleteOidcLoginHandler) Handle(c echo.Context) error {
+	var input CompleteOidcLoginHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(co.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, co.HttpValidator.Validate(input))
+	}
+
+	output, err := co.CompleteOidcLogin.Execute(usecases.CompleteOidcLoginInput{
+		Provider:   c.Param("provider"),
+		Code:       input.Code.(string),
+		State:      input.State.(string),
+		LoginToken: input.LoginToken.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "identity provider not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "oidc login is invalid or has expired",
+			"identity provider rejected the authorization code",
+			"identity provider returned an invalid id token":
+			return webhttp.NewUnauthorized(c, err.Error())
+		case "email address is already associated with another account":
+			return webhttp.NewConflict(c, err.Error())
+		case "identity provider did not share an email address":
+			return webhttp.NewUnprocessableEntity(c, err.Error())
+		}
+
+		co.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	if output.MfaChallengeToken != "" {
+		return webhttp.NewOk(c, newMfaChallengeHandlerOutput(output.MfaChallengeToken, output.MfaChallengeExpiresAt))
+	}
+
+	return webhttp.NewOk(c, CompleteOidcLoginHandlerOutput{
+		CustomerId:           output.CustomerId,
+		CustomerName:         output.CustomerName,
+		AccessToken:          output.AccessToken,
+		AccessTokenExpiresAt: output.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshToken:         output.RefreshToken,
+		CustomerCreated:      output.CustomerCreated,
+	})
+}
@@ -0,0 +1,135 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCompleteOidcLogin struct {
+	mock.Mock
+}
+
+func (m *MockCompleteOidcLogin) Execute(input usecases.CompleteOidcLoginInput) (usecases.CompleteOidcLoginOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CompleteOidcLoginOutput), args.Error(1)
+}
+
+type CompleteOidcLoginHandlerSuite struct {
+	suite.Suite
+	mockCompleteOidcLogin    MockCompleteOidcLogin
+	completeOidcLoginHandler handlers.CompleteOidcLoginHandler
+}
+
+func (co *CompleteOidcLoginHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	co.Require().NoError(err)
+
+	co.mockCompleteOidcLogin = MockCompleteOidcLogin{}
+	co.completeOidcLoginHandler = handlers.CompleteOidcLoginHandler{
+		HttpLogger:        webhttp.NewHttpLogger(),
+		HttpValidator:     httpValidator,
+		CompleteOidcLogin: &co.mockCompleteOidcLogin,
+	}
+}
+
+func (co *CompleteOidcLoginHandlerSuite) newContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	c.SetParamNames("provider")
+	c.SetParamValues("acme")
+	return c, recorder
+}
+
+func (co *CompleteOidcLoginHandlerSuite) TestHandle_OnNoErrors_ReturnsOk() {
+	co.mockCompleteOidcLogin.On("Execute", usecases.CompleteOidcLoginInput{
+		Provider:   "acme",
+		Code:       "any_code",
+		State:      "any_state",
+		LoginToken: "any_login_token",
+	}).Return(usecases.CompleteOidcLoginOutput{
+		CustomerId:           uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		CustomerName:         "Jane Doe",
+		AccessToken:          "any_access_token",
+		AccessTokenExpiresAt: time.Date(2030, 1, 10, 9, 15, 0, 0, time.UTC),
+		RefreshToken:         "any_refresh_token",
+		CustomerCreated:      true,
+	}, nil)
+	c, recorder := co.newContext(`{"code": "any_code", "state": "any_state", "loginToken": "any_login_token"}`)
+
+	err := co.completeOidcLoginHandler.Handle(c)
+	co.Require().NoError(err)
+
+	co.Equal(200, recorder.Code)
+	co.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"customerId": "aa473b65-90a8-48ad-ab7d-5bd50a806d38",
+				"customerName": "Jane Doe",
+				"accessToken": "any_access_token",
+				"accessTokenExpiresAt": "2030-01-10T09:15:00Z",
+				"refreshToken": "any_refresh_token",
+				"customerCreated": true
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (co *CompleteOidcLoginHandlerSuite) TestHandle_OnInvalidLogin_ReturnsUnauthorized() {
+	co.mockCompleteOidcLogin.On("Execute", mock.Anything).
+		Return(usecases.CompleteOidcLoginOutput{}, errors.New("oidc login is invalid or has expired"))
+	c, recorder := co.newContext(`{"code": "any_code", "state": "any_state", "loginToken": "any_login_token"}`)
+
+	err := co.completeOidcLoginHandler.Handle(c)
+	co.Require().NoError(err)
+
+	co.Equal(401, recorder.Code)
+	co.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "oidc login is invalid or has expired"
+		}
+	`, recorder.Body.String())
+}
+
+func (co *CompleteOidcLoginHandlerSuite) TestHandle_OnTakenEmail_ReturnsConflict() {
+	co.mockCompleteOidcLogin.On("Execute", mock.Anything).
+		Return(usecases.CompleteOidcLoginOutput{}, errors.New("email address is already associated with another account"))
+	c, recorder := co.newContext(`{"code": "any_code", "state": "any_state", "loginToken": "any_login_token"}`)
+
+	err := co.completeOidcLoginHandler.Handle(c)
+	co.Require().NoError(err)
+
+	co.Equal(409, recorder.Code)
+}
+
+func (co *CompleteOidcLoginHandlerSuite) TestHandle_OnMissingState_ReturnsBadRequest() {
+	c, recorder := co.newContext(`{"code": "any_code", "loginToken": "any_login_token"}`)
+
+	err := co.completeOidcLoginHandler.Handle(c)
+	co.Require().NoError(err)
+
+	co.Equal(400, recorder.Code)
+	co.mockCompleteOidcLogin.AssertNotCalled(co.T(), "Execute", mock.Anything)
+}
+
+func TestCompleteOidcLoginHandler(t *testing.T) {
+	suite.Run(t, new(CompleteOidcLoginHandlerSuite))
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateAddOnHandlerInput struct {
+	Code  any `validate:"required,string,notEmpty,lt=51"`
+	Name  any `validate:"required,string,notEmpty,lt=101"`
+	Price any `validate:"required,integer,positive,lt=1000000000"`
+	Unit  any `validate:"required,string,notEmpty"`
+}
+
+type CreateAddOnHandlerOutput struct {
+	AddOnId uuid.UUID `json:"addOnId"`
+}
+
+type CreateAddOnHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	CreateAddOn   usecases.ICreateAddOn
+}
+
+func (ca *CreateAddOnHandler) Handle(c echo.Context) error {
+	var input CreateAddOnHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ca.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ca.HttpValidator.Validate(input))
+	}
+
+	output, err := ca.CreateAddOn.Execute(usecases.CreateAddOnInput{
+		Code:  input.Code.(string),
+		Name:  input.Name.(string),
+		Price: uint64(input.Price.(float64)),
+		Unit:  input.Unit.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "add-on code must have 2 to 50 uppercase letters, digits or underscores (e.g. LATE_CHECK_OUT)",
+			"add-on name must be at least 3 characters long",
+			"invalid add-on price. Please enter a value greater than zero",
+			"add-on unit must be PER_STAY, PER_NIGHT or PER_PERSON_PER_NIGHT":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "an add-on with this code already exists":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		ca.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, CreateAddOnHandlerOutput{
+		AddOnId: output.AddOnId,
+	})
+}
@@ -0,0 +1,145 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreateAddOn struct {
+	mock.Mock
+}
+
+func (m *MockCreateAddOn) Execute(input usecases.CreateAddOnInput) (usecases.CreateAddOnOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreateAddOnOutput), args.Error(1)
+}
+
+type CreateAddOnHandlerSuite struct {
+	suite.Suite
+	mockCreateAddOn    MockCreateAddOn
+	createAddOnHandler handlers.CreateAddOnHandler
+}
+
+func (ca *CreateAddOnHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	ca.Require().NoError(err)
+
+	ca.mockCreateAddOn = MockCreateAddOn{}
+	ca.createAddOnHandler = handlers.CreateAddOnHandler{
+		HttpLogger:    webhttp.NewHttpLogger(),
+		HttpValidator: httpValidator,
+		CreateAddOn:   &ca.mockCreateAddOn,
+	}
+}
+
+func (ca *CreateAddOnHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
+	ca.mockCreateAddOn.On("Execute", usecases.CreateAddOnInput{
+		Code:  "BREAKFAST",
+		Name:  "Breakfast",
+		Price: 15,
+		Unit:  "PER_PERSON_PER_NIGHT",
+	}).Return(usecases.CreateAddOnOutput{
+		AddOnId: uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "BREAKFAST",
+			"name": "Breakfast",
+			"price": 15,
+			"unit": "PER_PERSON_PER_NIGHT"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := ca.createAddOnHandler.Handle(c)
+	ca.Require().NoError(err)
+
+	ca.Equal(201, recorder.Code)
+	ca.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"addOnId": "0dc94e80-3df8-40c9-8a79-9e9e555abbde"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (ca *CreateAddOnHandlerSuite) TestHandle_OnCodeAlreadyExists_ReturnsConflict() {
+	ca.mockCreateAddOn.On("Execute", mock.Anything).
+		Return(usecases.CreateAddOnOutput{}, errors.New("an add-on with this code already exists"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "BREAKFAST",
+			"name": "Breakfast",
+			"price": 15,
+			"unit": "PER_PERSON_PER_NIGHT"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := ca.createAddOnHandler.Handle(c)
+	ca.Require().NoError(err)
+
+	ca.Equal(409, recorder.Code)
+	ca.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "an add-on with this code already exists"
+		}
+	`, recorder.Body.String())
+}
+
+func (ca *CreateAddOnHandlerSuite) TestHandle_OnInvalidBody_ReturnsBadRequest() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "",
+			"name": 1,
+			"price": 1.5
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := ca.createAddOnHandler.Handle(c)
+	ca.Require().NoError(err)
+
+	ca.Equal(400, recorder.Code)
+	ca.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": [
+				"code must not be empty",
+				"name must be string",
+				"price must be integer",
+				"unit is required"
+			]
+		}
+	`, recorder.Body.String())
+}
+
+func TestCreateAddOnHandler(t *testing.T) {
+	suite.Run(t, new(CreateAddOnHandlerSuite))
+}
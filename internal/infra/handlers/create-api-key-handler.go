@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateApiKeyHandlerInput struct {
+	Name      any `validate:"required,string,notEmpty,lt=101"`
+	Scopes    any `validate:"required,stringArray"`
+	ExpiresAt any `validate:"omitempty,date"`
+}
+
+type CreateApiKeyHandlerOutput struct {
+	ApiKeyId uuid.UUID `json:"apiKeyId"`
+	Prefix   string    `json:"prefix"`
+	ApiKey   string    `json:"apiKey"`
+}
+
+type CreateApiKeyHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	CreateApiKey  usecases.ICreateApiKey
+}
+
+func (ck *CreateApiKeyHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	var input CreateApiKeyHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ck.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ck.HttpValidator.Validate(input))
+	}
+
+	var expiresAt time.Time
+	if input.ExpiresAt != nil {
+		expiresAt = toDate(input.ExpiresAt)
+	}
+
+	output, err := ck.CreateApiKey.Execute(usecases.CreateApiKeyInput{
+		Principal: principal,
+		Name:      input.Name.(string),
+		Scopes:    toStrings(input.Scopes),
+		ExpiresAt: expiresAt,
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "api key name must be between 3 and 100 characters long",
+			"api key must have at least one scope",
+			"api key expiry must be in the future":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "only staff users can create api keys",
+			"api keys cannot manage staff users or api keys",
+			"api key scopes must be permissions of your own role or partner scopes":
+			return webhttp.NewForbidden(c, err.Error())
+		}
+
+		ck.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, CreateApiKeyHandlerOutput{
+		ApiKeyId: output.ApiKeyId,
+		Prefix:   output.Prefix,
+		ApiKey:   output.ApiKey,
+	})
+}
+
+func toStrings(value any) []string {
+	values := []string{}
+
+	items, _ := value.([]any)
+	for _, item := range items {
+		values = append(values, item.(string))
+	}
+
+	return values
+}
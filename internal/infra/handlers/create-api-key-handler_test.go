@@ -0,0 +1,136 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreateApiKey struct {
+	mock.Mock
+}
+
+func (m *MockCreateApiKey) Execute(input usecases.CreateApiKeyInput) (usecases.CreateApiKeyOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreateApiKeyOutput), args.Error(1)
+}
+
+type CreateApiKeyHandlerSuite struct {
+	suite.Suite
+	principal           auth.Principal
+	mockCreateApiKey    MockCreateApiKey
+	createApiKeyHandler handlers.CreateApiKeyHandler
+}
+
+func (ck *CreateApiKeyHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	ck.Require().NoError(err)
+
+	ck.principal = auth.Principal{
+		StaffUserId: uuid.MustParse("3c0b7a52-0f5e-4a55-8d61-2f0b7f6f1a10"),
+		Role:        "ADMIN",
+	}
+	ck.mockCreateApiKey = MockCreateApiKey{}
+	ck.createApiKeyHandler = handlers.CreateApiKeyHandler{
+		HttpLogger:    webhttp.NewHttpLogger(),
+		HttpValidator: httpValidator,
+		CreateApiKey:  &ck.mockCreateApiKey,
+	}
+}
+
+func (ck *CreateApiKeyHandlerSuite) newContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, ck.principal)
+	return c, recorder
+}
+
+func (ck *CreateApiKeyHandlerSuite) TestHandle_OnNoErrors_ReturnsCreatedWithThePlainKey() {
+	ck.mockCreateApiKey.On("Execute", usecases.CreateApiKeyInput{
+		Principal: ck.principal,
+		Name:      "Channel manager",
+		Scopes:    []string{"rooms:read", "bookings:read:any"},
+		ExpiresAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}).Return(usecases.CreateApiKeyOutput{
+		ApiKeyId: uuid.MustParse("8b0f7c5e-0d3a-4bde-9d1b-1f6ef3f9a0a2"),
+		Prefix:   "0123456789ab",
+		ApiKey:   "hbk_0123456789ab_any_secret",
+	}, nil)
+	c, recorder := ck.newContext(`
+		{
+			"name": "Channel manager",
+			"scopes": ["rooms:read", "bookings:read:any"],
+			"expiresAt": "2030-01-01"
+		}
+	`)
+
+	err := ck.createApiKeyHandler.Handle(c)
+	ck.Require().NoError(err)
+
+	ck.Equal(201, recorder.Code)
+	ck.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"apiKeyId": "8b0f7c5e-0d3a-4bde-9d1b-1f6ef3f9a0a2",
+				"prefix": "0123456789ab",
+				"apiKey": "hbk_0123456789ab_any_secret"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (ck *CreateApiKeyHandlerSuite) TestHandle_OnScopeOutsideTheRole_ReturnsForbidden() {
+	ck.mockCreateApiKey.On("Execute", mock.Anything).
+		Return(usecases.CreateApiKeyOutput{}, errors.New("api key scopes must be permissions of your own role or partner scopes"))
+	c, recorder := ck.newContext(`{"name": "Channel manager", "scopes": ["refunds:write"]}`)
+
+	err := ck.createApiKeyHandler.Handle(c)
+	ck.Require().NoError(err)
+
+	ck.Equal(403, recorder.Code)
+	ck.JSONEq(`
+		{
+			"statusCode": 403,
+			"statusText": "FORBIDDEN",
+			"error": "api key scopes must be permissions of your own role or partner scopes"
+		}
+	`, recorder.Body.String())
+}
+
+func (ck *CreateApiKeyHandlerSuite) TestHandle_OnEmptyScopes_ReturnsBadRequest() {
+	c, recorder := ck.newContext(`{"name": "Channel manager", "scopes": []}`)
+
+	err := ck.createApiKeyHandler.Handle(c)
+	ck.Require().NoError(err)
+
+	ck.Equal(400, recorder.Code)
+	ck.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": ["scopes must be a non-empty array of non-empty strings"]
+		}
+	`, recorder.Body.String())
+	ck.mockCreateApiKey.AssertNotCalled(ck.T(), "Execute", mock.Anything)
+}
+
+func TestCreateApiKeyHandler(t *testing.T) {
+	suite.Run(t, new(CreateApiKeyHandlerSuite))
+}
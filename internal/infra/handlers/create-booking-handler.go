@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateBookingHandlerInput struct {
+	// CustomerId names the customer a partner system books for. Customers always book for themselves.
+	CustomerId   any `validate:"omitempty,string,uuid4"`
+	RoomIds      any `validate:"required,uuidArray"`
+	CheckIn      any `validate:"required,date"`
+	CheckOut     any `validate:"required,date"`
+	Adults       any `validate:"required,integer,positive,lt=256"`
+	ChildrenAges any `validate:"omitempty,integerArray"`
+	AddOns       any `validate:"omitempty,addOnArray"`
+	Package      any `validate:"omitempty,string,notEmpty,lt=51"`
+	PromoCode    any `validate:"omitempty,string,notEmpty,lt=51"`
+	QuoteToken   any `validate:"omitempty,string,notEmpty,lt=4096"`
+	PaymentToken any `validate:"omitempty,string,notEmpty,lt=256"`
+	RatePlan     any `validate:"omitempty,string,notEmpty,lt=51"`
+	CreditAmount any `validate:"omitempty,integer,positive,lt=1000000000"`
+}
+
+type CreateBookingHandlerOutput struct {
+	BookingId     uuid.UUID `json:"bookingId"`
+	TotalPrice    uint64    `json:"totalPrice"`
+	DepositAmount uint64    `json:"depositAmount"`
+	PrepaidAmount uint64    `json:"prepaidAmount"`
+	CreditAmount  uint64    `json:"creditAmount"`
+}
+
+type CreateBookingHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	CreateBooking usecases.ICreateBooking
+}
+
+// Handle lets customers book for themselves and partner systems, through an API key with the bookings:create scope,
+// book for the customer named in the body.
+func (cb *CreateBookingHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	if !principal.IsCustomer() && !(principal.IsApiKey() && principal.HasPermission("bookings:create")) {
+		return webhttp.NewForbidden(c, "you do not have permission to access this resource")
+	}
+
+	var input CreateBookingHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cb.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cb.HttpValidator.Validate(input))
+	}
+
+	customerId := principal.CustomerId
+
+	if principal.IsApiKey() {
+		rawCustomerId, _ := input.CustomerId.(string)
+
+		if rawCustomerId == "" {
+			return webhttp.NewBadRequestValidation(c, []string{"customerId is required when booking with an api key"})
+		}
+
+		customerId = uuid.MustParse(rawCustomerId)
+	}
+
+	packageCode, _ := input.Package.(string)
+	promoCode, _ := input.PromoCode.(string)
+	quoteToken, _ := input.QuoteToken.(string)
+	paymentToken, _ := input.PaymentToken.(string)
+	ratePlan, _ := input.RatePlan.(string)
+	creditAmount, _ := input.CreditAmount.(float64)
+
+	output, err := cb.CreateBooking.Execute(usecases.CreateBookingInput{
+		CustomerId:   customerId,
+		RoomIds:      toUuids(input.RoomIds),
+		CheckIn:      toDate(input.CheckIn),
+		CheckOut:     toDate(input.CheckOut),
+		Adults:       uint8(input.Adults.(float64)),
+		ChildrenAges: toAges(input.ChildrenAges),
+		AddOns:       toAddOns(input.AddOns),
+		Package:      packageCode,
+		PromoCode:    promoCode,
+		QuoteToken:   quoteToken,
+		PaymentToken: paymentToken,
+		RatePlan:     ratePlan,
+		CreditAmount: uint64(creditAmount),
+	})
+
+	if err != nil {
+		return handleBookingError(c, cb.HttpLogger, err)
+	}
+
+	return webhttp.NewCreated(c, CreateBookingHandlerOutput{
+		BookingId:     output.BookingId,
+		TotalPrice:    output.TotalPrice,
+		DepositAmount: output.DepositAmount,
+		PrepaidAmount: output.PrepaidAmount,
+		CreditAmount:  output.CreditAmount,
+	})
+}
@@ -0,0 +1,332 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreateBooking struct {
+	mock.Mock
+}
+
+func (m *MockCreateBooking) Execute(input usecases.CreateBookingInput) (usecases.CreateBookingOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreateBookingOutput), args.Error(1)
+}
+
+type CreateBookingHandlerSuite struct {
+	suite.Suite
+	mockCreateBooking    MockCreateBooking
+	createBookingHandler handlers.CreateBookingHandler
+	principal            auth.Principal
+}
+
+func (cb *CreateBookingHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cb.Require().NoError(err)
+
+	cb.mockCreateBooking = MockCreateBooking{}
+	cb.createBookingHandler = handlers.CreateBookingHandler{
+		HttpLogger:    webhttp.NewHttpLogger(),
+		HttpValidator: httpValidator,
+		CreateBooking: &cb.mockCreateBooking,
+	}
+	cb.principal = auth.Principal{CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), Role: "CUSTOMER"}
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
+	cb.mockCreateBooking.On("Execute", usecases.CreateBookingInput{
+		CustomerId:   uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:      []uuid.UUID{uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")},
+		CheckIn:      time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:     time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Adults:       2,
+		ChildrenAges: []uint8{},
+		AddOns:       []usecases.CreateQuoteInputAddOn{},
+		QuoteToken:   "any_quote_token",
+		PaymentToken: "tok_visa",
+	}).Return(usecases.CreateBookingOutput{
+		BookingId:     uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+		TotalPrice:    500,
+		DepositAmount: 150,
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2,
+			"quoteToken": "any_quote_token",
+			"paymentToken": "tok_visa"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, cb.principal)
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(201, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"bookingId": "0dc94e80-3df8-40c9-8a79-9e9e555abbde",
+				"totalPrice": 500,
+				"depositAmount": 150,
+				"prepaidAmount": 0,
+				"creditAmount": 0
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnApiKeyWithCustomerId_BooksForThatCustomer() {
+	cb.mockCreateBooking.On("Execute", mock.MatchedBy(func(input usecases.CreateBookingInput) bool {
+		return input.CustomerId == uuid.MustParse("5f0e3c8e-8a8f-4d2c-9f43-4b9b1f1f6a10")
+	})).Return(usecases.CreateBookingOutput{
+		BookingId:  uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+		TotalPrice: 500,
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"customerId": "5f0e3c8e-8a8f-4d2c-9f43-4b9b1f1f6a10",
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{ApiKeyId: uuid.New(), Permissions: []string{"bookings:create"}})
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(201, recorder.Code)
+	cb.mockCreateBooking.AssertExpectations(cb.T())
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnApiKeyWithoutCustomerId_ReturnsBadRequest() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{ApiKeyId: uuid.New(), Permissions: []string{"bookings:create"}})
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(400, recorder.Code)
+	cb.Contains(recorder.Body.String(), "customerId is required when booking with an api key")
+	cb.mockCreateBooking.AssertNotCalled(cb.T(), "Execute", mock.Anything)
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnStaffUser_ReturnsForbidden() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{StaffUserId: uuid.New(), Role: "ADMIN", Permissions: []string{"bookings:write:any"}})
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(403, recorder.Code)
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnAuthorizationTokenIsMissing_ReturnsError() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(401, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "missing or invalid authorization token"
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnExpiredQuote_ReturnsBadRequest() {
+	cb.mockCreateBooking.On("Execute", mock.Anything).
+		Return(usecases.CreateBookingOutput{}, errors.New("quote has expired or is invalid. Please request a new quote"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2,
+			"quoteToken": "any_quote_token"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, cb.principal)
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(400, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"error": "quote has expired or is invalid. Please request a new quote"
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnDeclinedDeposit_ReturnsPaymentRequired() {
+	cb.mockCreateBooking.On("Execute", mock.Anything).
+		Return(usecases.CreateBookingOutput{}, errors.New("the deposit payment was declined"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2,
+			"paymentToken": "tok_declined"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, cb.principal)
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(402, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 402,
+			"statusText": "PAYMENT_REQUIRED",
+			"error": "the deposit payment was declined"
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnRatePlan_ReturnsPrepaidAmount() {
+	cb.mockCreateBooking.On("Execute", usecases.CreateBookingInput{
+		CustomerId:   uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:      []uuid.UUID{uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")},
+		CheckIn:      time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:     time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Adults:       2,
+		ChildrenAges: []uint8{},
+		AddOns:       []usecases.CreateQuoteInputAddOn{},
+		PaymentToken: "tok_visa",
+		RatePlan:     "FLEXIBLE_SPLIT",
+	}).Return(usecases.CreateBookingOutput{
+		BookingId:     uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+		TotalPrice:    500,
+		PrepaidAmount: 100,
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2,
+			"paymentToken": "tok_visa",
+			"ratePlan": "FLEXIBLE_SPLIT"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, cb.principal)
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(201, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"bookingId": "0dc94e80-3df8-40c9-8a79-9e9e555abbde",
+				"totalPrice": 500,
+				"depositAmount": 0,
+				"prepaidAmount": 100,
+				"creditAmount": 0
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cb *CreateBookingHandlerSuite) TestHandle_OnAnyUnexpectedError_ReturnsInternalServerError() {
+	cb.mockCreateBooking.On("Execute", mock.Anything).
+		Return(usecases.CreateBookingOutput{}, errors.New("any unexpected error"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-03",
+			"adults": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, cb.principal)
+
+	err := cb.createBookingHandler.Handle(c)
+	cb.Require().NoError(err)
+
+	cb.Equal(500, recorder.Code)
+	cb.JSONEq(`
+		{
+			"statusCode": 500,
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"error": "something went wrong. Please try again later"
+		}
+	`, recorder.Body.String())
+}
+
+func TestCreateBookingHandler(t *testing.T) {
+	suite.Run(t, new(CreateBookingHandlerSuite))
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreatePackageHandlerInput struct {
+	Code          any `validate:"required,string,notEmpty,lt=51"`
+	Name          any `validate:"required,string,notEmpty,lt=101"`
+	RoomType      any `validate:"required,string,notEmpty,lt=256"`
+	Components    any `validate:"required,addOnArray"`
+	PricePerNight any `validate:"required,integer,positive,lt=1000000000"`
+	ValidFrom     any `validate:"required,date"`
+	ValidUntil    any `validate:"required,date"`
+	MinStayNights any `validate:"required,integer,positive,lt=256"`
+}
+
+type CreatePackageHandlerOutput struct {
+	PackageId uuid.UUID `json:"packageId"`
+}
+
+type CreatePackageHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	CreatePackage usecases.ICreatePackage
+}
+
+func (cp *CreatePackageHandler) Handle(c echo.Context) error {
+	var input CreatePackageHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cp.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cp.HttpValidator.Validate(input))
+	}
+
+	components := []usecases.CreatePackageInputComponent{}
+	for _, addOn := range toAddOns(input.Components) {
+		components = append(components, usecases.CreatePackageInputComponent{
+			AddOnCode: addOn.Code,
+			Quantity:  addOn.Quantity,
+		})
+	}
+
+	output, err := cp.CreatePackage.Execute(usecases.CreatePackageInput{
+		Code:          input.Code.(string),
+		Name:          input.Name.(string),
+		RoomType:      input.RoomType.(string),
+		Components:    components,
+		PricePerNight: uint64(input.PricePerNight.(float64)),
+		ValidFrom:     toDate(input.ValidFrom),
+		ValidUntil:    toDate(input.ValidUntil),
+		MinStayNights: uint8(input.MinStayNights.(float64)),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "package code must have 2 to 50 uppercase letters, digits or underscores (e.g. ROMANTIC_WEEKEND)",
+			"package name must be at least 3 characters long",
+			"room type must be SINGLE, DOUBLE, TWIN or SUITE",
+			"a package must include at least one add-on",
+			"package add-on quantity must be at least one",
+			"each add-on can only be included once in a package",
+			"invalid package price. Please enter a value greater than zero",
+			"package valid until date must be on or after valid from date",
+			"minimum stay must be at least one night":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "one or more add-ons were not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "a package with this code already exists":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		cp.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, CreatePackageHandlerOutput{
+		PackageId: output.PackageId,
+	})
+}
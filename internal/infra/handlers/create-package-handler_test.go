@@ -0,0 +1,163 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreatePackage struct {
+	mock.Mock
+}
+
+func (m *MockCreatePackage) Execute(input usecases.CreatePackageInput) (usecases.CreatePackageOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreatePackageOutput), args.Error(1)
+}
+
+type CreatePackageHandlerSuite struct {
+	suite.Suite
+	mockCreatePackage    MockCreatePackage
+	createPackageHandler handlers.CreatePackageHandler
+}
+
+func (cp *CreatePackageHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cp.Require().NoError(err)
+
+	cp.mockCreatePackage = MockCreatePackage{}
+	cp.createPackageHandler = handlers.CreatePackageHandler{
+		HttpLogger:    webhttp.NewHttpLogger(),
+		HttpValidator: httpValidator,
+		CreatePackage: &cp.mockCreatePackage,
+	}
+}
+
+func (cp *CreatePackageHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
+	cp.mockCreatePackage.On("Execute", usecases.CreatePackageInput{
+		Code:     "ROMANTIC_WEEKEND",
+		Name:     "Romantic weekend",
+		RoomType: "DOUBLE",
+		Components: []usecases.CreatePackageInputComponent{
+			{AddOnCode: "BREAKFAST", Quantity: 2},
+			{AddOnCode: "CHAMPAGNE", Quantity: 1},
+		},
+		PricePerNight: 250,
+		ValidFrom:     time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+		ValidUntil:    time.Date(2030, 2, 28, 0, 0, 0, 0, time.UTC),
+		MinStayNights: 2,
+	}).Return(usecases.CreatePackageOutput{
+		PackageId: uuid.MustParse("8b0f7c5e-0d3a-4bde-9d1b-1f6ef3f9a0a2"),
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "ROMANTIC_WEEKEND",
+			"name": "Romantic weekend",
+			"roomType": "DOUBLE",
+			"components": [{"code": "BREAKFAST", "quantity": 2}, {"code": "CHAMPAGNE", "quantity": 1}],
+			"pricePerNight": 250,
+			"validFrom": "2030-02-01",
+			"validUntil": "2030-02-28",
+			"minStayNights": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cp.createPackageHandler.Handle(c)
+	cp.Require().NoError(err)
+
+	cp.Equal(201, recorder.Code)
+	cp.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"packageId": "8b0f7c5e-0d3a-4bde-9d1b-1f6ef3f9a0a2"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cp *CreatePackageHandlerSuite) TestHandle_OnCodeAlreadyExists_ReturnsConflict() {
+	cp.mockCreatePackage.On("Execute", mock.Anything).
+		Return(usecases.CreatePackageOutput{}, errors.New("a package with this code already exists"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "ROMANTIC_WEEKEND",
+			"name": "Romantic weekend",
+			"roomType": "DOUBLE",
+			"components": [{"code": "BREAKFAST", "quantity": 2}],
+			"pricePerNight": 250,
+			"validFrom": "2030-02-01",
+			"validUntil": "2030-02-28",
+			"minStayNights": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cp.createPackageHandler.Handle(c)
+	cp.Require().NoError(err)
+
+	cp.Equal(409, recorder.Code)
+	cp.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "a package with this code already exists"
+		}
+	`, recorder.Body.String())
+}
+
+func (cp *CreatePackageHandlerSuite) TestHandle_OnUnknownAddOn_ReturnsNotFound() {
+	cp.mockCreatePackage.On("Execute", mock.Anything).
+		Return(usecases.CreatePackageOutput{}, errors.New("one or more add-ons were not found"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "ROMANTIC_WEEKEND",
+			"name": "Romantic weekend",
+			"roomType": "DOUBLE",
+			"components": [{"code": "SPA", "quantity": 1}],
+			"pricePerNight": 250,
+			"validFrom": "2030-02-01",
+			"validUntil": "2030-02-28",
+			"minStayNights": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cp.createPackageHandler.Handle(c)
+	cp.Require().NoError(err)
+
+	cp.Equal(404, recorder.Code)
+	cp.JSONEq(`
+		{
+			"statusCode": 404,
+			"statusText": "NOT_FOUND",
+			"error": "one or more add-ons were not found"
+		}
+	`, recorder.Body.String())
+}
+
+func TestCreatePackageHandler(t *testing.T) {
+	suite.Run(t, new(CreatePackageHandlerSuite))
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreatePricingRuleHandlerInput struct {
+	RoomType           any `validate:"required,string,notEmpty,lt=256"`
+	OccupancyThreshold any `validate:"required,integer,positive,lt=100"`
+	AdjustmentPercent  any `validate:"required,integer,positive,lt=256"`
+	FloorPrice         any `validate:"required,integer,positive,lt=1000000000"`
+	CeilingPrice       any `validate:"required,integer,positive,lt=1000000000"`
+}
+
+type CreatePricingRuleHandlerOutput struct {
+	PricingRuleId uuid.UUID `json:"pricingRuleId"`
+}
+
+type CreatePricingRuleHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	HttpValidator     webhttp.HttpValidator
+	CreatePricingRule usecases.ICreatePricingRule
+}
+
+func (cp *CreatePricingRuleHandler) Handle(c echo.Context) error {
+	var input CreatePricingRuleHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cp.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cp.HttpValidator.Validate(input))
+	}
+
+	output, err := cp.CreatePricingRule.Execute(usecases.CreatePricingRuleInput{
+		RoomType:           input.RoomType.(string),
+		OccupancyThreshold: uint8(input.OccupancyThreshold.(float64)),
+		AdjustmentPercent:  uint8(input.AdjustmentPercent.(float64)),
+		FloorPrice:         uint64(input.FloorPrice.(float64)),
+		CeilingPrice:       uint64(input.CeilingPrice.(float64)),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "room type must be SINGLE, DOUBLE, TWIN or SUITE",
+			"occupancy threshold must be less than 100",
+			"adjustment percent must be greater than zero",
+			"ceiling price must be greater than or equal to floor price":
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		cp.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, CreatePricingRuleHandlerOutput{
+		PricingRuleId: output.PricingRuleId,
+	})
+}
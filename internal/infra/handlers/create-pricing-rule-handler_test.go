@@ -0,0 +1,119 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreatePricingRule struct {
+	mock.Mock
+}
+
+func (m *MockCreatePricingRule) Execute(input usecases.CreatePricingRuleInput) (usecases.CreatePricingRuleOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreatePricingRuleOutput), args.Error(1)
+}
+
+type CreatePricingRuleHandlerSuite struct {
+	suite.Suite
+	mockCreatePricingRule    MockCreatePricingRule
+	createPricingRuleHandler handlers.CreatePricingRuleHandler
+}
+
+func (cp *CreatePricingRuleHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cp.Require().NoError(err)
+
+	cp.mockCreatePricingRule = MockCreatePricingRule{}
+	cp.createPricingRuleHandler = handlers.CreatePricingRuleHandler{
+		HttpLogger:        webhttp.NewHttpLogger(),
+		HttpValidator:     httpValidator,
+		CreatePricingRule: &cp.mockCreatePricingRule,
+	}
+}
+
+func (cp *CreatePricingRuleHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
+	cp.mockCreatePricingRule.On("Execute", usecases.CreatePricingRuleInput{
+		RoomType:           "SUITE",
+		OccupancyThreshold: 80,
+		AdjustmentPercent:  15,
+		FloorPrice:         200,
+		CeilingPrice:       400,
+	}).Return(usecases.CreatePricingRuleOutput{
+		PricingRuleId: uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomType": "SUITE",
+			"occupancyThreshold": 80,
+			"adjustmentPercent": 15,
+			"floorPrice": 200,
+			"ceilingPrice": 400
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cp.createPricingRuleHandler.Handle(c)
+	cp.Require().NoError(err)
+
+	cp.Equal(201, recorder.Code)
+	cp.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"pricingRuleId": "0dc94e80-3df8-40c9-8a79-9e9e555abbde"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cp *CreatePricingRuleHandlerSuite) TestHandle_OnInvalidBody_ReturnsBadRequest() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomType": "",
+			"occupancyThreshold": 100,
+			"adjustmentPercent": 1.5,
+			"floorPrice": -1
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cp.createPricingRuleHandler.Handle(c)
+	cp.Require().NoError(err)
+
+	cp.Equal(400, recorder.Code)
+	cp.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": [
+				"roomType must not be empty",
+				"occupancyThreshold must be less than 100",
+				"adjustmentPercent must be integer",
+				"floorPrice must be positive",
+				"ceilingPrice is required"
+			]
+		}
+	`, recorder.Body.String())
+}
+
+func TestCreatePricingRuleHandler(t *testing.T) {
+	suite.Run(t, new(CreatePricingRuleHandlerSuite))
+}
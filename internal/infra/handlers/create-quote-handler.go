@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateQuoteHandlerInput struct {
+	RoomIds      any `validate:"required,uuidArray"`
+	CheckIn      any `validate:"required,date"`
+	CheckOut     any `validate:"required,date"`
+	Adults       any `validate:"required,integer,positive,lt=256"`
+	ChildrenAges any `validate:"omitempty,integerArray"`
+	AddOns       any `validate:"omitempty,addOnArray"`
+	Package      any `validate:"omitempty,string,notEmpty,lt=51"`
+	PromoCode    any `validate:"omitempty,string,notEmpty,lt=51"`
+}
+
+type CreateQuoteHandlerOutputItem struct {
+	Type        string     `json:"type"`
+	RoomId      *uuid.UUID `json:"roomId,omitempty"`
+	Date        string     `json:"date,omitempty"`
+	Description string     `json:"description"`
+	Price       uint64     `json:"price"`
+}
+
+type CreateQuoteHandlerOutput struct {
+	QuoteToken string                         `json:"quoteToken"`
+	ExpiresAt  time.Time                      `json:"expiresAt"`
+	Items      []CreateQuoteHandlerOutputItem `json:"items"`
+	Subtotal   uint64                         `json:"subtotal"`
+	Discount   uint64                         `json:"discount"`
+	Total      uint64                         `json:"total"`
+}
+
+type CreateQuoteHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	CreateQuote   usecases.ICreateQuote
+}
+
+func (cq *CreateQuoteHandler) Handle(c echo.Context) error {
+	var input CreateQuoteHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cq.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cq.HttpValidator.Validate(input))
+	}
+
+	packageCode, _ := input.Package.(string)
+	promoCode, _ := input.PromoCode.(string)
+
+	output, err := cq.CreateQuote.Execute(usecases.CreateQuoteInput{
+		RoomIds:      toUuids(input.RoomIds),
+		CheckIn:      toDate(input.CheckIn),
+		CheckOut:     toDate(input.CheckOut),
+		Adults:       uint8(input.Adults.(float64)),
+		ChildrenAges: toAges(input.ChildrenAges),
+		AddOns:       toAddOns(input.AddOns),
+		Package:      packageCode,
+		PromoCode:    promoCode,
+	})
+
+	if err != nil {
+		return handleBookingError(c, cq.HttpLogger, err)
+	}
+
+	handlerOutput := CreateQuoteHandlerOutput{
+		QuoteToken: output.QuoteToken,
+		ExpiresAt:  output.ExpiresAt,
+		Items:      []CreateQuoteHandlerOutputItem{},
+		Subtotal:   output.Subtotal,
+		Discount:   output.Discount,
+		Total:      output.Total,
+	}
+
+	for _, item := range output.Items {
+		handlerOutputItem := CreateQuoteHandlerOutputItem{
+			Type:        item.Type,
+			Description: item.Description,
+			Price:       item.Price,
+		}
+
+		if item.RoomId != uuid.Nil {
+			handlerOutputItem.RoomId = &item.RoomId
+		}
+
+		if !item.Date.IsZero() {
+			handlerOutputItem.Date = item.Date.Format(time.DateOnly)
+		}
+
+		handlerOutput.Items = append(handlerOutput.Items, handlerOutputItem)
+	}
+
+	return webhttp.NewOk(c, handlerOutput)
+}
+
+func handleBookingError(c echo.Context, httpLogger webhttp.HttpLogger, err error) error {
+	switch err.Error() {
+	case "at least one room must be selected",
+		"check-in date cannot be in the past",
+		"check-out date must be after check-in date",
+		"at least one adult is required",
+		"children ages must be between 0 and 17",
+		"the selected rooms cannot accommodate the number of guests",
+		"add-on quantity must be at least one",
+		"each add-on can only be selected once",
+		"package is not available for the selected dates",
+		"the selected stay is shorter than the package minimum stay",
+		"the selected rooms do not match the package room type",
+		"promo codes cannot be combined with packages",
+		"promo code is invalid or has expired",
+		"quote has expired or is invalid. Please request a new quote",
+		"quote does not match the booking details",
+		"a payment token is required to pay the deposit",
+		"a payment token is required by the rate plan payment schedule",
+		"credit amount cannot exceed the booking total":
+		return webhttp.NewBadRequest(c, err.Error())
+	case "the deposit payment was declined",
+		"the prepayment was declined",
+		"insufficient credit balance":
+		return webhttp.NewPaymentRequired(c, err.Error())
+	case "one or more rooms were not found",
+		"one or more add-ons were not found",
+		"package not found",
+		"rate plan not found",
+		"customer not found":
+		return webhttp.NewNotFound(c, err.Error())
+	case "one or more rooms are not available for the selected dates":
+		return webhttp.NewConflict(c, err.Error())
+	case "email must be verified before booking":
+		return webhttp.NewForbidden(c, err.Error())
+	}
+
+	httpLogger.Log(c, err)
+	return webhttp.NewInternalServerError(c)
+}
+
+func toUuids(value any) []uuid.UUID {
+	uuids := []uuid.UUID{}
+
+	for _, item := range value.([]any) {
+		uuids = append(uuids, uuid.MustParse(item.(string)))
+	}
+
+	return uuids
+}
+
+func toAges(value any) []uint8 {
+	ages := []uint8{}
+
+	items, _ := value.([]any)
+	for _, item := range items {
+		ages = append(ages, uint8(min(item.(float64), 255)))
+	}
+
+	return ages
+}
+
+func toAddOns(value any) []usecases.CreateQuoteInputAddOn {
+	addOns := []usecases.CreateQuoteInputAddOn{}
+
+	items, _ := value.([]any)
+	for _, item := range items {
+		addOn := item.(map[string]any)
+		addOns = append(addOns, usecases.CreateQuoteInputAddOn{
+			Code:     addOn["code"].(string),
+			Quantity: uint8(addOn["quantity"].(float64)),
+		})
+	}
+
+	return addOns
+}
+
+func toDate(value any) time.Time {
+	date, _ := time.Parse(time.DateOnly, value.(string))
+	return date
+}
@@ -0,0 +1,193 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreateQuote struct {
+	mock.Mock
+}
+
+func (m *MockCreateQuote) Execute(input usecases.CreateQuoteInput) (usecases.CreateQuoteOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreateQuoteOutput), args.Error(1)
+}
+
+type CreateQuoteHandlerSuite struct {
+	suite.Suite
+	mockCreateQuote    MockCreateQuote
+	createQuoteHandler handlers.CreateQuoteHandler
+}
+
+func (cq *CreateQuoteHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cq.Require().NoError(err)
+
+	cq.mockCreateQuote = MockCreateQuote{}
+	cq.createQuoteHandler = handlers.CreateQuoteHandler{
+		HttpLogger:    webhttp.NewHttpLogger(),
+		HttpValidator: httpValidator,
+		CreateQuote:   &cq.mockCreateQuote,
+	}
+}
+
+func (cq *CreateQuoteHandlerSuite) TestHandle_OnNoErrors_ReturnsOk() {
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	cq.mockCreateQuote.On("Execute", usecases.CreateQuoteInput{
+		RoomIds:      []uuid.UUID{roomId},
+		CheckIn:      time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:     time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC),
+		Adults:       2,
+		ChildrenAges: []uint8{7},
+		AddOns:       []usecases.CreateQuoteInputAddOn{{Code: "BREAKFAST", Quantity: 1}},
+		PromoCode:    "SUMMER",
+	}).Return(usecases.CreateQuoteOutput{
+		QuoteToken: "any_quote_token",
+		ExpiresAt:  time.Date(2030, 5, 1, 12, 15, 0, 0, time.UTC),
+		Items: []usecases.CreateQuoteOutputItem{
+			{
+				Type:        "ROOM_NIGHT",
+				RoomId:      roomId,
+				Date:        time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+				Description: "Room 101",
+				Price:       250,
+			},
+			{
+				Type:        "ADD_ON",
+				Description: "Breakfast x1",
+				Price:       45,
+			},
+		},
+		Subtotal: 295,
+		Discount: 29,
+		Total:    266,
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-02",
+			"adults": 2,
+			"childrenAges": [7],
+			"addOns": [{"code": "BREAKFAST", "quantity": 1}],
+			"promoCode": "SUMMER"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cq.createQuoteHandler.Handle(c)
+	cq.Require().NoError(err)
+
+	cq.Equal(200, recorder.Code)
+	cq.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"quoteToken": "any_quote_token",
+				"expiresAt": "2030-05-01T12:15:00Z",
+				"items": [
+					{
+						"type": "ROOM_NIGHT",
+						"roomId": "849702fc-aad3-478f-9dd7-9963b4ca33ca",
+						"date": "2030-06-01",
+						"description": "Room 101",
+						"price": 250
+					},
+					{
+						"type": "ADD_ON",
+						"description": "Breakfast x1",
+						"price": 45
+					}
+				],
+				"subtotal": 295,
+				"discount": 29,
+				"total": 266
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cq *CreateQuoteHandlerSuite) TestHandle_OnRoomsNotAvailable_ReturnsConflict() {
+	cq.mockCreateQuote.On("Execute", mock.Anything).
+		Return(usecases.CreateQuoteOutput{}, errors.New("one or more rooms are not available for the selected dates"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"],
+			"checkIn": "2030-06-01",
+			"checkOut": "2030-06-02",
+			"adults": 2
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cq.createQuoteHandler.Handle(c)
+	cq.Require().NoError(err)
+
+	cq.Equal(409, recorder.Code)
+	cq.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "one or more rooms are not available for the selected dates"
+		}
+	`, recorder.Body.String())
+}
+
+func (cq *CreateQuoteHandlerSuite) TestHandle_OnInvalidBody_ReturnsBadRequest() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"roomIds": ["abc"],
+			"checkIn": "01/06/2030",
+			"adults": 1.5,
+			"childrenAges": [-1],
+			"promoCode": 1
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cq.createQuoteHandler.Handle(c)
+	cq.Require().NoError(err)
+
+	cq.Equal(400, recorder.Code)
+	cq.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": [
+				"roomIds must be a non-empty array of uuids",
+				"checkIn must be a date in the format YYYY-MM-DD",
+				"checkOut is required",
+				"adults must be integer",
+				"childrenAges must be an array of positive integers",
+				"promoCode must be string"
+			]
+		}
+	`, recorder.Body.String())
+}
+
+func TestCreateQuoteHandler(t *testing.T) {
+	suite.Run(t, new(CreateQuoteHandlerSuite))
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateRatePlanHandlerInput struct {
+	Code         any `validate:"required,string,notEmpty,lt=51"`
+	Name         any `validate:"required,string,notEmpty,lt=101"`
+	Installments any `validate:"required,installmentArray"`
+}
+
+type CreateRatePlanHandlerOutput struct {
+	RatePlanId uuid.UUID `json:"ratePlanId"`
+}
+
+type CreateRatePlanHandler struct {
+	HttpLogger     webhttp.HttpLogger
+	HttpValidator  webhttp.HttpValidator
+	CreateRatePlan usecases.ICreateRatePlan
+}
+
+func (cr *CreateRatePlanHandler) Handle(c echo.Context) error {
+	var input CreateRatePlanHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cr.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cr.HttpValidator.Validate(input))
+	}
+
+	output, err := cr.CreateRatePlan.Execute(usecases.CreateRatePlanInput{
+		Code:         input.Code.(string),
+		Name:         input.Name.(string),
+		Installments: toInstallments(input.Installments),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "rate plan code must have 2 to 50 uppercase letters, digits or underscores (e.g. NON_REFUNDABLE)",
+			"rate plan name must be at least 3 characters long",
+			"installment percent must be between 1 and 100",
+			"installment due must be AT_BOOKING, BEFORE_ARRIVAL or AT_CHECK_IN",
+			"installments due before arrival require at least one day before arrival",
+			"payment schedule percentages cannot exceed 100":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "a rate plan with this code already exists":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		cr.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, CreateRatePlanHandlerOutput{
+		RatePlanId: output.RatePlanId,
+	})
+}
+
+func toInstallments(value any) []usecases.CreateRatePlanInputInstallment {
+	installments := []usecases.CreateRatePlanInputInstallment{}
+
+	items, _ := value.([]any)
+	for _, item := range items {
+		installment := item.(map[string]any)
+		daysBeforeArrival, _ := installment["daysBeforeArrival"].(float64)
+		installments = append(installments, usecases.CreateRatePlanInputInstallment{
+			Percent:           uint8(installment["percent"].(float64)),
+			Due:               installment["due"].(string),
+			DaysBeforeArrival: uint16(daysBeforeArrival),
+		})
+	}
+
+	return installments
+}
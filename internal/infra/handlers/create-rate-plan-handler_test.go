@@ -0,0 +1,148 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockCreateRatePlan struct {
+	mock.Mock
+}
+
+func (m *MockCreateRatePlan) Execute(input usecases.CreateRatePlanInput) (usecases.CreateRatePlanOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.CreateRatePlanOutput), args.Error(1)
+}
+
+type CreateRatePlanHandlerSuite struct {
+	suite.Suite
+	mockCreateRatePlan    MockCreateRatePlan
+	createRatePlanHandler handlers.CreateRatePlanHandler
+}
+
+func (cr *CreateRatePlanHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	cr.Require().NoError(err)
+
+	cr.mockCreateRatePlan = MockCreateRatePlan{}
+	cr.createRatePlanHandler = handlers.CreateRatePlanHandler{
+		HttpLogger:     webhttp.NewHttpLogger(),
+		HttpValidator:  httpValidator,
+		CreateRatePlan: &cr.mockCreateRatePlan,
+	}
+}
+
+func (cr *CreateRatePlanHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
+	cr.mockCreateRatePlan.On("Execute", usecases.CreateRatePlanInput{
+		Code: "FLEXIBLE_SPLIT",
+		Name: "Flexible split",
+		Installments: []usecases.CreateRatePlanInputInstallment{
+			{Percent: 20, Due: "AT_BOOKING"},
+			{Percent: 80, Due: "BEFORE_ARRIVAL", DaysBeforeArrival: 7},
+		},
+	}).Return(usecases.CreateRatePlanOutput{
+		RatePlanId: uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "FLEXIBLE_SPLIT",
+			"name": "Flexible split",
+			"installments": [
+				{"percent": 20, "due": "AT_BOOKING"},
+				{"percent": 80, "due": "BEFORE_ARRIVAL", "daysBeforeArrival": 7}
+			]
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cr.createRatePlanHandler.Handle(c)
+	cr.Require().NoError(err)
+
+	cr.Equal(201, recorder.Code)
+	cr.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"ratePlanId": "0dc94e80-3df8-40c9-8a79-9e9e555abbde"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (cr *CreateRatePlanHandlerSuite) TestHandle_OnPercentagesAboveHundred_ReturnsBadRequest() {
+	cr.mockCreateRatePlan.On("Execute", mock.Anything).
+		Return(usecases.CreateRatePlanOutput{}, errors.New("payment schedule percentages cannot exceed 100"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "FLEXIBLE_SPLIT",
+			"name": "Flexible split",
+			"installments": [
+				{"percent": 60, "due": "AT_BOOKING"},
+				{"percent": 60, "due": "AT_CHECK_IN"}
+			]
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cr.createRatePlanHandler.Handle(c)
+	cr.Require().NoError(err)
+
+	cr.Equal(400, recorder.Code)
+	cr.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"error": "payment schedule percentages cannot exceed 100"
+		}
+	`, recorder.Body.String())
+}
+
+func (cr *CreateRatePlanHandlerSuite) TestHandle_OnInvalidBody_ReturnsBadRequest() {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"code": "FLEXIBLE_SPLIT",
+			"name": "Flexible split",
+			"installments": [{"due": "AT_BOOKING"}]
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := cr.createRatePlanHandler.Handle(c)
+	cr.Require().NoError(err)
+
+	cr.Equal(400, recorder.Code)
+	cr.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": [
+				"installments must be an array of objects with a due, a percent between 1 and 100 and optional days before arrival up to 365"
+			]
+		}
+	`, recorder.Body.String())
+}
+
+func TestCreateRatePlanHandler(t *testing.T) {
+	suite.Run(t, new(CreateRatePlanHandlerSuite))
+}
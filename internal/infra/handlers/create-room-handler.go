@@ -16,23 +16,12 @@ type CreateRoomHandlerInput struct {
 }
 
 type CreateRoomHandler struct {
-	HttpLogger        webhttp.HttpLogger
-	HttpAuthorization webhttp.HttpAuthorization
-	HttpValidator     webhttp.HttpValidator
-	CreateRoom        usecases.ICreateRoom
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	CreateRoom    usecases.ICreateRoom
 }
 
 func (cr *CreateRoomHandler) Handle(c echo.Context) error {
-	authorizationToken := c.Request().Header.Get("Authorization")
-
-	if authorizationToken == "" {
-		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
-	}
-
-	if !cr.HttpAuthorization.IsAdmin(authorizationToken) {
-		return webhttp.NewForbidden(c, "you do not have permission to access this resource")
-	}
-
 	var input CreateRoomHandlerInput
 
 	if err := c.Bind(&input); err != nil {
@@ -8,8 +8,9 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
@@ -29,9 +30,9 @@ func (m *MockCreateRoom) Execute(input usecases.CreateRoomInput) error {
 
 type CreateRoomHandlerSuite struct {
 	suite.Suite
-	mockCreateRoom     MockCreateRoom
-	fakeSecretsGateway gateways.FakeSecretsGateway
-	createRoomHandler  handlers.CreateRoomHandler
+	mockCreateRoom    MockCreateRoom
+	httpAuthorization webhttp.HttpAuthorization
+	createRoomHandler handlers.CreateRoomHandler
 }
 
 func (cr *CreateRoomHandlerSuite) SetupTest() {
@@ -39,25 +40,20 @@ func (cr *CreateRoomHandlerSuite) SetupTest() {
 	cr.Require().NoError(err)
 
 	cr.mockCreateRoom = MockCreateRoom{}
-	cr.fakeSecretsGateway = gateways.FakeSecretsGateway{}
-	httpAuthorization := webhttp.HttpAuthorization{
-		SecretsGateway: &cr.fakeSecretsGateway,
+	cr.httpAuthorization = webhttp.HttpAuthorization{
+		RolesRepository: &repositories.FakeRolesRepository{
+			RolePermissions: map[string][]string{"ADMIN": {"rooms:write"}, "CUSTOMER": {"rooms:read"}},
+		},
+		HttpLogger: webhttp.NewHttpLogger(),
 	}
 	cr.createRoomHandler = handlers.CreateRoomHandler{
-		HttpLogger:        webhttp.NewHttpLogger(),
-		HttpValidator:     httpValidator,
-		HttpAuthorization: httpAuthorization,
-		CreateRoom:        &cr.mockCreateRoom,
+		HttpLogger:    webhttp.NewHttpLogger(),
+		HttpValidator: httpValidator,
+		CreateRoom:    &cr.mockCreateRoom,
 	}
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "101",
 		Type:     "SUITE",
@@ -72,13 +68,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(201, recorder.Code)
@@ -92,12 +87,6 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnAuthorizationTokenIsMissing_ReturnsError() {
-	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
-		Number:   "101",
-		Type:     "SUITE",
-		Capacity: 2,
-		Price:    250,
-	}).Return(nil)
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"number": "101",
@@ -111,7 +100,7 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnAuthorizationTokenIsMissing_Retur
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err := cr.createRoomHandler.Handle(c)
+	err := cr.httpAuthorization.Require("rooms:write")(cr.createRoomHandler.Handle)(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(401, recorder.Code)
@@ -122,22 +111,10 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnAuthorizationTokenIsMissing_Retur
 		"error": "missing or invalid authorization token"
 	}
 `, recorder.Body.String())
-
+	cr.mockCreateRoom.AssertNotCalled(cr.T(), "Execute", mock.Anything)
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnNoPermissonToAccessResource_ReturnsError() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "CUSTOMER",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
-	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
-		Number:   "101",
-		Type:     "SUITE",
-		Capacity: 2,
-		Price:    250,
-	}).Return(nil)
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"number": "101",
@@ -146,13 +123,13 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnNoPermissonToAccessResource_Retur
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{CustomerId: uuid.New(), Role: "CUSTOMER"})
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.httpAuthorization.Require("rooms:write")(cr.createRoomHandler.Handle)(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(403, recorder.Code)
@@ -163,16 +140,10 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnNoPermissonToAccessResource_Retur
 		"error": "you do not have permission to access this resource"
 	}
 `, recorder.Body.String())
-
+	cr.mockCreateRoom.AssertNotCalled(cr.T(), "Execute", mock.Anything)
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnDuplicateRoomNumberError_ReturnsConflict() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "101",
@@ -188,13 +159,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnDuplicateRoomNumberError_ReturnsC
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(409, recorder.Code)
@@ -208,12 +178,6 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnDuplicateRoomNumberError_ReturnsC
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidNumberError_ReturnsConflict() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "1",
 		Type:     "SUITE",
@@ -228,13 +192,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidNumberError_ReturnsConflic
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(409, recorder.Code)
@@ -248,12 +211,6 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidNumberError_ReturnsConflic
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidTypeError_ReturnsConflict() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "101",
 		Type:     "abc",
@@ -268,13 +225,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidTypeError_ReturnsConflict(
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(409, recorder.Code)
@@ -288,12 +244,6 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidTypeError_ReturnsConflict(
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidCapacityError_ReturnsConflict() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "101",
 		Type:     "SUITE",
@@ -308,13 +258,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidCapacityError_ReturnsConfl
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(409, recorder.Code)
@@ -328,12 +277,6 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidCapacityError_ReturnsConfl
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidPriceError_ReturnsConflict() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "101",
 		Type:     "SUITE",
@@ -348,13 +291,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidPriceError_ReturnsConflict
 			"price": 0
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(409, recorder.Code)
@@ -368,12 +310,6 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidPriceError_ReturnsConflict
 }
 
 func (cr *CreateRoomHandlerSuite) TestHandle_OnAnyUnexpectedError_ReturnsInternalServerError() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	cr.Require().NoError(err)
-	cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	cr.mockCreateRoom.On("Execute", usecases.CreateRoomInput{
 		Number:   "101",
 		Type:     "SUITE",
@@ -388,13 +324,12 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnAnyUnexpectedError_ReturnsInterna
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = cr.createRoomHandler.Handle(c)
+	err := cr.createRoomHandler.Handle(c)
 	cr.Require().NoError(err)
 
 	cr.Equal(500, recorder.Code)
@@ -483,22 +418,15 @@ func (cr *CreateRoomHandlerSuite) TestHandle_OnInvalidBody_ReturnsBadRequest() {
 	}
 
 	for _, inputAndError := range bodiesAndErrors {
-		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-			"role": "ADMIN",
-		})
-		signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-		cr.Require().NoError(err)
-		cr.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 		body := inputAndError["body"]
 		errorMessage := inputAndError["errors"]
 		request := httptest.NewRequest("POST", "/", strings.NewReader(body))
-		request.Header.Set("Authorization", signedToken)
 		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		recorder := httptest.NewRecorder()
 		e := echo.New()
 		c := e.NewContext(request, recorder)
 
-		err = cr.createRoomHandler.Handle(c)
+		err := cr.createRoomHandler.Handle(c)
 		cr.Require().NoError(err)
 
 		cr.Equal(400, recorder.Code)
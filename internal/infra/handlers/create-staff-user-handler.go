@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateStaffUserHandlerInput struct {
+	Name     any `validate:"required,string,notEmpty,lt=51"`
+	Email    any `validate:"required,string,notEmpty,lt=101"`
+	Password any `validate:"required,string,notEmpty,lt=256"`
+	Role     any `validate:"required,string,notEmpty"`
+}
+
+type CreateStaffUserHandlerOutput struct {
+	StaffUserId uuid.UUID `json:"staffUserId"`
+}
+
+type CreateStaffUserHandler struct {
+	HttpLogger      webhttp.HttpLogger
+	HttpValidator   webhttp.HttpValidator
+	CreateStaffUser usecases.ICreateStaffUser
+}
+
+func (cs *CreateStaffUserHandler) Handle(c echo.Context) error {
+	var input CreateStaffUserHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(cs.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, cs.HttpValidator.Validate(input))
+	}
+
+	output, err := cs.CreateStaffUser.Execute(usecases.CreateStaffUserInput{
+		Name:     input.Name.(string),
+		Email:    input.Email.(string),
+		Password: input.Password.(string),
+		Role:     input.Role.(string),
+	})
+
+	if err != nil {
+		if isPasswordPolicyViolation(err) {
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		switch err.Error() {
+		case "staff name must be at least 3 characters long",
+			"staff email is invalid",
+			"staff password must be at least 8 characters long",
+			"staff role must be a staff role",
+			"staff role does not exist":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "email address is already associated with another account":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		cs.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, CreateStaffUserHandlerOutput{
+		StaffUserId: output.StaffUserId,
+	})
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type DeactivatePackageHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	DeactivatePackage usecases.IDeactivatePackage
+}
+
+func (dp *DeactivatePackageHandler) Handle(c echo.Context) error {
+	packageId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "package id must be uuidv4")
+	}
+
+	err = dp.DeactivatePackage.Execute(usecases.DeactivatePackageInput{
+		PackageId: packageId,
+	})
+
+	if err != nil {
+		if err.Error() == "package not found" {
+			return webhttp.NewNotFound(c, err.Error())
+		}
+
+		dp.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type EnablePricingRuleHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	EnablePricingRule usecases.IEnablePricingRule
+}
+
+func (ep *EnablePricingRuleHandler) Handle(c echo.Context) error {
+	pricingRuleId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "pricing rule id must be uuidv4")
+	}
+
+	err = ep.EnablePricingRule.Execute(usecases.EnablePricingRuleInput{
+		PricingRuleId: pricingRuleId,
+	})
+
+	if err != nil {
+		if err.Error() == "pricing rule not found" {
+			return webhttp.NewNotFound(c, err.Error())
+		}
+
+		ep.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type EnrollTotpHandlerOutput struct {
+	Secret          string `json:"secret"`
+	ManualEntryKey  string `json:"manualEntryKey"`
+	ProvisioningUri string `json:"provisioningUri"`
+}
+
+type EnrollTotpHandler struct {
+	HttpLogger webhttp.HttpLogger
+	EnrollTotp usecases.IEnrollTotp
+}
+
+func (et *EnrollTotpHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	output, err := et.EnrollTotp.Execute(usecases.EnrollTotpInput{
+		Principal: principal,
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "two-factor authentication is already enabled":
+			return webhttp.NewConflict(c, err.Error())
+		case "user not found":
+			return webhttp.NewNotFound(c, err.Error())
+		}
+
+		et.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, EnrollTotpHandlerOutput{
+		Secret:          output.Secret,
+		ManualEntryKey:  output.ManualEntryKey,
+		ProvisioningUri: output.ProvisioningUri,
+	})
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ForgotPasswordHandlerInput struct {
+	Email any `validate:"required,string,notEmpty,lt=256"`
+}
+
+type ForgotPasswordHandler struct {
+	HttpLogger           webhttp.HttpLogger
+	HttpValidator        webhttp.HttpValidator
+	RequestPasswordReset usecases.IRequestPasswordReset
+}
+
+// Handle answers 202 whether or not the email is registered, and also when sending the email fails, so the
+// response cannot be used to find out which emails have an account. The reset runs after the response is sent,
+// because only registered emails get a token stored and an email sent and the time that takes would tell them apart.
+func (fp *ForgotPasswordHandler) Handle(c echo.Context) error {
+	var input ForgotPasswordHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(fp.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, fp.HttpValidator.Validate(input))
+	}
+
+	email := input.Email.(string)
+
+	go func() {
+		err := fp.RequestPasswordReset.Execute(usecases.RequestPasswordResetInput{
+			Email: email,
+		})
+
+		if err != nil {
+			fp.HttpLogger.LogBackground("Request Password Reset Failed", err)
+		}
+	}()
+
+	return webhttp.NewAccepted(c, "if the email is registered, a link to reset the password has been sent to it")
+}
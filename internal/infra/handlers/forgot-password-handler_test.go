@@ -0,0 +1,127 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockRequestPasswordReset struct {
+	mock.Mock
+}
+
+func (m *MockRequestPasswordReset) Execute(input usecases.RequestPasswordResetInput) error {
+	args := m.Called(input)
+	return args.Error(0)
+}
+
+type ForgotPasswordHandlerSuite struct {
+	suite.Suite
+	mockRequestPasswordReset *MockRequestPasswordReset
+	forgotPasswordHandler    *handlers.ForgotPasswordHandler
+}
+
+func (fp *ForgotPasswordHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	fp.Require().NoError(err)
+
+	// Each test gets its own mock and handler, because the reset a test started may still be running in the
+	// background when the next one sets up.
+	fp.mockRequestPasswordReset = &MockRequestPasswordReset{}
+	fp.forgotPasswordHandler = &handlers.ForgotPasswordHandler{
+		HttpLogger:           webhttp.NewHttpLogger(),
+		HttpValidator:        httpValidator,
+		RequestPasswordReset: fp.mockRequestPasswordReset,
+	}
+}
+
+func (fp *ForgotPasswordHandlerSuite) newContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	return e.NewContext(request, recorder), recorder
+}
+
+func (fp *ForgotPasswordHandlerSuite) waitForExecute() <-chan struct{} {
+	executed := make(chan struct{})
+	fp.mockRequestPasswordReset.On("Execute", usecases.RequestPasswordResetInput{Email: "john.doe@gmail.com"}).
+		Run(func(args mock.Arguments) { close(executed) }).
+		Return(nil)
+	return executed
+}
+
+func (fp *ForgotPasswordHandlerSuite) TestHandle_OnNoErrors_ReturnsAccepted() {
+	executed := fp.waitForExecute()
+	c, recorder := fp.newContext(`{"email": "john.doe@gmail.com"}`)
+
+	err := fp.forgotPasswordHandler.Handle(c)
+	fp.Require().NoError(err)
+
+	select {
+	case <-executed:
+	case <-time.After(time.Second):
+		fp.Fail("the password reset was never requested")
+	}
+
+	fp.Equal(202, recorder.Code)
+	fp.JSONEq(`
+		{
+			"statusCode": 202,
+			"statusText": "ACCEPTED",
+			"data": "if the email is registered, a link to reset the password has been sent to it"
+		}
+	`, recorder.Body.String())
+}
+
+func (fp *ForgotPasswordHandlerSuite) TestHandle_OnFailureToSendEmail_StillReturnsAccepted() {
+	executed := make(chan struct{})
+	fp.mockRequestPasswordReset.On("Execute", mock.Anything).
+		Run(func(args mock.Arguments) { close(executed) }).
+		Return(errors.New("email gateway responded with status 502"))
+	c, recorder := fp.newContext(`{"email": "john.doe@gmail.com"}`)
+
+	err := fp.forgotPasswordHandler.Handle(c)
+	fp.Require().NoError(err)
+	<-executed
+
+	fp.Equal(202, recorder.Code)
+}
+
+func (fp *ForgotPasswordHandlerSuite) TestHandle_OnSlowReset_ReturnsAcceptedWithoutWaitingForIt() {
+	release := make(chan struct{})
+	defer close(release)
+	fp.mockRequestPasswordReset.On("Execute", mock.Anything).
+		Run(func(args mock.Arguments) { <-release }).
+		Return(nil)
+	c, recorder := fp.newContext(`{"email": "john.doe@gmail.com"}`)
+
+	err := fp.forgotPasswordHandler.Handle(c)
+	fp.Require().NoError(err)
+
+	fp.Equal(202, recorder.Code)
+}
+
+func (fp *ForgotPasswordHandlerSuite) TestHandle_OnMissingEmail_ReturnsBadRequest() {
+	c, recorder := fp.newContext(`{}`)
+
+	err := fp.forgotPasswordHandler.Handle(c)
+	fp.Require().NoError(err)
+
+	fp.Equal(400, recorder.Code)
+	fp.mockRequestPasswordReset.AssertNotCalled(fp.T(), "Execute", mock.Anything)
+}
+
+func TestForgotPasswordHandler(t *testing.T) {
+	suite.Run(t, new(ForgotPasswordHandlerSuite))
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAddOnsHandlerOutput struct {
+	Id    uuid.UUID `json:"id"`
+	Code  string    `json:"code"`
+	Name  string    `json:"name"`
+	Price uint64    `json:"price"`
+	Unit  string    `json:"unit"`
+}
+
+type GetAddOnsHandler struct {
+	Pool       *pgxpool.Pool
+	HttpLogger webhttp.HttpLogger
+}
+
+func (g *GetAddOnsHandler) Handle(c echo.Context) error {
+	rows, err := g.Pool.Query(context.Background(), "SELECT id, code, name, price, unit FROM add_ons ORDER BY code")
+
+	if err != nil {
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	defer rows.Close()
+
+	getAddOnsHandlerOutput := []GetAddOnsHandlerOutput{}
+	for rows.Next() {
+		var output GetAddOnsHandlerOutput
+		err := rows.Scan(&output.Id, &output.Code, &output.Name, &output.Price, &output.Unit)
+
+		if err != nil {
+			g.HttpLogger.Log(c, err)
+			return webhttp.NewInternalServerError(c)
+		}
+
+		getAddOnsHandlerOutput = append(getAddOnsHandlerOutput, output)
+	}
+
+	return webhttp.NewOk(c, getAddOnsHandlerOutput)
+}
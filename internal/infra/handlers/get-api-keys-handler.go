@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiKeysHandlerOutput struct {
+	Id         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// GetApiKeysHandler lists every key, revoked and expired ones included, without the hashed secrets.
+type GetApiKeysHandler struct {
+	Pool       *pgxpool.Pool
+	HttpLogger webhttp.HttpLogger
+}
+
+func (g *GetApiKeysHandler) Handle(c echo.Context) error {
+	rows, err := g.Pool.Query(context.Background(), `SELECT id, name, prefix, scopes, expires_at, last_used_at, revoked_at,
+			created_at
+		FROM api_keys
+		ORDER BY created_at DESC`)
+
+	if err != nil {
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	defer rows.Close()
+
+	getApiKeysHandlerOutput := []GetApiKeysHandlerOutput{}
+	for rows.Next() {
+		var output GetApiKeysHandlerOutput
+		err := rows.Scan(&output.Id, &output.Name, &output.Prefix, &output.Scopes, &output.ExpiresAt, &output.LastUsedAt,
+			&output.RevokedAt, &output.CreatedAt)
+
+		if err != nil {
+			g.HttpLogger.Log(c, err)
+			return webhttp.NewInternalServerError(c)
+		}
+
+		getApiKeysHandlerOutput = append(getApiKeysHandlerOutput, output)
+	}
+
+	return webhttp.NewOk(c, getApiKeysHandlerOutput)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetBookingRefundsHandlerOutputRefund struct {
+	Id        uuid.UUID `json:"id"`
+	Amount    uint64    `json:"amount"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Status    string    `json:"status"`
+	CreatedAt string    `json:"createdAt"`
+}
+
+type GetBookingRefundsHandlerOutput struct {
+	CapturedAmount uint64                                 `json:"capturedAmount"`
+	RefundedAmount uint64                                 `json:"refundedAmount"`
+	Refunds        []GetBookingRefundsHandlerOutputRefund `json:"refunds"`
+}
+
+type GetBookingRefundsHandler struct {
+	Pool       *pgxpool.Pool
+	HttpLogger webhttp.HttpLogger
+}
+
+func (g *GetBookingRefundsHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	customerId, ok := bookingOwnerFilter(principal, "bookings:read:any")
+
+	if !ok {
+		return webhttp.NewNotFound(c, "booking not found")
+	}
+
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	ctx := context.Background()
+
+	var output GetBookingRefundsHandlerOutput
+	err = g.Pool.QueryRow(ctx, `SELECT COALESCE((SELECT SUM(p.captured_amount) FROM payments p WHERE p.booking_id = b.id), 0)
+		FROM bookings b
+		WHERE b.id = $1 AND ($2::uuid IS NULL OR b.customer_id = $2::uuid)`, bookingId.String(), nullableUuid(customerId)).
+		Scan(&output.CapturedAmount)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return webhttp.NewNotFound(c, "booking not found")
+		}
+
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	rows, err := g.Pool.Query(ctx, `SELECT id, amount, type, COALESCE(reason, ''), status, created_at
+		FROM refunds WHERE booking_id = $1 ORDER BY created_at`, bookingId.String())
+
+	if err != nil {
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	defer rows.Close()
+
+	output.Refunds = []GetBookingRefundsHandlerOutputRefund{}
+	for rows.Next() {
+		var refund GetBookingRefundsHandlerOutputRefund
+		var createdAt time.Time
+		err := rows.Scan(&refund.Id, &refund.Amount, &refund.Type, &refund.Reason, &refund.Status, &createdAt)
+
+		if err != nil {
+			g.HttpLogger.Log(c, err)
+			return webhttp.NewInternalServerError(c)
+		}
+
+		if refund.Status == "PENDING" || refund.Status == "APPROVED" {
+			output.RefundedAmount += refund.Amount
+		}
+
+		refund.CreatedAt = createdAt.Format(time.RFC3339)
+		output.Refunds = append(output.Refunds, refund)
+	}
+
+	return webhttp.NewOk(c, output)
+}
+
+func nullableUuid(value uuid.UUID) *string {
+	if value == uuid.Nil {
+		return nil
+	}
+
+	id := value.String()
+	return &id
+}
+
+// bookingOwnerFilter returns the customer id that must own the booking, or uuid.Nil when the principal may read any
+// booking. It reports false when the principal can read neither.
+func bookingOwnerFilter(principal auth.Principal, anyBookingPermission string) (uuid.UUID, bool) {
+	if principal.HasPermission(anyBookingPermission) {
+		return uuid.Nil, true
+	}
+
+	return principal.CustomerId, principal.IsCustomer()
+}
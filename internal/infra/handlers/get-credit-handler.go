@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetCreditHandlerOutputTransaction struct {
+	Id           uuid.UUID `json:"id"`
+	Type         string    `json:"type"`
+	Amount       int64     `json:"amount"`
+	BalanceAfter uint64    `json:"balanceAfter"`
+	Reference    *string   `json:"reference"`
+	Description  string    `json:"description"`
+	CreatedAt    string    `json:"createdAt"`
+}
+
+type GetCreditHandlerOutput struct {
+	Balance      uint64                              `json:"balance"`
+	Transactions []GetCreditHandlerOutputTransaction `json:"transactions"`
+}
+
+type GetCreditHandler struct {
+	HttpLogger webhttp.HttpLogger
+	GetCredit  usecases.IGetCredit
+}
+
+func (gc *GetCreditHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	if !principal.IsCustomer() {
+		return webhttp.NewForbidden(c, "you do not have permission to access this resource")
+	}
+
+	output, err := gc.GetCredit.Execute(usecases.GetCreditInput{
+		CustomerId: principal.CustomerId,
+	})
+
+	if err != nil {
+		gc.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	transactions := []GetCreditHandlerOutputTransaction{}
+	for _, transaction := range output.Transactions {
+		transactions = append(transactions, GetCreditHandlerOutputTransaction{
+			Id:           transaction.Id,
+			Type:         transaction.Type,
+			Amount:       transaction.Amount,
+			BalanceAfter: transaction.BalanceAfter,
+			Reference:    nullableUuid(transaction.Reference),
+			Description:  transaction.Description,
+			CreatedAt:    transaction.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return webhttp.NewOk(c, GetCreditHandlerOutput{
+		Balance:      output.Balance,
+		Transactions: transactions,
+	})
+}
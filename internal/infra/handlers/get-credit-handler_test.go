@@ -0,0 +1,151 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockGetCredit struct {
+	mock.Mock
+}
+
+func (m *MockGetCredit) Execute(input usecases.GetCreditInput) (usecases.GetCreditOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.GetCreditOutput), args.Error(1)
+}
+
+type GetCreditHandlerSuite struct {
+	suite.Suite
+	mockGetCredit    MockGetCredit
+	getCreditHandler handlers.GetCreditHandler
+	principal        auth.Principal
+}
+
+func (gc *GetCreditHandlerSuite) SetupTest() {
+	gc.mockGetCredit = MockGetCredit{}
+	gc.getCreditHandler = handlers.GetCreditHandler{
+		HttpLogger: webhttp.NewHttpLogger(),
+		GetCredit:  &gc.mockGetCredit,
+	}
+	gc.principal = auth.Principal{CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), Role: "CUSTOMER"}
+}
+
+func (gc *GetCreditHandlerSuite) TestHandle_OnNoErrors_ReturnsBalanceAndTransactions() {
+	gc.mockGetCredit.On("Execute", usecases.GetCreditInput{
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+	}).Return(usecases.GetCreditOutput{
+		Balance: 30,
+		Transactions: []usecases.GetCreditOutputTransaction{
+			{
+				Id:           uuid.MustParse("1f5c1c3e-7d1b-4b8e-9a55-0a4c58d9c001"),
+				Type:         "BOOKING_PAYMENT",
+				Amount:       -20,
+				BalanceAfter: 30,
+				Reference:    uuid.MustParse("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70"),
+				Description:  "Booking payment",
+				CreatedAt:    time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC),
+			},
+			{
+				Id:           uuid.MustParse("1f5c1c3e-7d1b-4b8e-9a55-0a4c58d9c000"),
+				Type:         "GIFT_CARD",
+				Amount:       50,
+				BalanceAfter: 50,
+				Description:  "Gift card ****-WXYZ",
+				CreatedAt:    time.Date(2030, 1, 10, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}, nil)
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, gc.principal)
+
+	err := gc.getCreditHandler.Handle(c)
+	gc.Require().NoError(err)
+
+	gc.Equal(200, recorder.Code)
+	gc.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"balance": 30,
+				"transactions": [
+					{
+						"id": "1f5c1c3e-7d1b-4b8e-9a55-0a4c58d9c001",
+						"type": "BOOKING_PAYMENT",
+						"amount": -20,
+						"balanceAfter": 30,
+						"reference": "6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70",
+						"description": "Booking payment",
+						"createdAt": "2030-01-12T09:00:00Z"
+					},
+					{
+						"id": "1f5c1c3e-7d1b-4b8e-9a55-0a4c58d9c000",
+						"type": "GIFT_CARD",
+						"amount": 50,
+						"balanceAfter": 50,
+						"reference": null,
+						"description": "Gift card ****-WXYZ",
+						"createdAt": "2030-01-10T09:00:00Z"
+					}
+				]
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (gc *GetCreditHandlerSuite) TestHandle_OnMissingToken_ReturnsUnauthorized() {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := gc.getCreditHandler.Handle(c)
+	gc.Require().NoError(err)
+
+	gc.Equal(401, recorder.Code)
+	gc.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "missing or invalid authorization token"
+		}
+	`, recorder.Body.String())
+}
+
+func (gc *GetCreditHandlerSuite) TestHandle_OnStaffPrincipal_ReturnsForbidden() {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{StaffUserId: uuid.New(), Role: "ADMIN"})
+
+	err := gc.getCreditHandler.Handle(c)
+	gc.Require().NoError(err)
+
+	gc.Equal(403, recorder.Code)
+	gc.JSONEq(`
+		{
+			"statusCode": 403,
+			"statusText": "FORBIDDEN",
+			"error": "you do not have permission to access this resource"
+		}
+	`, recorder.Body.String())
+}
+
+func TestGetCreditHandler(t *testing.T) {
+	suite.Run(t, new(GetCreditHandlerSuite))
+}
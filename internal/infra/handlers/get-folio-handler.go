@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetFolioHandlerOutputLine struct {
+	EntryId     uuid.UUID `json:"entryId"`
+	Type        string    `json:"type"`
+	Category    string    `json:"category"`
+	Description string    `json:"description"`
+	Amount      uint64    `json:"amount"`
+	Status      string    `json:"status"`
+	PostedAt    string    `json:"postedAt"`
+	Balance     int64     `json:"balance"`
+}
+
+type GetFolioHandlerOutput struct {
+	BookingId     uuid.UUID                   `json:"bookingId"`
+	Lines         []GetFolioHandlerOutputLine `json:"lines"`
+	TotalCharges  uint64                      `json:"totalCharges"`
+	TotalPayments uint64                      `json:"totalPayments"`
+	TotalRefunds  uint64                      `json:"totalRefunds"`
+	Balance       int64                       `json:"balance"`
+}
+
+type GetFolioHandler struct {
+	HttpLogger webhttp.HttpLogger
+	GetFolio   usecases.IGetFolio
+}
+
+func (gf *GetFolioHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	output, err := gf.GetFolio.Execute(usecases.GetFolioInput{
+		BookingId: bookingId,
+		Principal: principal,
+	})
+
+	if err != nil {
+		return handleFolioError(c, gf.HttpLogger, err)
+	}
+
+	lines := []GetFolioHandlerOutputLine{}
+	for _, line := range output.Lines {
+		lines = append(lines, GetFolioHandlerOutputLine{
+			EntryId:     line.EntryId,
+			Type:        line.Type,
+			Category:    line.Category,
+			Description: line.Description,
+			Amount:      line.Amount,
+			Status:      line.Status,
+			PostedAt:    line.PostedAt.Format(time.RFC3339),
+			Balance:     line.Balance,
+		})
+	}
+
+	return webhttp.NewOk(c, GetFolioHandlerOutput{
+		BookingId:     output.BookingId,
+		Lines:         lines,
+		TotalCharges:  output.TotalCharges,
+		TotalPayments: output.TotalPayments,
+		TotalRefunds:  output.TotalRefunds,
+		Balance:       output.Balance,
+	})
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/documents"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetInvoiceHandlerOutputLine struct {
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	TaxRate     uint8  `json:"taxRate"`
+	NetAmount   int64  `json:"netAmount"`
+	TaxAmount   int64  `json:"taxAmount"`
+	GrossAmount int64  `json:"grossAmount"`
+}
+
+type GetInvoiceHandlerOutputTax struct {
+	TaxRate     uint8 `json:"taxRate"`
+	NetAmount   int64 `json:"netAmount"`
+	TaxAmount   int64 `json:"taxAmount"`
+	GrossAmount int64 `json:"grossAmount"`
+}
+
+type GetInvoiceHandlerOutputBillTo struct {
+	Name    string `json:"name"`
+	TaxId   string `json:"taxId"`
+	Address string `json:"address"`
+	Email   string `json:"email"`
+}
+
+type GetInvoiceHandlerOutputCreditNote struct {
+	CreditNoteId uuid.UUID `json:"creditNoteId"`
+	Number       string    `json:"number"`
+	Reason       string    `json:"reason"`
+	GrossTotal   int64     `json:"grossTotal"`
+	IssuedAt     string    `json:"issuedAt"`
+}
+
+type GetInvoiceHandlerOutput struct {
+	InvoiceId    uuid.UUID                           `json:"invoiceId"`
+	Number       string                              `json:"number"`
+	BookingId    uuid.UUID                           `json:"bookingId"`
+	BillTo       GetInvoiceHandlerOutputBillTo       `json:"billTo"`
+	Lines        []GetInvoiceHandlerOutputLine       `json:"lines"`
+	TaxBreakdown []GetInvoiceHandlerOutputTax        `json:"taxBreakdown"`
+	NetTotal     int64                               `json:"netTotal"`
+	TaxTotal     int64                               `json:"taxTotal"`
+	GrossTotal   int64                               `json:"grossTotal"`
+	AmountPaid   int64                               `json:"amountPaid"`
+	AmountDue    int64                               `json:"amountDue"`
+	IssuedAt     string                              `json:"issuedAt"`
+	CreditNotes  []GetInvoiceHandlerOutputCreditNote `json:"creditNotes"`
+}
+
+type GetInvoiceHandler struct {
+	HttpLogger webhttp.HttpLogger
+	GetInvoice usecases.IGetInvoice
+}
+
+func (gi *GetInvoiceHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	output, err := gi.GetInvoice.Execute(usecases.GetInvoiceInput{
+		BookingId: bookingId,
+		Principal: principal,
+	})
+
+	if err != nil {
+		return handleInvoiceError(c, gi.HttpLogger, err)
+	}
+
+	if c.QueryParam("format") == "pdf" || strings.Contains(c.Request().Header.Get(echo.HeaderAccept), "application/pdf") {
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s.pdf"`, output.Number))
+		return c.Blob(200, "application/pdf", documents.RenderInvoicePdf(output))
+	}
+
+	lines := []GetInvoiceHandlerOutputLine{}
+	for _, line := range output.Lines {
+		lines = append(lines, GetInvoiceHandlerOutputLine(line))
+	}
+
+	taxBreakdown := []GetInvoiceHandlerOutputTax{}
+	for _, tax := range output.TaxBreakdown {
+		taxBreakdown = append(taxBreakdown, GetInvoiceHandlerOutputTax(tax))
+	}
+
+	creditNotes := []GetInvoiceHandlerOutputCreditNote{}
+	for _, creditNote := range output.CreditNotes {
+		creditNotes = append(creditNotes, GetInvoiceHandlerOutputCreditNote{
+			CreditNoteId: creditNote.CreditNoteId,
+			Number:       creditNote.Number,
+			Reason:       creditNote.Reason,
+			GrossTotal:   creditNote.GrossTotal,
+			IssuedAt:     creditNote.IssuedAt.Format(time.RFC3339),
+		})
+	}
+
+	return webhttp.NewOk(c, GetInvoiceHandlerOutput{
+		InvoiceId: output.InvoiceId,
+		Number:    output.Number,
+		BookingId: output.BookingId,
+		BillTo: GetInvoiceHandlerOutputBillTo{
+			Name:    output.BillingName,
+			TaxId:   output.BillingTaxId,
+			Address: output.BillingAddress,
+			Email:   output.BillingEmail,
+		},
+		Lines:        lines,
+		TaxBreakdown: taxBreakdown,
+		NetTotal:     output.NetTotal,
+		TaxTotal:     output.TaxTotal,
+		GrossTotal:   output.GrossTotal,
+		AmountPaid:   output.AmountPaid,
+		AmountDue:    output.AmountDue,
+		IssuedAt:     output.IssuedAt.Format(time.RFC3339),
+		CreditNotes:  creditNotes,
+	})
+}
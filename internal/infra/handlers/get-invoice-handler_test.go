@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockGetInvoice struct {
+	mock.Mock
+}
+
+func (m *MockGetInvoice) Execute(input usecases.GetInvoiceInput) (usecases.GetInvoiceOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.GetInvoiceOutput), args.Error(1)
+}
+
+type GetInvoiceHandlerSuite struct {
+	suite.Suite
+	mockGetInvoice    MockGetInvoice
+	getInvoiceHandler handlers.GetInvoiceHandler
+	principal         auth.Principal
+	output            usecases.GetInvoiceOutput
+}
+
+func (gi *GetInvoiceHandlerSuite) SetupTest() {
+	gi.mockGetInvoice = MockGetInvoice{}
+	gi.getInvoiceHandler = handlers.GetInvoiceHandler{
+		HttpLogger: webhttp.NewHttpLogger(),
+		GetInvoice: &gi.mockGetInvoice,
+	}
+	gi.principal = auth.Principal{CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), Role: "CUSTOMER"}
+	gi.output = usecases.GetInvoiceOutput{
+		InvoiceId:    uuid.MustParse("0c8f9a1e-8b7d-4c6e-a5f4-3e2d1c0b9a87"),
+		Number:       "MAIN-INV-000001",
+		BookingId:    uuid.MustParse("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70"),
+		BillingName:  "Acme Corp",
+		BillingTaxId: "12.345.678/0001-90",
+		Lines: []usecases.GetInvoiceOutputLine{
+			{Category: "ROOM", Description: "Room stay", TaxRate: 10, NetAmount: 400, TaxAmount: 40, GrossAmount: 440},
+		},
+		TaxBreakdown: []usecases.GetInvoiceOutputTax{{TaxRate: 10, NetAmount: 400, TaxAmount: 40, GrossAmount: 440}},
+		NetTotal:     400,
+		TaxTotal:     40,
+		GrossTotal:   440,
+		AmountPaid:   440,
+		IssuedAt:     time.Date(2030, 1, 12, 9, 0, 0, 0, time.UTC),
+		CreditNotes:  []usecases.GetInvoiceOutputCreditNote{},
+	}
+}
+
+func (gi *GetInvoiceHandlerSuite) newContext(target string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, gi.principal)
+	c.SetParamNames("id")
+	c.SetParamValues("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70")
+	return c, recorder
+}
+
+func (gi *GetInvoiceHandlerSuite) TestHandle_OnNoErrors_ReturnsJson() {
+	gi.mockGetInvoice.On("Execute", usecases.GetInvoiceInput{
+		BookingId: uuid.MustParse("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70"),
+		Principal: gi.principal,
+	}).Return(gi.output, nil)
+	c, recorder := gi.newContext("/")
+
+	err := gi.getInvoiceHandler.Handle(c)
+	gi.Require().NoError(err)
+
+	gi.Equal(200, recorder.Code)
+	gi.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"invoiceId": "0c8f9a1e-8b7d-4c6e-a5f4-3e2d1c0b9a87",
+				"number": "MAIN-INV-000001",
+				"bookingId": "6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70",
+				"billTo": {"name": "Acme Corp", "taxId": "12.345.678/0001-90", "address": "", "email": ""},
+				"lines": [
+					{"category": "ROOM", "description": "Room stay", "taxRate": 10, "netAmount": 400, "taxAmount": 40, "grossAmount": 440}
+				],
+				"taxBreakdown": [{"taxRate": 10, "netAmount": 400, "taxAmount": 40, "grossAmount": 440}],
+				"netTotal": 400,
+				"taxTotal": 40,
+				"grossTotal": 440,
+				"amountPaid": 440,
+				"amountDue": 0,
+				"issuedAt": "2030-01-12T09:00:00Z",
+				"creditNotes": []
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (gi *GetInvoiceHandlerSuite) TestHandle_OnPdfFormat_ReturnsPdf() {
+	gi.mockGetInvoice.On("Execute", mock.Anything).Return(gi.output, nil)
+	c, recorder := gi.newContext("/?format=pdf")
+
+	err := gi.getInvoiceHandler.Handle(c)
+	gi.Require().NoError(err)
+
+	gi.Equal(200, recorder.Code)
+	gi.Equal("application/pdf", recorder.Header().Get(echo.HeaderContentType))
+	gi.Equal(`inline; filename="MAIN-INV-000001.pdf"`, recorder.Header().Get(echo.HeaderContentDisposition))
+	gi.True(bytes.HasPrefix(recorder.Body.Bytes(), []byte("%PDF-")))
+}
+
+func (gi *GetInvoiceHandlerSuite) TestHandle_OnNoInvoice_ReturnsNotFound() {
+	gi.mockGetInvoice.On("Execute", mock.Anything).Return(usecases.GetInvoiceOutput{}, errors.New("invoice not found"))
+	c, recorder := gi.newContext("/")
+
+	err := gi.getInvoiceHandler.Handle(c)
+	gi.Require().NoError(err)
+
+	gi.Equal(404, recorder.Code)
+	gi.JSONEq(`
+		{
+			"statusCode": 404,
+			"statusText": "NOT_FOUND",
+			"error": "invoice not found"
+		}
+	`, recorder.Body.String())
+}
+
+func TestGetInvoiceHandler(t *testing.T) {
+	suite.Run(t, new(GetInvoiceHandlerSuite))
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetJwksHandlerOutputKey struct {
+	KeyType   string `json:"kty"`
+	Id        string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Modulus   string `json:"n,omitempty"`
+	Exponent  string `json:"e,omitempty"`
+}
+
+type GetJwksHandlerOutput struct {
+	Keys []GetJwksHandlerOutputKey `json:"keys"`
+}
+
+type GetJwksHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	SigningKeysSource *auth.SigningKeysSource
+}
+
+// Handle publishes the public half of every signing key that has not been retired. The body is a bare JWK set
+// (RFC 7517) instead of the usual envelope so that JWT libraries can consume it directly.
+func (g *GetJwksHandler) Handle(c echo.Context) error {
+	signingKeys, err := g.SigningKeysSource.Load()
+
+	if err != nil {
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	output := GetJwksHandlerOutput{Keys: []GetJwksHandlerOutputKey{}}
+	for _, signingKey := range signingKeys.Published(time.Now()) {
+		key := GetJwksHandlerOutputKey{Id: signingKey.Id, Use: "sig", Algorithm: signingKey.Algorithm}
+
+		switch publicKey := signingKey.PublicKey().(type) {
+		case *rsa.PublicKey:
+			key.KeyType = "RSA"
+			key.Modulus = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			key.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			key.KeyType = "OKP"
+			key.Curve = "Ed25519"
+			key.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+
+		output.Keys = append(output.Keys, key)
+	}
+
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(200, output)
+}
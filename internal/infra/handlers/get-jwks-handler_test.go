@@ -0,0 +1,87 @@
+package handlers_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetJwksHandlerSuite struct {
+	suite.Suite
+	fakeSecretsGateway gateways.FakeSecretsGateway
+	getJwksHandler     handlers.GetJwksHandler
+}
+
+func (g *GetJwksHandlerSuite) SetupTest() {
+	g.fakeSecretsGateway = gateways.FakeSecretsGateway{Secrets: map[string]string{}}
+	g.getJwksHandler = handlers.GetJwksHandler{
+		HttpLogger:        webhttp.NewHttpLogger(),
+		SigningKeysSource: &auth.SigningKeysSource{SecretsGateway: &g.fakeSecretsGateway},
+	}
+}
+
+func (g *GetJwksHandlerSuite) TestHandle_OnSigningKeys_ReturnsThePublishedPublicKeys() {
+	now := time.Now().UTC()
+	retiredKey, err := auth.NewSigningKey("2030-05", "RS256", now.AddDate(0, -2, 0))
+	g.Require().NoError(err)
+	retiredKey.RetiresAt = now.AddDate(0, -1, 0)
+	currentKey, err := auth.NewSigningKey("2030-06", "RS256", now.AddDate(0, -1, 0))
+	g.Require().NoError(err)
+	nextKey, err := auth.NewSigningKey("2030-07", "EdDSA", now.AddDate(0, 0, 7))
+	g.Require().NoError(err)
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys{retiredKey, currentKey, nextKey})
+	g.Require().NoError(err)
+	g.fakeSecretsGateway.Secrets["JWT_SIGNING_KEYS"] = string(rawSigningKeys)
+	request := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err = g.getJwksHandler.Handle(c)
+	g.Require().NoError(err)
+
+	g.Equal(200, recorder.Code)
+	g.Equal("public, max-age=300", recorder.Header().Get("Cache-Control"))
+	var output handlers.GetJwksHandlerOutput
+	g.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &output))
+	g.Require().Len(output.Keys, 2)
+	g.Equal("2030-06", output.Keys[0].Id)
+	g.Equal("RSA", output.Keys[0].KeyType)
+	g.Equal("RS256", output.Keys[0].Algorithm)
+	g.Equal("sig", output.Keys[0].Use)
+	g.Equal("AQAB", output.Keys[0].Exponent)
+	g.NotEmpty(output.Keys[0].Modulus)
+	g.Equal("2030-07", output.Keys[1].Id)
+	g.Equal("OKP", output.Keys[1].KeyType)
+	g.Equal("Ed25519", output.Keys[1].Curve)
+	g.Equal("EdDSA", output.Keys[1].Algorithm)
+	g.Equal(base64.RawURLEncoding.EncodeToString(nextKey.PublicKey().(ed25519.PublicKey)), output.Keys[1].X)
+}
+
+func (g *GetJwksHandlerSuite) TestHandle_OnInvalidSigningKeys_ReturnsInternalServerError() {
+	g.fakeSecretsGateway.Secrets["JWT_SIGNING_KEYS"] = "abc"
+	request := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := g.getJwksHandler.Handle(c)
+	g.Require().NoError(err)
+
+	g.Equal(500, recorder.Code)
+}
+
+func TestGetJwksHandler(t *testing.T) {
+	suite.Run(t, new(GetJwksHandlerSuite))
+}
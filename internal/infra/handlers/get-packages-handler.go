@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetPackagesHandlerOutputComponent struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Quantity uint8  `json:"quantity"`
+}
+
+type GetPackagesHandlerOutput struct {
+	Id            uuid.UUID                           `json:"id"`
+	Code          string                              `json:"code"`
+	Name          string                              `json:"name"`
+	RoomType      string                              `json:"roomType"`
+	Components    []GetPackagesHandlerOutputComponent `json:"components"`
+	PricePerNight uint64                              `json:"pricePerNight"`
+	ValidFrom     string                              `json:"validFrom"`
+	ValidUntil    string                              `json:"validUntil"`
+	MinStayNights uint8                               `json:"minStayNights"`
+}
+
+type GetPackagesHandler struct {
+	Pool       *pgxpool.Pool
+	HttpLogger webhttp.HttpLogger
+}
+
+func (g *GetPackagesHandler) Handle(c echo.Context) error {
+	rows, err := g.Pool.Query(context.Background(), `SELECT p.id, p.code, p.name, p.room_type, p.price_per_night, p.valid_from,
+			p.valid_until, p.min_stay_nights,
+			COALESCE(json_agg(json_build_object('code', a.code, 'name', a.name, 'quantity', pc.quantity) ORDER BY a.code)
+				FILTER (WHERE a.code IS NOT NULL), '[]')
+		FROM packages p
+		LEFT JOIN package_components pc ON pc.package_id = p.id
+		LEFT JOIN add_ons a ON a.code = pc.add_on_code
+		WHERE p.active = TRUE AND p.valid_until >= CURRENT_DATE
+		GROUP BY p.id
+		ORDER BY p.valid_from, p.code`)
+
+	if err != nil {
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	defer rows.Close()
+
+	getPackagesHandlerOutput := []GetPackagesHandlerOutput{}
+	for rows.Next() {
+		var output GetPackagesHandlerOutput
+		var validFrom, validUntil time.Time
+		var components []byte
+		err := rows.Scan(&output.Id, &output.Code, &output.Name, &output.RoomType, &output.PricePerNight, &validFrom, &validUntil,
+			&output.MinStayNights, &components)
+
+		if err != nil {
+			g.HttpLogger.Log(c, err)
+			return webhttp.NewInternalServerError(c)
+		}
+
+		err = json.Unmarshal(components, &output.Components)
+
+		if err != nil {
+			g.HttpLogger.Log(c, err)
+			return webhttp.NewInternalServerError(c)
+		}
+
+		output.ValidFrom = validFrom.Format(time.DateOnly)
+		output.ValidUntil = validUntil.Format(time.DateOnly)
+		getPackagesHandlerOutput = append(getPackagesHandlerOutput, output)
+	}
+
+	return webhttp.NewOk(c, getPackagesHandlerOutput)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetRoomTypeCalendarHandlerInput struct {
+	From any `validate:"required,date"`
+	To   any `validate:"required,date"`
+}
+
+type GetRoomTypeCalendarHandlerOutputDay struct {
+	Date              string  `json:"date"`
+	AvailableRooms    int     `json:"availableRooms"`
+	LowestPrice       *uint64 `json:"lowestPrice"`
+	MinStayNights     uint8   `json:"minStayNights"`
+	ClosedToArrival   bool    `json:"closedToArrival"`
+	ClosedToDeparture bool    `json:"closedToDeparture"`
+}
+
+type GetRoomTypeCalendarHandlerOutput struct {
+	RoomType string                                `json:"roomType"`
+	Days     []GetRoomTypeCalendarHandlerOutputDay `json:"days"`
+}
+
+type GetRoomTypeCalendarHandler struct {
+	HttpLogger          webhttp.HttpLogger
+	HttpValidator       webhttp.HttpValidator
+	GetRoomTypeCalendar usecases.IGetRoomTypeCalendar
+}
+
+func (g *GetRoomTypeCalendarHandler) Handle(c echo.Context) error {
+	input := GetRoomTypeCalendarHandlerInput{
+		From: queryParam(c, "from"),
+		To:   queryParam(c, "to"),
+	}
+
+	if len(g.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, g.HttpValidator.Validate(input))
+	}
+
+	output, err := g.GetRoomTypeCalendar.Execute(usecases.GetRoomTypeCalendarInput{
+		RoomType: c.Param("type"),
+		From:     toDate(input.From),
+		To:       toDate(input.To),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "room type must be SINGLE, DOUBLE, TWIN or SUITE",
+			"to date must be on or after from date",
+			"date range cannot exceed 366 days":
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		g.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	handlerOutput := GetRoomTypeCalendarHandlerOutput{
+		RoomType: output.RoomType,
+		Days:     []GetRoomTypeCalendarHandlerOutputDay{},
+	}
+
+	for _, day := range output.Days {
+		handlerOutput.Days = append(handlerOutput.Days, GetRoomTypeCalendarHandlerOutputDay{
+			Date:              day.Date.Format(time.DateOnly),
+			AvailableRooms:    day.AvailableRooms,
+			LowestPrice:       day.LowestPrice,
+			MinStayNights:     day.MinStayNights,
+			ClosedToArrival:   day.ClosedToArrival,
+			ClosedToDeparture: day.ClosedToDeparture,
+		})
+	}
+
+	return webhttp.NewOk(c, handlerOutput)
+}
+
+func queryParam(c echo.Context, name string) any {
+	value := c.QueryParam(name)
+
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
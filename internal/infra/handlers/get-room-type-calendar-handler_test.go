@@ -0,0 +1,153 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockGetRoomTypeCalendar struct {
+	mock.Mock
+}
+
+func (m *MockGetRoomTypeCalendar) Execute(input usecases.GetRoomTypeCalendarInput) (usecases.GetRoomTypeCalendarOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.GetRoomTypeCalendarOutput), args.Error(1)
+}
+
+type GetRoomTypeCalendarHandlerSuite struct {
+	suite.Suite
+	mockGetRoomTypeCalendar    MockGetRoomTypeCalendar
+	getRoomTypeCalendarHandler handlers.GetRoomTypeCalendarHandler
+}
+
+func (g *GetRoomTypeCalendarHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	g.Require().NoError(err)
+
+	g.mockGetRoomTypeCalendar = MockGetRoomTypeCalendar{}
+	g.getRoomTypeCalendarHandler = handlers.GetRoomTypeCalendarHandler{
+		HttpLogger:          webhttp.NewHttpLogger(),
+		HttpValidator:       httpValidator,
+		GetRoomTypeCalendar: &g.mockGetRoomTypeCalendar,
+	}
+}
+
+func (g *GetRoomTypeCalendarHandlerSuite) newContext(target string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	c.SetParamNames("type")
+	c.SetParamValues("SUITE")
+
+	return c, recorder
+}
+
+func (g *GetRoomTypeCalendarHandlerSuite) TestHandle_OnNoErrors_ReturnsOk() {
+	lowestPrice := uint64(300)
+	g.mockGetRoomTypeCalendar.On("Execute", usecases.GetRoomTypeCalendarInput{
+		RoomType: "SUITE",
+		From:     time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC),
+	}).Return(usecases.GetRoomTypeCalendarOutput{
+		RoomType: "SUITE",
+		Days: []usecases.GetRoomTypeCalendarOutputDay{
+			{
+				Date:            time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+				AvailableRooms:  2,
+				LowestPrice:     &lowestPrice,
+				MinStayNights:   2,
+				ClosedToArrival: true,
+			},
+			{
+				Date:           time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC),
+				AvailableRooms: 0,
+				MinStayNights:  1,
+			},
+		},
+	}, nil)
+	c, recorder := g.newContext("/?from=2030-06-01&to=2030-06-02")
+
+	err := g.getRoomTypeCalendarHandler.Handle(c)
+	g.Require().NoError(err)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"roomType": "SUITE",
+				"days": [
+					{
+						"date": "2030-06-01",
+						"availableRooms": 2,
+						"lowestPrice": 300,
+						"minStayNights": 2,
+						"closedToArrival": true,
+						"closedToDeparture": false
+					},
+					{
+						"date": "2030-06-02",
+						"availableRooms": 0,
+						"lowestPrice": null,
+						"minStayNights": 1,
+						"closedToArrival": false,
+						"closedToDeparture": false
+					}
+				]
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (g *GetRoomTypeCalendarHandlerSuite) TestHandle_OnInvalidQuery_ReturnsBadRequest() {
+	c, recorder := g.newContext("/?from=01-06-2030")
+
+	err := g.getRoomTypeCalendarHandler.Handle(c)
+	g.Require().NoError(err)
+
+	g.Equal(400, recorder.Code)
+	g.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": [
+				"from must be a date in the format YYYY-MM-DD",
+				"to is required"
+			]
+		}
+	`, recorder.Body.String())
+}
+
+func (g *GetRoomTypeCalendarHandlerSuite) TestHandle_OnRangeTooLong_ReturnsBadRequest() {
+	g.mockGetRoomTypeCalendar.On("Execute", mock.Anything).
+		Return(usecases.GetRoomTypeCalendarOutput{}, errors.New("date range cannot exceed 366 days"))
+	c, recorder := g.newContext("/?from=2030-01-01&to=2031-06-01")
+
+	err := g.getRoomTypeCalendarHandler.Handle(c)
+	g.Require().NoError(err)
+
+	g.Equal(400, recorder.Code)
+	g.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"error": "date range cannot exceed 366 days"
+		}
+	`, recorder.Body.String())
+}
+
+func TestGetRoomTypeCalendarHandler(t *testing.T) {
+	suite.Run(t, new(GetRoomTypeCalendarHandlerSuite))
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 )
 
@@ -18,22 +18,11 @@ type GetRoomsHandlerOutput struct {
 }
 
 type GetRoomsHandler struct {
-	Conn              *pgx.Conn
-	HttpLogger        webhttp.HttpLogger
-	HttpAuthorization webhttp.HttpAuthorization
+	Pool       *pgxpool.Pool
+	HttpLogger webhttp.HttpLogger
 }
 
 func (g *GetRoomsHandler) Handle(c echo.Context) error {
-	authorizationToken := c.Request().Header.Get("Authorization")
-
-	if authorizationToken == "" {
-		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
-	}
-
-	if !g.HttpAuthorization.IsCustomer(authorizationToken) {
-		return webhttp.NewForbidden(c, "you do not have permission to access this resource")
-	}
-
 	type RoomSchema struct {
 		Id       uuid.UUID
 		Type     string
@@ -42,7 +31,7 @@ func (g *GetRoomsHandler) Handle(c echo.Context) error {
 		Price    uint64
 	}
 
-	rows, err := g.Conn.Query(context.Background(), "SELECT id, number, type, capacity, price FROM rooms")
+	rows, err := g.Pool.Query(context.Background(), "SELECT id, number, type, capacity, price FROM rooms")
 
 	if err != nil {
 		g.HttpLogger.Log(c, err)
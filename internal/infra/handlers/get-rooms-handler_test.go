@@ -11,11 +11,12 @@ import (
 	"testing"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
@@ -24,10 +25,10 @@ import (
 
 type GetRoomsHandlerSuite struct {
 	suite.Suite
-	conn               *pgx.Conn
-	postgresContainer  testcontainers.Container
-	fakeSecretsGateway gateways.FakeSecretsGateway
-	getRoomsHandler    handlers.GetRoomsHandler
+	pool              *pgxpool.Pool
+	postgresContainer testcontainers.Container
+	httpAuthorization webhttp.HttpAuthorization
+	getRoomsHandler   handlers.GetRoomsHandler
 }
 
 func (g *GetRoomsHandlerSuite) SetupSuite() {
@@ -62,19 +63,20 @@ func (g *GetRoomsHandlerSuite) SetupSuite() {
 		host = "host.docker.internal"
 	}
 
-	conn, err := pgx.Connect(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
 	g.Require().NoError(err)
 
-	g.conn = conn
+	g.pool = pool
 	httpLogger := webhttp.NewHttpLogger()
-	g.fakeSecretsGateway = gateways.FakeSecretsGateway{}
-	httpAuthorization := webhttp.HttpAuthorization{
-		SecretsGateway: &g.fakeSecretsGateway,
+	g.httpAuthorization = webhttp.HttpAuthorization{
+		RolesRepository: &repositories.FakeRolesRepository{
+			RolePermissions: map[string][]string{"ADMIN": {"rooms:read"}, "ANY": {}},
+		},
+		HttpLogger: httpLogger,
 	}
 	g.getRoomsHandler = handlers.GetRoomsHandler{
-		Conn:              conn,
-		HttpLogger:        httpLogger,
-		HttpAuthorization: httpAuthorization,
+		Pool:       pool,
+		HttpLogger: httpLogger,
 	}
 
 	os.Setenv("PGUSER", "postgres")
@@ -90,7 +92,7 @@ func (g *GetRoomsHandlerSuite) SetupSuite() {
 
 func (g *GetRoomsHandlerSuite) SetupTest() {
 	ctx := context.Background()
-	_, err := g.conn.Exec(ctx, "TRUNCATE TABLE rooms")
+	_, err := g.pool.Exec(ctx, "TRUNCATE TABLE rooms CASCADE")
 	g.Require().NoError(err)
 }
 
@@ -100,25 +102,16 @@ func (g *GetRoomsHandlerSuite) TearDownSuite() {
 	err := g.postgresContainer.Terminate(ctx)
 	g.Require().NoError(err)
 
-	err = g.conn.Close(ctx)
-	g.Require().NoError(err)
+	g.pool.Close()
 }
 
 func (g *GetRoomsHandlerSuite) TestHandle_OnAuthorizationTokenIsMissing_ReturnsError() {
-	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
-	{
-		"number": "101",
-		"type": "SUITE",
-		"capacity": 2,
-		"price": 250
-	}
-`))
-	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err := g.getRoomsHandler.Handle(c)
+	err := g.httpAuthorization.Require("rooms:read")(g.getRoomsHandler.Handle)(c)
 	g.Require().NoError(err)
 
 	g.Equal(401, recorder.Code)
@@ -132,27 +125,13 @@ func (g *GetRoomsHandlerSuite) TestHandle_OnAuthorizationTokenIsMissing_ReturnsE
 }
 
 func (g *GetRoomsHandlerSuite) TestHandle_OnNoPermissonToAccessResource_ReturnsError() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ANY",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	g.Require().NoError(err)
-	g.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
-	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
-	{
-		"number": "101",
-		"type": "SUITE",
-		"capacity": 2,
-		"price": 250
-	}
-`))
-	request.Header.Set("Authorization", signedToken)
-	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{StaffUserId: uuid.New(), Role: "ANY"})
 
-	err = g.getRoomsHandler.Handle(c)
+	err := g.httpAuthorization.Require("rooms:read")(g.getRoomsHandler.Handle)(c)
 	g.Require().NoError(err)
 
 	g.Equal(403, recorder.Code)
@@ -166,21 +145,15 @@ func (g *GetRoomsHandlerSuite) TestHandle_OnNoPermissonToAccessResource_ReturnsE
 }
 
 func (g *GetRoomsHandlerSuite) TestHandle_OnNoErrorsAndThereAreRooms_ReturnsOk() {
-	_, err := g.conn.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+	_, err := g.pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
 		"849702fc-aad3-478f-9dd7-9963b4ca33ca", "101", "SUITE", 2, 250)
 	g.Require().NoError(err)
-	_, err = g.conn.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+	_, err = g.pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
 		"57dba1c3-0421-4f24-a7c3-2a0b6c13063d", "204", "SINGLE", 8, 122)
 	g.Require().NoError(err)
-	_, err = g.conn.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+	_, err = g.pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
 		"0dc94e80-3df8-40c9-8a79-9e9e555abbde", "132", "DOUBLE", 3, 990)
 	g.Require().NoError(err)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	g.Require().NoError(err)
-	g.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"number": "101",
@@ -189,7 +162,6 @@ func (g *GetRoomsHandlerSuite) TestHandle_OnNoErrorsAndThereAreRooms_ReturnsOk()
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
@@ -231,12 +203,6 @@ func (g *GetRoomsHandlerSuite) TestHandle_OnNoErrorsAndThereAreRooms_ReturnsOk()
 }
 
 func (g *GetRoomsHandlerSuite) TestHandle_OnNoErrorsAndThereAreNoRooms_ReturnsOk() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "CUSTOMER",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	g.Require().NoError(err)
-	g.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"number": "101",
@@ -245,13 +211,12 @@ func (g *GetRoomsHandlerSuite) TestHandle_OnNoErrorsAndThereAreNoRooms_ReturnsOk
 			"price": 250
 		}
 	`))
-	request.Header.Set("Authorization", signedToken)
 	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	recorder := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(request, recorder)
 
-	err = g.getRoomsHandler.Handle(c)
+	err := g.getRoomsHandler.Handle(c)
 	g.Require().NoError(err)
 
 	g.Equal(200, recorder.Code)
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type IssueCreditHandlerInput struct {
+	Type        any `validate:"required,string,notEmpty"`
+	Amount      any `validate:"required,integer,positive,lt=1000000000"`
+	BookingId   any `validate:"omitempty,string,uuid4"`
+	Description any `validate:"required,string,notEmpty,lt=256"`
+}
+
+type IssueCreditHandlerOutput struct {
+	EntryId uuid.UUID `json:"entryId"`
+	Balance uint64    `json:"balance"`
+}
+
+type IssueCreditHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	IssueCredit   usecases.IIssueCredit
+}
+
+func (ic *IssueCreditHandler) Handle(c echo.Context) error {
+	customerId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "customer id must be uuidv4")
+	}
+
+	var input IssueCreditHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ic.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ic.HttpValidator.Validate(input))
+	}
+
+	var bookingId uuid.UUID
+	if value, ok := input.BookingId.(string); ok {
+		bookingId = uuid.MustParse(value)
+	}
+
+	output, err := ic.IssueCredit.Execute(usecases.IssueCreditInput{
+		CustomerId:  customerId,
+		Type:        input.Type.(string),
+		Amount:      uint64(input.Amount.(float64)),
+		BookingId:   bookingId,
+		Description: input.Description.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "credit type must be REFUND or ADJUSTMENT",
+			"credit amount must be greater than zero",
+			"credit description must be at least 3 characters long":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "customer not found":
+			return webhttp.NewNotFound(c, err.Error())
+		}
+
+		ic.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, IssueCreditHandlerOutput{
+		EntryId: output.EntryId,
+		Balance: output.Balance,
+	})
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type IssueCreditNoteHandlerInput struct {
+	Reason any `validate:"required,string,notEmpty,lt=501"`
+}
+
+type IssueCreditNoteHandlerOutput struct {
+	CreditNoteId uuid.UUID `json:"creditNoteId"`
+	Number       string    `json:"number"`
+}
+
+type IssueCreditNoteHandler struct {
+	HttpLogger      webhttp.HttpLogger
+	HttpValidator   webhttp.HttpValidator
+	IssueCreditNote usecases.IIssueCreditNote
+}
+
+func (ic *IssueCreditNoteHandler) Handle(c echo.Context) error {
+	invoiceId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "invoice id must be uuidv4")
+	}
+
+	var input IssueCreditNoteHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ic.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ic.HttpValidator.Validate(input))
+	}
+
+	output, err := ic.IssueCreditNote.Execute(usecases.IssueCreditNoteInput{
+		InvoiceId: invoiceId,
+		Reason:    input.Reason.(string),
+	})
+
+	if err != nil {
+		return handleInvoiceError(c, ic.HttpLogger, err)
+	}
+
+	return webhttp.NewCreated(c, IssueCreditNoteHandlerOutput{
+		CreditNoteId: output.CreditNoteId,
+		Number:       output.Number,
+	})
+}
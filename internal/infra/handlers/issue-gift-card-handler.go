@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type IssueGiftCardHandlerInput struct {
+	Amount       any `validate:"required,integer,positive,lt=1000000000"`
+	ValidForDays any `validate:"required,integer,positive,lt=3661"`
+}
+
+type IssueGiftCardHandlerOutput struct {
+	GiftCardId uuid.UUID `json:"giftCardId"`
+	Code       string    `json:"code"`
+	ExpiresAt  string    `json:"expiresAt"`
+}
+
+type IssueGiftCardHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	IssueGiftCard usecases.IIssueGiftCard
+}
+
+func (ig *IssueGiftCardHandler) Handle(c echo.Context) error {
+	var input IssueGiftCardHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ig.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ig.HttpValidator.Validate(input))
+	}
+
+	output, err := ig.IssueGiftCard.Execute(usecases.IssueGiftCardInput{
+		Amount:       uint64(input.Amount.(float64)),
+		ValidForDays: uint16(input.ValidForDays.(float64)),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "gift card amount must be greater than zero",
+			"gift card must be valid for at least one day",
+			"gift card expiry must be in the future":
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		ig.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, IssueGiftCardHandlerOutput{
+		GiftCardId: output.GiftCardId,
+		Code:       output.Code,
+		ExpiresAt:  output.ExpiresAt.Format(time.RFC3339),
+	})
+}
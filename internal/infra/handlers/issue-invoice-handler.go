@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type IssueInvoiceHandlerInput struct {
+	CompanyName any `validate:"required,string,notEmpty,lt=151"`
+	TaxId       any `validate:"omitempty,string,notEmpty,lt=51"`
+	Address     any `validate:"omitempty,string,notEmpty,lt=256"`
+	Email       any `validate:"omitempty,string,notEmpty,lt=101"`
+}
+
+type IssueInvoiceHandlerOutput struct {
+	InvoiceId uuid.UUID `json:"invoiceId"`
+	Number    string    `json:"number"`
+}
+
+type IssueInvoiceHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	IssueInvoice  usecases.IIssueInvoice
+}
+
+func (ii *IssueInvoiceHandler) Handle(c echo.Context) error {
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	var input IssueInvoiceHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ii.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ii.HttpValidator.Validate(input))
+	}
+
+	taxId, _ := input.TaxId.(string)
+	address, _ := input.Address.(string)
+	email, _ := input.Email.(string)
+
+	output, err := ii.IssueInvoice.Execute(usecases.IssueInvoiceInput{
+		BookingId:      bookingId,
+		BillingName:    input.CompanyName.(string),
+		BillingTaxId:   taxId,
+		BillingAddress: address,
+		BillingEmail:   email,
+	})
+
+	if err != nil {
+		return handleInvoiceError(c, ii.HttpLogger, err)
+	}
+
+	return webhttp.NewCreated(c, IssueInvoiceHandlerOutput{
+		InvoiceId: output.InvoiceId,
+		Number:    output.Number,
+	})
+}
+
+func handleInvoiceError(c echo.Context, httpLogger webhttp.HttpLogger, err error) error {
+	switch err.Error() {
+	case "billing name is required",
+		"credit notes require a reason of at least 3 characters",
+		"only invoices can be credited":
+		return webhttp.NewBadRequest(c, err.Error())
+	case "booking not found", "invoice not found":
+		return webhttp.NewNotFound(c, err.Error())
+	case "invoices can only be issued for checked-out bookings",
+		"booking already has an active invoice",
+		"invoice has already been credited":
+		return webhttp.NewConflict(c, err.Error())
+	}
+
+	httpLogger.Log(c, err)
+	return webhttp.NewInternalServerError(c)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type IssueManualRefundHandlerInput struct {
+	Amount any `validate:"required,integer,positive,lt=1000000000"`
+	Reason any `validate:"required,string,notEmpty,lt=501"`
+}
+
+type IssueManualRefundHandlerOutput struct {
+	RefundId uuid.UUID `json:"refundId"`
+	Status   string    `json:"status"`
+}
+
+type IssueManualRefundHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	HttpValidator     webhttp.HttpValidator
+	IssueManualRefund usecases.IIssueManualRefund
+}
+
+func (im *IssueManualRefundHandler) Handle(c echo.Context) error {
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	var input IssueManualRefundHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(im.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, im.HttpValidator.Validate(input))
+	}
+
+	output, err := im.IssueManualRefund.Execute(usecases.IssueManualRefundInput{
+		BookingId: bookingId,
+		Amount:    uint64(input.Amount.(float64)),
+		Reason:    input.Reason.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "manual refunds require a reason of at least 3 characters":
+			return webhttp.NewBadRequest(c, err.Error())
+		case "booking not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "booking has no captured payment to refund",
+			"refund amount exceeds the refundable amount":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		im.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewCreated(c, IssueManualRefundHandlerOutput{
+		RefundId: output.RefundId,
+		Status:   output.Status,
+	})
+}
@@ -0,0 +1,120 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockIssueManualRefund struct {
+	mock.Mock
+}
+
+func (m *MockIssueManualRefund) Execute(input usecases.IssueManualRefundInput) (usecases.IssueManualRefundOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.IssueManualRefundOutput), args.Error(1)
+}
+
+type IssueManualRefundHandlerSuite struct {
+	suite.Suite
+	mockIssueManualRefund    MockIssueManualRefund
+	issueManualRefundHandler handlers.IssueManualRefundHandler
+}
+
+func (im *IssueManualRefundHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	im.Require().NoError(err)
+
+	im.mockIssueManualRefund = MockIssueManualRefund{}
+	im.issueManualRefundHandler = handlers.IssueManualRefundHandler{
+		HttpLogger:        webhttp.NewHttpLogger(),
+		HttpValidator:     httpValidator,
+		IssueManualRefund: &im.mockIssueManualRefund,
+	}
+}
+
+func (im *IssueManualRefundHandlerSuite) newContext(bookingId string, body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	c.SetParamNames("id")
+	c.SetParamValues(bookingId)
+	return c, recorder
+}
+
+func (im *IssueManualRefundHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
+	im.mockIssueManualRefund.On("Execute", usecases.IssueManualRefundInput{
+		BookingId: uuid.MustParse("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70"),
+		Amount:    50,
+		Reason:    "noisy room",
+	}).Return(usecases.IssueManualRefundOutput{
+		RefundId: uuid.MustParse("0c8f9a1e-8b7d-4c6e-a5f4-3e2d1c0b9a87"),
+		Status:   "APPROVED",
+	}, nil)
+	c, recorder := im.newContext("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70", `{"amount": 50, "reason": "noisy room"}`)
+
+	err := im.issueManualRefundHandler.Handle(c)
+	im.Require().NoError(err)
+
+	im.Equal(201, recorder.Code)
+	im.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": {
+				"refundId": "0c8f9a1e-8b7d-4c6e-a5f4-3e2d1c0b9a87",
+				"status": "APPROVED"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (im *IssueManualRefundHandlerSuite) TestHandle_OnAmountExceedsRefundable_ReturnsConflict() {
+	im.mockIssueManualRefund.On("Execute", mock.Anything).
+		Return(usecases.IssueManualRefundOutput{}, errors.New("refund amount exceeds the refundable amount"))
+	c, recorder := im.newContext("6b6e2f4e-3f6a-4c3e-9f0e-2d3c4b5a6f70", `{"amount": 5000, "reason": "noisy room"}`)
+
+	err := im.issueManualRefundHandler.Handle(c)
+	im.Require().NoError(err)
+
+	im.Equal(409, recorder.Code)
+	im.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "refund amount exceeds the refundable amount"
+		}
+	`, recorder.Body.String())
+}
+
+func (im *IssueManualRefundHandlerSuite) TestHandle_OnInvalidBookingId_ReturnsBadRequest() {
+	c, recorder := im.newContext("invalid", `{"amount": 50, "reason": "noisy room"}`)
+
+	err := im.issueManualRefundHandler.Handle(c)
+	im.Require().NoError(err)
+
+	im.Equal(400, recorder.Code)
+	im.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"error": "booking id must be uuidv4"
+		}
+	`, recorder.Body.String())
+}
+
+func TestIssueManualRefundHandler(t *testing.T) {
+	suite.Run(t, new(IssueManualRefundHandlerSuite))
+}
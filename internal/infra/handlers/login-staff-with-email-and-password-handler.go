@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type LoginStaffWithEmailAndPasswordHandlerInput struct {
+	Email    any `validate:"required,string,notEmpty,lt=256"`
+	Password any `validate:"required,string,notEmpty,lt=256"`
+}
+
+type LoginStaffWithEmailAndPasswordHandlerOutput struct {
+	StaffUserId          uuid.UUID `json:"staffUserId"`
+	Name                 string    `json:"name"`
+	Role                 string    `json:"role"`
+	AccessToken          string    `json:"accessToken"`
+	AccessTokenExpiresAt string    `json:"accessTokenExpiresAt"`
+	RefreshToken         string    `json:"refreshToken"`
+}
+
+type LoginStaffWithEmailAndPasswordHandler struct {
+	HttpLogger                     webhttp.HttpLogger
+	HttpValidator                  webhttp.HttpValidator
+	LoginStaffWithEmailAndPassword usecases.ILoginStaffWithEmailAndPassword
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandler) Handle(c echo.Context) error {
+	var input LoginStaffWithEmailAndPasswordHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(l.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, l.HttpValidator.Validate(input))
+	}
+
+	output, err := l.LoginStaffWithEmailAndPassword.Execute(usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         input.Email.(string),
+		PlainPassword: input.Password.(string),
+		IpAddress:     c.RealIP(),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "email or password is incorrect":
+			return webhttp.NewUnauthorized(c, err.Error())
+		case "too many failed login attempts. Please try again later":
+			return webhttp.NewTooManyRequests(c, output.RetryAfter, err.Error())
+		}
+
+		l.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	if output.MfaChallengeToken != "" {
+		return webhttp.NewOk(c, newMfaChallengeHandlerOutput(output.MfaChallengeToken, output.MfaChallengeExpiresAt))
+	}
+
+	return webhttp.NewOk(c, LoginStaffWithEmailAndPasswordHandlerOutput{
+		StaffUserId:          output.StaffUserId,
+		Name:                 output.Name,
+		Role:                 output.Role,
+		AccessToken:          output.AccessToken,
+		AccessTokenExpiresAt: output.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshToken:         output.RefreshToken,
+	})
+}
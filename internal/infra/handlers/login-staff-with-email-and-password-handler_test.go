@@ -0,0 +1,160 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockLoginStaffWithEmailAndPassword struct {
+	mock.Mock
+}
+
+func (m *MockLoginStaffWithEmailAndPassword) Execute(input usecases.LoginStaffWithEmailAndPasswordInput) (usecases.LoginStaffWithEmailAndPasswordOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.LoginStaffWithEmailAndPasswordOutput), args.Error(1)
+}
+
+type LoginStaffWithEmailAndPasswordHandlerSuite struct {
+	suite.Suite
+	mockLoginStaffWithEmailAndPassword    MockLoginStaffWithEmailAndPassword
+	loginStaffWithEmailAndPasswordHandler handlers.LoginStaffWithEmailAndPasswordHandler
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	l.Require().NoError(err)
+
+	l.mockLoginStaffWithEmailAndPassword = MockLoginStaffWithEmailAndPassword{}
+	l.loginStaffWithEmailAndPasswordHandler = handlers.LoginStaffWithEmailAndPasswordHandler{
+		HttpLogger:                     webhttp.NewHttpLogger(),
+		HttpValidator:                  httpValidator,
+		LoginStaffWithEmailAndPassword: &l.mockLoginStaffWithEmailAndPassword,
+	}
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandlerSuite) newContext() (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"email": "jane.roe@hotel.com",
+			"password": "s3cret-password"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	return e.NewContext(request, recorder), recorder
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandlerSuite) TestHandle_OnNoErrors_ReturnsOk() {
+	l.mockLoginStaffWithEmailAndPassword.On("Execute", usecases.LoginStaffWithEmailAndPasswordInput{
+		Email:         "jane.roe@hotel.com",
+		PlainPassword: "s3cret-password",
+		IpAddress:     "192.0.2.1",
+	}).Return(usecases.LoginStaffWithEmailAndPasswordOutput{
+		StaffUserId:          uuid.MustParse("3c0b7a52-0f5e-4a55-8d61-2f0b7f6f1a10"),
+		Name:                 "Jane Roe",
+		Role:                 "ADMIN",
+		AccessToken:          "any_access_token",
+		AccessTokenExpiresAt: time.Date(2030, 1, 10, 9, 15, 0, 0, time.UTC),
+		RefreshToken:         "any_refresh_token",
+	}, nil)
+	c, recorder := l.newContext()
+
+	err := l.loginStaffWithEmailAndPasswordHandler.Handle(c)
+	l.Require().NoError(err)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"staffUserId": "3c0b7a52-0f5e-4a55-8d61-2f0b7f6f1a10",
+				"name": "Jane Roe",
+				"role": "ADMIN",
+				"accessToken": "any_access_token",
+				"accessTokenExpiresAt": "2030-01-10T09:15:00Z",
+				"refreshToken": "any_refresh_token"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandlerSuite) TestHandle_OnIncorrectCredentials_ReturnsUnauthorized() {
+	l.mockLoginStaffWithEmailAndPassword.On("Execute", mock.Anything).
+		Return(usecases.LoginStaffWithEmailAndPasswordOutput{}, errors.New("email or password is incorrect"))
+	c, recorder := l.newContext()
+
+	err := l.loginStaffWithEmailAndPasswordHandler.Handle(c)
+	l.Require().NoError(err)
+
+	l.Equal(401, recorder.Code)
+	l.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "email or password is incorrect"
+		}
+	`, recorder.Body.String())
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandlerSuite) TestHandle_OnTooManyFailedAttempts_ReturnsTooManyRequests() {
+	l.mockLoginStaffWithEmailAndPassword.On("Execute", mock.Anything).Return(
+		usecases.LoginStaffWithEmailAndPasswordOutput{RetryAfter: 14*time.Minute + 30*time.Second},
+		errors.New("too many failed login attempts. Please try again later"))
+	c, recorder := l.newContext()
+
+	err := l.loginStaffWithEmailAndPasswordHandler.Handle(c)
+	l.Require().NoError(err)
+
+	l.Equal(429, recorder.Code)
+	l.Equal("870", recorder.Header().Get("Retry-After"))
+	l.JSONEq(`
+		{
+			"statusCode": 429,
+			"statusText": "TOO_MANY_REQUESTS",
+			"error": "too many failed login attempts. Please try again later"
+		}
+	`, recorder.Body.String())
+}
+
+func (l *LoginStaffWithEmailAndPasswordHandlerSuite) TestHandle_OnMfaEnabled_ReturnsChallenge() {
+	l.mockLoginStaffWithEmailAndPassword.On("Execute", mock.Anything).
+		Return(usecases.LoginStaffWithEmailAndPasswordOutput{
+			MfaChallengeToken:     "any_challenge_token",
+			MfaChallengeExpiresAt: time.Date(2030, 1, 10, 9, 5, 0, 0, time.UTC),
+		}, nil)
+	c, recorder := l.newContext()
+
+	err := l.loginStaffWithEmailAndPasswordHandler.Handle(c)
+	l.Require().NoError(err)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"mfaRequired": true,
+				"mfaChallengeToken": "any_challenge_token",
+				"mfaChallengeExpiresAt": "2030-01-10T09:05:00Z"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func TestLoginStaffWithEmailAndPasswordHandler(t *testing.T) {
+	suite.Run(t, new(LoginStaffWithEmailAndPasswordHandlerSuite))
+}
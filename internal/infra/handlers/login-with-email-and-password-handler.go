@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
@@ -13,9 +15,11 @@ type LoginWithEmailAndPasswordHandlerInput struct {
 }
 
 type LoginWithEmailAndPasswordHandlerOutput struct {
-	CustomerId   uuid.UUID `json:"customerId"`
-	CustomerName string    `json:"customerName"`
-	AccessToken  string    `json:"accessToken"`
+	CustomerId           uuid.UUID `json:"customerId"`
+	CustomerName         string    `json:"customerName"`
+	AccessToken          string    `json:"accessToken"`
+	AccessTokenExpiresAt string    `json:"accessTokenExpiresAt"`
+	RefreshToken         string    `json:"refreshToken"`
 }
 
 type LoginWithEmailAndPasswordHandler struct {
@@ -47,23 +51,33 @@ func (l *LoginWithEmailAndPasswordHandler) Handle(c echo.Context) error {
 	input := usecases.LoginWithEmailAndPasswordInput{
 		Email:         (requestBody.Email).(string),
 		PlainPassword: (requestBody.Password).(string),
+		IpAddress:     c.RealIP(),
 	}
 
 	output, err := l.LoginWithEmailAndPassword.Execute(input)
 
 	if err != nil {
-		if err.Error() == "email or password is incorrect" {
+		switch err.Error() {
+		case "email or password is incorrect":
 			return webhttp.NewUnauthorized(c, err.Error())
+		case "too many failed login attempts. Please try again later":
+			return webhttp.NewTooManyRequests(c, output.RetryAfter, err.Error())
 		}
 
 		l.HttpLogger.Log(c, err)
 		return webhttp.NewInternalServerError(c)
 	}
 
+	if output.MfaChallengeToken != "" {
+		return webhttp.NewOk(c, newMfaChallengeHandlerOutput(output.MfaChallengeToken, output.MfaChallengeExpiresAt))
+	}
+
 	requestOutput := LoginWithEmailAndPasswordHandlerOutput{
-		CustomerId:   output.CustomerId,
-		CustomerName: output.CustomerName,
-		AccessToken:  output.AccessToken,
+		CustomerId:           output.CustomerId,
+		CustomerName:         output.CustomerName,
+		AccessToken:          output.AccessToken,
+		AccessTokenExpiresAt: output.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshToken:         output.RefreshToken,
 	}
 
 	return webhttp.NewOk(c, requestOutput)
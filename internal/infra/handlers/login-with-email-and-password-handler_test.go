@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
@@ -47,11 +48,14 @@ func (l *LoginWithEmailAndPasswordHandlerSuite) TestHandle_OnNoErrors_ReturnsOk(
 	loginWithEmailAndPasswordInput := usecases.LoginWithEmailAndPasswordInput{
 		Email:         "john.doe@gmail.com",
 		PlainPassword: "123456",
+		IpAddress:     "192.0.2.1",
 	}
 	loginWithEmailAndPasswordOutput := usecases.LoginWithEmailAndPasswordOutput{
-		CustomerId:   customerId,
-		CustomerName: "John Doe",
-		AccessToken:  "any_access_token",
+		CustomerId:           customerId,
+		CustomerName:         "John Doe",
+		AccessToken:          "any_access_token",
+		AccessTokenExpiresAt: time.Date(2030, 1, 10, 9, 15, 0, 0, time.UTC),
+		RefreshToken:         "any_refresh_token",
 	}
 	l.loginWithEmailAndPasswordMock.On("Execute", loginWithEmailAndPasswordInput).Return(loginWithEmailAndPasswordOutput, nil)
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
@@ -76,7 +80,9 @@ func (l *LoginWithEmailAndPasswordHandlerSuite) TestHandle_OnNoErrors_ReturnsOk(
 			"data": {
 				"customerId": "5579e9a0-2596-4a30-9741-c0d4005a0327",
 				"customerName": "John Doe",
-				"accessToken": "any_access_token"
+				"accessToken": "any_access_token",
+				"accessTokenExpiresAt": "2030-01-10T09:15:00Z",
+				"refreshToken": "any_refresh_token"
 			}
 		}
 	`, recorder.Body.String())
@@ -86,6 +92,7 @@ func (l *LoginWithEmailAndPasswordHandlerSuite) TestHandle_OnEmailOrPasswordIsIn
 	l.loginWithEmailAndPasswordMock.On("Execute", usecases.LoginWithEmailAndPasswordInput{
 		Email:         "john.doe@gmail.com",
 		PlainPassword: "123456",
+		IpAddress:     "192.0.2.1",
 	}).Return(usecases.LoginWithEmailAndPasswordOutput{}, errors.New("email or password is incorrect"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
@@ -199,6 +206,7 @@ func (l *LoginWithEmailAndPasswordHandlerSuite) TestHandle_OnAnyUnexpectedError_
 	l.loginWithEmailAndPasswordMock.On("Execute", usecases.LoginWithEmailAndPasswordInput{
 		Email:         "john.doe@gmail.com",
 		PlainPassword: "123456",
+		IpAddress:     "192.0.2.1",
 	}).Return(usecases.LoginWithEmailAndPasswordOutput{}, errors.New("unexpected_error"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
@@ -224,6 +232,35 @@ func (l *LoginWithEmailAndPasswordHandlerSuite) TestHandle_OnAnyUnexpectedError_
 	`, recorder.Body.String())
 }
 
+func (l *LoginWithEmailAndPasswordHandlerSuite) TestHandle_OnTooManyFailedAttempts_ReturnsTooManyRequests() {
+	l.loginWithEmailAndPasswordMock.On("Execute", mock.Anything).Return(
+		usecases.LoginWithEmailAndPasswordOutput{RetryAfter: 14*time.Minute + 30*time.Second},
+		errors.New("too many failed login attempts. Please try again later"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"email": "john.doe@gmail.com",
+			"password": "123456"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := l.loginWithEmailAndPasswordHandler.Handle(c)
+
+	l.Require().NoError(err)
+	l.Equal(429, recorder.Code)
+	l.Equal("870", recorder.Header().Get("Retry-After"))
+	l.JSONEq(`
+		{
+			"statusCode": 429,
+			"statusText": "TOO_MANY_REQUESTS",
+			"error": "too many failed login attempts. Please try again later"
+		}
+	`, recorder.Body.String())
+}
+
 func TestLoginWithEmailAndPasswordHandler(t *testing.T) {
 	suite.Run(t, new(LoginWithEmailAndPasswordHandlerSuite))
 }
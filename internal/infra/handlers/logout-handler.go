@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type LogoutHandler struct {
+	HttpLogger webhttp.HttpLogger
+	Logout     usecases.ILogout
+}
+
+func (l *LogoutHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	err := l.Logout.Execute(usecases.LogoutInput{
+		SessionId: principal.SessionId,
+	})
+
+	if err != nil {
+		l.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type PostFolioChargeHandlerInput struct {
+	Category    any `validate:"required,string,notEmpty,lt=21"`
+	Description any `validate:"required,string,notEmpty,lt=256"`
+	Amount      any `validate:"required,integer,positive,lt=1000000000"`
+}
+
+type PostFolioChargeHandlerOutput struct {
+	ChargeId uuid.UUID `json:"chargeId"`
+}
+
+type PostFolioChargeHandler struct {
+	HttpLogger      webhttp.HttpLogger
+	HttpValidator   webhttp.HttpValidator
+	PostFolioCharge usecases.IPostFolioCharge
+}
+
+func (pf *PostFolioChargeHandler) Handle(c echo.Context) error {
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	var input PostFolioChargeHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(pf.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, pf.HttpValidator.Validate(input))
+	}
+
+	output, err := pf.PostFolioCharge.Execute(usecases.PostFolioChargeInput{
+		BookingId:   bookingId,
+		Category:    input.Category.(string),
+		Description: input.Description.(string),
+		Amount:      uint64(input.Amount.(float64)),
+	})
+
+	if err != nil {
+		return handleFolioError(c, pf.HttpLogger, err)
+	}
+
+	return webhttp.NewCreated(c, PostFolioChargeHandlerOutput{
+		ChargeId: output.ChargeId,
+	})
+}
+
+func handleFolioError(c echo.Context, httpLogger webhttp.HttpLogger, err error) error {
+	switch err.Error() {
+	case "charge category must be MINIBAR, RESTAURANT, SPA, LAUNDRY or OTHER",
+		"charge description is required",
+		"charge amount must be greater than zero",
+		"payment method must be CASH, CARD or BANK_TRANSFER",
+		"payment amount must be greater than zero",
+		"voiding a charge requires a reason of at least 3 characters":
+		return webhttp.NewBadRequest(c, err.Error())
+	case "booking not found", "charge not found":
+		return webhttp.NewNotFound(c, err.Error())
+	case "folio can only be changed while the booking is confirmed",
+		"charge is already voided",
+		"folio balance must be settled before check-out",
+		"only confirmed bookings can be checked out":
+		return webhttp.NewConflict(c, err.Error())
+	}
+
+	httpLogger.Log(c, err)
+	return webhttp.NewInternalServerError(c)
+}
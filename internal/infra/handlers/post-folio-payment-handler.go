@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type PostFolioPaymentHandlerInput struct {
+	Method any `validate:"required,string,notEmpty,lt=21"`
+	Amount any `validate:"required,integer,positive,lt=1000000000"`
+}
+
+type PostFolioPaymentHandlerOutput struct {
+	PaymentId uuid.UUID `json:"paymentId"`
+}
+
+type PostFolioPaymentHandler struct {
+	HttpLogger       webhttp.HttpLogger
+	HttpValidator    webhttp.HttpValidator
+	PostFolioPayment usecases.IPostFolioPayment
+}
+
+func (pf *PostFolioPaymentHandler) Handle(c echo.Context) error {
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	var input PostFolioPaymentHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(pf.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, pf.HttpValidator.Validate(input))
+	}
+
+	output, err := pf.PostFolioPayment.Execute(usecases.PostFolioPaymentInput{
+		BookingId: bookingId,
+		Method:    input.Method.(string),
+		Amount:    uint64(input.Amount.(float64)),
+	})
+
+	if err != nil {
+		return handleFolioError(c, pf.HttpLogger, err)
+	}
+
+	return webhttp.NewCreated(c, PostFolioPaymentHandlerOutput{
+		PaymentId: output.PaymentId,
+	})
+}
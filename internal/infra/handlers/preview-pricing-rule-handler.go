@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type PreviewPricingRuleHandlerOutputDay struct {
+	Date             string  `json:"date"`
+	OccupancyPercent float64 `json:"occupancyPercent"`
+	BaseRate         uint64  `json:"baseRate"`
+	CurrentPrice     uint64  `json:"currentPrice"`
+	PreviewPrice     uint64  `json:"previewPrice"`
+}
+
+type PreviewPricingRuleHandlerOutput struct {
+	RoomType string                               `json:"roomType"`
+	Days     []PreviewPricingRuleHandlerOutputDay `json:"days"`
+}
+
+type PreviewPricingRuleHandler struct {
+	HttpLogger         webhttp.HttpLogger
+	PreviewPricingRule usecases.IPreviewPricingRule
+}
+
+func (pp *PreviewPricingRuleHandler) Handle(c echo.Context) error {
+	pricingRuleId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "pricing rule id must be uuidv4")
+	}
+
+	output, err := pp.PreviewPricingRule.Execute(usecases.PreviewPricingRuleInput{
+		PricingRuleId: pricingRuleId,
+	})
+
+	if err != nil {
+		if err.Error() == "pricing rule not found" {
+			return webhttp.NewNotFound(c, err.Error())
+		}
+
+		if err.Error() == "there are no rooms of the pricing rule room type" {
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		pp.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	handlerOutput := PreviewPricingRuleHandlerOutput{
+		RoomType: output.RoomType,
+		Days:     []PreviewPricingRuleHandlerOutputDay{},
+	}
+
+	for _, day := range output.Days {
+		handlerOutput.Days = append(handlerOutput.Days, PreviewPricingRuleHandlerOutputDay{
+			Date:             day.Date.Format(time.DateOnly),
+			OccupancyPercent: day.OccupancyPercent,
+			BaseRate:         day.BaseRate,
+			CurrentPrice:     day.CurrentPrice,
+			PreviewPrice:     day.PreviewPrice,
+		})
+	}
+
+	return webhttp.NewOk(c, handlerOutput)
+}
@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockPreviewPricingRule struct {
+	mock.Mock
+}
+
+func (m *MockPreviewPricingRule) Execute(input usecases.PreviewPricingRuleInput) (usecases.PreviewPricingRuleOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.PreviewPricingRuleOutput), args.Error(1)
+}
+
+type PreviewPricingRuleHandlerSuite struct {
+	suite.Suite
+	mockPreviewPricingRule    MockPreviewPricingRule
+	previewPricingRuleHandler handlers.PreviewPricingRuleHandler
+}
+
+func (pp *PreviewPricingRuleHandlerSuite) SetupTest() {
+	pp.mockPreviewPricingRule = MockPreviewPricingRule{}
+	pp.previewPricingRuleHandler = handlers.PreviewPricingRuleHandler{
+		HttpLogger:         webhttp.NewHttpLogger(),
+		PreviewPricingRule: &pp.mockPreviewPricingRule,
+	}
+}
+
+func (pp *PreviewPricingRuleHandlerSuite) newContext(role string, pricingRuleId string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	c.SetParamNames("id")
+	c.SetParamValues(pricingRuleId)
+
+	return c, recorder
+}
+
+func (pp *PreviewPricingRuleHandlerSuite) TestHandle_OnNoErrors_ReturnsOk() {
+	pp.mockPreviewPricingRule.On("Execute", usecases.PreviewPricingRuleInput{
+		PricingRuleId: uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+	}).Return(usecases.PreviewPricingRuleOutput{
+		RoomType: "SUITE",
+		Days: []usecases.PreviewPricingRuleOutputDay{
+			{
+				Date:             time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+				OccupancyPercent: 90,
+				BaseRate:         200,
+				CurrentPrice:     200,
+				PreviewPrice:     230,
+			},
+		},
+	}, nil)
+	c, recorder := pp.newContext("ADMIN", "0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+
+	err := pp.previewPricingRuleHandler.Handle(c)
+	pp.Require().NoError(err)
+
+	pp.Equal(200, recorder.Code)
+	pp.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"roomType": "SUITE",
+				"days": [
+					{
+						"date": "2030-06-01",
+						"occupancyPercent": 90,
+						"baseRate": 200,
+						"currentPrice": 200,
+						"previewPrice": 230
+					}
+				]
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (pp *PreviewPricingRuleHandlerSuite) TestHandle_OnInvalidPricingRuleId_ReturnsBadRequest() {
+	c, recorder := pp.newContext("ADMIN", "abc")
+
+	err := pp.previewPricingRuleHandler.Handle(c)
+	pp.Require().NoError(err)
+
+	pp.Equal(400, recorder.Code)
+	pp.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"error": "pricing rule id must be uuidv4"
+		}
+	`, recorder.Body.String())
+}
+
+func (pp *PreviewPricingRuleHandlerSuite) TestHandle_OnPricingRuleNotFound_ReturnsNotFound() {
+	pp.mockPreviewPricingRule.On("Execute", mock.Anything).
+		Return(usecases.PreviewPricingRuleOutput{}, errors.New("pricing rule not found"))
+	c, recorder := pp.newContext("ADMIN", "0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+
+	err := pp.previewPricingRuleHandler.Handle(c)
+	pp.Require().NoError(err)
+
+	pp.Equal(404, recorder.Code)
+	pp.JSONEq(`
+		{
+			"statusCode": 404,
+			"statusText": "NOT_FOUND",
+			"error": "pricing rule not found"
+		}
+	`, recorder.Body.String())
+}
+
+func TestPreviewPricingRuleHandler(t *testing.T) {
+	suite.Run(t, new(PreviewPricingRuleHandlerSuite))
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ReceivePaymentEventHandlerOutput struct {
+	EventId string `json:"eventId"`
+	Status  string `json:"status"`
+}
+
+type ReceivePaymentEventHandler struct {
+	HttpLogger          webhttp.HttpLogger
+	ProcessPaymentEvent usecases.IProcessPaymentEvent
+}
+
+func (r *ReceivePaymentEventHandler) Handle(c echo.Context) error {
+	payload, err := io.ReadAll(c.Request().Body)
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "payment event payload is invalid")
+	}
+
+	output, err := r.ProcessPaymentEvent.Execute(usecases.ProcessPaymentEventInput{
+		Payload:   payload,
+		Signature: c.Request().Header.Get("X-Payments-Signature"),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "payment event signature is invalid":
+			r.HttpLogger.Warn(c, "Payment Event Rejected", slog.String("reason", err.Error()))
+			return webhttp.NewUnauthorized(c, err.Error())
+		case "payment event payload is invalid",
+			"payment event id is required",
+			"payment event type is required":
+			r.HttpLogger.Warn(c, "Payment Event Rejected", slog.String("reason", err.Error()))
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		r.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	if output.Status == "IGNORED" {
+		r.HttpLogger.Warn(c, "Payment Event Ignored",
+			slog.String("event_id", output.EventId),
+			slog.String("reason", output.Reason),
+		)
+	}
+
+	return webhttp.NewOk(c, ReceivePaymentEventHandlerOutput{
+		EventId: output.EventId,
+		Status:  output.Status,
+	})
+}
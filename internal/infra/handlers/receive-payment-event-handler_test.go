@@ -0,0 +1,120 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockProcessPaymentEvent struct {
+	mock.Mock
+}
+
+func (m *MockProcessPaymentEvent) Execute(input usecases.ProcessPaymentEventInput) (usecases.ProcessPaymentEventOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.ProcessPaymentEventOutput), args.Error(1)
+}
+
+type ReceivePaymentEventHandlerSuite struct {
+	suite.Suite
+	mockProcessPaymentEvent    MockProcessPaymentEvent
+	receivePaymentEventHandler handlers.ReceivePaymentEventHandler
+}
+
+func (r *ReceivePaymentEventHandlerSuite) SetupTest() {
+	r.mockProcessPaymentEvent = MockProcessPaymentEvent{}
+	r.receivePaymentEventHandler = handlers.ReceivePaymentEventHandler{
+		HttpLogger:          webhttp.NewHttpLogger(),
+		ProcessPaymentEvent: &r.mockProcessPaymentEvent,
+	}
+}
+
+func (r *ReceivePaymentEventHandlerSuite) TestHandle_OnProcessedEvent_ReturnsOk() {
+	payload := `{"id": "evt_1", "type": "payment.succeeded", "transactionId": "txn_1"}`
+	r.mockProcessPaymentEvent.On("Execute", usecases.ProcessPaymentEventInput{
+		Payload:   []byte(payload),
+		Signature: "sha256=abc",
+	}).Return(usecases.ProcessPaymentEventOutput{EventId: "evt_1", Status: "PROCESSED"}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set("X-Payments-Signature", "sha256=abc")
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := r.receivePaymentEventHandler.Handle(c)
+	r.Require().NoError(err)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"eventId": "evt_1",
+				"status": "PROCESSED"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (r *ReceivePaymentEventHandlerSuite) TestHandle_OnIgnoredEvent_ReturnsOk() {
+	r.mockProcessPaymentEvent.On("Execute", mock.Anything).Return(usecases.ProcessPaymentEventOutput{
+		EventId: "evt_1",
+		Status:  "IGNORED",
+		Reason:  "payment event was already received",
+	}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id": "evt_1"}`))
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := r.receivePaymentEventHandler.Handle(c)
+	r.Require().NoError(err)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"eventId": "evt_1",
+				"status": "IGNORED"
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (r *ReceivePaymentEventHandlerSuite) TestHandle_OnInvalidSignature_ReturnsUnauthorized() {
+	r.mockProcessPaymentEvent.On("Execute", mock.Anything).
+		Return(usecases.ProcessPaymentEventOutput{}, errors.New("payment event signature is invalid"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id": "evt_1"}`))
+	request.Header.Set("X-Payments-Signature", "sha256=forged")
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := r.receivePaymentEventHandler.Handle(c)
+	r.Require().NoError(err)
+
+	r.Equal(401, recorder.Code)
+	r.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "payment event signature is invalid"
+		}
+	`, recorder.Body.String())
+}
+
+func TestReceivePaymentEventHandler(t *testing.T) {
+	suite.Run(t, new(ReceivePaymentEventHandlerSuite))
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RedeemGiftCardHandlerInput struct {
+	Code any `validate:"required,string,notEmpty,lt=51"`
+}
+
+type RedeemGiftCardHandlerOutput struct {
+	CreditedAmount uint64 `json:"creditedAmount"`
+	Balance        uint64 `json:"balance"`
+}
+
+type RedeemGiftCardHandler struct {
+	HttpLogger     webhttp.HttpLogger
+	HttpValidator  webhttp.HttpValidator
+	RedeemGiftCard usecases.IRedeemGiftCard
+}
+
+func (rg *RedeemGiftCardHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	if !principal.IsCustomer() {
+		return webhttp.NewForbidden(c, "you do not have permission to access this resource")
+	}
+
+	var input RedeemGiftCardHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(rg.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, rg.HttpValidator.Validate(input))
+	}
+
+	output, err := rg.RedeemGiftCard.Execute(usecases.RedeemGiftCardInput{
+		CustomerId: principal.CustomerId,
+		Code:       input.Code.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "gift card not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "gift card has already been redeemed",
+			"gift card has expired":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		rg.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, RedeemGiftCardHandlerOutput{
+		CreditedAmount: output.CreditedAmount,
+		Balance:        output.Balance,
+	})
+}
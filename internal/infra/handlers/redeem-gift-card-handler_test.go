@@ -0,0 +1,139 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockRedeemGiftCard struct {
+	mock.Mock
+}
+
+func (m *MockRedeemGiftCard) Execute(input usecases.RedeemGiftCardInput) (usecases.RedeemGiftCardOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.RedeemGiftCardOutput), args.Error(1)
+}
+
+type RedeemGiftCardHandlerSuite struct {
+	suite.Suite
+	mockRedeemGiftCard    MockRedeemGiftCard
+	redeemGiftCardHandler handlers.RedeemGiftCardHandler
+	principal             auth.Principal
+}
+
+func (rg *RedeemGiftCardHandlerSuite) SetupTest() {
+	httpValidator, err := webhttp.NewHttpValidator()
+	rg.Require().NoError(err)
+
+	rg.mockRedeemGiftCard = MockRedeemGiftCard{}
+	rg.redeemGiftCardHandler = handlers.RedeemGiftCardHandler{
+		HttpLogger:     webhttp.NewHttpLogger(),
+		HttpValidator:  httpValidator,
+		RedeemGiftCard: &rg.mockRedeemGiftCard,
+	}
+	rg.principal = auth.Principal{CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), Role: "CUSTOMER"}
+}
+
+func (rg *RedeemGiftCardHandlerSuite) newContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, rg.principal)
+	return c, recorder
+}
+
+func (rg *RedeemGiftCardHandlerSuite) TestHandle_OnNoErrors_ReturnsOk() {
+	rg.mockRedeemGiftCard.On("Execute", usecases.RedeemGiftCardInput{
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		Code:       "ABCD-EFGH-JKLM-NPQR",
+	}).Return(usecases.RedeemGiftCardOutput{
+		CreditedAmount: 50,
+		Balance:        80,
+	}, nil)
+	c, recorder := rg.newContext(`{"code": "ABCD-EFGH-JKLM-NPQR"}`)
+
+	err := rg.redeemGiftCardHandler.Handle(c)
+	rg.Require().NoError(err)
+
+	rg.Equal(200, recorder.Code)
+	rg.JSONEq(`
+		{
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": {
+				"creditedAmount": 50,
+				"balance": 80
+			}
+		}
+	`, recorder.Body.String())
+}
+
+func (rg *RedeemGiftCardHandlerSuite) TestHandle_OnGiftCardNotFound_ReturnsNotFound() {
+	rg.mockRedeemGiftCard.On("Execute", mock.Anything).
+		Return(usecases.RedeemGiftCardOutput{}, errors.New("gift card not found"))
+	c, recorder := rg.newContext(`{"code": "ABCD-EFGH-JKLM-NPQR"}`)
+
+	err := rg.redeemGiftCardHandler.Handle(c)
+	rg.Require().NoError(err)
+
+	rg.Equal(404, recorder.Code)
+	rg.JSONEq(`
+		{
+			"statusCode": 404,
+			"statusText": "NOT_FOUND",
+			"error": "gift card not found"
+		}
+	`, recorder.Body.String())
+}
+
+func (rg *RedeemGiftCardHandlerSuite) TestHandle_OnGiftCardAlreadyRedeemed_ReturnsConflict() {
+	rg.mockRedeemGiftCard.On("Execute", mock.Anything).
+		Return(usecases.RedeemGiftCardOutput{}, errors.New("gift card has already been redeemed"))
+	c, recorder := rg.newContext(`{"code": "ABCD-EFGH-JKLM-NPQR"}`)
+
+	err := rg.redeemGiftCardHandler.Handle(c)
+	rg.Require().NoError(err)
+
+	rg.Equal(409, recorder.Code)
+	rg.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "gift card has already been redeemed"
+		}
+	`, recorder.Body.String())
+}
+
+func (rg *RedeemGiftCardHandlerSuite) TestHandle_OnMissingCode_ReturnsBadRequest() {
+	c, recorder := rg.newContext(`{}`)
+
+	err := rg.redeemGiftCardHandler.Handle(c)
+	rg.Require().NoError(err)
+
+	rg.Equal(400, recorder.Code)
+	rg.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": ["code is required"]
+		}
+	`, recorder.Body.String())
+}
+
+func TestRedeemGiftCardHandler(t *testing.T) {
+	suite.Run(t, new(RedeemGiftCardHandlerSuite))
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RefreshSessionHandlerInput struct {
+	RefreshToken any `validate:"required,string,notEmpty,lt=256"`
+}
+
+type RefreshSessionHandlerOutput struct {
+	AccessToken          string `json:"accessToken"`
+	AccessTokenExpiresAt string `json:"accessTokenExpiresAt"`
+	RefreshToken         string `json:"refreshToken"`
+}
+
+type RefreshSessionHandler struct {
+	HttpLogger     webhttp.HttpLogger
+	HttpValidator  webhttp.HttpValidator
+	RefreshSession usecases.IRefreshSession
+}
+
+func (rs *RefreshSessionHandler) Handle(c echo.Context) error {
+	var input RefreshSessionHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(rs.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, rs.HttpValidator.Validate(input))
+	}
+
+	output, err := rs.RefreshSession.Execute(usecases.RefreshSessionInput{
+		RefreshToken: input.RefreshToken.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "refresh token is invalid or has expired",
+			"refresh token has been revoked":
+			return webhttp.NewUnauthorized(c, err.Error())
+		}
+
+		rs.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, RefreshSessionHandlerOutput{
+		AccessToken:          output.AccessToken,
+		AccessTokenExpiresAt: output.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshToken:         output.RefreshToken,
+	})
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ResendVerificationEmailHandler struct {
+	HttpLogger              webhttp.HttpLogger
+	ResendVerificationEmail usecases.IResendVerificationEmail
+}
+
+func (rv *ResendVerificationEmailHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	if !principal.IsCustomer() {
+		return webhttp.NewForbidden(c, "you do not have permission to access this resource")
+	}
+
+	output, err := rv.ResendVerificationEmail.Execute(usecases.ResendVerificationEmailInput{
+		CustomerId: principal.CustomerId,
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "email is already verified":
+			return webhttp.NewConflict(c, err.Error())
+		case "customer not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "verification email was sent recently. Please try again later":
+			return webhttp.NewTooManyRequests(c, output.RetryAfter, err.Error())
+		}
+
+		rv.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewAccepted(c, "a new verification link has been sent to your email")
+}
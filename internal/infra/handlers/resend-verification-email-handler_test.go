@@ -0,0 +1,116 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockResendVerificationEmail struct {
+	mock.Mock
+}
+
+func (m *MockResendVerificationEmail) Execute(input usecases.ResendVerificationEmailInput) (usecases.ResendVerificationEmailOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(usecases.ResendVerificationEmailOutput), args.Error(1)
+}
+
+type ResendVerificationEmailHandlerSuite struct {
+	suite.Suite
+	customerId                     uuid.UUID
+	mockResendVerificationEmail    MockResendVerificationEmail
+	resendVerificationEmailHandler handlers.ResendVerificationEmailHandler
+}
+
+func (rv *ResendVerificationEmailHandlerSuite) SetupTest() {
+	rv.customerId = uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	rv.mockResendVerificationEmail = MockResendVerificationEmail{}
+	rv.resendVerificationEmailHandler = handlers.ResendVerificationEmailHandler{
+		HttpLogger:              webhttp.NewHttpLogger(),
+		ResendVerificationEmail: &rv.mockResendVerificationEmail,
+	}
+}
+
+func (rv *ResendVerificationEmailHandlerSuite) newContext() (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(http.MethodPost, "/", nil)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+	webhttp.SetPrincipal(c, auth.Principal{CustomerId: rv.customerId, Role: "CUSTOMER"})
+	return c, recorder
+}
+
+func (rv *ResendVerificationEmailHandlerSuite) TestHandle_OnNoErrors_ReturnsAccepted() {
+	rv.mockResendVerificationEmail.On("Execute", usecases.ResendVerificationEmailInput{CustomerId: rv.customerId}).
+		Return(usecases.ResendVerificationEmailOutput{}, nil)
+	c, recorder := rv.newContext()
+
+	err := rv.resendVerificationEmailHandler.Handle(c)
+	rv.Require().NoError(err)
+
+	rv.Equal(202, recorder.Code)
+	rv.JSONEq(`
+		{
+			"statusCode": 202,
+			"statusText": "ACCEPTED",
+			"data": "a new verification link has been sent to your email"
+		}
+	`, recorder.Body.String())
+}
+
+func (rv *ResendVerificationEmailHandlerSuite) TestHandle_OnEmailSentRecently_ReturnsTooManyRequests() {
+	rv.mockResendVerificationEmail.On("Execute", mock.Anything).
+		Return(usecases.ResendVerificationEmailOutput{RetryAfter: 90*time.Second + time.Millisecond},
+			errors.New("verification email was sent recently. Please try again later"))
+	c, recorder := rv.newContext()
+
+	err := rv.resendVerificationEmailHandler.Handle(c)
+	rv.Require().NoError(err)
+
+	rv.Equal(429, recorder.Code)
+	rv.Equal("91", recorder.Header().Get("Retry-After"))
+	rv.JSONEq(`
+		{
+			"statusCode": 429,
+			"statusText": "TOO_MANY_REQUESTS",
+			"error": "verification email was sent recently. Please try again later"
+		}
+	`, recorder.Body.String())
+}
+
+func (rv *ResendVerificationEmailHandlerSuite) TestHandle_OnAlreadyVerified_ReturnsConflict() {
+	rv.mockResendVerificationEmail.On("Execute", mock.Anything).
+		Return(usecases.ResendVerificationEmailOutput{}, errors.New("email is already verified"))
+	c, recorder := rv.newContext()
+
+	err := rv.resendVerificationEmailHandler.Handle(c)
+	rv.Require().NoError(err)
+
+	rv.Equal(409, recorder.Code)
+}
+
+func (rv *ResendVerificationEmailHandlerSuite) TestHandle_OnStaffPrincipal_ReturnsForbidden() {
+	c, recorder := rv.newContext()
+	webhttp.SetPrincipal(c, auth.Principal{StaffUserId: uuid.New(), Role: "ADMIN"})
+
+	err := rv.resendVerificationEmailHandler.Handle(c)
+	rv.Require().NoError(err)
+
+	rv.Equal(403, recorder.Code)
+	rv.mockResendVerificationEmail.AssertNotCalled(rv.T(), "Execute", mock.Anything)
+}
+
+func TestResendVerificationEmailHandler(t *testing.T) {
+	suite.Run(t, new(ResendVerificationEmailHandlerSuite))
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ResetPasswordHandlerInput struct {
+	Token       any `validate:"required,string,notEmpty,lt=256"`
+	NewPassword any `validate:"required,string,notEmpty,lt=256"`
+}
+
+type ResetPasswordHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	ResetPassword usecases.IResetPassword
+}
+
+func (rp *ResetPasswordHandler) Handle(c echo.Context) error {
+	var input ResetPasswordHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(rp.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, rp.HttpValidator.Validate(input))
+	}
+
+	err := rp.ResetPassword.Execute(usecases.ResetPasswordInput{
+		Token:       input.Token.(string),
+		NewPassword: input.NewPassword.(string),
+	})
+
+	if err != nil {
+		if isPasswordPolicyViolation(err) || err.Error() == "password reset token is invalid or has expired" {
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		rp.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
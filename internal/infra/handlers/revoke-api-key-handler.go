@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RevokeApiKeyHandler struct {
+	HttpLogger   webhttp.HttpLogger
+	RevokeApiKey usecases.IRevokeApiKey
+}
+
+func (rk *RevokeApiKeyHandler) Handle(c echo.Context) error {
+	apiKeyId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "api key id must be uuidv4")
+	}
+
+	err = rk.RevokeApiKey.Execute(usecases.RevokeApiKeyInput{
+		ApiKeyId: apiKeyId,
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "api key not found":
+			return webhttp.NewNotFound(c, err.Error())
+		case "api key is already revoked":
+			return webhttp.NewConflict(c, err.Error())
+		}
+
+		rk.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetExtraGuestRateHandlerInput struct {
+	BaseOccupancy   any `validate:"required,integer,positive,lt=256"`
+	ExtraAdultPrice any `validate:"required,integer,positive,lt=1000000000"`
+	ExtraChildPrice any `validate:"integer,positive,lt=1000000000"`
+	ChildMaxAge     any `validate:"integer,positive,lt=18"`
+}
+
+type SetExtraGuestRateHandler struct {
+	HttpLogger        webhttp.HttpLogger
+	HttpValidator     webhttp.HttpValidator
+	SetExtraGuestRate usecases.ISetExtraGuestRate
+}
+
+func (se *SetExtraGuestRateHandler) Handle(c echo.Context) error {
+	var input SetExtraGuestRateHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(se.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, se.HttpValidator.Validate(input))
+	}
+
+	err := se.SetExtraGuestRate.Execute(usecases.SetExtraGuestRateInput{
+		RoomType:        c.Param("roomType"),
+		BaseOccupancy:   uint8(input.BaseOccupancy.(float64)),
+		ExtraAdultPrice: uint64(input.ExtraAdultPrice.(float64)),
+		ExtraChildPrice: uint64(input.ExtraChildPrice.(float64)),
+		ChildMaxAge:     uint8(input.ChildMaxAge.(float64)),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "room type must be SINGLE, DOUBLE, TWIN or SUITE",
+			"base occupancy must be at least one",
+			"child max age must be between 0 and 17":
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		se.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
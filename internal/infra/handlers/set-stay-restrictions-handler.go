@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetStayRestrictionsHandlerInput struct {
+	From              any `validate:"required,date"`
+	To                any `validate:"required,date"`
+	MinStayNights     any `validate:"required,integer,positive,lt=256"`
+	ClosedToArrival   any `validate:"boolean"`
+	ClosedToDeparture any `validate:"boolean"`
+}
+
+type SetStayRestrictionsHandler struct {
+	HttpLogger          webhttp.HttpLogger
+	HttpValidator       webhttp.HttpValidator
+	SetStayRestrictions usecases.ISetStayRestrictions
+}
+
+func (ss *SetStayRestrictionsHandler) Handle(c echo.Context) error {
+	var input SetStayRestrictionsHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ss.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ss.HttpValidator.Validate(input))
+	}
+
+	err := ss.SetStayRestrictions.Execute(usecases.SetStayRestrictionsInput{
+		RoomType:          c.Param("type"),
+		From:              toDate(input.From),
+		To:                toDate(input.To),
+		MinStayNights:     uint8(input.MinStayNights.(float64)),
+		ClosedToArrival:   input.ClosedToArrival.(bool),
+		ClosedToDeparture: input.ClosedToDeparture.(bool),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "room type must be SINGLE, DOUBLE, TWIN or SUITE",
+			"to date must be on or after from date",
+			"date range cannot exceed 366 days",
+			"minimum stay must be at least one night":
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		ss.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
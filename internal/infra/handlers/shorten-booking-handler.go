@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ShortenBookingHandlerInput struct {
+	CheckOut any `validate:"required,date"`
+}
+
+type ShortenBookingHandlerOutput struct {
+	TotalPrice   uint64 `json:"totalPrice"`
+	RefundAmount uint64 `json:"refundAmount"`
+	RefundStatus string `json:"refundStatus"`
+}
+
+type ShortenBookingHandler struct {
+	HttpLogger     webhttp.HttpLogger
+	HttpValidator  webhttp.HttpValidator
+	ShortenBooking usecases.IShortenBooking
+}
+
+func (sb *ShortenBookingHandler) Handle(c echo.Context) error {
+	principal, ok := webhttp.GetPrincipal(c)
+
+	if !ok {
+		return webhttp.NewUnauthorized(c, "missing or invalid authorization token")
+	}
+
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	var input ShortenBookingHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(sb.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, sb.HttpValidator.Validate(input))
+	}
+
+	output, err := sb.ShortenBooking.Execute(usecases.ShortenBookingInput{
+		BookingId: bookingId,
+		Principal: principal,
+		CheckOut:  toDate(input.CheckOut),
+	})
+
+	if err != nil {
+		return handleBookingChangeError(c, sb.HttpLogger, err)
+	}
+
+	return webhttp.NewOk(c, ShortenBookingHandlerOutput{
+		TotalPrice:   output.TotalPrice,
+		RefundAmount: output.RefundAmount,
+		RefundStatus: output.RefundStatus,
+	})
+}
@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"log/slog"
+	"strings"
+
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
 	"github.com/labstack/echo/v4"
@@ -38,7 +41,7 @@ func (s *SignUpHandler) Handle(c echo.Context) error {
 		return webhttp.NewBadRequestValidation(c, errorMessages)
 	}
 
-	err = s.SignUp.Execute(usecases.SignUpInput{
+	signUpOutput, err := s.SignUp.Execute(usecases.SignUpInput{
 		Name:     requestBody.Name.(string),
 		Email:    requestBody.Email.(string),
 		Password: requestBody.Password.(string),
@@ -49,7 +52,7 @@ func (s *SignUpHandler) Handle(c echo.Context) error {
 			return webhttp.NewBadRequest(c, err.Error())
 		}
 
-		if err.Error() == "password must be at least 6 characters long" {
+		if isPasswordPolicyViolation(err) {
 			return webhttp.NewBadRequest(c, err.Error())
 		}
 
@@ -65,6 +68,18 @@ func (s *SignUpHandler) Handle(c echo.Context) error {
 		return webhttp.NewInternalServerError(c)
 	}
 
+	if signUpOutput.VerificationEmailFailure != "" {
+		s.HttpLogger.Warn(c, "Verification Email Failed",
+			slog.String("error_message", signUpOutput.VerificationEmailFailure))
+	}
+
 	return webhttp.NewCreated(c, "customer sign up successfully")
 
 }
+
+// isPasswordPolicyViolation reports whether err is one of the messages of account.PasswordPolicy, whose length and
+// character class requirements are configurable and so part of the message.
+func isPasswordPolicyViolation(err error) bool {
+	return strings.HasPrefix(err.Error(), "password must ") ||
+		err.Error() == "password is too common or has appeared in a data breach. Please choose another one"
+}
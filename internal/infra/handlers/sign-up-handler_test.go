@@ -20,9 +20,9 @@ type SignUpMock struct {
 	mock.Mock
 }
 
-func (s *SignUpMock) Execute(input usecases.SignUpInput) error {
+func (s *SignUpMock) Execute(input usecases.SignUpInput) (usecases.SignUpOutput, error) {
 	args := s.Called(input)
-	return args.Error(0)
+	return args.Get(0).(usecases.SignUpOutput), args.Error(1)
 }
 
 type SignUpHandlerSuite struct {
@@ -45,7 +45,7 @@ func (s *SignUpHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123456",
-	}).Return(nil)
+	}).Return(usecases.SignUpOutput{}, nil)
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"name": "John Doe",
@@ -72,12 +72,43 @@ func (s *SignUpHandlerSuite) TestHandle_OnNoErrors_ReturnsCreated() {
 	`, recorder.Body.String())
 }
 
+func (s *SignUpHandlerSuite) TestHandle_OnVerificationEmailFailure_ReturnsCreated() {
+	s.signUpMock.On("Execute", usecases.SignUpInput{
+		Name:     "John Doe",
+		Email:    "john.doe@gmail.com",
+		Password: "123456",
+	}).Return(usecases.SignUpOutput{VerificationEmailFailure: "smtp server unavailable"}, nil)
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"name": "John Doe",
+			"email": "john.doe@gmail.com",
+			"password": "123456"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := s.signUpHandler.Handle(c)
+	s.Require().NoError(err)
+
+	s.Equal(201, recorder.Code)
+	s.JSONEq(`
+		{
+			"statusCode": 201,
+			"statusText": "CREATED",
+			"data": "customer sign up successfully"
+		}
+	`, recorder.Body.String())
+}
+
 func (s *SignUpHandlerSuite) TestHandle_OnInvalidName_ReturnsBadRequest() {
 	s.signUpMock.On("Execute", usecases.SignUpInput{
 		Name:     "J",
 		Email:    "john.doe@gmail.com",
 		Password: "123456",
-	}).Return(errors.New("name must be at least 3 characters long"))
+	}).Return(usecases.SignUpOutput{}, errors.New("name must be at least 3 characters long"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"name": "J",
@@ -108,7 +139,7 @@ func (s *SignUpHandlerSuite) TestHandle_OnInvalidEmail_ReturnsBadRequest() {
 		Name:     "John Doe",
 		Email:    "jjgmail.com",
 		Password: "123456",
-	}).Return(errors.New("email is invalid"))
+	}).Return(usecases.SignUpOutput{}, errors.New("email is invalid"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"name": "John Doe",
@@ -139,7 +170,7 @@ func (s *SignUpHandlerSuite) TestHandle_OnInvalidPassword_ReturnsBadRequest() {
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123",
-	}).Return(errors.New("password must be at least 6 characters long"))
+	}).Return(usecases.SignUpOutput{}, errors.New("password must be at least 6 characters long"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"name": "John Doe",
@@ -165,12 +196,43 @@ func (s *SignUpHandlerSuite) TestHandle_OnInvalidPassword_ReturnsBadRequest() {
 	`, recorder.Body.String())
 }
 
+func (s *SignUpHandlerSuite) TestHandle_OnBreachedPassword_ReturnsBadRequest() {
+	s.signUpMock.On("Execute", usecases.SignUpInput{
+		Name:     "John Doe",
+		Email:    "john.doe@gmail.com",
+		Password: "Password1",
+	}).Return(usecases.SignUpOutput{}, errors.New("password is too common or has appeared in a data breach. Please choose another one"))
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
+		{
+			"name": "John Doe",
+			"email": "john.doe@gmail.com",
+			"password": "Password1"
+		}
+	`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := s.signUpHandler.Handle(c)
+	s.Require().NoError(err)
+
+	s.Equal(400, recorder.Code)
+	s.JSONEq(`
+		{
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"error": "password is too common or has appeared in a data breach. Please choose another one"
+		}
+	`, recorder.Body.String())
+}
+
 func (s *SignUpHandlerSuite) TestHandle_OnEmailAddressAlreadyAssociatedWithAnotherAccount_ReturnsConflict() {
 	s.signUpMock.On("Execute", usecases.SignUpInput{
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123456",
-	}).Return(errors.New("email address is already associated with another account"))
+	}).Return(usecases.SignUpOutput{}, errors.New("email address is already associated with another account"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"name": "John Doe",
@@ -201,7 +263,7 @@ func (s *SignUpHandlerSuite) TestHandle_OnAnyUnexpectedError_ReturnsInternalServ
 		Name:     "John Doe",
 		Email:    "john.doe@gmail.com",
 		Password: "123",
-	}).Return(errors.New("any unexpected error"))
+	}).Return(usecases.SignUpOutput{}, errors.New("any unexpected error"))
 	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`
 		{
 			"name": "John Doe",
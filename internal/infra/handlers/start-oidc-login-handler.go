@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type StartOidcLoginHandlerOutput struct {
+	AuthorizationUrl    string `json:"authorizationUrl"`
+	LoginToken          string `json:"loginToken"`
+	LoginTokenExpiresAt string `json:"loginTokenExpiresAt"`
+}
+
+type StartOidcLoginHandler struct {
+	HttpLogger     webhttp.HttpLogger
+	StartOidcLogin usecases.IStartOidcLogin
+}
+
+func (so *StartOidcLoginHandler) Handle(c echo.Context) error {
+	output, err := so.StartOidcLogin.Execute(usecases.StartOidcLoginInput{
+		Provider: c.Param("provider"),
+	})
+
+	if err != nil {
+		if err.Error() == "identity provider not found" {
+			return webhttp.NewNotFound(c, err.Error())
+		}
+
+		so.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, StartOidcLoginHandlerOutput{
+		AuthorizationUrl:    output.AuthorizationUrl,
+		LoginToken:          output.LoginToken,
+		LoginTokenExpiresAt: output.LoginTokenExpiresAt.Format(time.RFC3339),
+	})
+}
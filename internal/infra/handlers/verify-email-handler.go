@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type VerifyEmailHandlerInput struct {
+	Token any `validate:"required,string,notEmpty,lt=1024"`
+}
+
+type VerifyEmailHandler struct {
+	HttpLogger    webhttp.HttpLogger
+	HttpValidator webhttp.HttpValidator
+	VerifyEmail   usecases.IVerifyEmail
+}
+
+func (ve *VerifyEmailHandler) Handle(c echo.Context) error {
+	var input VerifyEmailHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(ve.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, ve.HttpValidator.Validate(input))
+	}
+
+	err := ve.VerifyEmail.Execute(usecases.VerifyEmailInput{
+		Token: input.Token.(string),
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "email verification token is invalid or has expired":
+			return webhttp.NewBadRequest(c, err.Error())
+		}
+
+		ve.HttpLogger.Log(c, err)
+		return webhttp.NewInternalServerError(c)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type VoidFolioChargeHandlerInput struct {
+	Reason any `validate:"required,string,notEmpty,lt=501"`
+}
+
+type VoidFolioChargeHandlerOutput struct {
+	ChargeId uuid.UUID `json:"chargeId"`
+	Status   string    `json:"status"`
+}
+
+type VoidFolioChargeHandler struct {
+	HttpLogger      webhttp.HttpLogger
+	HttpValidator   webhttp.HttpValidator
+	VoidFolioCharge usecases.IVoidFolioCharge
+}
+
+func (vf *VoidFolioChargeHandler) Handle(c echo.Context) error {
+	bookingId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "booking id must be uuidv4")
+	}
+
+	chargeId, err := uuid.Parse(c.Param("chargeId"))
+
+	if err != nil {
+		return webhttp.NewBadRequest(c, "charge id must be uuidv4")
+	}
+
+	var input VoidFolioChargeHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json"})
+	}
+
+	if len(vf.HttpValidator.Validate(input)) > 0 {
+		return webhttp.NewBadRequestValidation(c, vf.HttpValidator.Validate(input))
+	}
+
+	output, err := vf.VoidFolioCharge.Execute(usecases.VoidFolioChargeInput{
+		BookingId: bookingId,
+		ChargeId:  chargeId,
+		Reason:    input.Reason.(string),
+	})
+
+	if err != nil {
+		return handleFolioError(c, vf.HttpLogger, err)
+	}
+
+	return webhttp.NewOk(c, VoidFolioChargeHandlerOutput{
+		ChargeId: output.ChargeId,
+		Status:   output.Status,
+	})
+}
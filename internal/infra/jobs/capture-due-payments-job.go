@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+)
+
+type CaptureDuePaymentsJob struct {
+	interval           time.Duration
+	captureDuePayments usecases.ICaptureDuePayments
+	logger             *slog.Logger
+}
+
+func NewCaptureDuePaymentsJob(interval time.Duration, captureDuePayments usecases.ICaptureDuePayments) CaptureDuePaymentsJob {
+	return CaptureDuePaymentsJob{
+		interval:           interval,
+		captureDuePayments: captureDuePayments,
+		logger:             slog.New(slog.NewJSONHandler(os.Stderr, nil)),
+	}
+}
+
+func (c *CaptureDuePaymentsJob) Start(ctx context.Context) {
+	runPeriodically(ctx, c.interval, c.Run)
+}
+
+func (c *CaptureDuePaymentsJob) Run() {
+	output, err := c.captureDuePayments.Execute(usecases.CaptureDuePaymentsInput{
+		Date: time.Now().UTC().Truncate(24 * time.Hour),
+	})
+
+	if err != nil {
+		c.logger.LogAttrs(context.Background(), slog.LevelError, "Capture Due Payments Failed",
+			slog.String("error_message", err.Error()),
+		)
+		return
+	}
+
+	if output.Captured > 0 || output.Failed > 0 {
+		c.logger.LogAttrs(context.Background(), slog.LevelInfo, "Capture Due Payments",
+			slog.Uint64("captured", uint64(output.Captured)),
+			slog.Uint64("failed", uint64(output.Failed)),
+		)
+	}
+}
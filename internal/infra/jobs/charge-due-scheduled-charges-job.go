@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/usecases"
+)
+
+type ChargeDueScheduledChargesJob struct {
+	interval                  time.Duration
+	chargeDueScheduledCharges usecases.IChargeDueScheduledCharges
+	logger                    *slog.Logger
+}
+
+func NewChargeDueScheduledChargesJob(interval time.Duration,
+	chargeDueScheduledCharges usecases.IChargeDueScheduledCharges) ChargeDueScheduledChargesJob {
+	return ChargeDueScheduledChargesJob{
+		interval:                  interval,
+		chargeDueScheduledCharges: chargeDueScheduledCharges,
+		logger:                    slog.New(slog.NewJSONHandler(os.Stderr, nil)),
+	}
+}
+
+func (c *ChargeDueScheduledChargesJob) Start(ctx context.Context) {
+	runPeriodically(ctx, c.interval, c.Run)
+}
+
+func (c *ChargeDueScheduledChargesJob) Run() {
+	output, err := c.chargeDueScheduledCharges.Execute(usecases.ChargeDueScheduledChargesInput{
+		Now: time.Now().UTC(),
+	})
+
+	if err != nil {
+		c.logger.LogAttrs(context.Background(), slog.LevelError, "Charge Due Scheduled Charges Failed",
+			slog.String("error_message", err.Error()),
+		)
+		return
+	}
+
+	if output.Paid > 0 || output.Retrying > 0 || output.Failed > 0 {
+		c.logger.LogAttrs(context.Background(), slog.LevelInfo, "Charge Due Scheduled Charges",
+			slog.Uint64("paid", uint64(output.Paid)),
+			slog.Uint64("retrying", uint64(output.Retrying)),
+			slog.Uint64("failed", uint64(output.Failed)),
+			slog.Uint64("cancelled_bookings", uint64(output.CancelledBookings)),
+		)
+	}
+}
@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically calls run right away and then once every interval until ctx is done.
+func runPeriodically(ctx context.Context, interval time.Duration, run func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	run()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run()
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/addon"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AddOnsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (a *AddOnsRepository) Create(addOn addon.AddOn) error {
+	_, err := a.Pool.Exec(context.Background(), "INSERT INTO add_ons (id, code, name, price, unit) VALUES ($1, $2, $3, $4, $5)",
+		addOn.Id.String(), addOn.Code, addOn.Name, addOn.Price, addOn.Unit)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AddOnsRepository) ExistsByCode(code string) (bool, error) {
+	var exists bool
+	err := a.Pool.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM add_ons WHERE code = $1)", code).Scan(&exists)
+
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (a *AddOnsRepository) FindAll() ([]addon.AddOn, error) {
+	return a.query("SELECT id, code, name, price, unit FROM add_ons ORDER BY code")
+}
+
+func (a *AddOnsRepository) FindAllByCodes(codes []string) ([]addon.AddOn, error) {
+	return a.query("SELECT id, code, name, price, unit FROM add_ons WHERE code = ANY($1::text[]) ORDER BY code", codes)
+}
+
+func (a *AddOnsRepository) query(sql string, args ...any) ([]addon.AddOn, error) {
+	rows, err := a.Pool.Query(context.Background(), sql, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	addOns := []addon.AddOn{}
+	for rows.Next() {
+		var addOn addon.AddOn
+		err := rows.Scan(&addOn.Id, &addOn.Code, &addOn.Name, &addOn.Price, &addOn.Unit)
+
+		if err != nil {
+			return nil, err
+		}
+
+		addOns = append(addOns, addOn)
+	}
+
+	return addOns, rows.Err()
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ApiKeysRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (a *ApiKeysRepository) Create(apiKey apikey.ApiKey) error {
+	_, err := a.Pool.Exec(context.Background(), `INSERT INTO api_keys
+		(id, name, prefix, hashed_secret, scopes, created_by_staff_user_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		apiKey.Id.String(), apiKey.Name, apiKey.Prefix, apiKey.HashedSecret, apiKey.Scopes,
+		nullableUuid(apiKey.CreatedByStaffUserId), nullableTime(apiKey.ExpiresAt), apiKey.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *ApiKeysRepository) FindOneById(id uuid.UUID) (*apikey.ApiKey, error) {
+	return a.findOne("id = $1", id.String())
+}
+
+func (a *ApiKeysRepository) FindOneByPrefix(prefix string) (*apikey.ApiKey, error) {
+	return a.findOne("prefix = $1", prefix)
+}
+
+func (a *ApiKeysRepository) findOne(condition string, value string) (*apikey.ApiKey, error) {
+	var apiKey apikey.ApiKey
+	var createdByStaffUserId *uuid.UUID
+	var expiresAt, lastUsedAt, revokedAt *time.Time
+
+	err := a.Pool.QueryRow(context.Background(), `SELECT id, name, prefix, hashed_secret, scopes,
+			created_by_staff_user_id, expires_at, last_used_at, revoked_at, created_at
+		FROM api_keys WHERE `+condition, value).
+		Scan(&apiKey.Id, &apiKey.Name, &apiKey.Prefix, &apiKey.HashedSecret, &apiKey.Scopes, &createdByStaffUserId,
+			&expiresAt, &lastUsedAt, &revokedAt, &apiKey.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if createdByStaffUserId != nil {
+		apiKey.CreatedByStaffUserId = *createdByStaffUserId
+	}
+
+	if expiresAt != nil {
+		apiKey.ExpiresAt = *expiresAt
+	}
+
+	if lastUsedAt != nil {
+		apiKey.LastUsedAt = *lastUsedAt
+	}
+
+	if revokedAt != nil {
+		apiKey.RevokedAt = *revokedAt
+	}
+
+	return &apiKey, nil
+}
+
+func (a *ApiKeysRepository) UpdateLastUsedAt(id uuid.UUID, lastUsedAt time.Time) error {
+	_, err := a.Pool.Exec(context.Background(), `UPDATE api_keys SET last_used_at = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $2)`, id.String(), lastUsedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *ApiKeysRepository) Revoke(apiKey apikey.ApiKey) error {
+	commandTag, err := a.Pool.Exec(context.Background(), `UPDATE api_keys SET revoked_at = $2
+		WHERE id = $1 AND revoked_at IS NULL`, apiKey.Id.String(), apiKey.RevokedAt)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("api key is already revoked")
+	}
+
+	return nil
+}
+
+func nullableTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,145 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type ApiKeysRepositorySuite struct {
+	suite.Suite
+	pool              *pgxpool.Pool
+	postgresContainer testcontainers.Container
+	apiKeysRepository repositories.ApiKeysRepository
+}
+
+func (a *ApiKeysRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	a.Require().NoError(err)
+
+	a.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	a.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	a.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	a.Require().NoError(err)
+
+	a.pool = pool
+	a.apiKeysRepository = repositories.ApiKeysRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	a.Require().NoError(err)
+}
+
+func (a *ApiKeysRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := a.pool.Exec(ctx, "TRUNCATE TABLE api_keys CASCADE")
+	a.Require().NoError(err)
+}
+
+func (a *ApiKeysRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := a.postgresContainer.Terminate(ctx)
+	a.Require().NoError(err)
+
+	a.pool.Close()
+}
+
+func (a *ApiKeysRepositorySuite) TestCreate_OnNoErrors_FindsKeyByItsPrefix() {
+	apiKey, plainKey, err := apikey.NewApiKey("Channel manager", []string{"bookings:read"}, time.Time{}, uuid.Nil)
+	a.Require().NoError(err)
+
+	err = a.apiKeysRepository.Create(apiKey)
+	a.Require().NoError(err)
+
+	prefix, secret, ok := apikey.SplitApiKey(plainKey)
+	a.Require().True(ok)
+	foundKey, err := a.apiKeysRepository.FindOneByPrefix(prefix)
+	a.Require().NoError(err)
+	a.Require().NotNil(foundKey)
+	a.Equal(apiKey.Id, foundKey.Id)
+	a.Equal([]string{"bookings:read"}, foundKey.Scopes)
+	a.True(foundKey.ExpiresAt.IsZero())
+	a.NoError(foundKey.Verify(secret, time.Now().UTC()))
+}
+
+func (a *ApiKeysRepositorySuite) TestUpdateLastUsedAt_OnOlderTimestamp_KeepsTheLatestOne() {
+	apiKey, _, err := apikey.NewApiKey("Channel manager", []string{"bookings:read"}, time.Time{}, uuid.Nil)
+	a.Require().NoError(err)
+	err = a.apiKeysRepository.Create(apiKey)
+	a.Require().NoError(err)
+	latestUse := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	err = a.apiKeysRepository.UpdateLastUsedAt(apiKey.Id, latestUse)
+	a.Require().NoError(err)
+	err = a.apiKeysRepository.UpdateLastUsedAt(apiKey.Id, latestUse.Add(-time.Hour))
+	a.Require().NoError(err)
+
+	foundKey, err := a.apiKeysRepository.FindOneById(apiKey.Id)
+	a.Require().NoError(err)
+	a.True(latestUse.Equal(foundKey.LastUsedAt))
+}
+
+func (a *ApiKeysRepositorySuite) TestRevoke_OnKeyAlreadyRevoked_ReturnsError() {
+	apiKey, _, err := apikey.NewApiKey("Channel manager", []string{"bookings:read"}, time.Time{}, uuid.Nil)
+	a.Require().NoError(err)
+	err = a.apiKeysRepository.Create(apiKey)
+	a.Require().NoError(err)
+	err = apiKey.Revoke(time.Now())
+	a.Require().NoError(err)
+	err = a.apiKeysRepository.Revoke(apiKey)
+	a.Require().NoError(err)
+
+	err = a.apiKeysRepository.Revoke(apiKey)
+
+	a.EqualError(err, "api key is already revoked")
+}
+
+func TestApiKeysRepository(t *testing.T) {
+	suite.Run(t, new(ApiKeysRepositorySuite))
+}
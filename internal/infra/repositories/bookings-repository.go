@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BookingsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (b *BookingsRepository) Create(booking booking.Booking) error {
+	return b.CreateWithPayments(booking, nil, nil)
+}
+
+func (b *BookingsRepository) CreateWithPayments(booking booking.Booking, payments []payment.Payment,
+	scheduledCharges []payment.ScheduledCharge) error {
+	ctx := context.Background()
+	tx, err := b.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = insertBooking(ctx, tx, booking)
+
+	if err != nil {
+		return err
+	}
+
+	for _, payment := range payments {
+		err = insertPayment(ctx, tx, payment)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, scheduledCharge := range scheduledCharges {
+		err = insertScheduledCharge(ctx, tx, scheduledCharge)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertBooking(ctx context.Context, tx pgx.Tx, booking booking.Booking) error {
+	if booking.Status != "CANCELLED" {
+		// The transaction holds a pooled connection of its own, so locking the rooms serializes concurrent
+		// bookings of the same room and the overlap check below sees every booking committed before this one.
+		_, err := tx.Exec(ctx, "SELECT id FROM rooms WHERE id = ANY($1::uuid[]) ORDER BY id FOR UPDATE",
+			roomIdStrings(booking.RoomIds))
+
+		if err != nil {
+			return err
+		}
+
+		var overlaps bool
+		err = tx.QueryRow(ctx, existsOverlappingQuery, roomIdStrings(booking.RoomIds), booking.CheckIn, booking.CheckOut).
+			Scan(&overlaps)
+
+		if err != nil {
+			return err
+		}
+
+		if overlaps {
+			return errors.New("one or more rooms are not available for the selected dates")
+		}
+	}
+
+	var promoCode *string
+	if booking.PromoCode != "" {
+		promoCode = &booking.PromoCode
+	}
+
+	var packageId *string
+	if booking.PackageId != uuid.Nil {
+		id := booking.PackageId.String()
+		packageId = &id
+	}
+
+	childrenAges := []int32{}
+	for _, age := range booking.Guests.ChildrenAges {
+		childrenAges = append(childrenAges, int32(age))
+	}
+
+	_, err := tx.Exec(ctx, `INSERT INTO bookings
+		(id, customer_id, check_in, check_out, adults, children_ages, package_id, promo_code, total_price, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		booking.Id.String(), booking.CustomerId.String(), booking.CheckIn, booking.CheckOut, booking.Guests.Adults,
+		childrenAges, packageId, promoCode, booking.TotalPrice, booking.Status)
+
+	if err != nil {
+		return err
+	}
+
+	for _, roomId := range booking.RoomIds {
+		_, err = tx.Exec(ctx, "INSERT INTO booking_rooms (booking_id, room_id) VALUES ($1, $2)", booking.Id.String(), roomId.String())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, addOn := range booking.AddOns {
+		_, err = tx.Exec(ctx, "INSERT INTO booking_add_ons (booking_id, add_on_code, quantity, price) VALUES ($1, $2, $3, $4)",
+			booking.Id.String(), addOn.Code, addOn.Quantity, addOn.Price)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const updateBookingQuery = `UPDATE bookings
+	SET check_out = $2, total_price = $3, status = $4, updated_at = CURRENT_TIMESTAMP
+	WHERE id = $1`
+
+func (b *BookingsRepository) Update(booking booking.Booking) error {
+	_, err := b.Pool.Exec(context.Background(), updateBookingQuery,
+		booking.Id.String(), booking.CheckOut, booking.TotalPrice, booking.Status)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *BookingsRepository) FindOneById(bookingId uuid.UUID) (*booking.Booking, error) {
+	ctx := context.Background()
+
+	var foundBooking booking.Booking
+	var childrenAges []int32
+	var packageId *uuid.UUID
+	var promoCode *string
+	err := b.Pool.QueryRow(ctx, `SELECT id, customer_id, check_in, check_out, adults, COALESCE(children_ages, '{}'), package_id,
+			promo_code, total_price, status
+		FROM bookings WHERE id = $1`, bookingId.String()).
+		Scan(&foundBooking.Id, &foundBooking.CustomerId, &foundBooking.CheckIn, &foundBooking.CheckOut, &foundBooking.Guests.Adults,
+			&childrenAges, &packageId, &promoCode, &foundBooking.TotalPrice, &foundBooking.Status)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	foundBooking.Guests.ChildrenAges = []uint8{}
+	for _, age := range childrenAges {
+		foundBooking.Guests.ChildrenAges = append(foundBooking.Guests.ChildrenAges, uint8(age))
+	}
+
+	if packageId != nil {
+		foundBooking.PackageId = *packageId
+	}
+
+	if promoCode != nil {
+		foundBooking.PromoCode = *promoCode
+	}
+
+	roomRows, err := b.Pool.Query(ctx, "SELECT room_id FROM booking_rooms WHERE booking_id = $1", bookingId.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	foundBooking.RoomIds, err = pgx.CollectRows(roomRows, pgx.RowTo[uuid.UUID])
+
+	if err != nil {
+		return nil, err
+	}
+
+	addOnRows, err := b.Pool.Query(ctx, "SELECT add_on_code, quantity, price FROM booking_add_ons WHERE booking_id = $1",
+		bookingId.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	foundBooking.AddOns, err = pgx.CollectRows(addOnRows, func(row pgx.CollectableRow) (booking.BookingAddOn, error) {
+		var addOn booking.BookingAddOn
+		err := row.Scan(&addOn.Code, &addOn.Quantity, &addOn.Price)
+		return addOn, err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundBooking, nil
+}
+
+const existsOverlappingQuery = `SELECT EXISTS (
+		SELECT 1 FROM booking_rooms br
+		JOIN bookings b ON b.id = br.booking_id
+		WHERE br.room_id = ANY($1::uuid[]) AND b.status <> 'CANCELLED' AND b.check_in < $3 AND b.check_out > $2
+	)`
+
+func (b *BookingsRepository) ExistsOverlapping(roomIds []uuid.UUID, checkIn time.Time, checkOut time.Time) (bool, error) {
+	var exists bool
+	err := b.Pool.QueryRow(context.Background(), existsOverlappingQuery, roomIdStrings(roomIds), checkIn, checkOut).
+		Scan(&exists)
+
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (b *BookingsRepository) FindOccupancy(from time.Time, to time.Time) ([]pricing.Occupancy, error) {
+	rows, err := b.Pool.Query(context.Background(), `SELECT r.type, d.date::date, COUNT(*),
+			COUNT(*) FILTER (WHERE EXISTS (
+				SELECT 1 FROM booking_rooms br
+				JOIN bookings b ON b.id = br.booking_id
+				WHERE br.room_id = r.id AND b.status <> 'CANCELLED' AND b.check_in <= d.date AND b.check_out > d.date
+			))
+		FROM generate_series($1::date, $2::date - 1, interval '1 day') AS d(date)
+		CROSS JOIN rooms r
+		GROUP BY r.type, d.date
+		ORDER BY d.date, r.type`, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	occupancies := []pricing.Occupancy{}
+	for rows.Next() {
+		var occupancy pricing.Occupancy
+		err := rows.Scan(&occupancy.RoomType, &occupancy.Date, &occupancy.TotalRooms, &occupancy.BookedRooms)
+
+		if err != nil {
+			return nil, err
+		}
+
+		occupancies = append(occupancies, occupancy)
+	}
+
+	return occupancies, rows.Err()
+}
+
+func roomIdStrings(roomIds []uuid.UUID) []string {
+	ids := []string{}
+	for _, roomId := range roomIds {
+		ids = append(ids, roomId.String())
+	}
+
+	return ids
+}
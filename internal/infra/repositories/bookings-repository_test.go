@@ -0,0 +1,299 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/guests"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type BookingsRepositorySuite struct {
+	suite.Suite
+	pool               *pgxpool.Pool
+	postgresContainer  testcontainers.Container
+	bookingsRepository repositories.BookingsRepository
+}
+
+func (b *BookingsRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	b.Require().NoError(err)
+
+	b.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	b.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	b.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	b.Require().NoError(err)
+
+	b.pool = pool
+	b.bookingsRepository = repositories.BookingsRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	b.Require().NoError(err)
+}
+
+func (b *BookingsRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := b.pool.Exec(ctx, "TRUNCATE TABLE booking_rooms, bookings, rooms, customers CASCADE")
+	b.Require().NoError(err)
+	_, err = b.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	b.Require().NoError(err)
+	_, err = b.pool.Exec(ctx, "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+		"849702fc-aad3-478f-9dd7-9963b4ca33ca", "101", "SUITE", 2, 250)
+	b.Require().NoError(err)
+}
+
+func (b *BookingsRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := b.postgresContainer.Terminate(ctx)
+	b.Require().NoError(err)
+
+	b.pool.Close()
+}
+
+func (b *BookingsRepositorySuite) TestCreate_OnNoErrors_ReturnsNil() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+
+	err := b.bookingsRepository.Create(booking.Booking{
+		Id:         bookingId,
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:    []uuid.UUID{roomId},
+		CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{}},
+		PromoCode:  "",
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	})
+	b.Require().NoError(err)
+
+	var totalPrice uint64
+	var status string
+	var promoCode *string
+	err = b.pool.QueryRow(context.Background(), "SELECT total_price, status, promo_code FROM bookings WHERE id = $1", bookingId).
+		Scan(&totalPrice, &status, &promoCode)
+	b.Require().NoError(err)
+	b.Equal(uint64(500), totalPrice)
+	b.Equal("CONFIRMED", status)
+	b.Nil(promoCode)
+
+	var bookedRoomId uuid.UUID
+	err = b.pool.QueryRow(context.Background(), "SELECT room_id FROM booking_rooms WHERE booking_id = $1", bookingId).
+		Scan(&bookedRoomId)
+	b.Require().NoError(err)
+	b.Equal(roomId, bookedRoomId)
+}
+
+func (b *BookingsRepositorySuite) TestCreateWithPayments_OnNoErrors_StoresBookingAndPayment() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	deposit, err := payment.NewDepositPayment(bookingId, 150, time.Date(2030, 5, 31, 0, 0, 0, 0, time.UTC))
+	b.Require().NoError(err)
+
+	err = b.bookingsRepository.CreateWithPayments(booking.Booking{
+		Id:         bookingId,
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:    []uuid.UUID{uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")},
+		CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{}},
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	}, []payment.Payment{deposit}, nil)
+	b.Require().NoError(err)
+
+	var amount uint64
+	err = b.pool.QueryRow(context.Background(), "SELECT amount FROM payments WHERE booking_id = $1", bookingId).Scan(&amount)
+	b.Require().NoError(err)
+	b.Equal(uint64(150), amount)
+}
+
+func (b *BookingsRepositorySuite) TestCreateWithPayments_OnScheduledChargeError_StoresNothing() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	scheduledCharge, err := payment.NewScheduledCharge(bookingId, payment.PlannedCharge{
+		Sequence: 1,
+		Amount:   500,
+		DueOn:    time.Date(2030, 5, 27, 0, 0, 0, 0, time.UTC),
+		Due:      "BEFORE_ARRIVAL",
+	}, "tok_visa")
+	b.Require().NoError(err)
+
+	err = b.bookingsRepository.CreateWithPayments(booking.Booking{
+		Id:         bookingId,
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:    []uuid.UUID{uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")},
+		CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{}},
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	}, nil, []payment.ScheduledCharge{scheduledCharge, scheduledCharge})
+	b.Require().Error(err)
+
+	foundBooking, err := b.bookingsRepository.FindOneById(bookingId)
+	b.Require().NoError(err)
+	b.Nil(foundBooking)
+}
+
+func (b *BookingsRepositorySuite) TestExistsOverlapping_OnOverlappingBooking_ReturnsTrue() {
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	err := b.bookingsRepository.Create(booking.Booking{
+		Id:         uuid.New(),
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:    []uuid.UUID{roomId},
+		CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{}},
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	})
+	b.Require().NoError(err)
+
+	exists, err := b.bookingsRepository.ExistsOverlapping([]uuid.UUID{roomId},
+		time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC), time.Date(2030, 6, 4, 0, 0, 0, 0, time.UTC))
+	b.Require().NoError(err)
+
+	b.True(exists)
+}
+
+func (b *BookingsRepositorySuite) TestExistsOverlapping_OnBackToBackBooking_ReturnsFalse() {
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	err := b.bookingsRepository.Create(booking.Booking{
+		Id:         uuid.New(),
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:    []uuid.UUID{roomId},
+		CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{}},
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	})
+	b.Require().NoError(err)
+
+	exists, err := b.bookingsRepository.ExistsOverlapping([]uuid.UUID{roomId},
+		time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC), time.Date(2030, 6, 5, 0, 0, 0, 0, time.UTC))
+	b.Require().NoError(err)
+
+	b.False(exists)
+}
+
+func (b *BookingsRepositorySuite) TestCreate_OnConcurrentOverlappingBookings_StoresOnlyOne() {
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	errs := make(chan error, 5)
+
+	for range 5 {
+		go func() {
+			errs <- b.bookingsRepository.Create(booking.Booking{
+				Id:         uuid.New(),
+				CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+				RoomIds:    []uuid.UUID{roomId},
+				CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+				CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+				Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{}},
+				TotalPrice: 500,
+				Status:     "CONFIRMED",
+			})
+		}()
+	}
+
+	stored := 0
+	for range 5 {
+		if err := <-errs; err != nil {
+			b.EqualError(err, "one or more rooms are not available for the selected dates")
+			continue
+		}
+
+		stored++
+	}
+
+	b.Equal(1, stored)
+
+	var count int
+	err := b.pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM booking_rooms WHERE room_id = $1", roomId).Scan(&count)
+	b.Require().NoError(err)
+	b.Equal(1, count)
+}
+
+func (b *BookingsRepositorySuite) TestFindOneById_AfterUpdate_ReturnsBookingWithNewStatus() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	roomId := uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca")
+	createdBooking := booking.Booking{
+		Id:         bookingId,
+		CustomerId: uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"),
+		RoomIds:    []uuid.UUID{roomId},
+		CheckIn:    time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:   time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC),
+		Guests:     guests.Guests{Adults: 2, ChildrenAges: []uint8{5}},
+		AddOns:     []booking.BookingAddOn{},
+		TotalPrice: 500,
+		Status:     "CONFIRMED",
+	}
+	err := b.bookingsRepository.Create(createdBooking)
+	b.Require().NoError(err)
+
+	createdBooking.Cancel()
+	err = b.bookingsRepository.Update(createdBooking)
+	b.Require().NoError(err)
+
+	foundBooking, err := b.bookingsRepository.FindOneById(bookingId)
+	b.Require().NoError(err)
+	b.Equal(createdBooking, *foundBooking)
+}
+
+func (b *BookingsRepositorySuite) TestFindOneById_OnBookingNotFound_ReturnsNil() {
+	foundBooking, err := b.bookingsRepository.FindOneById(uuid.New())
+	b.Require().NoError(err)
+
+	b.Nil(foundBooking)
+}
+
+func TestBookingsRepository(t *testing.T) {
+	suite.Run(t, new(BookingsRepositorySuite))
+}
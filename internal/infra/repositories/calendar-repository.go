@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/calendar"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CalendarRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (cr *CalendarRepository) FindDays(roomType string, from time.Time, to time.Time) ([]calendar.CalendarDay, error) {
+	rows, err := cr.Pool.Query(context.Background(), `SELECT d.date::date, COUNT(r.id),
+			COUNT(r.id) FILTER (WHERE NOT booked.is_booked),
+			COALESCE(MIN(r.price) FILTER (WHERE NOT booked.is_booked), 0),
+			COALESCE(sr.min_stay_nights, 1), COALESCE(sr.closed_to_arrival, FALSE), COALESCE(sr.closed_to_departure, FALSE)
+		FROM generate_series($2::date, $3::date, interval '1 day') AS d(date)
+		LEFT JOIN rooms r ON r.type = $1
+		LEFT JOIN LATERAL (
+			SELECT EXISTS (
+				SELECT 1 FROM booking_rooms br
+				JOIN bookings b ON b.id = br.booking_id
+				WHERE br.room_id = r.id AND b.status <> 'CANCELLED' AND b.check_in <= d.date AND b.check_out > d.date
+			) AS is_booked
+		) booked ON TRUE
+		LEFT JOIN stay_restrictions sr ON sr.room_type = $1 AND sr.date = d.date
+		GROUP BY d.date, sr.min_stay_nights, sr.closed_to_arrival, sr.closed_to_departure
+		ORDER BY d.date`, roomType, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	days := []calendar.CalendarDay{}
+	for rows.Next() {
+		day := calendar.CalendarDay{
+			Restriction: calendar.StayRestriction{RoomType: roomType},
+		}
+		err := rows.Scan(&day.Date, &day.TotalRooms, &day.AvailableRooms, &day.LowestBaseRate, &day.Restriction.MinStayNights,
+			&day.Restriction.ClosedToArrival, &day.Restriction.ClosedToDeparture)
+
+		if err != nil {
+			return nil, err
+		}
+
+		day.Restriction.Date = day.Date
+		days = append(days, day)
+	}
+
+	return days, rows.Err()
+}
+
+func (cr *CalendarRepository) SaveRestrictions(restrictions []calendar.StayRestriction) error {
+	ctx := context.Background()
+	tx, err := cr.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, restriction := range restrictions {
+		_, err = tx.Exec(ctx, `INSERT INTO stay_restrictions (room_type, date, min_stay_nights, closed_to_arrival, closed_to_departure)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (room_type, date) DO UPDATE
+			SET min_stay_nights = EXCLUDED.min_stay_nights, closed_to_arrival = EXCLUDED.closed_to_arrival,
+				closed_to_departure = EXCLUDED.closed_to_departure, updated_at = CURRENT_TIMESTAMP`,
+			restriction.RoomType, restriction.Date, restriction.MinStayNights, restriction.ClosedToArrival, restriction.ClosedToDeparture)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CreditEntriesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (c *CreditEntriesRepository) Append(entry credit.CreditEntry) (credit.CreditEntry, error) {
+	ctx := context.Background()
+	tx, err := c.Pool.Begin(ctx)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	entry, err = appendCreditEntry(ctx, tx, entry)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (c *CreditEntriesRepository) FindAllByCustomerId(customerId uuid.UUID) ([]credit.CreditEntry, error) {
+	rows, err := c.Pool.Query(context.Background(), `SELECT id, customer_id, type, amount, balance_after,
+			COALESCE(reference, '00000000-0000-0000-0000-000000000000'), description, created_at
+		FROM credit_entries WHERE customer_id = $1 ORDER BY position`, customerId.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (credit.CreditEntry, error) {
+		var entry credit.CreditEntry
+		err := row.Scan(&entry.Id, &entry.CustomerId, &entry.Type, &entry.Amount, &entry.BalanceAfter, &entry.Reference,
+			&entry.Description, &entry.CreatedAt)
+		return entry, err
+	})
+}
+
+// appendCreditEntry serializes writers of the same customer with an advisory lock and chains the entry to the
+// latest one. The table trigger rejects any entry whose balance does not follow from the previous entry.
+func appendCreditEntry(ctx context.Context, tx pgx.Tx, entry credit.CreditEntry) (credit.CreditEntry, error) {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", entry.CustomerId.String())
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	var position uint64
+	var balance uint64
+	err = tx.QueryRow(ctx, `SELECT position, balance_after FROM credit_entries WHERE customer_id = $1
+		ORDER BY position DESC LIMIT 1`, entry.CustomerId.String()).Scan(&position, &balance)
+
+	if err != nil && err.Error() != "no rows in result set" {
+		return credit.CreditEntry{}, err
+	}
+
+	err = entry.Apply(balance)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	var reference *string
+	if entry.Reference != uuid.Nil {
+		id := entry.Reference.String()
+		reference = &id
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO credit_entries
+		(id, customer_id, position, type, amount, balance_after, reference, description, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		entry.Id.String(), entry.CustomerId.String(), position+1, entry.Type, entry.Amount, entry.BalanceAfter, reference,
+		entry.Description, entry.CreatedAt)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	return entry, nil
+}
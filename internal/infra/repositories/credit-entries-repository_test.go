@@ -0,0 +1,149 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type CreditEntriesRepositorySuite struct {
+	suite.Suite
+	pool                    *pgxpool.Pool
+	postgresContainer       testcontainers.Container
+	creditEntriesRepository repositories.CreditEntriesRepository
+}
+
+func (c *CreditEntriesRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	c.Require().NoError(err)
+
+	c.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	c.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	c.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	c.Require().NoError(err)
+
+	c.pool = pool
+	c.creditEntriesRepository = repositories.CreditEntriesRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	c.Require().NoError(err)
+}
+
+func (c *CreditEntriesRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := c.pool.Exec(ctx, "TRUNCATE TABLE credit_entries, customers CASCADE")
+	c.Require().NoError(err)
+	_, err = c.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	c.Require().NoError(err)
+}
+
+func (c *CreditEntriesRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := c.postgresContainer.Terminate(ctx)
+	c.Require().NoError(err)
+
+	c.pool.Close()
+}
+
+func (c *CreditEntriesRepositorySuite) TestAppend_OnNoErrors_ChainsBalanceFromThePreviousEntry() {
+	customerId := uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	creditEntry, err := credit.NewCredit(customerId, "ADJUSTMENT", 100, uuid.Nil, "Goodwill credit")
+	c.Require().NoError(err)
+	_, err = c.creditEntriesRepository.Append(creditEntry)
+	c.Require().NoError(err)
+
+	debitEntry, err := credit.NewDebit(customerId, 30, uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"))
+	c.Require().NoError(err)
+	appendedEntry, err := c.creditEntriesRepository.Append(debitEntry)
+	c.Require().NoError(err)
+	c.Equal(uint64(70), appendedEntry.BalanceAfter)
+
+	entries, err := c.creditEntriesRepository.FindAllByCustomerId(customerId)
+	c.Require().NoError(err)
+	c.Len(entries, 2)
+	c.Equal(uint64(100), entries[0].BalanceAfter)
+	c.Equal(int64(-30), entries[1].Amount)
+	c.Equal(uint64(70), entries[1].BalanceAfter)
+	c.Equal(uint64(70), credit.Balance(entries))
+}
+
+func (c *CreditEntriesRepositorySuite) TestAppend_OnDebitAboveBalance_ReturnsError() {
+	customerId := uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	creditEntry, err := credit.NewCredit(customerId, "ADJUSTMENT", 20, uuid.Nil, "Goodwill credit")
+	c.Require().NoError(err)
+	_, err = c.creditEntriesRepository.Append(creditEntry)
+	c.Require().NoError(err)
+
+	debitEntry, err := credit.NewDebit(customerId, 30, uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"))
+	c.Require().NoError(err)
+	_, err = c.creditEntriesRepository.Append(debitEntry)
+
+	c.EqualError(err, "insufficient credit balance")
+	entries, err := c.creditEntriesRepository.FindAllByCustomerId(customerId)
+	c.Require().NoError(err)
+	c.Len(entries, 1)
+}
+
+func (c *CreditEntriesRepositorySuite) TestAppend_OnEntryChangedAfterwards_IsRejectedByTheLedger() {
+	customerId := uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38")
+	creditEntry, err := credit.NewCredit(customerId, "ADJUSTMENT", 100, uuid.Nil, "Goodwill credit")
+	c.Require().NoError(err)
+	_, err = c.creditEntriesRepository.Append(creditEntry)
+	c.Require().NoError(err)
+
+	_, err = c.pool.Exec(context.Background(), "UPDATE credit_entries SET balance_after = 1000 WHERE id = $1", creditEntry.Id)
+
+	c.ErrorContains(err, "credit entries are append-only")
+}
+
+func TestCreditEntriesRepository(t *testing.T) {
+	suite.Run(t, new(CreditEntriesRepositorySuite))
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CustomerIdentitiesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (c *CustomerIdentitiesRepository) Create(customerIdentity account.CustomerIdentity) error {
+	_, err := c.Pool.Exec(context.Background(), `INSERT INTO customer_identities
+		(id, customer_id, provider, subject, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		customerIdentity.Id.String(), customerIdentity.CustomerId.String(), customerIdentity.Provider,
+		customerIdentity.Subject, customerIdentity.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CustomerIdentitiesRepository) FindOne(provider string, subject string) (*account.CustomerIdentity, error) {
+	var customerIdentity account.CustomerIdentity
+
+	err := c.Pool.QueryRow(context.Background(), `SELECT id, customer_id, provider, subject, created_at
+		FROM customer_identities WHERE provider = $1 AND subject = $2`, provider, subject).
+		Scan(&customerIdentity.Id, &customerIdentity.CustomerId, &customerIdentity.Provider, &customerIdentity.Subject,
+			&customerIdentity.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &customerIdentity, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExtraGuestRatesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (e *ExtraGuestRatesRepository) Save(rate pricing.ExtraGuestRate) error {
+	_, err := e.Pool.Exec(context.Background(), `INSERT INTO extra_guest_rates
+		(room_type, base_occupancy, extra_adult_price, extra_child_price, child_max_age)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_type) DO UPDATE
+		SET base_occupancy = EXCLUDED.base_occupancy, extra_adult_price = EXCLUDED.extra_adult_price,
+			extra_child_price = EXCLUDED.extra_child_price, child_max_age = EXCLUDED.child_max_age, updated_at = CURRENT_TIMESTAMP`,
+		rate.RoomType, rate.BaseOccupancy, rate.ExtraAdultPrice, rate.ExtraChildPrice, rate.ChildMaxAge)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *ExtraGuestRatesRepository) FindAll() ([]pricing.ExtraGuestRate, error) {
+	rows, err := e.Pool.Query(context.Background(), `SELECT room_type, base_occupancy, extra_adult_price, extra_child_price, child_max_age
+		FROM extra_guest_rates`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rates := []pricing.ExtraGuestRate{}
+	for rows.Next() {
+		var rate pricing.ExtraGuestRate
+		err := rows.Scan(&rate.RoomType, &rate.BaseOccupancy, &rate.ExtraAdultPrice, &rate.ExtraChildPrice, &rate.ChildMaxAge)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
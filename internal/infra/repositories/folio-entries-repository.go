@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/folio"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FolioEntriesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (f *FolioEntriesRepository) Create(entry folio.FolioEntry) error {
+	_, err := f.Pool.Exec(context.Background(), `INSERT INTO folio_entries
+		(id, booking_id, type, category, description, amount, status, void_reason, posted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		entry.Id.String(), entry.BookingId.String(), entry.Type, entry.Category, entry.Description, entry.Amount,
+		entry.Status, nullableString(entry.VoidReason), entry.PostedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *FolioEntriesRepository) Update(entry folio.FolioEntry) error {
+	_, err := f.Pool.Exec(context.Background(), `UPDATE folio_entries
+		SET status = $2, void_reason = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		entry.Id.String(), entry.Status, nullableString(entry.VoidReason))
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *FolioEntriesRepository) FindOneById(id uuid.UUID) (*folio.FolioEntry, error) {
+	rows, err := f.Pool.Query(context.Background(), `SELECT id, booking_id, type, category, description, amount, status,
+			COALESCE(void_reason, ''), posted_at
+		FROM folio_entries WHERE id = $1`, id.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := pgx.CollectOneRow(rows, scanFolioEntry)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (f *FolioEntriesRepository) FindAllByBookingId(bookingId uuid.UUID) ([]folio.FolioEntry, error) {
+	rows, err := f.Pool.Query(context.Background(), `SELECT id, booking_id, type, category, description, amount, status,
+			COALESCE(void_reason, ''), posted_at
+		FROM folio_entries WHERE booking_id = $1 ORDER BY posted_at`, bookingId.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanFolioEntry)
+}
+
+func scanFolioEntry(row pgx.CollectableRow) (folio.FolioEntry, error) {
+	var entry folio.FolioEntry
+	err := row.Scan(&entry.Id, &entry.BookingId, &entry.Type, &entry.Category, &entry.Description, &entry.Amount,
+		&entry.Status, &entry.VoidReason, &entry.PostedAt)
+	return entry, err
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/credit"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GiftCardsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (g *GiftCardsRepository) Create(giftCard credit.GiftCard) error {
+	_, err := g.Pool.Exec(context.Background(), `INSERT INTO gift_cards
+		(id, code, amount, balance, status, expires_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		giftCard.Id.String(), giftCard.Code, giftCard.Amount, giftCard.Balance, giftCard.Status, giftCard.ExpiresAt,
+		giftCard.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *GiftCardsRepository) FindOneByCode(code string) (*credit.GiftCard, error) {
+	var giftCard credit.GiftCard
+	var redeemedAt *time.Time
+
+	err := g.Pool.QueryRow(context.Background(), `SELECT id, code, amount, balance, status, expires_at,
+			COALESCE(redeemed_by, '00000000-0000-0000-0000-000000000000'), redeemed_at, created_at
+		FROM gift_cards WHERE code = $1`, code).
+		Scan(&giftCard.Id, &giftCard.Code, &giftCard.Amount, &giftCard.Balance, &giftCard.Status, &giftCard.ExpiresAt,
+			&giftCard.RedeemedBy, &redeemedAt, &giftCard.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if redeemedAt != nil {
+		giftCard.RedeemedAt = *redeemedAt
+	}
+
+	return &giftCard, nil
+}
+
+// Redeem marks the gift card as redeemed and credits the customer in one transaction. The status guard makes a
+// concurrent second redemption fail instead of crediting the balance twice.
+func (g *GiftCardsRepository) Redeem(giftCard credit.GiftCard, entry credit.CreditEntry) (credit.CreditEntry, error) {
+	ctx := context.Background()
+	tx, err := g.Pool.Begin(ctx)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	commandTag, err := tx.Exec(ctx, `UPDATE gift_cards SET balance = $2, status = $3, redeemed_by = $4, redeemed_at = $5
+		WHERE id = $1 AND status = 'ACTIVE'`,
+		giftCard.Id.String(), giftCard.Balance, giftCard.Status, giftCard.RedeemedBy.String(), giftCard.RedeemedAt)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return credit.CreditEntry{}, errors.New("gift card has already been redeemed")
+	}
+
+	entry, err = appendCreditEntry(ctx, tx, entry)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return credit.CreditEntry{}, err
+	}
+
+	return entry, nil
+}
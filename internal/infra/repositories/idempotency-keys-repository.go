@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IdempotencyKeysRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (i *IdempotencyKeysRepository) Reserve(scope string, key string, requestHash string,
+	expiredBefore time.Time) (*repositories.IdempotencyRecordDTO, error) {
+	ctx := context.Background()
+	tx, err := i.Pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, "DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND created_at < $3",
+		scope, key, expiredBefore)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `INSERT INTO idempotency_keys (scope, key, request_hash, created_at)
+		VALUES ($1, $2, $3, $4) ON CONFLICT (scope, key) DO NOTHING`, scope, key, requestHash, time.Now().UTC())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, tx.Commit(ctx)
+	}
+
+	var record repositories.IdempotencyRecordDTO
+	err = tx.QueryRow(ctx, `SELECT scope, key, request_hash, COALESCE(status_code, 0), COALESCE(content_type, ''),
+			COALESCE(body, '')
+		FROM idempotency_keys WHERE scope = $1 AND key = $2`, scope, key).
+		Scan(&record.Scope, &record.Key, &record.RequestHash, &record.StatusCode, &record.ContentType, &record.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, tx.Commit(ctx)
+}
+
+func (i *IdempotencyKeysRepository) Complete(record repositories.IdempotencyRecordDTO) error {
+	_, err := i.Pool.Exec(context.Background(), `UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, body = $5, completed_at = CURRENT_TIMESTAMP
+		WHERE scope = $1 AND key = $2`,
+		record.Scope, record.Key, record.StatusCode, record.ContentType, record.Body)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *IdempotencyKeysRepository) Release(scope string, key string) error {
+	_, err := i.Pool.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2", scope, key)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type InvoicesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+// Issue takes the next number of the property sequence in the same transaction that stores the document, so a
+// failed insert rolls the sequence back and numbers stay gap-free.
+func (i *InvoicesRepository) Issue(newInvoice invoice.Invoice) (invoice.Invoice, error) {
+	ctx := context.Background()
+	tx, err := i.Pool.Begin(ctx)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var sequence uint64
+	err = tx.QueryRow(ctx, `INSERT INTO invoice_sequences (property_code, last_number) VALUES ($1, 1)
+		ON CONFLICT (property_code) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+		RETURNING last_number`, newInvoice.PropertyCode).Scan(&sequence)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	newInvoice.AssignNumber(sequence)
+
+	document, err := json.Marshal(newInvoice)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	var correctsInvoiceId *string
+	if newInvoice.CorrectsInvoiceId != uuid.Nil {
+		id := newInvoice.CorrectsInvoiceId.String()
+		correctsInvoiceId = &id
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO invoices
+		(id, number, property_code, type, booking_id, corrects_invoice_id, gross_total, document, issued_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		newInvoice.Id.String(), newInvoice.Number, newInvoice.PropertyCode, newInvoice.Type, newInvoice.BookingId.String(),
+		correctsInvoiceId, newInvoice.GrossTotal, document, newInvoice.IssuedAt)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "invoices_corrects_invoice_id_key" {
+			return invoice.Invoice{}, errors.New("invoice has already been credited")
+		}
+
+		return invoice.Invoice{}, err
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	return newInvoice, nil
+}
+
+func (i *InvoicesRepository) FindOneById(id uuid.UUID) (*invoice.Invoice, error) {
+	var document []byte
+	err := i.Pool.QueryRow(context.Background(), "SELECT document FROM invoices WHERE id = $1", id.String()).
+		Scan(&document)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var foundInvoice invoice.Invoice
+	err = json.Unmarshal(document, &foundInvoice)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundInvoice, nil
+}
+
+func (i *InvoicesRepository) FindAllByBookingId(bookingId uuid.UUID) ([]invoice.Invoice, error) {
+	rows, err := i.Pool.Query(context.Background(), `SELECT document FROM invoices
+		WHERE booking_id = $1 ORDER BY issued_at, number`, bookingId.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (invoice.Invoice, error) {
+		var document []byte
+		var foundInvoice invoice.Invoice
+
+		err := row.Scan(&document)
+		if err != nil {
+			return invoice.Invoice{}, err
+		}
+
+		err = json.Unmarshal(document, &foundInvoice)
+		return foundInvoice, err
+	})
+}
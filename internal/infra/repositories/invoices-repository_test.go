@@ -0,0 +1,148 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/invoice"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type InvoicesRepositorySuite struct {
+	suite.Suite
+	pool               *pgxpool.Pool
+	postgresContainer  testcontainers.Container
+	invoicesRepository repositories.InvoicesRepository
+}
+
+func (i *InvoicesRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	i.Require().NoError(err)
+
+	i.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	i.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	i.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	i.Require().NoError(err)
+
+	i.pool = pool
+	i.invoicesRepository = repositories.InvoicesRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	i.Require().NoError(err)
+}
+
+func (i *InvoicesRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := i.pool.Exec(ctx, "TRUNCATE TABLE invoices, invoice_sequences, bookings, customers CASCADE")
+	i.Require().NoError(err)
+	_, err = i.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	i.Require().NoError(err)
+	_, err = i.pool.Exec(ctx, `INSERT INTO bookings (id, customer_id, check_in, check_out, adults, total_price, status)
+		VALUES ('0dc94e80-3df8-40c9-8a79-9e9e555abbde', 'aa473b65-90a8-48ad-ab7d-5bd50a806d38', '2030-06-01', '2030-06-05', 2,
+			800, 'CONFIRMED')`)
+	i.Require().NoError(err)
+}
+
+func (i *InvoicesRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := i.postgresContainer.Terminate(ctx)
+	i.Require().NoError(err)
+
+	i.pool.Close()
+}
+
+func (i *InvoicesRepositorySuite) TestIssue_OnNoErrors_NumbersInvoicesSequentiallyPerProperty() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	issuedAt := time.Date(2030, 6, 5, 10, 0, 0, 0, time.UTC)
+
+	firstInvoice, err := i.invoicesRepository.Issue(invoice.Invoice{Id: uuid.New(), PropertyCode: "LIS", Type: "INVOICE",
+		BookingId: bookingId, GrossTotal: 800, IssuedAt: issuedAt})
+	i.Require().NoError(err)
+	secondInvoice, err := i.invoicesRepository.Issue(invoice.Invoice{Id: uuid.New(), PropertyCode: "LIS", Type: "INVOICE",
+		BookingId: bookingId, GrossTotal: 100, IssuedAt: issuedAt.Add(time.Hour)})
+	i.Require().NoError(err)
+
+	i.Equal("LIS-INV-000001", firstInvoice.Number)
+	i.Equal("LIS-INV-000002", secondInvoice.Number)
+
+	invoices, err := i.invoicesRepository.FindAllByBookingId(bookingId)
+	i.Require().NoError(err)
+	i.Len(invoices, 2)
+	i.Equal(firstInvoice.Id, invoices[0].Id)
+	i.Equal("LIS-INV-000001", invoices[0].Number)
+}
+
+func (i *InvoicesRepositorySuite) TestIssue_OnInvoiceAlreadyCredited_ReturnsErrorWithoutUsingANumber() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	issuedInvoice, err := i.invoicesRepository.Issue(invoice.Invoice{Id: uuid.New(), PropertyCode: "LIS", Type: "INVOICE",
+		BookingId: bookingId, GrossTotal: 800, IssuedAt: time.Now().UTC()})
+	i.Require().NoError(err)
+	_, err = i.invoicesRepository.Issue(invoice.Invoice{Id: uuid.New(), PropertyCode: "LIS", Type: "CREDIT_NOTE",
+		BookingId: bookingId, CorrectsInvoiceId: issuedInvoice.Id, GrossTotal: -800, IssuedAt: time.Now().UTC()})
+	i.Require().NoError(err)
+
+	_, err = i.invoicesRepository.Issue(invoice.Invoice{Id: uuid.New(), PropertyCode: "LIS", Type: "CREDIT_NOTE",
+		BookingId: bookingId, CorrectsInvoiceId: issuedInvoice.Id, GrossTotal: -800, IssuedAt: time.Now().UTC()})
+	i.EqualError(err, "invoice has already been credited")
+
+	nextCreditNote, err := i.invoicesRepository.Issue(invoice.Invoice{Id: uuid.New(), PropertyCode: "LIS",
+		Type: "CREDIT_NOTE", BookingId: bookingId, GrossTotal: -100, IssuedAt: time.Now().UTC()})
+	i.Require().NoError(err)
+	i.Equal("LIS-CN-000003", nextCreditNote.Number)
+}
+
+func (i *InvoicesRepositorySuite) TestFindOneById_OnInvoiceNotFound_ReturnsNil() {
+	foundInvoice, err := i.invoicesRepository.FindOneById(uuid.New())
+	i.Require().NoError(err)
+	i.Nil(foundInvoice)
+}
+
+func TestInvoicesRepository(t *testing.T) {
+	suite.Run(t, new(InvoicesRepositorySuite))
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LoginAttemptsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (l *LoginAttemptsRepository) FindOne(scope string, subject string) (*account.LoginAttempts, error) {
+	loginAttempts := account.LoginAttempts{Scope: scope, Subject: subject}
+
+	err := l.Pool.QueryRow(context.Background(), `SELECT failures, last_failed_at FROM login_attempts
+		WHERE scope = $1 AND subject = $2`, scope, subject).
+		Scan(&loginAttempts.Failures, &loginAttempts.LastFailedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &loginAttempts, nil
+}
+
+func (l *LoginAttemptsRepository) RecordFailure(scope string, subject string, failedAt time.Time,
+	resetBefore time.Time) (account.LoginAttempts, error) {
+	loginAttempts := account.LoginAttempts{Scope: scope, Subject: subject}
+
+	err := l.Pool.QueryRow(context.Background(), `INSERT INTO login_attempts (scope, subject, failures, last_failed_at)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (scope, subject) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failed_at < $4 THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failed_at = EXCLUDED.last_failed_at
+		RETURNING failures, last_failed_at`, scope, subject, failedAt, resetBefore).
+		Scan(&loginAttempts.Failures, &loginAttempts.LastFailedAt)
+
+	if err != nil {
+		return account.LoginAttempts{}, err
+	}
+
+	return loginAttempts, nil
+}
+
+func (l *LoginAttemptsRepository) Delete(scope string, subject string) error {
+	_, err := l.Pool.Exec(context.Background(), "DELETE FROM login_attempts WHERE scope = $1 AND subject = $2", scope,
+		subject)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
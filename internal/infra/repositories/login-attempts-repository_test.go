@@ -0,0 +1,139 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type LoginAttemptsRepositorySuite struct {
+	suite.Suite
+	pool                    *pgxpool.Pool
+	postgresContainer       testcontainers.Container
+	loginAttemptsRepository repositories.LoginAttemptsRepository
+}
+
+func (l *LoginAttemptsRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	l.Require().NoError(err)
+
+	l.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	l.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	l.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	l.Require().NoError(err)
+
+	l.pool = pool
+	l.loginAttemptsRepository = repositories.LoginAttemptsRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	l.Require().NoError(err)
+}
+
+func (l *LoginAttemptsRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := l.pool.Exec(ctx, "TRUNCATE TABLE login_attempts")
+	l.Require().NoError(err)
+}
+
+func (l *LoginAttemptsRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := l.postgresContainer.Terminate(ctx)
+	l.Require().NoError(err)
+
+	l.pool.Close()
+}
+
+func (l *LoginAttemptsRepositorySuite) TestRecordFailure_WithinTheResetWindow_CountsFailures() {
+	failedAt := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := l.loginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", failedAt, failedAt.Add(-15*time.Minute))
+	l.Require().NoError(err)
+	loginAttempts, err := l.loginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", failedAt.Add(time.Minute),
+		failedAt.Add(-14*time.Minute))
+	l.Require().NoError(err)
+
+	l.Equal(uint32(2), loginAttempts.Failures)
+	foundAttempts, err := l.loginAttemptsRepository.FindOne("ACCOUNT", "john.doe@gmail.com")
+	l.Require().NoError(err)
+	l.Require().NotNil(foundAttempts)
+	l.Equal(uint32(2), foundAttempts.Failures)
+}
+
+func (l *LoginAttemptsRepositorySuite) TestRecordFailure_AfterTheResetWindow_StartsCountingAgain() {
+	failedAt := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	_, err := l.loginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", failedAt, failedAt.Add(-15*time.Minute))
+	l.Require().NoError(err)
+
+	loginAttempts, err := l.loginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com",
+		failedAt.Add(time.Hour), failedAt.Add(45*time.Minute))
+	l.Require().NoError(err)
+
+	l.Equal(uint32(1), loginAttempts.Failures)
+}
+
+func (l *LoginAttemptsRepositorySuite) TestDelete_RemovesOnlyTheGivenSubject() {
+	failedAt := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	_, err := l.loginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", failedAt, failedAt.Add(-15*time.Minute))
+	l.Require().NoError(err)
+	_, err = l.loginAttemptsRepository.RecordFailure("IP_ADDRESS", "203.0.113.7", failedAt, failedAt.Add(-15*time.Minute))
+	l.Require().NoError(err)
+
+	err = l.loginAttemptsRepository.Delete("ACCOUNT", "john.doe@gmail.com")
+	l.Require().NoError(err)
+
+	deletedAttempts, err := l.loginAttemptsRepository.FindOne("ACCOUNT", "john.doe@gmail.com")
+	l.Require().NoError(err)
+	l.Nil(deletedAttempts)
+	remainingAttempts, err := l.loginAttemptsRepository.FindOne("IP_ADDRESS", "203.0.113.7")
+	l.Require().NoError(err)
+	l.NotNil(remainingAttempts)
+}
+
+func TestLoginAttemptsRepository(t *testing.T) {
+	suite.Run(t, new(LoginAttemptsRepositorySuite))
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LoginLockoutsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (l *LoginLockoutsRepository) Create(loginLockout account.LoginLockout) error {
+	_, err := l.Pool.Exec(context.Background(), `INSERT INTO login_lockouts
+		(id, scope, subject, failures, locked_until, created_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+		loginLockout.Id.String(), loginLockout.Scope, loginLockout.Subject, loginLockout.Failures,
+		loginLockout.LockedUntil, loginLockout.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
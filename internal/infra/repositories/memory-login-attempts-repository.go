@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+)
+
+type memoryLoginAttemptsKey struct {
+	scope   string
+	subject string
+}
+
+// MemoryLoginAttemptsRepository backs single-instance deployments that run with LOGIN_ATTEMPTS_STORAGE=memory.
+// Recording a failure evicts the attempts of its scope that would start over anyway, so that addresses and accounts
+// that stop failing do not pile up for the life of the process.
+type MemoryLoginAttemptsRepository struct {
+	loginAttempts map[memoryLoginAttemptsKey]account.LoginAttempts
+	mutex         sync.Mutex
+}
+
+func (m *MemoryLoginAttemptsRepository) FindOne(scope string, subject string) (*account.LoginAttempts, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	loginAttempts, ok := m.loginAttempts[memoryLoginAttemptsKey{scope: scope, subject: subject}]
+	if !ok {
+		return nil, nil
+	}
+
+	return &loginAttempts, nil
+}
+
+func (m *MemoryLoginAttemptsRepository) RecordFailure(scope string, subject string, failedAt time.Time,
+	resetBefore time.Time) (account.LoginAttempts, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.loginAttempts == nil {
+		m.loginAttempts = map[memoryLoginAttemptsKey]account.LoginAttempts{}
+	}
+
+	for key, loginAttempts := range m.loginAttempts {
+		if key.scope == scope && loginAttempts.LastFailedAt.Before(resetBefore) {
+			delete(m.loginAttempts, key)
+		}
+	}
+
+	key := memoryLoginAttemptsKey{scope: scope, subject: subject}
+	loginAttempts, ok := m.loginAttempts[key]
+	if !ok {
+		loginAttempts = account.LoginAttempts{Scope: scope, Subject: subject}
+	}
+
+	loginAttempts.Failures++
+	loginAttempts.LastFailedAt = failedAt
+	m.loginAttempts[key] = loginAttempts
+	return loginAttempts, nil
+}
+
+func (m *MemoryLoginAttemptsRepository) Delete(scope string, subject string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.loginAttempts, memoryLoginAttemptsKey{scope: scope, subject: subject})
+	return nil
+}
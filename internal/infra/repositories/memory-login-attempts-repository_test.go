@@ -0,0 +1,83 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryLoginAttemptsRepositorySuite struct {
+	suite.Suite
+	memoryLoginAttemptsRepository repositories.MemoryLoginAttemptsRepository
+}
+
+func (m *MemoryLoginAttemptsRepositorySuite) SetupTest() {
+	m.memoryLoginAttemptsRepository = repositories.MemoryLoginAttemptsRepository{}
+}
+
+func (m *MemoryLoginAttemptsRepositorySuite) TestRecordFailure_OnRecentFailures_CountsThem() {
+	now := time.Now()
+
+	_, err := m.memoryLoginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", now.Add(-time.Minute),
+		now.Add(-time.Hour))
+	m.Require().NoError(err)
+	loginAttempts, err := m.memoryLoginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", now,
+		now.Add(-time.Hour))
+	m.Require().NoError(err)
+
+	m.Equal(uint32(2), loginAttempts.Failures)
+	m.Equal(now, loginAttempts.LastFailedAt)
+}
+
+func (m *MemoryLoginAttemptsRepositorySuite) TestRecordFailure_OnFailureBeforeTheResetWindow_StartsOver() {
+	now := time.Now()
+
+	_, err := m.memoryLoginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", now.Add(-2*time.Hour),
+		now.Add(-3*time.Hour))
+	m.Require().NoError(err)
+	loginAttempts, err := m.memoryLoginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", now,
+		now.Add(-time.Hour))
+	m.Require().NoError(err)
+
+	m.Equal(uint32(1), loginAttempts.Failures)
+}
+
+func (m *MemoryLoginAttemptsRepositorySuite) TestRecordFailure_OnStaleAttemptsOfTheSameScope_EvictsThem() {
+	now := time.Now()
+
+	_, err := m.memoryLoginAttemptsRepository.RecordFailure("IP_ADDRESS", "10.0.0.1", now.Add(-2*time.Hour),
+		now.Add(-3*time.Hour))
+	m.Require().NoError(err)
+	_, err = m.memoryLoginAttemptsRepository.RecordFailure("ACCOUNT", "jane.doe@gmail.com", now.Add(-2*time.Hour),
+		now.Add(-3*time.Hour))
+	m.Require().NoError(err)
+
+	_, err = m.memoryLoginAttemptsRepository.RecordFailure("IP_ADDRESS", "10.0.0.2", now, now.Add(-time.Hour))
+	m.Require().NoError(err)
+
+	loginAttempts, err := m.memoryLoginAttemptsRepository.FindOne("IP_ADDRESS", "10.0.0.1")
+	m.Require().NoError(err)
+	m.Nil(loginAttempts)
+	loginAttempts, err = m.memoryLoginAttemptsRepository.FindOne("ACCOUNT", "jane.doe@gmail.com")
+	m.Require().NoError(err)
+	m.NotNil(loginAttempts)
+}
+
+func (m *MemoryLoginAttemptsRepositorySuite) TestDelete_OnRecordedFailures_RemovesThem() {
+	now := time.Now()
+	_, err := m.memoryLoginAttemptsRepository.RecordFailure("ACCOUNT", "john.doe@gmail.com", now, now.Add(-time.Hour))
+	m.Require().NoError(err)
+
+	err = m.memoryLoginAttemptsRepository.Delete("ACCOUNT", "john.doe@gmail.com")
+	m.Require().NoError(err)
+
+	loginAttempts, err := m.memoryLoginAttemptsRepository.FindOne("ACCOUNT", "john.doe@gmail.com")
+	m.Require().NoError(err)
+	m.Nil(loginAttempts)
+}
+
+func TestMemoryLoginAttemptsRepository(t *testing.T) {
+	suite.Run(t, new(MemoryLoginAttemptsRepositorySuite))
+}
@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/bundle"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PackagesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PackagesRepository) Create(travelPackage bundle.Package) error {
+	ctx := context.Background()
+	tx, err := p.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `INSERT INTO packages
+		(id, code, name, room_type, price_per_night, valid_from, valid_until, min_stay_nights, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		travelPackage.Id.String(), travelPackage.Code, travelPackage.Name, travelPackage.RoomType, travelPackage.PricePerNight,
+		travelPackage.ValidFrom, travelPackage.ValidUntil, travelPackage.MinStayNights, travelPackage.Active)
+
+	if err != nil {
+		return err
+	}
+
+	for _, component := range travelPackage.Components {
+		_, err = tx.Exec(ctx, "INSERT INTO package_components (package_id, add_on_code, quantity) VALUES ($1, $2, $3)",
+			travelPackage.Id.String(), component.AddOnCode, component.Quantity)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *PackagesRepository) Update(travelPackage bundle.Package) error {
+	_, err := p.Pool.Exec(context.Background(), `UPDATE packages
+		SET name = $2, price_per_night = $3, valid_from = $4, valid_until = $5, min_stay_nights = $6, active = $7,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		travelPackage.Id.String(), travelPackage.Name, travelPackage.PricePerNight, travelPackage.ValidFrom, travelPackage.ValidUntil,
+		travelPackage.MinStayNights, travelPackage.Active)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PackagesRepository) ExistsByCode(code string) (bool, error) {
+	var exists bool
+	err := p.Pool.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM packages WHERE code = $1)", code).Scan(&exists)
+
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (p *PackagesRepository) FindOneById(packageId uuid.UUID) (*bundle.Package, error) {
+	return p.findOne("id = $1", packageId.String())
+}
+
+func (p *PackagesRepository) FindOneByCode(code string) (*bundle.Package, error) {
+	return p.findOne("code = $1", code)
+}
+
+func (p *PackagesRepository) findOne(condition string, value string) (*bundle.Package, error) {
+	var travelPackage bundle.Package
+	err := p.Pool.QueryRow(context.Background(), `SELECT id, code, name, room_type, price_per_night, valid_from, valid_until,
+			min_stay_nights, active
+		FROM packages WHERE `+condition, value).
+		Scan(&travelPackage.Id, &travelPackage.Code, &travelPackage.Name, &travelPackage.RoomType, &travelPackage.PricePerNight,
+			&travelPackage.ValidFrom, &travelPackage.ValidUntil, &travelPackage.MinStayNights, &travelPackage.Active)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	rows, err := p.Pool.Query(context.Background(),
+		"SELECT add_on_code, quantity FROM package_components WHERE package_id = $1 ORDER BY add_on_code", travelPackage.Id.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var component bundle.PackageComponent
+		err := rows.Scan(&component.AddOnCode, &component.Quantity)
+
+		if err != nil {
+			return nil, err
+		}
+
+		travelPackage.Components = append(travelPackage.Components, component)
+	}
+
+	return &travelPackage, rows.Err()
+}
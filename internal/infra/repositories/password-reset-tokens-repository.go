@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/account"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PasswordResetTokensRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PasswordResetTokensRepository) Create(passwordResetToken account.PasswordResetToken) error {
+	_, err := p.Pool.Exec(context.Background(), `INSERT INTO password_reset_tokens
+		(id, customer_id, hashed_token, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $6)`,
+		passwordResetToken.Id.String(), passwordResetToken.CustomerId.String(), passwordResetToken.HashedToken,
+		passwordResetToken.Status, passwordResetToken.ExpiresAt, passwordResetToken.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PasswordResetTokensRepository) FindOneByHashedToken(hashedToken string) (*account.PasswordResetToken, error) {
+	var passwordResetToken account.PasswordResetToken
+
+	err := p.Pool.QueryRow(context.Background(), `SELECT id, customer_id, hashed_token, status, expires_at, created_at
+		FROM password_reset_tokens WHERE hashed_token = $1`, hashedToken).
+		Scan(&passwordResetToken.Id, &passwordResetToken.CustomerId, &passwordResetToken.HashedToken,
+			&passwordResetToken.Status, &passwordResetToken.ExpiresAt, &passwordResetToken.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &passwordResetToken, nil
+}
+
+// Use guards the update on the current status, so two concurrent resets with the same link cannot both succeed.
+func (p *PasswordResetTokensRepository) Use(passwordResetToken account.PasswordResetToken) error {
+	commandTag, err := p.Pool.Exec(context.Background(), `UPDATE password_reset_tokens SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'ACTIVE'`, passwordResetToken.Id.String(), passwordResetToken.Status)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("password reset token is invalid or has expired")
+	}
+
+	return nil
+}
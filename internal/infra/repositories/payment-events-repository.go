@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PaymentEventsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PaymentEventsRepository) Record(paymentEvent payment.PaymentEvent, changedPayment *payment.Payment,
+	changedBooking *booking.Booking) (bool, error) {
+	ctx := context.Background()
+	tx, err := p.Pool.Begin(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	inserted, err := tx.Exec(ctx, `INSERT INTO payment_events
+		(id, type, transaction_id, payload, status, reason, received_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO NOTHING`,
+		paymentEvent.Id, paymentEvent.Type, nullableString(paymentEvent.TransactionId), paymentEvent.Payload, paymentEvent.Status,
+		nullableString(paymentEvent.Reason), paymentEvent.ReceivedAt)
+
+	if err != nil {
+		return false, err
+	}
+
+	if inserted.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if changedPayment != nil {
+		err = updatePayment(ctx, tx, *changedPayment)
+
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if changedBooking != nil {
+		_, err = tx.Exec(ctx, updateBookingQuery,
+			changedBooking.Id.String(), changedBooking.CheckOut, changedBooking.TotalPrice, changedBooking.Status)
+
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, tx.Commit(ctx)
+}
@@ -0,0 +1,169 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/booking"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type PaymentEventsRepositorySuite struct {
+	suite.Suite
+	pool                    *pgxpool.Pool
+	postgresContainer       testcontainers.Container
+	paymentEventsRepository repositories.PaymentEventsRepository
+}
+
+func (p *PaymentEventsRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	p.Require().NoError(err)
+
+	p.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	p.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	p.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	p.Require().NoError(err)
+
+	p.pool = pool
+	p.paymentEventsRepository = repositories.PaymentEventsRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	p.Require().NoError(err)
+}
+
+func (p *PaymentEventsRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := p.pool.Exec(ctx, "TRUNCATE TABLE payment_events, payment_attempts, payments, bookings, customers CASCADE")
+	p.Require().NoError(err)
+	_, err = p.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	p.Require().NoError(err)
+	_, err = p.pool.Exec(ctx, `INSERT INTO bookings (id, customer_id, check_in, check_out, adults, total_price, status)
+		VALUES ('0dc94e80-3df8-40c9-8a79-9e9e555abbde', 'aa473b65-90a8-48ad-ab7d-5bd50a806d38', '2030-06-01', '2030-06-05', 2,
+			800, 'CONFIRMED')`)
+	p.Require().NoError(err)
+	_, err = p.pool.Exec(ctx, `INSERT INTO payments (id, booking_id, amount, captured_amount, capture_on, transaction_id, status)
+		VALUES ('5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41', '0dc94e80-3df8-40c9-8a79-9e9e555abbde', 240, 240, '2030-05-25',
+			'txn_deposit', 'CAPTURED')`)
+	p.Require().NoError(err)
+}
+
+func (p *PaymentEventsRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := p.postgresContainer.Terminate(ctx)
+	p.Require().NoError(err)
+
+	p.pool.Close()
+}
+
+func (p *PaymentEventsRepositorySuite) TestRecord_OnNewEvent_StoresEventTogetherWithTheChanges() {
+	paymentEvent, err := payment.NewPaymentEvent("evt_1", "charge.dispute.created", "txn_deposit", []byte(`{}`))
+	p.Require().NoError(err)
+	changedPayment := payment.Payment{Id: uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41"), Amount: 240,
+		CapturedAmount: 240, TransactionId: "txn_deposit", Status: "DISPUTED"}
+	changedBooking := booking.Booking{Id: uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+		CheckOut: time.Date(2030, 6, 5, 0, 0, 0, 0, time.UTC), TotalPrice: 800, Status: "CANCELLED"}
+
+	recorded, err := p.paymentEventsRepository.Record(paymentEvent, &changedPayment, &changedBooking)
+	p.Require().NoError(err)
+	p.True(recorded)
+
+	var paymentStatus, bookingStatus, eventStatus string
+	err = p.pool.QueryRow(context.Background(), "SELECT status FROM payments WHERE id = $1", changedPayment.Id).
+		Scan(&paymentStatus)
+	p.Require().NoError(err)
+	err = p.pool.QueryRow(context.Background(), "SELECT status FROM bookings WHERE id = $1", changedBooking.Id).
+		Scan(&bookingStatus)
+	p.Require().NoError(err)
+	err = p.pool.QueryRow(context.Background(), "SELECT status FROM payment_events WHERE id = $1", "evt_1").
+		Scan(&eventStatus)
+	p.Require().NoError(err)
+	p.Equal("DISPUTED", paymentStatus)
+	p.Equal("CANCELLED", bookingStatus)
+	p.Equal("RECEIVED", eventStatus)
+}
+
+func (p *PaymentEventsRepositorySuite) TestRecord_OnDuplicateEvent_ReturnsFalseAndLeavesChangesUnapplied() {
+	paymentEvent, err := payment.NewPaymentEvent("evt_1", "charge.dispute.created", "txn_deposit", []byte(`{}`))
+	p.Require().NoError(err)
+	recorded, err := p.paymentEventsRepository.Record(paymentEvent, nil, nil)
+	p.Require().NoError(err)
+	p.True(recorded)
+
+	changedPayment := payment.Payment{Id: uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41"), Amount: 240,
+		CapturedAmount: 240, TransactionId: "txn_deposit", Status: "DISPUTED"}
+	recorded, err = p.paymentEventsRepository.Record(paymentEvent, &changedPayment, nil)
+	p.Require().NoError(err)
+	p.False(recorded)
+
+	var paymentStatus string
+	err = p.pool.QueryRow(context.Background(), "SELECT status FROM payments WHERE id = $1", changedPayment.Id).
+		Scan(&paymentStatus)
+	p.Require().NoError(err)
+	p.Equal("CAPTURED", paymentStatus)
+}
+
+func (p *PaymentEventsRepositorySuite) TestRecord_OnFailingChange_DoesNotStoreTheEvent() {
+	paymentEvent, err := payment.NewPaymentEvent("evt_1", "charge.dispute.created", "txn_deposit", []byte(`{}`))
+	p.Require().NoError(err)
+	changedPayment := payment.Payment{Id: uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41"), Amount: 0,
+		TransactionId: "txn_deposit", Status: "DISPUTED"}
+
+	_, err = p.paymentEventsRepository.Record(paymentEvent, &changedPayment, nil)
+	p.Require().Error(err)
+
+	var events int
+	err = p.pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM payment_events").Scan(&events)
+	p.Require().NoError(err)
+	p.Equal(0, events)
+}
+
+func TestPaymentEventsRepository(t *testing.T) {
+	suite.Run(t, new(PaymentEventsRepositorySuite))
+}
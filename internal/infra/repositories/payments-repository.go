@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PaymentsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PaymentsRepository) Create(payment payment.Payment) error {
+	ctx := context.Background()
+	tx, err := p.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = insertPayment(ctx, tx, payment)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *PaymentsRepository) Update(payment payment.Payment) error {
+	ctx := context.Background()
+	tx, err := p.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = updatePayment(ctx, tx, payment)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *PaymentsRepository) FindOneByTransactionId(transactionId string) (*payment.Payment, error) {
+	return p.findOne("transaction_id = $1", transactionId)
+}
+
+func (p *PaymentsRepository) FindOneByBookingId(bookingId uuid.UUID) (*payment.Payment, error) {
+	return p.findOne("booking_id = $1", bookingId.String())
+}
+
+func (p *PaymentsRepository) FindAllDueForCapture(date time.Time) ([]payment.Payment, error) {
+	rows, err := p.Pool.Query(context.Background(), `SELECT id, booking_id, amount, captured_amount, capture_on, transaction_id, status
+		FROM payments
+		WHERE status = 'AUTHORIZED' AND capture_on <= $1
+		ORDER BY capture_on`, date)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	payments := []payment.Payment{}
+	for rows.Next() {
+		var duePayment payment.Payment
+		var transactionId *string
+		err := rows.Scan(&duePayment.Id, &duePayment.BookingId, &duePayment.Amount, &duePayment.CapturedAmount,
+			&duePayment.CaptureOn, &transactionId, &duePayment.Status)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if transactionId != nil {
+			duePayment.TransactionId = *transactionId
+		}
+
+		duePayment.Attempts = []payment.PaymentAttempt{}
+		payments = append(payments, duePayment)
+	}
+
+	return payments, rows.Err()
+}
+
+func (p *PaymentsRepository) findOne(condition string, value string) (*payment.Payment, error) {
+	ctx := context.Background()
+
+	var foundPayment payment.Payment
+	var foundTransactionId *string
+	err := p.Pool.QueryRow(ctx, `SELECT id, booking_id, amount, captured_amount, capture_on, transaction_id, status
+		FROM payments WHERE `+condition+` ORDER BY created_at DESC LIMIT 1`, value).
+		Scan(&foundPayment.Id, &foundPayment.BookingId, &foundPayment.Amount, &foundPayment.CapturedAmount, &foundPayment.CaptureOn,
+			&foundTransactionId, &foundPayment.Status)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if foundTransactionId != nil {
+		foundPayment.TransactionId = *foundTransactionId
+	}
+
+	rows, err := p.Pool.Query(ctx, `SELECT id, operation, amount, COALESCE(transaction_id, ''), status,
+			COALESCE(failure_reason, ''), created_at
+		FROM payment_attempts WHERE payment_id = $1 ORDER BY created_at`, foundPayment.Id.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	foundPayment.Attempts, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (payment.PaymentAttempt, error) {
+		var attempt payment.PaymentAttempt
+		err := row.Scan(&attempt.Id, &attempt.Operation, &attempt.Amount, &attempt.TransactionId, &attempt.Status,
+			&attempt.FailureReason, &attempt.CreatedAt)
+		return attempt, err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundPayment, nil
+}
+
+func updatePayment(ctx context.Context, tx pgx.Tx, payment payment.Payment) error {
+	_, err := tx.Exec(ctx, `UPDATE payments
+		SET amount = $2, captured_amount = $3, transaction_id = $4, status = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		payment.Id.String(), payment.Amount, payment.CapturedAmount, nullableString(payment.TransactionId), payment.Status)
+
+	if err != nil {
+		return err
+	}
+
+	return insertPaymentAttempts(ctx, tx, payment)
+}
+
+func insertPayment(ctx context.Context, tx pgx.Tx, payment payment.Payment) error {
+	_, err := tx.Exec(ctx, `INSERT INTO payments (id, booking_id, amount, captured_amount, capture_on, transaction_id, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		payment.Id.String(), payment.BookingId.String(), payment.Amount, payment.CapturedAmount, payment.CaptureOn,
+		nullableString(payment.TransactionId), payment.Status)
+
+	if err != nil {
+		return err
+	}
+
+	return insertPaymentAttempts(ctx, tx, payment)
+}
+
+func insertPaymentAttempts(ctx context.Context, tx pgx.Tx, payment payment.Payment) error {
+	for _, attempt := range payment.Attempts {
+		_, err := tx.Exec(ctx, `INSERT INTO payment_attempts
+			(id, payment_id, operation, amount, transaction_id, status, failure_reason, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (id) DO NOTHING`,
+			attempt.Id.String(), payment.Id.String(), attempt.Operation, attempt.Amount, nullableString(attempt.TransactionId),
+			attempt.Status, nullableString(attempt.FailureReason), attempt.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,172 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type PaymentsRepositorySuite struct {
+	suite.Suite
+	pool               *pgxpool.Pool
+	postgresContainer  testcontainers.Container
+	paymentsRepository repositories.PaymentsRepository
+}
+
+func (p *PaymentsRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	p.Require().NoError(err)
+
+	p.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	p.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	p.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	p.Require().NoError(err)
+
+	p.pool = pool
+	p.paymentsRepository = repositories.PaymentsRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	p.Require().NoError(err)
+}
+
+func (p *PaymentsRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := p.pool.Exec(ctx, "TRUNCATE TABLE payment_attempts, payments, bookings, customers CASCADE")
+	p.Require().NoError(err)
+	_, err = p.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	p.Require().NoError(err)
+	_, err = p.pool.Exec(ctx, `INSERT INTO bookings (id, customer_id, check_in, check_out, adults, total_price, status)
+		VALUES ('0dc94e80-3df8-40c9-8a79-9e9e555abbde', 'aa473b65-90a8-48ad-ab7d-5bd50a806d38', '2030-06-01', '2030-06-05', 2,
+			800, 'CONFIRMED')`)
+	p.Require().NoError(err)
+}
+
+func (p *PaymentsRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := p.postgresContainer.Terminate(ctx)
+	p.Require().NoError(err)
+
+	p.pool.Close()
+}
+
+func (p *PaymentsRepositorySuite) TestCreate_OnNoErrors_StoresPaymentWithItsAttempts() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	deposit, err := payment.NewDepositPayment(bookingId, 240, time.Date(2030, 5, 25, 0, 0, 0, 0, time.UTC))
+	p.Require().NoError(err)
+	err = deposit.RecordAuthorization("txn_deposit", true, "")
+	p.Require().NoError(err)
+
+	err = p.paymentsRepository.Create(deposit)
+	p.Require().NoError(err)
+
+	foundPayment, err := p.paymentsRepository.FindOneByBookingId(bookingId)
+	p.Require().NoError(err)
+	p.Require().NotNil(foundPayment)
+	p.Equal(deposit.Id, foundPayment.Id)
+	p.Equal("AUTHORIZED", foundPayment.Status)
+	p.Equal("txn_deposit", foundPayment.TransactionId)
+	p.Len(foundPayment.Attempts, 1)
+	p.Equal("AUTHORIZE", foundPayment.Attempts[0].Operation)
+}
+
+func (p *PaymentsRepositorySuite) TestUpdate_OnCapture_StoresCapturedAmountAndNewAttempt() {
+	deposit, err := payment.NewDepositPayment(uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"), 240,
+		time.Date(2030, 5, 25, 0, 0, 0, 0, time.UTC))
+	p.Require().NoError(err)
+	err = deposit.RecordAuthorization("txn_deposit", true, "")
+	p.Require().NoError(err)
+	err = p.paymentsRepository.Create(deposit)
+	p.Require().NoError(err)
+
+	err = deposit.RecordCapture(240, true, "")
+	p.Require().NoError(err)
+	err = p.paymentsRepository.Update(deposit)
+	p.Require().NoError(err)
+
+	foundPayment, err := p.paymentsRepository.FindOneByTransactionId("txn_deposit")
+	p.Require().NoError(err)
+	p.Require().NotNil(foundPayment)
+	p.Equal("CAPTURED", foundPayment.Status)
+	p.Equal(uint64(240), foundPayment.CapturedAmount)
+	p.Len(foundPayment.Attempts, 2)
+}
+
+func (p *PaymentsRepositorySuite) TestFindAllDueForCapture_ReturnsOnlyAuthorizedPaymentsDueByTheDate() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	dueDeposit, err := payment.NewDepositPayment(bookingId, 240, time.Date(2030, 5, 25, 0, 0, 0, 0, time.UTC))
+	p.Require().NoError(err)
+	err = dueDeposit.RecordAuthorization("txn_due", true, "")
+	p.Require().NoError(err)
+	err = p.paymentsRepository.Create(dueDeposit)
+	p.Require().NoError(err)
+
+	laterDeposit, err := payment.NewDepositPayment(bookingId, 240, time.Date(2030, 5, 28, 0, 0, 0, 0, time.UTC))
+	p.Require().NoError(err)
+	err = laterDeposit.RecordAuthorization("txn_later", true, "")
+	p.Require().NoError(err)
+	err = p.paymentsRepository.Create(laterDeposit)
+	p.Require().NoError(err)
+
+	payments, err := p.paymentsRepository.FindAllDueForCapture(time.Date(2030, 5, 26, 0, 0, 0, 0, time.UTC))
+	p.Require().NoError(err)
+	p.Len(payments, 1)
+	p.Equal(dueDeposit.Id, payments[0].Id)
+}
+
+func (p *PaymentsRepositorySuite) TestFindOneByTransactionId_OnPaymentNotFound_ReturnsNil() {
+	foundPayment, err := p.paymentsRepository.FindOneByTransactionId("txn_unknown")
+	p.Require().NoError(err)
+	p.Nil(foundPayment)
+}
+
+func TestPaymentsRepository(t *testing.T) {
+	suite.Run(t, new(PaymentsRepositorySuite))
+}
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/pricing"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PricingRulesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PricingRulesRepository) Create(rule pricing.OccupancyPricingRule) error {
+	_, err := p.Pool.Exec(context.Background(), `INSERT INTO pricing_rules
+		(id, room_type, occupancy_threshold, adjustment_percent, floor_price, ceiling_price, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		rule.Id.String(), rule.RoomType, rule.OccupancyThreshold, rule.AdjustmentPercent, rule.FloorPrice, rule.CeilingPrice, rule.Enabled)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PricingRulesRepository) Update(rule pricing.OccupancyPricingRule) error {
+	_, err := p.Pool.Exec(context.Background(), `UPDATE pricing_rules
+		SET room_type = $2, occupancy_threshold = $3, adjustment_percent = $4, floor_price = $5, ceiling_price = $6, enabled = $7,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		rule.Id.String(), rule.RoomType, rule.OccupancyThreshold, rule.AdjustmentPercent, rule.FloorPrice, rule.CeilingPrice, rule.Enabled)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PricingRulesRepository) FindOneById(ruleId uuid.UUID) (*pricing.OccupancyPricingRule, error) {
+	var rule pricing.OccupancyPricingRule
+	err := p.Pool.QueryRow(context.Background(), `SELECT id, room_type, occupancy_threshold, adjustment_percent, floor_price, ceiling_price, enabled
+		FROM pricing_rules WHERE id = $1`, ruleId.String()).
+		Scan(&rule.Id, &rule.RoomType, &rule.OccupancyThreshold, &rule.AdjustmentPercent, &rule.FloorPrice, &rule.CeilingPrice, &rule.Enabled)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (p *PricingRulesRepository) FindAllEnabled() ([]pricing.OccupancyPricingRule, error) {
+	rows, err := p.Pool.Query(context.Background(), `SELECT id, room_type, occupancy_threshold, adjustment_percent, floor_price, ceiling_price, enabled
+		FROM pricing_rules WHERE enabled = TRUE ORDER BY created_at`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rules := []pricing.OccupancyPricingRule{}
+	for rows.Next() {
+		var rule pricing.OccupancyPricingRule
+		err := rows.Scan(&rule.Id, &rule.RoomType, &rule.OccupancyThreshold, &rule.AdjustmentPercent, &rule.FloorPrice, &rule.CeilingPrice, &rule.Enabled)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/promocode"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PromoCodesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PromoCodesRepository) FindOneByCode(code string) (*promocode.PromoCode, error) {
+	var promoCode promocode.PromoCode
+	err := p.Pool.QueryRow(context.Background(), "SELECT code, discount_percent, valid_from, valid_until FROM promo_codes WHERE code = $1", code).
+		Scan(&promoCode.Code, &promoCode.DiscountPercent, &promoCode.ValidFrom, &promoCode.ValidUntil)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &promoCode, nil
+}
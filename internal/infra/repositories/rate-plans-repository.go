@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/rateplan"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RatePlansRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (r *RatePlansRepository) Create(ratePlan rateplan.RatePlan) error {
+	schedule, err := json.Marshal(ratePlan.Schedule.Installments)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.Pool.Exec(context.Background(), "INSERT INTO rate_plans (id, code, name, payment_schedule) VALUES ($1, $2, $3, $4)",
+		ratePlan.Id.String(), ratePlan.Code, ratePlan.Name, schedule)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RatePlansRepository) ExistsByCode(code string) (bool, error) {
+	var exists bool
+	err := r.Pool.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM rate_plans WHERE code = $1)", code).Scan(&exists)
+
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *RatePlansRepository) FindOneByCode(code string) (*rateplan.RatePlan, error) {
+	var ratePlan rateplan.RatePlan
+	var schedule []byte
+	err := r.Pool.QueryRow(context.Background(), "SELECT id, code, name, payment_schedule FROM rate_plans WHERE code = $1", code).
+		Scan(&ratePlan.Id, &ratePlan.Code, &ratePlan.Name, &schedule)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var installments []payment.Installment
+	err = json.Unmarshal(schedule, &installments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ratePlan.Schedule = payment.PaymentSchedule{Installments: installments}
+
+	return &ratePlan, nil
+}
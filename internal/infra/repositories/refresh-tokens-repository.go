@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefreshTokensRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (r *RefreshTokensRepository) Create(refreshToken session.RefreshToken) error {
+	_, err := r.Pool.Exec(context.Background(), `INSERT INTO refresh_tokens
+		(id, family_id, customer_id, staff_user_id, hashed_token, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)`,
+		refreshToken.Id.String(), refreshToken.FamilyId.String(), nullableUuid(refreshToken.CustomerId),
+		nullableUuid(refreshToken.StaffUserId), refreshToken.HashedToken, refreshToken.Status, refreshToken.ExpiresAt,
+		refreshToken.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RefreshTokensRepository) FindOneByHashedToken(hashedToken string) (*session.RefreshToken, error) {
+	var refreshToken session.RefreshToken
+
+	err := r.Pool.QueryRow(context.Background(), `SELECT id, family_id,
+			COALESCE(customer_id, '00000000-0000-0000-0000-000000000000'),
+			COALESCE(staff_user_id, '00000000-0000-0000-0000-000000000000'), hashed_token, status, expires_at, created_at
+		FROM refresh_tokens WHERE hashed_token = $1`, hashedToken).
+		Scan(&refreshToken.Id, &refreshToken.FamilyId, &refreshToken.CustomerId, &refreshToken.StaffUserId,
+			&refreshToken.HashedToken, &refreshToken.Status, &refreshToken.ExpiresAt, &refreshToken.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+// Rotate guards the update on the current status, so two concurrent refreshes with the same token cannot both
+// succeed; the loser is treated as a reuse.
+func (r *RefreshTokensRepository) Rotate(current session.RefreshToken, next session.RefreshToken) error {
+	ctx := context.Background()
+	tx, err := r.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	commandTag, err := tx.Exec(ctx, `UPDATE refresh_tokens SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'ACTIVE'`, current.Id.String(), current.Status)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("refresh token has already been used")
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO refresh_tokens
+		(id, family_id, customer_id, staff_user_id, hashed_token, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)`,
+		next.Id.String(), next.FamilyId.String(), nullableUuid(next.CustomerId), nullableUuid(next.StaffUserId),
+		next.HashedToken, next.Status, next.ExpiresAt, next.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *RefreshTokensRepository) RevokeFamily(familyId uuid.UUID) error {
+	_, err := r.Pool.Exec(context.Background(), `UPDATE refresh_tokens SET status = 'REVOKED', updated_at = NOW()
+		WHERE family_id = $1 AND status = 'ACTIVE'`, familyId.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RefreshTokensRepository) RevokeAllByCustomerId(customerId uuid.UUID) error {
+	_, err := r.Pool.Exec(context.Background(), `UPDATE refresh_tokens SET status = 'REVOKED', updated_at = NOW()
+		WHERE customer_id = $1 AND status = 'ACTIVE'`, customerId.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func nullableUuid(value uuid.UUID) *string {
+	if value == uuid.Nil {
+		return nil
+	}
+
+	id := value.String()
+	return &id
+}
@@ -0,0 +1,158 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/session"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type RefreshTokensRepositorySuite struct {
+	suite.Suite
+	pool                    *pgxpool.Pool
+	postgresContainer       testcontainers.Container
+	refreshTokensRepository repositories.RefreshTokensRepository
+}
+
+func (r *RefreshTokensRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	r.Require().NoError(err)
+
+	r.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	r.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	r.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	r.Require().NoError(err)
+
+	r.pool = pool
+	r.refreshTokensRepository = repositories.RefreshTokensRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	r.Require().NoError(err)
+}
+
+func (r *RefreshTokensRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := r.pool.Exec(ctx, "TRUNCATE TABLE refresh_tokens, customers CASCADE")
+	r.Require().NoError(err)
+	_, err = r.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	r.Require().NoError(err)
+}
+
+func (r *RefreshTokensRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := r.postgresContainer.Terminate(ctx)
+	r.Require().NoError(err)
+
+	r.pool.Close()
+}
+
+func (r *RefreshTokensRepositorySuite) TestCreate_OnNoErrors_FindsTokenByItsHash() {
+	refreshToken, plainToken, err := session.NewRefreshToken(uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), time.Hour)
+	r.Require().NoError(err)
+
+	err = r.refreshTokensRepository.Create(refreshToken)
+	r.Require().NoError(err)
+
+	foundToken, err := r.refreshTokensRepository.FindOneByHashedToken(session.HashRefreshToken(plainToken))
+	r.Require().NoError(err)
+	r.Require().NotNil(foundToken)
+	r.Equal(refreshToken.Id, foundToken.Id)
+	r.Equal(refreshToken.FamilyId, foundToken.FamilyId)
+	r.Equal(refreshToken.CustomerId, foundToken.CustomerId)
+	r.Equal(uuid.Nil, foundToken.StaffUserId)
+	r.Equal("ACTIVE", foundToken.Status)
+}
+
+func (r *RefreshTokensRepositorySuite) TestRotate_OnTokenAlreadyRotated_ReturnsError() {
+	current, _, err := session.NewRefreshToken(uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), time.Hour)
+	r.Require().NoError(err)
+	err = r.refreshTokensRepository.Create(current)
+	r.Require().NoError(err)
+
+	staleCopy := current
+	next, _, err := current.Rotate(time.Hour, time.Now().UTC())
+	r.Require().NoError(err)
+	err = r.refreshTokensRepository.Rotate(current, next)
+	r.Require().NoError(err)
+
+	concurrentNext, _, err := staleCopy.Rotate(time.Hour, time.Now().UTC())
+	r.Require().NoError(err)
+	err = r.refreshTokensRepository.Rotate(staleCopy, concurrentNext)
+
+	r.EqualError(err, "refresh token has already been used")
+	foundToken, err := r.refreshTokensRepository.FindOneByHashedToken(concurrentNext.HashedToken)
+	r.Require().NoError(err)
+	r.Nil(foundToken)
+}
+
+func (r *RefreshTokensRepositorySuite) TestRevokeFamily_RevokesTheActiveTokenOfTheFamily() {
+	current, _, err := session.NewRefreshToken(uuid.MustParse("aa473b65-90a8-48ad-ab7d-5bd50a806d38"), time.Hour)
+	r.Require().NoError(err)
+	err = r.refreshTokensRepository.Create(current)
+	r.Require().NoError(err)
+	next, _, err := current.Rotate(time.Hour, time.Now().UTC())
+	r.Require().NoError(err)
+	err = r.refreshTokensRepository.Rotate(current, next)
+	r.Require().NoError(err)
+
+	err = r.refreshTokensRepository.RevokeFamily(current.FamilyId)
+	r.Require().NoError(err)
+
+	rotatedToken, err := r.refreshTokensRepository.FindOneByHashedToken(current.HashedToken)
+	r.Require().NoError(err)
+	revokedToken, err := r.refreshTokensRepository.FindOneByHashedToken(next.HashedToken)
+	r.Require().NoError(err)
+	r.Equal("ROTATED", rotatedToken.Status)
+	r.Equal("REVOKED", revokedToken.Status)
+}
+
+func TestRefreshTokensRepository(t *testing.T) {
+	suite.Run(t, new(RefreshTokensRepositorySuite))
+}
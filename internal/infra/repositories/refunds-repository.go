@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefundsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (r *RefundsRepository) Reserve(refund payment.Refund) error {
+	ctx := context.Background()
+	tx, err := r.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var capturedAmount uint64
+	var refundedAmount uint64
+
+	if refund.ScheduledChargeId != uuid.Nil {
+		err = tx.QueryRow(ctx, `SELECT CASE WHEN status = 'PAID' THEN amount ELSE 0 END FROM scheduled_charges
+			WHERE id = $1 FOR UPDATE`, refund.ScheduledChargeId.String()).Scan(&capturedAmount)
+
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, `SELECT COALESCE(SUM(amount), 0) FROM refunds
+			WHERE scheduled_charge_id = $1 AND status IN ('PENDING', 'APPROVED')`, refund.ScheduledChargeId.String()).
+			Scan(&refundedAmount)
+	} else {
+		err = tx.QueryRow(ctx, "SELECT captured_amount FROM payments WHERE id = $1 FOR UPDATE", refund.PaymentId.String()).
+			Scan(&capturedAmount)
+
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, `SELECT COALESCE(SUM(amount), 0) FROM refunds
+			WHERE payment_id = $1 AND status IN ('PENDING', 'APPROVED')`, refund.PaymentId.String()).Scan(&refundedAmount)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if refundedAmount+refund.Amount > capturedAmount {
+		return errors.New("refund amount exceeds the refundable amount")
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO refunds
+		(id, booking_id, payment_id, scheduled_charge_id, amount, type, reason, transaction_id, status, failure_reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		refund.Id.String(), refund.BookingId.String(), nullableUuid(refund.PaymentId), nullableUuid(refund.ScheduledChargeId),
+		refund.Amount, refund.Type,
+		nullableString(refund.Reason), nullableString(refund.TransactionId), refund.Status, nullableString(refund.FailureReason),
+		refund.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *RefundsRepository) Update(refund payment.Refund) error {
+	_, err := r.Pool.Exec(context.Background(), `UPDATE refunds
+		SET transaction_id = $2, status = $3, failure_reason = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		refund.Id.String(), nullableString(refund.TransactionId), refund.Status, nullableString(refund.FailureReason))
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RefundsRepository) FindAllByBookingId(bookingId uuid.UUID) ([]payment.Refund, error) {
+	rows, err := r.Pool.Query(context.Background(), `SELECT id, booking_id, payment_id, scheduled_charge_id, amount, type,
+			COALESCE(reason, ''),
+			COALESCE(transaction_id, ''), status, COALESCE(failure_reason, ''), created_at
+		FROM refunds WHERE booking_id = $1 ORDER BY created_at`, bookingId.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (payment.Refund, error) {
+		var refund payment.Refund
+		var paymentId *uuid.UUID
+		var scheduledChargeId *uuid.UUID
+		err := row.Scan(&refund.Id, &refund.BookingId, &paymentId, &scheduledChargeId, &refund.Amount, &refund.Type,
+			&refund.Reason, &refund.TransactionId, &refund.Status, &refund.FailureReason, &refund.CreatedAt)
+
+		if paymentId != nil {
+			refund.PaymentId = *paymentId
+		}
+
+		if scheduledChargeId != nil {
+			refund.ScheduledChargeId = *scheduledChargeId
+		}
+
+		return refund, err
+	})
+}
@@ -0,0 +1,229 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type RefundsRepositorySuite struct {
+	suite.Suite
+	pool              *pgxpool.Pool
+	postgresContainer testcontainers.Container
+	refundsRepository repositories.RefundsRepository
+}
+
+func (r *RefundsRepositorySuite) SetupSuite() {
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	ctx := context.Background()
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17.2-alpine3.21",
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(10 * time.Second),
+			Env: map[string]string{
+				"POSTGRES_DB":       "postgres",
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+			},
+		},
+	})
+	r.Require().NoError(err)
+
+	r.postgresContainer = postgresContainer
+
+	host, err := postgresContainer.Host(ctx)
+	r.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	r.Require().NoError(err)
+
+	if _, ok := os.LookupEnv("ACT"); ok {
+		host = "host.docker.internal"
+	}
+
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	r.Require().NoError(err)
+
+	r.pool = pool
+	r.refundsRepository = repositories.RefundsRepository{
+		Pool: pool,
+	}
+
+	os.Setenv("PGUSER", "postgres")
+	os.Setenv("PGPASSWORD", "postgres")
+	os.Setenv("PGHOST", host)
+	os.Setenv("PGPORT", port.Port())
+	os.Setenv("PGDATABASE", "postgres")
+
+	cmd := exec.Command("tern", "migrate", "-m", "../../../migrations")
+	_, err = cmd.CombinedOutput()
+	r.Require().NoError(err)
+}
+
+func (r *RefundsRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	_, err := r.pool.Exec(ctx, "TRUNCATE TABLE refunds, scheduled_charges, payment_attempts, payments, bookings, customers CASCADE")
+	r.Require().NoError(err)
+	_, err = r.pool.Exec(ctx, `INSERT INTO customers (id, name, email, password)
+		VALUES ('aa473b65-90a8-48ad-ab7d-5bd50a806d38', 'John Doe', 'john.doe@gmail.com', '$2a$12$zkX5/W4LHciSZLR4YRLxHetVwAdppboUHJ6JnNhfSrKqVaSJk5hzu')`)
+	r.Require().NoError(err)
+	_, err = r.pool.Exec(ctx, `INSERT INTO bookings (id, customer_id, check_in, check_out, adults, total_price, status)
+		VALUES ('0dc94e80-3df8-40c9-8a79-9e9e555abbde', 'aa473b65-90a8-48ad-ab7d-5bd50a806d38', '2030-06-01', '2030-06-05', 2,
+			800, 'CONFIRMED')`)
+	r.Require().NoError(err)
+	_, err = r.pool.Exec(ctx, `INSERT INTO payments (id, booking_id, amount, captured_amount, capture_on, transaction_id, status)
+		VALUES ('5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41', '0dc94e80-3df8-40c9-8a79-9e9e555abbde', 240, 240, '2030-05-25',
+			'txn_deposit', 'CAPTURED')`)
+	r.Require().NoError(err)
+	_, err = r.pool.Exec(ctx, `INSERT INTO scheduled_charges
+		(id, booking_id, sequence, amount, due_on, mandatory, payment_token, transaction_id, status, next_attempt_at)
+		VALUES ('9b2f6c1e-0d4a-4e8b-b6f7-3c5a1d9e2f70', '0dc94e80-3df8-40c9-8a79-9e9e555abbde', 1, 400, '2030-05-25', true,
+			'tok_visa', 'txn_charge', 'PAID', '2030-05-25')`)
+	r.Require().NoError(err)
+}
+
+func (r *RefundsRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	err := r.postgresContainer.Terminate(ctx)
+	r.Require().NoError(err)
+
+	r.pool.Close()
+}
+
+func (r *RefundsRepositorySuite) TestReserve_OnNoErrors_StoresPendingRefund() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	paymentId := uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41")
+	refund, err := payment.NewRefund(bookingId, paymentId, 100, "CANCELLATION", "")
+	r.Require().NoError(err)
+
+	err = r.refundsRepository.Reserve(refund)
+	r.Require().NoError(err)
+
+	refunds, err := r.refundsRepository.FindAllByBookingId(bookingId)
+	r.Require().NoError(err)
+	r.Len(refunds, 1)
+	r.Equal(refund.Id, refunds[0].Id)
+	r.Equal(paymentId, refunds[0].PaymentId)
+	r.Equal(uuid.Nil, refunds[0].ScheduledChargeId)
+	r.Equal(uint64(100), refunds[0].Amount)
+	r.Equal("PENDING", refunds[0].Status)
+}
+
+func (r *RefundsRepositorySuite) TestReserve_OnAmountAboveWhatIsLeftToRefund_ReturnsError() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	paymentId := uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41")
+	firstRefund, err := payment.NewRefund(bookingId, paymentId, 200, "MANUAL", "Broken air conditioning")
+	r.Require().NoError(err)
+	err = r.refundsRepository.Reserve(firstRefund)
+	r.Require().NoError(err)
+
+	secondRefund, err := payment.NewRefund(bookingId, paymentId, 50, "CANCELLATION", "")
+	r.Require().NoError(err)
+	err = r.refundsRepository.Reserve(secondRefund)
+
+	r.EqualError(err, "refund amount exceeds the refundable amount")
+	refunds, err := r.refundsRepository.FindAllByBookingId(bookingId)
+	r.Require().NoError(err)
+	r.Len(refunds, 1)
+}
+
+func (r *RefundsRepositorySuite) TestReserve_OnConcurrentRefunds_NeverExceedsTheCapturedAmount() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	paymentId := uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41")
+	errs := make(chan error, 5)
+
+	for range 5 {
+		go func() {
+			refund, err := payment.NewRefund(bookingId, paymentId, 100, "MANUAL", "Broken air conditioning")
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			errs <- r.refundsRepository.Reserve(refund)
+		}()
+	}
+
+	reserved := 0
+	for range 5 {
+		if err := <-errs; err != nil {
+			r.EqualError(err, "refund amount exceeds the refundable amount")
+			continue
+		}
+
+		reserved++
+	}
+
+	r.Equal(2, reserved)
+	var refundedAmount uint64
+	err := r.pool.QueryRow(context.Background(), "SELECT COALESCE(SUM(amount), 0) FROM refunds WHERE payment_id = $1",
+		paymentId.String()).Scan(&refundedAmount)
+	r.Require().NoError(err)
+	r.Equal(uint64(200), refundedAmount)
+}
+
+func (r *RefundsRepositorySuite) TestReserve_AfterFailedRefund_AllowsRefundingTheSameAmountAgain() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	paymentId := uuid.MustParse("5f1c9a52-6f0b-4c55-9a3e-2f1d2b7c8e41")
+	failedRefund, err := payment.NewRefund(bookingId, paymentId, 240, "CANCELLATION", "")
+	r.Require().NoError(err)
+	err = r.refundsRepository.Reserve(failedRefund)
+	r.Require().NoError(err)
+	failedRefund.RecordFailure("gateway timeout")
+	err = r.refundsRepository.Update(failedRefund)
+	r.Require().NoError(err)
+
+	retriedRefund, err := payment.NewRefund(bookingId, paymentId, 240, "CANCELLATION", "")
+	r.Require().NoError(err)
+	err = r.refundsRepository.Reserve(retriedRefund)
+
+	r.Require().NoError(err)
+}
+
+func (r *RefundsRepositorySuite) TestReserve_OnPaidScheduledCharge_StoresRefundOfTheCharge() {
+	bookingId := uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde")
+	scheduledChargeId := uuid.MustParse("9b2f6c1e-0d4a-4e8b-b6f7-3c5a1d9e2f70")
+	refund, err := payment.NewScheduledChargeRefund(bookingId, scheduledChargeId, 400, "CANCELLATION")
+	r.Require().NoError(err)
+
+	err = r.refundsRepository.Reserve(refund)
+	r.Require().NoError(err)
+
+	refunds, err := r.refundsRepository.FindAllByBookingId(bookingId)
+	r.Require().NoError(err)
+	r.Len(refunds, 1)
+	r.Equal(scheduledChargeId, refunds[0].ScheduledChargeId)
+	r.Equal(uuid.Nil, refunds[0].PaymentId)
+}
+
+func (r *RefundsRepositorySuite) TestReserve_OnScheduledChargeNotPaid_ReturnsError() {
+	_, err := r.pool.Exec(context.Background(), "UPDATE scheduled_charges SET status = 'SCHEDULED', transaction_id = NULL")
+	r.Require().NoError(err)
+	refund, err := payment.NewScheduledChargeRefund(uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+		uuid.MustParse("9b2f6c1e-0d4a-4e8b-b6f7-3c5a1d9e2f70"), 100, "CANCELLATION")
+	r.Require().NoError(err)
+
+	err = r.refundsRepository.Reserve(refund)
+
+	r.EqualError(err, "refund amount exceeds the refundable amount")
+}
+
+func TestRefundsRepository(t *testing.T) {
+	suite.Run(t, new(RefundsRepositorySuite))
+}
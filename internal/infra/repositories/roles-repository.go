@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RolesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (r *RolesRepository) ExistsByName(name string) (bool, error) {
+	var exists bool
+
+	err := r.Pool.QueryRow(context.Background(), `SELECT EXISTS (SELECT 1 FROM roles WHERE name = $1)`, name).
+		Scan(&exists)
+
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *RolesRepository) FindPermissionsByRole(role string) ([]string, error) {
+	rows, err := r.Pool.Query(context.Background(), `SELECT permission FROM role_permissions WHERE role = $1`, role)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	permissions := []string{}
+	for rows.Next() {
+		var permission string
+
+		err = rows.Scan(&permission)
+
+		if err != nil {
+			return nil, err
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
@@ -5,15 +5,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type RoomsRepository struct {
-	Conn *pgx.Conn
+	Pool *pgxpool.Pool
 }
 
 func (r *RoomsRepository) Create(room room.Room) error {
-	_, err := r.Conn.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+	_, err := r.Pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
 		room.Id.String(), room.Number, room.Type, room.Capacity, room.Price)
 
 	if err != nil {
@@ -25,7 +25,7 @@ func (r *RoomsRepository) Create(room room.Room) error {
 
 func (r *RoomsRepository) ExistsByRoomNumber(roomNumber string) (bool, error) {
 	var roomId uuid.UUID
-	err := r.Conn.QueryRow(context.Background(), "SELECT id FROM rooms WHERE number = $1", roomNumber).Scan(&roomId)
+	err := r.Pool.QueryRow(context.Background(), "SELECT id FROM rooms WHERE number = $1", roomNumber).Scan(&roomId)
 
 	if err != nil {
 		if err.Error() == "no rows in result set" {
@@ -37,3 +37,56 @@ func (r *RoomsRepository) ExistsByRoomNumber(roomNumber string) (bool, error) {
 
 	return true, nil
 }
+
+func (r *RoomsRepository) FindAllByIds(roomIds []uuid.UUID) ([]room.Room, error) {
+	ids := []string{}
+	for _, roomId := range roomIds {
+		ids = append(ids, roomId.String())
+	}
+
+	rows, err := r.Pool.Query(context.Background(), "SELECT id, number, type, capacity, price FROM rooms WHERE id = ANY($1::uuid[])", ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rooms := []room.Room{}
+	for rows.Next() {
+		var foundRoom room.Room
+		err := rows.Scan(&foundRoom.Id, &foundRoom.Number, &foundRoom.Type, &foundRoom.Capacity, &foundRoom.Price)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rooms = append(rooms, foundRoom)
+	}
+
+	return rooms, rows.Err()
+}
+
+func (r *RoomsRepository) FindAllByType(roomType string) ([]room.Room, error) {
+	rows, err := r.Pool.Query(context.Background(), "SELECT id, number, type, capacity, price FROM rooms WHERE type = $1", roomType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rooms := []room.Room{}
+	for rows.Next() {
+		var foundRoom room.Room
+		err := rows.Scan(&foundRoom.Id, &foundRoom.Number, &foundRoom.Type, &foundRoom.Capacity, &foundRoom.Price)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rooms = append(rooms, foundRoom)
+	}
+
+	return rooms, rows.Err()
+}
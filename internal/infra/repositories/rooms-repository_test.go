@@ -11,7 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/room"
 	"github.com/gsaaraujo/hotel-booking-api/internal/infra/repositories"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -19,7 +19,7 @@ import (
 
 type RoomsRepositorySuite struct {
 	suite.Suite
-	conn              *pgx.Conn
+	pool              *pgxpool.Pool
 	postgresContainer testcontainers.Container
 	roomsRepository   repositories.RoomsRepository
 }
@@ -56,12 +56,12 @@ func (r *RoomsRepositorySuite) SetupSuite() {
 		host = "host.docker.internal"
 	}
 
-	conn, err := pgx.Connect(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
+	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port()))
 	r.Require().NoError(err)
 
-	r.conn = conn
+	r.pool = pool
 	r.roomsRepository = repositories.RoomsRepository{
-		Conn: conn,
+		Pool: pool,
 	}
 
 	os.Setenv("PGUSER", "postgres")
@@ -77,7 +77,7 @@ func (r *RoomsRepositorySuite) SetupSuite() {
 
 func (r *RoomsRepositorySuite) SetupTest() {
 	ctx := context.Background()
-	_, err := r.conn.Exec(ctx, "TRUNCATE TABLE rooms")
+	_, err := r.pool.Exec(ctx, "TRUNCATE TABLE rooms CASCADE")
 	r.Require().NoError(err)
 }
 
@@ -87,8 +87,7 @@ func (r *RoomsRepositorySuite) TearDownSuite() {
 	err := r.postgresContainer.Terminate(ctx)
 	r.Require().NoError(err)
 
-	err = r.conn.Close(ctx)
-	r.Require().NoError(err)
+	r.pool.Close()
 }
 
 func (r *RoomsRepositorySuite) TestCreate_OnNoErrors_ReturnsNil() {
@@ -113,7 +112,7 @@ func (r *RoomsRepositorySuite) TestCreate_OnNoErrors_ReturnsNil() {
 	r.NoError(err)
 
 	var roomSchema RoomSchema
-	err = r.conn.QueryRow(context.Background(), "SELECT id, number, type, capacity, price FROM rooms WHERE id = $1", roomId).
+	err = r.pool.QueryRow(context.Background(), "SELECT id, number, type, capacity, price FROM rooms WHERE id = $1", roomId).
 		Scan(&roomSchema.Id, &roomSchema.Number, &roomSchema.Type, &roomSchema.Capacity, &roomSchema.Price)
 	r.NoError(err)
 	r.Equal("849702fc-aad3-478f-9dd7-9963b4ca33ca", roomSchema.Id.String())
@@ -124,7 +123,7 @@ func (r *RoomsRepositorySuite) TestCreate_OnNoErrors_ReturnsNil() {
 }
 
 func (r *RoomsRepositorySuite) TestExistsByRoomNumber_OnExists_ReturnsTrue() {
-	_, err := r.conn.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+	_, err := r.pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
 		"849702fc-aad3-478f-9dd7-9963b4ca33ca", "101", "SUITE", 2, 250)
 	r.Require().NoError(err)
 
@@ -141,6 +140,28 @@ func (r *RoomsRepositorySuite) TestExistsByRoomNumber_OnNotExists_ReturnsFalse()
 	r.False(exists)
 }
 
+func (r *RoomsRepositorySuite) TestFindAllByIds_OnExistingRooms_ReturnsRooms() {
+	_, err := r.pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+		"849702fc-aad3-478f-9dd7-9963b4ca33ca", "101", "SUITE", 2, 250)
+	r.Require().NoError(err)
+	_, err = r.pool.Exec(context.Background(), "INSERT INTO rooms (id, number, type, capacity, price) VALUES ($1, $2, $3, $4, $5)",
+		"57dba1c3-0421-4f24-a7c3-2a0b6c13063d", "204", "SINGLE", 1, 122)
+	r.Require().NoError(err)
+
+	rooms, err := r.roomsRepository.FindAllByIds([]uuid.UUID{
+		uuid.MustParse("849702fc-aad3-478f-9dd7-9963b4ca33ca"),
+		uuid.MustParse("0dc94e80-3df8-40c9-8a79-9e9e555abbde"),
+	})
+	r.NoError(err)
+
+	r.Len(rooms, 1)
+	r.Equal("849702fc-aad3-478f-9dd7-9963b4ca33ca", rooms[0].Id.String())
+	r.Equal("101", rooms[0].Number)
+	r.Equal("SUITE", rooms[0].Type)
+	r.Equal(uint8(2), rooms[0].Capacity)
+	r.Equal(uint64(250), rooms[0].Price)
+}
+
 func TestRoomsRepository(t *testing.T) {
 	suite.Run(t, new(RoomsRepositorySuite))
 }
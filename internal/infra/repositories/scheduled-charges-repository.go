@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ScheduledChargesRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (s *ScheduledChargesRepository) Create(scheduledCharge payment.ScheduledCharge) error {
+	ctx := context.Background()
+	tx, err := s.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = insertScheduledCharge(ctx, tx, scheduledCharge)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertScheduledCharge(ctx context.Context, tx pgx.Tx, scheduledCharge payment.ScheduledCharge) error {
+	_, err := tx.Exec(ctx, `INSERT INTO scheduled_charges
+		(id, booking_id, sequence, amount, due_on, mandatory, payment_token, transaction_id, status, attempts,
+			next_attempt_at, last_failure_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		scheduledCharge.Id.String(), scheduledCharge.BookingId.String(), scheduledCharge.Sequence, scheduledCharge.Amount,
+		scheduledCharge.DueOn, scheduledCharge.Mandatory, scheduledCharge.PaymentToken,
+		nullableString(scheduledCharge.TransactionId), scheduledCharge.Status, scheduledCharge.Attempts,
+		scheduledCharge.NextAttemptAt, nullableString(scheduledCharge.LastFailureReason))
+
+	return err
+}
+
+func (s *ScheduledChargesRepository) Update(scheduledCharge payment.ScheduledCharge) error {
+	_, err := s.Pool.Exec(context.Background(), `UPDATE scheduled_charges
+		SET transaction_id = $2, status = $3, attempts = $4, next_attempt_at = $5, last_failure_reason = $6,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		scheduledCharge.Id.String(), nullableString(scheduledCharge.TransactionId), scheduledCharge.Status,
+		scheduledCharge.Attempts, scheduledCharge.NextAttemptAt, nullableString(scheduledCharge.LastFailureReason))
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *ScheduledChargesRepository) FindAllDue(now time.Time) ([]payment.ScheduledCharge, error) {
+	return s.query(`SELECT id, booking_id, sequence, amount, due_on, mandatory, payment_token, COALESCE(transaction_id, ''),
+			status, attempts, next_attempt_at, COALESCE(last_failure_reason, '')
+		FROM scheduled_charges WHERE status = 'SCHEDULED' AND next_attempt_at <= $1 ORDER BY next_attempt_at`, now)
+}
+
+func (s *ScheduledChargesRepository) FindAllByBookingId(bookingId uuid.UUID) ([]payment.ScheduledCharge, error) {
+	return s.query(`SELECT id, booking_id, sequence, amount, due_on, mandatory, payment_token, COALESCE(transaction_id, ''),
+			status, attempts, next_attempt_at, COALESCE(last_failure_reason, '')
+		FROM scheduled_charges WHERE booking_id = $1 ORDER BY sequence`, bookingId.String())
+}
+
+func (s *ScheduledChargesRepository) query(sql string, args ...any) ([]payment.ScheduledCharge, error) {
+	rows, err := s.Pool.Query(context.Background(), sql, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (payment.ScheduledCharge, error) {
+		var scheduledCharge payment.ScheduledCharge
+		err := row.Scan(&scheduledCharge.Id, &scheduledCharge.BookingId, &scheduledCharge.Sequence, &scheduledCharge.Amount,
+			&scheduledCharge.DueOn, &scheduledCharge.Mandatory, &scheduledCharge.PaymentToken, &scheduledCharge.TransactionId,
+			&scheduledCharge.Status, &scheduledCharge.Attempts, &scheduledCharge.NextAttemptAt,
+			&scheduledCharge.LastFailureReason)
+		return scheduledCharge, err
+	})
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/staff"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StaffUsersRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (s *StaffUsersRepository) Create(staffUser staff.StaffUser) error {
+	_, err := s.Pool.Exec(context.Background(), `INSERT INTO staff_users
+		(id, name, email, password, role, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $6)`,
+		staffUser.Id.String(), staffUser.Name, staffUser.Email, staffUser.HashedPassword, staffUser.Role,
+		staffUser.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *StaffUsersRepository) ExistsByEmail(email string) (bool, error) {
+	var exists bool
+
+	err := s.Pool.QueryRow(context.Background(), `SELECT EXISTS (SELECT 1 FROM staff_users WHERE email = $1)`, email).
+		Scan(&exists)
+
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (s *StaffUsersRepository) FindOneByEmail(email string) (*staff.StaffUser, error) {
+	return s.findOne(`SELECT id, name, email, password, role, created_at FROM staff_users WHERE email = $1`, email)
+}
+
+func (s *StaffUsersRepository) FindOneById(staffUserId uuid.UUID) (*staff.StaffUser, error) {
+	return s.findOne(`SELECT id, name, email, password, role, created_at FROM staff_users WHERE id = $1`,
+		staffUserId.String())
+}
+
+func (s *StaffUsersRepository) UpdatePassword(staffUserId uuid.UUID, hashedPassword string) error {
+	_, err := s.Pool.Exec(context.Background(), `UPDATE staff_users SET password = $1, updated_at = $2 WHERE id = $3`,
+		hashedPassword, time.Now().UTC(), staffUserId.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *StaffUsersRepository) findOne(query string, argument string) (*staff.StaffUser, error) {
+	var staffUser staff.StaffUser
+
+	err := s.Pool.QueryRow(context.Background(), query, argument).
+		Scan(&staffUser.Id, &staffUser.Name, &staffUser.Email, &staffUser.HashedPassword, &staffUser.Role,
+			&staffUser.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &staffUser, nil
+}
@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/mfa"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TotpFactorsRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func (t *TotpFactorsRepository) Create(totpFactor mfa.TotpFactor) error {
+	_, err := t.Pool.Exec(context.Background(), `INSERT INTO totp_factors
+		(id, customer_id, staff_user_id, secret, status, last_used_step, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)`,
+		totpFactor.Id.String(), nullableUuid(totpFactor.CustomerId), nullableUuid(totpFactor.StaffUserId),
+		totpFactor.Secret, totpFactor.Status, totpFactor.LastUsedStep, totpFactor.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *TotpFactorsRepository) FindOneByCustomerId(customerId uuid.UUID) (*mfa.TotpFactor, error) {
+	return t.findOne("customer_id = $1", customerId)
+}
+
+func (t *TotpFactorsRepository) FindOneByStaffUserId(staffUserId uuid.UUID) (*mfa.TotpFactor, error) {
+	return t.findOne("staff_user_id = $1", staffUserId)
+}
+
+func (t *TotpFactorsRepository) findOne(condition string, ownerId uuid.UUID) (*mfa.TotpFactor, error) {
+	var totpFactor mfa.TotpFactor
+
+	err := t.Pool.QueryRow(context.Background(), `SELECT id,
+			COALESCE(customer_id, '00000000-0000-0000-0000-000000000000'),
+			COALESCE(staff_user_id, '00000000-0000-0000-0000-000000000000'), secret, status, last_used_step, created_at
+		FROM totp_factors WHERE `+condition, ownerId.String()).
+		Scan(&totpFactor.Id, &totpFactor.CustomerId, &totpFactor.StaffUserId, &totpFactor.Secret, &totpFactor.Status,
+			&totpFactor.LastUsedStep, &totpFactor.CreatedAt)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &totpFactor, nil
+}
+
+func (t *TotpFactorsRepository) Delete(totpFactorId uuid.UUID) error {
+	_, err := t.Pool.Exec(context.Background(), "DELETE FROM totp_factors WHERE id = $1", totpFactorId.String())
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *TotpFactorsRepository) Activate(totpFactor mfa.TotpFactor, recoveryCodes []mfa.RecoveryCode) error {
+	ctx := context.Background()
+	tx, err := t.Pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	commandTag, err := tx.Exec(ctx, `UPDATE totp_factors SET status = $2, last_used_step = $3, updated_at = NOW()
+		WHERE id = $1 AND status = 'PENDING'`, totpFactor.Id.String(), totpFactor.Status, totpFactor.LastUsedStep)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("two-factor authentication is already enabled")
+	}
+
+	for _, recoveryCode := range recoveryCodes {
+		_, err = tx.Exec(ctx, `INSERT INTO mfa_recovery_codes (id, totp_factor_id, hashed_code, created_at)
+			VALUES ($1, $2, $3, $4)`,
+			recoveryCode.Id.String(), recoveryCode.TotpFactorId.String(), recoveryCode.HashedCode,
+			recoveryCode.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (t *TotpFactorsRepository) UseStep(totpFactorId uuid.UUID, step int64) error {
+	commandTag, err := t.Pool.Exec(context.Background(), `UPDATE totp_factors SET last_used_step = $2, updated_at = NOW()
+		WHERE id = $1 AND last_used_step < $2`, totpFactorId.String(), step)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("verification code is invalid")
+	}
+
+	return nil
+}
+
+func (t *TotpFactorsRepository) UseRecoveryCode(totpFactorId uuid.UUID, hashedCode string) error {
+	commandTag, err := t.Pool.Exec(context.Background(), `UPDATE mfa_recovery_codes SET used_at = NOW()
+		WHERE id = (SELECT id FROM mfa_recovery_codes
+			WHERE totp_factor_id = $1 AND hashed_code = $2 AND used_at IS NULL LIMIT 1) AND used_at IS NULL`,
+		totpFactorId.String(), hashedCode)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("verification code is invalid")
+	}
+
+	return nil
+}
@@ -1,46 +1,202 @@
 package webhttp
 
 import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
+	"github.com/labstack/echo/v4"
 )
 
+const principalContextKey = "principal"
+
 type HttpAuthorization struct {
-	SecretsGateway gateways.ISecretsGateway
+	SigningKeysSource *auth.SigningKeysSource
+	RolesRepository   repositories.IRolesRepository
+	ApiKeysRepository repositories.IApiKeysRepository
+	HttpLogger        HttpLogger
 }
 
-func (h *HttpAuthorization) IsAdmin(authorizationToken string) bool {
-	token := h.isTokenValid(authorizationToken)
+// Authenticate validates the access token, or the API key sent in X-Api-Key, once per request and stores the acting
+// principal in the context. Requests without valid credentials are let through anonymously; routes that need a user
+// are guarded by Require.
+func (h *HttpAuthorization) Authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if plainKey := c.Request().Header.Get("X-Api-Key"); plainKey != "" {
+			principal, ok, err := h.parseApiKeyPrincipal(c, plainKey)
 
-	if token == nil {
-		return false
+			if err != nil {
+				h.HttpLogger.Log(c, err)
+				return NewInternalServerError(c)
+			}
+
+			if ok {
+				SetPrincipal(c, principal)
+			}
+
+			return next(c)
+		}
+
+		principal, ok := h.parsePrincipal(c.Request().Header.Get("Authorization"))
+
+		if ok {
+			SetPrincipal(c, principal)
+		}
+
+		return next(c)
 	}
+}
 
-	claims := token.Claims.(jwt.MapClaims)
-	return claims["role"] == "ADMIN"
+// Require lets the request through only when the role of the principal grants at least one of the given
+// permissions. Roles are mapped to permissions in the database, so they can be changed without a deploy. An API key
+// is granted its own scopes.
+func (h *HttpAuthorization) Require(permissions ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := GetPrincipal(c)
+
+			if !ok {
+				return NewUnauthorized(c, "missing or invalid authorization token")
+			}
+
+			granted := principal.Permissions
+
+			if !principal.IsApiKey() {
+				var err error
+				granted, err = h.RolesRepository.FindPermissionsByRole(principal.Role)
+
+				if err != nil {
+					h.HttpLogger.Log(c, err)
+					return NewInternalServerError(c)
+				}
+			}
+
+			if !slices.ContainsFunc(permissions, func(permission string) bool {
+				return slices.Contains(granted, permission)
+			}) {
+				return NewForbidden(c, "you do not have permission to access this resource")
+			}
+
+			principal.Permissions = granted
+			SetPrincipal(c, principal)
+			return next(c)
+		}
+	}
+}
+
+// GetPrincipal returns the user acting on the request, as set by Authenticate and completed by Require.
+func GetPrincipal(c echo.Context) (auth.Principal, bool) {
+	principal, ok := c.Get(principalContextKey).(auth.Principal)
+	return principal, ok
 }
 
-func (h *HttpAuthorization) IsCustomer(authorizationToken string) bool {
+func SetPrincipal(c echo.Context, principal auth.Principal) {
+	c.Set(principalContextKey, principal)
+}
+
+func (h *HttpAuthorization) parsePrincipal(authorizationHeader string) (auth.Principal, bool) {
+	authorizationToken := strings.TrimSpace(authorizationHeader)
+
+	if scheme, credentials, found := strings.Cut(authorizationToken, " "); found && strings.EqualFold(scheme, "Bearer") {
+		authorizationToken = strings.TrimSpace(credentials)
+	}
+
 	token := h.isTokenValid(authorizationToken)
 
 	if token == nil {
-		return false
+		return auth.Principal{}, false
 	}
 
 	claims := token.Claims.(jwt.MapClaims)
-	return claims["role"] == "ADMIN" || claims["role"] == "CUSTOMER"
+	role, _ := claims["role"].(string)
+
+	if role == "" {
+		return auth.Principal{}, false
+	}
+
+	principal := auth.Principal{Role: role}
+
+	var ok bool
+
+	if principal.CustomerId, ok = parseUuidClaim(claims, "customerId"); !ok {
+		if principal.StaffUserId, ok = parseUuidClaim(claims, "staffUserId"); !ok {
+			return auth.Principal{}, false
+		}
+	}
+
+	principal.SessionId, _ = parseUuidClaim(claims, "sessionId")
+	return principal, true
+}
+
+// parseApiKeyPrincipal looks the key up by its prefix and checks the secret. The last-used timestamp is written at
+// most once a minute per key, and failing to write it does not fail the request.
+func (h *HttpAuthorization) parseApiKeyPrincipal(c echo.Context, plainKey string) (auth.Principal, bool, error) {
+	prefix, secret, ok := apikey.SplitApiKey(strings.TrimSpace(plainKey))
+
+	if !ok || h.ApiKeysRepository == nil {
+		return auth.Principal{}, false, nil
+	}
+
+	apiKey, err := h.ApiKeysRepository.FindOneByPrefix(prefix)
+
+	if err != nil {
+		return auth.Principal{}, false, err
+	}
+
+	now := time.Now().UTC()
+
+	if apiKey == nil || apiKey.Verify(secret, now) != nil {
+		return auth.Principal{}, false, nil
+	}
+
+	if apiKey.ShouldRecordUse(now) {
+		if err := h.ApiKeysRepository.UpdateLastUsedAt(apiKey.Id, now); err != nil {
+			h.HttpLogger.Log(c, err)
+		}
+	}
+
+	return auth.Principal{ApiKeyId: apiKey.Id, Permissions: apiKey.Scopes}, true, nil
+}
+
+func parseUuidClaim(claims jwt.MapClaims, name string) (uuid.UUID, bool) {
+	value, ok := claims[name].(string)
+
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(value)
+
+	if err != nil || id == uuid.Nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
 }
 
 func (h *HttpAuthorization) isTokenValid(authorizationToken string) *jwt.Token {
 	token, err := jwt.Parse(authorizationToken, func(token *jwt.Token) (any, error) {
-		jwtSigningAccessToken, err := h.SecretsGateway.Get("JWT_SIGNING_ACCESS_TOKEN")
+		signingKeys, err := h.SigningKeysSource.Load()
 
 		if err != nil {
 			return nil, err
 		}
 
-		return []byte(jwtSigningAccessToken), nil
-	})
+		kid, _ := token.Header["kid"].(string)
+		signingKey, ok := signingKeys.Find(kid, time.Now())
+
+		if !ok || signingKey.Algorithm != token.Method.Alg() {
+			return nil, errors.New("token is not signed with a published signing key")
+		}
+
+		return signingKey.PublicKey(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 
 	if err != nil {
 		return nil
@@ -1,101 +1,320 @@
 package webhttp_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
 	"github.com/gsaaraujo/hotel-booking-api/internal/application/gateways"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/gsaaraujo/hotel-booking-api/internal/domain/models/apikey"
 	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 )
 
 type HttpAuthorizationSuite struct {
 	suite.Suite
+	signingKey         auth.SigningKey
 	fakeSecretsGateway gateways.FakeSecretsGateway
+	fakeApiKeys        repositories.FakeApiKeysRepository
 	httpAuthorization  webhttp.HttpAuthorization
 }
 
 func (h *HttpAuthorizationSuite) SetupTest() {
-	h.fakeSecretsGateway = gateways.FakeSecretsGateway{}
+	h.signingKey = h.newSigningKey("2030-06", "EdDSA")
+	h.fakeSecretsGateway = gateways.FakeSecretsGateway{Secrets: map[string]string{}}
+	h.publishSigningKeys(h.signingKey)
+	h.fakeApiKeys = repositories.FakeApiKeysRepository{}
 	h.httpAuthorization = webhttp.HttpAuthorization{
-		SecretsGateway: &h.fakeSecretsGateway,
+		SigningKeysSource: &auth.SigningKeysSource{SecretsGateway: &h.fakeSecretsGateway},
+		RolesRepository: &repositories.FakeRolesRepository{
+			RolePermissions: map[string][]string{
+				"CUSTOMER":   {"rooms:read", "bookings:read:own"},
+				"FRONT_DESK": {"rooms:read", "bookings:read:any", "folio:post"},
+			},
+		},
+		ApiKeysRepository: &h.fakeApiKeys,
+		HttpLogger:        webhttp.NewHttpLogger(),
 	}
 }
 
-func (h *HttpAuthorizationSuite) TestIsAdmin_OnValidTokenWithRoleAdmin_ReturnsTrue() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
-	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
+func (h *HttpAuthorizationSuite) newSigningKey(id string, algorithm string) auth.SigningKey {
+	signingKey, err := auth.NewSigningKey(id, algorithm, time.Now().Add(-time.Hour))
+	h.Require().NoError(err)
+	return signingKey
+}
+
+func (h *HttpAuthorizationSuite) publishSigningKeys(signingKeys ...auth.SigningKey) {
+	rawSigningKeys, err := json.Marshal(auth.SigningKeys(signingKeys))
 	h.Require().NoError(err)
-	h.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
+	h.fakeSecretsGateway.Secrets["JWT_SIGNING_KEYS"] = string(rawSigningKeys)
+}
 
-	isAdmin := h.httpAuthorization.IsAdmin(signedToken)
+func (h *HttpAuthorizationSuite) signTokenWith(signingKey auth.SigningKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(signingKey.Algorithm), claims)
+	token.Header["kid"] = signingKey.Id
+	signedToken, err := token.SignedString(signingKey.PrivateKey)
+	h.Require().NoError(err)
+	return signedToken
+}
 
-	h.True(isAdmin)
+func (h *HttpAuthorizationSuite) signToken(claims jwt.MapClaims) string {
+	return h.signTokenWith(h.signingKey, claims)
 }
 
-func (h *HttpAuthorizationSuite) TestIsCustomer_OnValidTokenWithRoleCustomer_ReturnsTrue() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "CUSTOMER",
+func (h *HttpAuthorizationSuite) signStaffToken(role string) string {
+	return h.signToken(jwt.MapClaims{
+		"staffUserId": "0b3ab4a2-6c53-4bd1-8d59-1f5b3f2b9e11",
+		"role":        role,
+		"sessionId":   "5d1f0c4e-8a4b-4d41-9a8e-0b7c3a6b2f10",
 	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
+}
+
+func (h *HttpAuthorizationSuite) signCustomerToken() string {
+	return h.signToken(jwt.MapClaims{
+		"customerId": "aa473b65-90a8-48ad-ab7d-5bd50a806d38",
+		"role":       "CUSTOMER",
+		"sessionId":  "5d1f0c4e-8a4b-4d41-9a8e-0b7c3a6b2f10",
+	})
+}
+
+func (h *HttpAuthorizationSuite) serve(authorizationHeader string, permissions ...string) (*httptest.ResponseRecorder,
+	echo.Context) {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("Authorization", authorizationHeader)
+	recorder := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(request, recorder)
+
+	err := h.httpAuthorization.Authenticate(h.httpAuthorization.Require(permissions...)(func(c echo.Context) error {
+		return webhttp.NewOk(c, nil)
+	}))(c)
+	h.Require().NoError(err)
+
+	return recorder, c
+}
+
+func (h *HttpAuthorizationSuite) TestAuthenticate_OnValidCustomerToken_SetsPrincipal() {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("Authorization", h.signCustomerToken())
+	c := echo.New().NewContext(request, httptest.NewRecorder())
+
+	err := h.httpAuthorization.Authenticate(func(c echo.Context) error { return nil })(c)
 	h.Require().NoError(err)
-	h.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 
-	isCustomer := h.httpAuthorization.IsCustomer(signedToken)
+	principal, ok := webhttp.GetPrincipal(c)
+	h.True(ok)
+	h.Equal("aa473b65-90a8-48ad-ab7d-5bd50a806d38", principal.CustomerId.String())
+	h.Equal("CUSTOMER", principal.Role)
+	h.Equal("5d1f0c4e-8a4b-4d41-9a8e-0b7c3a6b2f10", principal.SessionId.String())
+	h.True(principal.IsCustomer())
+}
+
+func (h *HttpAuthorizationSuite) TestAuthenticate_OnBearerPrefix_SetsPrincipal() {
+	for _, scheme := range []string{"Bearer ", "bearer "} {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("Authorization", scheme+h.signStaffToken("FRONT_DESK"))
+		c := echo.New().NewContext(request, httptest.NewRecorder())
 
-	h.True(isCustomer)
+		err := h.httpAuthorization.Authenticate(func(c echo.Context) error { return nil })(c)
+		h.Require().NoError(err)
+
+		principal, ok := webhttp.GetPrincipal(c)
+		h.True(ok)
+		h.Equal("0b3ab4a2-6c53-4bd1-8d59-1f5b3f2b9e11", principal.StaffUserId.String())
+		h.Equal("FRONT_DESK", principal.Role)
+		h.False(principal.IsCustomer())
+	}
 }
 
-func (h *HttpAuthorizationSuite) TestIsCustomer_OnValidTokenWithRoleAdmin_ReturnsTrue() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ADMIN",
+func (h *HttpAuthorizationSuite) TestAuthenticate_OnInvalidToken_CallsNextHandlerWithoutPrincipal() {
+	for _, authorizationHeader := range []string{"", "Bearer abc", h.signToken(jwt.MapClaims{"role": "ADMIN"})} {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("Authorization", authorizationHeader)
+		c := echo.New().NewContext(request, httptest.NewRecorder())
+		called := false
+
+		err := h.httpAuthorization.Authenticate(func(c echo.Context) error {
+			called = true
+			return nil
+		})(c)
+		h.Require().NoError(err)
+
+		_, ok := webhttp.GetPrincipal(c)
+		h.True(called)
+		h.False(ok)
+	}
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnRoleWithPermission_CallsNextHandler() {
+	recorder, c := h.serve(h.signStaffToken("FRONT_DESK"), "folio:post")
+
+	h.Equal(200, recorder.Code)
+	principal, _ := webhttp.GetPrincipal(c)
+	h.True(principal.HasPermission("bookings:read:any"))
+	h.False(principal.HasPermission("staff:write"))
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnRoleWithAnyOfThePermissions_CallsNextHandler() {
+	recorder, c := h.serve("Bearer "+h.signCustomerToken(), "bookings:read:own", "bookings:read:any")
+
+	h.Equal(200, recorder.Code)
+	principal, _ := webhttp.GetPrincipal(c)
+	h.False(principal.HasPermission("bookings:read:any"))
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnRoleWithoutPermission_ReturnsForbidden() {
+	recorder, _ := h.serve(h.signCustomerToken(), "folio:post")
+
+	h.Equal(403, recorder.Code)
+	h.JSONEq(`
+		{
+			"statusCode": 403,
+			"statusText": "FORBIDDEN",
+			"error": "you do not have permission to access this resource"
+		}
+	`, recorder.Body.String())
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnUnknownRole_ReturnsForbidden() {
+	recorder, _ := h.serve(h.signStaffToken("ABC"), "rooms:read")
+
+	h.Equal(403, recorder.Code)
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnMissingToken_ReturnsUnauthorized() {
+	recorder, _ := h.serve("", "rooms:read")
+
+	h.Equal(401, recorder.Code)
+	h.JSONEq(`
+		{
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "missing or invalid authorization token"
+		}
+	`, recorder.Body.String())
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnUnpublishedSigningKey_ReturnsUnauthorized() {
+	signedToken := h.signTokenWith(h.newSigningKey("2030-06", "EdDSA"), jwt.MapClaims{
+		"staffUserId": "0b3ab4a2-6c53-4bd1-8d59-1f5b3f2b9e11",
+		"role":        "ADMIN",
 	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	h.Require().NoError(err)
-	h.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 
-	isCustomer := h.httpAuthorization.IsCustomer(signedToken)
+	recorder, _ := h.serve(signedToken, "rooms:read")
 
-	h.True(isCustomer)
+	h.Equal(401, recorder.Code)
 }
 
-func (h *HttpAuthorizationSuite) TestIsCustomer_OnValidTokenWithRoleDiffThanCustomerOrAdmin_ReturnsFalse() {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "ABC",
+func (h *HttpAuthorizationSuite) TestRequire_OnRetiredSigningKey_ReturnsUnauthorized() {
+	signedToken := h.signStaffToken("FRONT_DESK")
+	h.signingKey.RetiresAt = time.Now().Add(-time.Minute)
+	h.publishSigningKeys(h.signingKey, h.newSigningKey("2030-07", "EdDSA"))
+
+	recorder, _ := h.serve(signedToken, "rooms:read")
+
+	h.Equal(401, recorder.Code)
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnRotatedRsaSigningKey_CallsNextHandler() {
+	rsaSigningKey := h.newSigningKey("2030-07", "RS256")
+	h.publishSigningKeys(h.signingKey, rsaSigningKey)
+	signedToken := h.signTokenWith(rsaSigningKey, jwt.MapClaims{
+		"staffUserId": "0b3ab4a2-6c53-4bd1-8d59-1f5b3f2b9e11",
+		"role":        "FRONT_DESK",
 	})
-	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
-	h.Require().NoError(err)
-	h.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 
-	isCustomer := h.httpAuthorization.IsCustomer(signedToken)
+	recorder, _ := h.serve(signedToken, "rooms:read")
 
-	h.False(isCustomer)
+	h.Equal(200, recorder.Code)
 }
 
-func (h *HttpAuthorizationSuite) TestIsAdmin_OnValidTokenButRoleIsNotAdmin_ReturnsFalse() {
+func (h *HttpAuthorizationSuite) TestRequire_OnSymmetricallySignedToken_ReturnsUnauthorized() {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": "CUSTOMER",
+		"staffUserId": "0b3ab4a2-6c53-4bd1-8d59-1f5b3f2b9e11",
+		"role":        "ADMIN",
 	})
+	token.Header["kid"] = h.signingKey.Id
 	signedToken, err := token.SignedString([]byte("6b45b2cb79974f989447f1d850d139f1"))
 	h.Require().NoError(err)
-	h.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "6b45b2cb79974f989447f1d850d139f1"}
 
-	isAdmin := h.httpAuthorization.IsAdmin(signedToken)
+	recorder, _ := h.serve(signedToken, "rooms:read")
+
+	h.Equal(401, recorder.Code)
+}
 
-	h.False(isAdmin)
+func (h *HttpAuthorizationSuite) newApiKey(scopes ...string) (apikey.ApiKey, string) {
+	apiKey, plainKey, err := apikey.NewApiKey("Channel manager", scopes, time.Now().Add(time.Hour), uuid.New())
+	h.Require().NoError(err)
+	h.fakeApiKeys.ApiKeys = append(h.fakeApiKeys.ApiKeys, apiKey)
+	return apiKey, plainKey
 }
 
-func (h *HttpAuthorizationSuite) TestIsAdmin_OnDifferentJwtSigningAccessToken_ReturnsFalse() {
-	token := jwt.New(jwt.SigningMethodHS256)
-	signedToken, err := token.SignedString([]byte("7e1408f7ff794cbc85403d2aaeb666c7"))
+func (h *HttpAuthorizationSuite) serveApiKey(plainKey string, permissions ...string) (*httptest.ResponseRecorder,
+	echo.Context) {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-Api-Key", plainKey)
+	recorder := httptest.NewRecorder()
+	c := echo.New().NewContext(request, recorder)
+
+	err := h.httpAuthorization.Authenticate(h.httpAuthorization.Require(permissions...)(func(c echo.Context) error {
+		return webhttp.NewOk(c, nil)
+	}))(c)
 	h.Require().NoError(err)
-	h.fakeSecretsGateway.Secrets = map[string]string{"JWT_SIGNING_ACCESS_TOKEN": "2f9996348690465d8980256d21bc2e43"}
 
-	isAdmin := h.httpAuthorization.IsAdmin(signedToken)
+	return recorder, c
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnApiKeyWithScope_CallsNextHandlerAndRecordsUse() {
+	apiKey, plainKey := h.newApiKey("bookings:read:any", "rooms:read")
+
+	recorder, c := h.serveApiKey(plainKey, "bookings:read:own", "bookings:read:any")
+
+	h.Equal(200, recorder.Code)
+	principal, _ := webhttp.GetPrincipal(c)
+	h.True(principal.IsApiKey())
+	h.Equal(apiKey.Id, principal.ApiKeyId)
+	h.False(principal.IsCustomer())
+	h.True(principal.HasPermission("rooms:read"))
+	h.WithinDuration(time.Now(), h.fakeApiKeys.ApiKeys[0].LastUsedAt, time.Minute)
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnApiKeyWithoutScope_ReturnsForbidden() {
+	_, plainKey := h.newApiKey("rooms:read")
+
+	recorder, _ := h.serveApiKey(plainKey, "folio:post")
+
+	h.Equal(403, recorder.Code)
+}
+
+func (h *HttpAuthorizationSuite) TestRequire_OnRevokedOrWrongApiKey_ReturnsUnauthorized() {
+	_, plainKey := h.newApiKey("rooms:read")
+
+	recorder, _ := h.serveApiKey(plainKey+"x", "rooms:read")
+	h.Equal(401, recorder.Code)
+
+	h.fakeApiKeys.ApiKeys[0].RevokedAt = time.Now()
+	recorder, _ = h.serveApiKey(plainKey, "rooms:read")
+	h.Equal(401, recorder.Code)
+}
+
+func (h *HttpAuthorizationSuite) TestGetPrincipal_OnPrincipalSet_ReturnsPrincipal() {
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	_, ok := webhttp.GetPrincipal(c)
+	h.False(ok)
+
+	webhttp.SetPrincipal(c, auth.Principal{Role: "ADMIN"})
+	principal, ok := webhttp.GetPrincipal(c)
 
-	h.False(isAdmin)
+	h.True(ok)
+	h.Equal("ADMIN", principal.Role)
 }
 
 func TestHttpAuthorization(t *testing.T) {
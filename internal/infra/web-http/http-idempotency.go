@@ -0,0 +1,131 @@
+package webhttp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	"github.com/labstack/echo/v4"
+)
+
+type HttpIdempotency struct {
+	HttpLogger                HttpLogger
+	IdempotencyKeysRepository repositories.IIdempotencyKeysRepository
+	Ttl                       time.Duration
+}
+
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *recordingResponseWriter) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Middleware replays the stored response when a request is retried with the same Idempotency-Key and body.
+// Keys are scoped by method, path and the authenticated caller, and server errors release the key so the client can
+// retry.
+func (h *HttpIdempotency) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get("Idempotency-Key")
+
+		if key == "" {
+			return next(c)
+		}
+
+		if len(key) > 255 {
+			return NewBadRequest(c, "idempotency key must have at most 255 characters")
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+
+		if err != nil {
+			return NewBadRequest(c, "request body could not be read")
+		}
+
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+		requestHash := sha256.Sum256(body)
+		scope := c.Request().Method + " " + c.Request().URL.Path + " " + idempotencyCaller(c)
+
+		ttl := h.Ttl
+		if ttl == 0 {
+			ttl = 24 * time.Hour
+		}
+
+		existing, err := h.IdempotencyKeysRepository.Reserve(scope, key, hex.EncodeToString(requestHash[:]),
+			time.Now().UTC().Add(-ttl))
+
+		if err != nil {
+			h.HttpLogger.Log(c, err)
+			return NewInternalServerError(c)
+		}
+
+		if existing != nil {
+			if existing.RequestHash != hex.EncodeToString(requestHash[:]) {
+				return NewUnprocessableEntity(c, "idempotency key was already used with a different request body")
+			}
+
+			if existing.StatusCode == 0 {
+				return NewConflict(c, "a request with this idempotency key is still being processed")
+			}
+
+			c.Response().Header().Set("Idempotent-Replayed", "true")
+			return c.Blob(existing.StatusCode, existing.ContentType, existing.Body)
+		}
+
+		recorder := &recordingResponseWriter{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+
+		err = next(c)
+
+		if err != nil || c.Response().Status >= 500 {
+			if releaseErr := h.IdempotencyKeysRepository.Release(scope, key); releaseErr != nil {
+				h.HttpLogger.Log(c, releaseErr)
+			}
+
+			return err
+		}
+
+		err = h.IdempotencyKeysRepository.Complete(repositories.IdempotencyRecordDTO{
+			Scope:       scope,
+			Key:         key,
+			RequestHash: hex.EncodeToString(requestHash[:]),
+			StatusCode:  c.Response().Status,
+			ContentType: c.Response().Header().Get(echo.HeaderContentType),
+			Body:        recorder.body.Bytes(),
+		})
+
+		if err != nil {
+			h.HttpLogger.Log(c, err)
+		}
+
+		return nil
+	}
+}
+
+// idempotencyCaller identifies who sent the request by principal rather than by credentials, so refreshed access tokens
+// keep their keys and every API key gets its own.
+func idempotencyCaller(c echo.Context) string {
+	principal, ok := GetPrincipal(c)
+
+	switch {
+	case !ok:
+		return "anonymous"
+	case principal.IsApiKey():
+		return "api-key:" + principal.ApiKeyId.String()
+	case principal.StaffUserId != uuid.Nil:
+		return "staff-user:" + principal.StaffUserId.String()
+	case principal.IsCustomer():
+		return "customer:" + principal.CustomerId.String()
+	}
+
+	return "anonymous"
+}
@@ -0,0 +1,168 @@
+package webhttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/auth"
+	"github.com/gsaaraujo/hotel-booking-api/internal/application/repositories"
+	webhttp "github.com/gsaaraujo/hotel-booking-api/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type HttpIdempotencySuite struct {
+	suite.Suite
+	fakeIdempotencyKeysRepository repositories.FakeIdempotencyKeysRepository
+	httpIdempotency               webhttp.HttpIdempotency
+	calls                         int
+	statusCode                    int
+	e                             *echo.Echo
+}
+
+func (h *HttpIdempotencySuite) SetupTest() {
+	h.fakeIdempotencyKeysRepository = repositories.FakeIdempotencyKeysRepository{}
+	h.httpIdempotency = webhttp.HttpIdempotency{
+		HttpLogger:                webhttp.NewHttpLogger(),
+		IdempotencyKeysRepository: &h.fakeIdempotencyKeysRepository,
+	}
+	h.calls = 0
+	h.statusCode = 201
+	h.e = echo.New()
+	h.e.POST("/api/sign-up", func(c echo.Context) error {
+		h.calls++
+		return c.JSON(h.statusCode, map[string]int{"call": h.calls})
+	}, h.httpIdempotency.Middleware)
+}
+
+func (h *HttpIdempotencySuite) send(key string, body string) *httptest.ResponseRecorder {
+	return h.sendTo("/api/sign-up", key, body)
+}
+
+func (h *HttpIdempotencySuite) sendTo(path string, key string, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	if key != "" {
+		request.Header.Set("Idempotency-Key", key)
+	}
+
+	recorder := httptest.NewRecorder()
+	h.e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnRetryWithSameBody_ReplaysStoredResponse() {
+	first := h.send("f2b4c2d0", `{"name": "John"}`)
+	second := h.send("f2b4c2d0", `{"name": "John"}`)
+
+	h.Equal(1, h.calls)
+	h.Equal(201, second.Code)
+	h.JSONEq(first.Body.String(), second.Body.String())
+	h.Equal("true", second.Header().Get("Idempotent-Replayed"))
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnSameKeyWithDifferentBody_ReturnsUnprocessableEntity() {
+	h.send("f2b4c2d0", `{"name": "John"}`)
+	recorder := h.send("f2b4c2d0", `{"name": "Jane"}`)
+
+	h.Equal(1, h.calls)
+	h.Equal(422, recorder.Code)
+	h.JSONEq(`
+		{
+			"statusCode": 422,
+			"statusText": "UNPROCESSABLE_ENTITY",
+			"error": "idempotency key was already used with a different request body"
+		}
+	`, recorder.Body.String())
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnServerError_ReleasesKey() {
+	h.statusCode = 500
+	h.send("f2b4c2d0", `{"name": "John"}`)
+	h.statusCode = 201
+	recorder := h.send("f2b4c2d0", `{"name": "John"}`)
+
+	h.Equal(2, h.calls)
+	h.Equal(201, recorder.Code)
+	h.Empty(recorder.Header().Get("Idempotent-Replayed"))
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnRequestInFlight_ReturnsConflict() {
+	var inFlight *httptest.ResponseRecorder
+	h.e.POST("/api/bookings", func(c echo.Context) error {
+		h.calls++
+
+		if inFlight == nil {
+			inFlight = h.sendTo("/api/bookings", "f2b4c2d0", `{"room": 1}`)
+		}
+
+		return c.JSON(201, map[string]int{"call": h.calls})
+	}, h.httpIdempotency.Middleware)
+
+	h.sendTo("/api/bookings", "f2b4c2d0", `{"room": 1}`)
+
+	h.Equal(1, h.calls)
+	h.Equal(409, inFlight.Code)
+	h.JSONEq(`
+		{
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "a request with this idempotency key is still being processed"
+		}
+	`, inFlight.Body.String())
+}
+
+func (h *HttpIdempotencySuite) sendAs(principal auth.Principal, authorization string, key string,
+	body string) *httptest.ResponseRecorder {
+	h.e.POST("/api/bookings", func(c echo.Context) error {
+		h.calls++
+		return c.JSON(201, map[string]int{"call": h.calls})
+	}, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			webhttp.SetPrincipal(c, principal)
+			return next(c)
+		}
+	}, h.httpIdempotency.Middleware)
+
+	request := httptest.NewRequest(http.MethodPost, "/api/bookings", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.Header.Set("Authorization", authorization)
+	request.Header.Set("Idempotency-Key", key)
+
+	recorder := httptest.NewRecorder()
+	h.e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnRetryWithRefreshedAccessToken_ReplaysStoredResponse() {
+	customer := auth.Principal{CustomerId: uuid.New(), Role: "CUSTOMER"}
+	h.sendAs(customer, "Bearer first-access-token", "f2b4c2d0", `{"room": 1}`)
+	recorder := h.sendAs(customer, "Bearer refreshed-access-token", "f2b4c2d0", `{"room": 1}`)
+
+	h.Equal(1, h.calls)
+	h.Equal("true", recorder.Header().Get("Idempotent-Replayed"))
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnSameKeyFromAnotherApiKey_CallsHandlerAgain() {
+	h.sendAs(auth.Principal{ApiKeyId: uuid.New()}, "", "f2b4c2d0", `{"room": 1}`)
+	recorder := h.sendAs(auth.Principal{ApiKeyId: uuid.New()}, "", "f2b4c2d0", `{"room": 1}`)
+
+	h.Equal(2, h.calls)
+	h.Empty(recorder.Header().Get("Idempotent-Replayed"))
+}
+
+func (h *HttpIdempotencySuite) TestMiddleware_OnMissingKey_CallsHandlerEveryTime() {
+	h.send("", `{"name": "John"}`)
+	h.send("", `{"name": "John"}`)
+
+	h.Equal(2, h.calls)
+	h.Empty(h.fakeIdempotencyKeysRepository.Records)
+}
+
+func TestHttpIdempotency(t *testing.T) {
+	suite.Run(t, new(HttpIdempotencySuite))
+}
@@ -31,3 +31,19 @@ func (h *HttpLogger) Log(c echo.Context, err error) {
 		slog.String("error_message", err.Error()),
 	)
 }
+
+// LogBackground logs the error of work a handler left running after its response, once the request can no longer be
+// read.
+func (h *HttpLogger) LogBackground(message string, err error) {
+	h.logger.LogAttrs(context.Background(), slog.LevelError, message,
+		slog.String("error_message", err.Error()),
+	)
+}
+
+func (h *HttpLogger) Warn(c echo.Context, message string, attrs ...slog.Attr) {
+	requestAttrs := []slog.Attr{
+		slog.String("request_method", c.Request().Method),
+		slog.String("request_endpoint", c.Request().RequestURI),
+	}
+	h.logger.LogAttrs(context.Background(), slog.LevelWarn, message, append(requestAttrs, attrs...)...)
+}
@@ -1,6 +1,12 @@
 package webhttp
 
-import "github.com/labstack/echo/v4"
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
 
 type HttpResponseSuccess struct {
 	StatusCode uint16 `json:"statusCode"`
@@ -36,6 +42,14 @@ func NewCreated(c echo.Context, data any) error {
 	})
 }
 
+func NewAccepted(c echo.Context, data any) error {
+	return c.JSON(202, HttpResponseSuccess{
+		StatusCode: 202,
+		StatusText: "ACCEPTED",
+		Data:       data,
+	})
+}
+
 func NewBadRequestValidation(c echo.Context, errorMessages []string) error {
 	return c.JSON(400, HttpResponseErrors{
 		StatusCode:    400,
@@ -60,6 +74,14 @@ func NewUnauthorized(c echo.Context, errorMessage string) error {
 	})
 }
 
+func NewPaymentRequired(c echo.Context, errorMessage string) error {
+	return c.JSON(402, HttpResponseError{
+		StatusCode:   402,
+		StatusText:   "PAYMENT_REQUIRED",
+		ErrorMessage: errorMessage,
+	})
+}
+
 func NewForbidden(c echo.Context, errorMessage string) error {
 	return c.JSON(403, HttpResponseError{
 		StatusCode:   403,
@@ -84,6 +106,25 @@ func NewConflict(c echo.Context, errorMessage string) error {
 	})
 }
 
+func NewUnprocessableEntity(c echo.Context, errorMessage string) error {
+	return c.JSON(422, HttpResponseError{
+		StatusCode:   422,
+		StatusText:   "UNPROCESSABLE_ENTITY",
+		ErrorMessage: errorMessage,
+	})
+}
+
+// NewTooManyRequests tells the client in the Retry-After header how many seconds to wait before trying again.
+func NewTooManyRequests(c echo.Context, retryAfter time.Duration, errorMessage string) error {
+	c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+
+	return c.JSON(429, HttpResponseError{
+		StatusCode:   429,
+		StatusText:   "TOO_MANY_REQUESTS",
+		ErrorMessage: errorMessage,
+	})
+}
+
 func NewInternalServerError(c echo.Context) error {
 	return c.JSON(500, HttpResponseError{
 		StatusCode:   500,
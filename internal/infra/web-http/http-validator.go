@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 )
 
 type HttpValidator struct {
@@ -38,6 +40,48 @@ func NewHttpValidator() (HttpValidator, error) {
 		return HttpValidator{}, err
 	}
 
+	err = newValidator.RegisterValidation("boolean", isBoolean)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
+	err = newValidator.RegisterValidation("date", isDate)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
+	err = newValidator.RegisterValidation("uuidArray", isUuidArray)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
+	err = newValidator.RegisterValidation("integerArray", isIntegerArray)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
+	err = newValidator.RegisterValidation("addOnArray", isAddOnArray)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
+	err = newValidator.RegisterValidation("installmentArray", isInstallmentArray)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
+	err = newValidator.RegisterValidation("stringArray", isStringArray)
+
+	if err != nil {
+		return HttpValidator{}, err
+	}
+
 	HttpValidator := HttpValidator{
 		validate: newValidator,
 	}
@@ -76,6 +120,152 @@ func isPositive(fieldLevel validator.FieldLevel) bool {
 	return value >= 0
 }
 
+func isBoolean(fieldLevel validator.FieldLevel) bool {
+	return fieldLevel.Field().Kind() == reflect.Bool
+}
+
+func isDate(fieldLevel validator.FieldLevel) bool {
+	if fieldLevel.Field().Kind() != reflect.String {
+		return false
+	}
+
+	_, err := time.Parse(time.DateOnly, fieldLevel.Field().String())
+	return err == nil
+}
+
+func isUuidArray(fieldLevel validator.FieldLevel) bool {
+	field := fieldLevel.Field()
+
+	if field.Kind() != reflect.Slice || field.Len() == 0 {
+		return false
+	}
+
+	for i := range field.Len() {
+		item := field.Index(i)
+
+		if item.Kind() == reflect.Interface {
+			item = item.Elem()
+		}
+
+		if item.Kind() != reflect.String {
+			return false
+		}
+
+		if _, err := uuid.Parse(item.String()); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isStringArray(fieldLevel validator.FieldLevel) bool {
+	field := fieldLevel.Field()
+
+	if field.Kind() != reflect.Slice || field.Len() == 0 {
+		return false
+	}
+
+	for i := range field.Len() {
+		item := field.Index(i)
+
+		if item.Kind() == reflect.Interface {
+			item = item.Elem()
+		}
+
+		if item.Kind() != reflect.String || strings.TrimSpace(item.String()) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isIntegerArray(fieldLevel validator.FieldLevel) bool {
+	field := fieldLevel.Field()
+
+	if field.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := range field.Len() {
+		item := field.Index(i)
+
+		if item.Kind() == reflect.Interface {
+			item = item.Elem()
+		}
+
+		if item.Kind() != reflect.Float64 || item.Float() < 0 || item.Float() != float64(int(item.Float())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAddOnArray(fieldLevel validator.FieldLevel) bool {
+	field := fieldLevel.Field()
+
+	if field.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := range field.Len() {
+		item, ok := field.Index(i).Interface().(map[string]any)
+
+		if !ok {
+			return false
+		}
+
+		code, ok := item["code"].(string)
+		if !ok || strings.TrimSpace(code) == "" {
+			return false
+		}
+
+		quantity, ok := item["quantity"].(float64)
+		if !ok || quantity < 1 || quantity > 255 || quantity != float64(int(quantity)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isInstallmentArray(fieldLevel validator.FieldLevel) bool {
+	field := fieldLevel.Field()
+
+	if field.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := range field.Len() {
+		item, ok := field.Index(i).Interface().(map[string]any)
+
+		if !ok {
+			return false
+		}
+
+		percent, ok := item["percent"].(float64)
+		if !ok || percent < 1 || percent > 100 || percent != float64(int(percent)) {
+			return false
+		}
+
+		due, ok := item["due"].(string)
+		if !ok || strings.TrimSpace(due) == "" {
+			return false
+		}
+
+		if daysBeforeArrival, exists := item["daysBeforeArrival"]; exists {
+			days, ok := daysBeforeArrival.(float64)
+			if !ok || days < 0 || days > 365 || days != float64(int(days)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (h *HttpValidator) Validate(body any) []string {
 	err := h.validate.Struct(body)
 
@@ -105,6 +295,20 @@ func (h *HttpValidator) Validate(body any) []string {
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must not be empty", field))
 			case "positive":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must be positive", field))
+			case "boolean":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be boolean", field))
+			case "date":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be a date in the format YYYY-MM-DD", field))
+			case "uuidArray":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be a non-empty array of uuids", field))
+			case "stringArray":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be a non-empty array of non-empty strings", field))
+			case "integerArray":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be an array of positive integers", field))
+			case "addOnArray":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be an array of objects with a code and a quantity between 1 and 255", field))
+			case "installmentArray":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be an array of objects with a due, a percent between 1 and 100 and optional days before arrival up to 365", field))
 			}
 		}
 
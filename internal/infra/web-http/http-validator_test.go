@@ -197,6 +197,181 @@ func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagPositive_ReturnEr
 	h.EqualValues([]string{"field2 must be positive"}, errorMessages)
 }
 
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagBoolean_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"boolean"`
+		Field2 any `validate:"boolean"`
+		Field3 any `validate:"boolean"`
+		Field4 any `validate:"boolean"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": "true",
+			"field2": 1,
+			"field3": false
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{"field1 must be boolean", "field2 must be boolean", "field4 must be boolean"}, errorMessages)
+}
+
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagDate_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"date"`
+		Field2 any `validate:"date"`
+		Field3 any `validate:"date"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": "2025-02-30",
+			"field2": 20250210,
+			"field3": "2025-02-10"
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{"field1 must be a date in the format YYYY-MM-DD", "field2 must be a date in the format YYYY-MM-DD"}, errorMessages)
+}
+
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagUuidArray_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"uuidArray"`
+		Field2 any `validate:"uuidArray"`
+		Field3 any `validate:"uuidArray"`
+		Field4 any `validate:"uuidArray"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": [],
+			"field2": ["abc"],
+			"field3": "849702fc-aad3-478f-9dd7-9963b4ca33ca",
+			"field4": ["849702fc-aad3-478f-9dd7-9963b4ca33ca"]
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{"field1 must be a non-empty array of uuids", "field2 must be a non-empty array of uuids", "field3 must be a non-empty array of uuids"}, errorMessages)
+}
+
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagIntegerArray_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"integerArray"`
+		Field2 any `validate:"integerArray"`
+		Field3 any `validate:"integerArray"`
+		Field4 any `validate:"integerArray"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": [1.5],
+			"field2": ["4"],
+			"field3": [],
+			"field4": [4, 12]
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{"field1 must be an array of positive integers", "field2 must be an array of positive integers"}, errorMessages)
+}
+
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagAddOnArray_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"addOnArray"`
+		Field2 any `validate:"addOnArray"`
+		Field3 any `validate:"addOnArray"`
+		Field4 any `validate:"addOnArray"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": [{"code": "BREAKFAST"}],
+			"field2": [{"code": "", "quantity": 1}],
+			"field3": [],
+			"field4": [{"code": "BREAKFAST", "quantity": 2}]
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{
+		"field1 must be an array of objects with a code and a quantity between 1 and 255",
+		"field2 must be an array of objects with a code and a quantity between 1 and 255",
+	}, errorMessages)
+}
+
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagStringArray_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"stringArray"`
+		Field2 any `validate:"stringArray"`
+		Field3 any `validate:"stringArray"`
+		Field4 any `validate:"stringArray"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": [],
+			"field2": ["rooms:read", 1],
+			"field3": ["rooms:read", " "],
+			"field4": ["rooms:read", "bookings:read:any"]
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{
+		"field1 must be a non-empty array of non-empty strings",
+		"field2 must be a non-empty array of non-empty strings",
+		"field3 must be a non-empty array of non-empty strings",
+	}, errorMessages)
+}
+
+func (h *HttpValidatorSuite) TestValidate_OnInvalidFieldWithTagInstallmentArray_ReturnErrors() {
+	type Example struct {
+		Field1 any `validate:"installmentArray"`
+		Field2 any `validate:"installmentArray"`
+		Field3 any `validate:"installmentArray"`
+		Field4 any `validate:"installmentArray"`
+	}
+	var example Example
+	err := json.Unmarshal([]byte(`
+		{
+			"field1": [{"due": "AT_BOOKING"}],
+			"field2": [{"percent": 20, "due": "BEFORE_ARRIVAL", "daysBeforeArrival": -1}],
+			"field3": [],
+			"field4": [{"percent": 20, "due": "BEFORE_ARRIVAL", "daysBeforeArrival": 7}, {"percent": 80, "due": "AT_CHECK_IN"}]
+		}
+`), &example)
+	h.Require().NoError(err)
+	validator, err := webhttp.NewHttpValidator()
+	h.Require().NoError(err)
+	errorMessages := validator.Validate(example)
+
+	h.EqualValues([]string{
+		"field1 must be an array of objects with a due, a percent between 1 and 100 and optional days before arrival up to 365",
+		"field2 must be an array of objects with a due, a percent between 1 and 100 and optional days before arrival up to 365",
+	}, errorMessages)
+}
+
 func TestHttpValidator(t *testing.T) {
 	suite.Run(t, new(HttpValidatorSuite))
 }
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS bookings (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL REFERENCES customers (id),
+  check_in DATE NOT NULL,
+  check_out DATE NOT NULL,
+  guests INTEGER NOT NULL,
+  promo_code VARCHAR(50),
+  total_price INTEGER NOT NULL,
+  status VARCHAR(20) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CHECK (check_out > check_in)
+);
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS booking_rooms (
+  booking_id UUID NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+  room_id UUID NOT NULL REFERENCES rooms (id),
+  PRIMARY KEY (booking_id, room_id)
+);
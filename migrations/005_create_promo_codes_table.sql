@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS promo_codes (
+  code VARCHAR(50) PRIMARY KEY,
+  discount_percent INTEGER NOT NULL CHECK (discount_percent > 0 AND discount_percent <= 100),
+  valid_from DATE NOT NULL,
+  valid_until DATE NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS pricing_rules (
+  id UUID PRIMARY KEY,
+  room_type VARCHAR(50) NOT NULL,
+  occupancy_threshold INTEGER NOT NULL,
+  adjustment_percent INTEGER NOT NULL,
+  floor_price INTEGER NOT NULL,
+  ceiling_price INTEGER NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+ALTER TABLE bookings RENAME COLUMN guests TO adults;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS children_ages INTEGER[] NOT NULL DEFAULT '{}';
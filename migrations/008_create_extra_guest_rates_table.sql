@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS extra_guest_rates (
+  room_type VARCHAR(50) PRIMARY KEY,
+  base_occupancy INTEGER NOT NULL,
+  extra_adult_price INTEGER NOT NULL,
+  extra_child_price INTEGER NOT NULL,
+  child_max_age INTEGER NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS add_ons (
+  id UUID PRIMARY KEY,
+  code VARCHAR(50) NOT NULL UNIQUE,
+  name VARCHAR(100) NOT NULL,
+  price INTEGER NOT NULL,
+  unit VARCHAR(30) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
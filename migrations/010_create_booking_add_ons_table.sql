@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS booking_add_ons (
+  booking_id UUID NOT NULL REFERENCES bookings (id),
+  add_on_code VARCHAR(50) NOT NULL REFERENCES add_ons (code),
+  quantity INTEGER NOT NULL,
+  price INTEGER NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (booking_id, add_on_code)
+);
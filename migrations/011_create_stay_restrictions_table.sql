@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS stay_restrictions (
+  room_type VARCHAR(50) NOT NULL,
+  date DATE NOT NULL,
+  min_stay_nights INTEGER NOT NULL DEFAULT 1,
+  closed_to_arrival BOOLEAN NOT NULL DEFAULT FALSE,
+  closed_to_departure BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (room_type, date)
+);
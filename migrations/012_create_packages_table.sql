@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS packages (
+  id UUID PRIMARY KEY,
+  code VARCHAR(50) NOT NULL UNIQUE,
+  name VARCHAR(100) NOT NULL,
+  room_type VARCHAR(50) NOT NULL,
+  price_per_night INTEGER NOT NULL,
+  valid_from DATE NOT NULL,
+  valid_until DATE NOT NULL,
+  min_stay_nights INTEGER NOT NULL,
+  active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CHECK (valid_until >= valid_from)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS package_components (
+  package_id UUID NOT NULL REFERENCES packages (id),
+  add_on_code VARCHAR(50) NOT NULL REFERENCES add_ons (code),
+  quantity INTEGER NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (package_id, add_on_code)
+);
@@ -0,0 +1 @@
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS package_id UUID REFERENCES packages (id);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS payments (
+  id UUID PRIMARY KEY,
+  booking_id UUID NOT NULL REFERENCES bookings (id),
+  amount INTEGER NOT NULL CHECK (amount > 0),
+  capture_on DATE NOT NULL,
+  transaction_id VARCHAR(255),
+  status VARCHAR(20) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS payments_status_capture_on_idx ON payments (status, capture_on);
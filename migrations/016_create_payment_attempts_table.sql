@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS payment_attempts (
+  id UUID PRIMARY KEY,
+  payment_id UUID NOT NULL REFERENCES payments (id),
+  operation VARCHAR(20) NOT NULL,
+  amount INTEGER NOT NULL,
+  transaction_id VARCHAR(255),
+  status VARCHAR(20) NOT NULL,
+  failure_reason TEXT,
+  created_at TIMESTAMP NOT NULL
+);
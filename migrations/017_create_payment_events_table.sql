@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS payment_events (
+  id VARCHAR(255) PRIMARY KEY,
+  type VARCHAR(100) NOT NULL,
+  transaction_id VARCHAR(255),
+  payload JSONB NOT NULL,
+  status VARCHAR(20) NOT NULL,
+  reason TEXT,
+  received_at TIMESTAMP NOT NULL
+);
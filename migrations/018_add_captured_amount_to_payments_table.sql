@@ -0,0 +1,2 @@
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS captured_amount INTEGER NOT NULL DEFAULT 0;
+UPDATE payments SET captured_amount = amount WHERE status IN ('CAPTURED', 'DISPUTED');
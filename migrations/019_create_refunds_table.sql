@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS refunds (
+  id UUID PRIMARY KEY,
+  booking_id UUID NOT NULL REFERENCES bookings (id),
+  payment_id UUID NOT NULL REFERENCES payments (id),
+  amount INTEGER NOT NULL CHECK (amount > 0),
+  type VARCHAR(20) NOT NULL,
+  reason TEXT,
+  transaction_id VARCHAR(255),
+  status VARCHAR(20) NOT NULL,
+  failure_reason TEXT,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS refunds_payment_id_idx ON refunds (payment_id);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS folio_entries (
+  id UUID PRIMARY KEY,
+  booking_id UUID NOT NULL REFERENCES bookings (id),
+  type VARCHAR(20) NOT NULL,
+  category VARCHAR(20) NOT NULL,
+  description TEXT NOT NULL,
+  amount INTEGER NOT NULL CHECK (amount > 0),
+  status VARCHAR(20) NOT NULL,
+  void_reason TEXT,
+  posted_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS folio_entries_booking_id_idx ON folio_entries (booking_id);
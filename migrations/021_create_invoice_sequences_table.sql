@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+  property_code VARCHAR(20) PRIMARY KEY,
+  last_number BIGINT NOT NULL
+);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS invoices (
+  id UUID PRIMARY KEY,
+  number VARCHAR(40) UNIQUE NOT NULL,
+  property_code VARCHAR(20) NOT NULL,
+  type VARCHAR(20) NOT NULL,
+  booking_id UUID NOT NULL REFERENCES bookings (id),
+  corrects_invoice_id UUID UNIQUE REFERENCES invoices (id),
+  gross_total BIGINT NOT NULL,
+  document JSONB NOT NULL,
+  issued_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoices_booking_id_idx ON invoices (booking_id);
+
+CREATE OR REPLACE FUNCTION prevent_invoice_changes() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'issued invoices are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS invoices_are_immutable ON invoices;
+
+CREATE TRIGGER invoices_are_immutable
+  BEFORE UPDATE OR DELETE ON invoices
+  FOR EACH ROW EXECUTE FUNCTION prevent_invoice_changes();
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  scope VARCHAR(600) NOT NULL,
+  key VARCHAR(255) NOT NULL,
+  request_hash CHAR(64) NOT NULL,
+  status_code SMALLINT,
+  content_type VARCHAR(255),
+  body BYTEA,
+  created_at TIMESTAMP NOT NULL,
+  completed_at TIMESTAMP,
+  PRIMARY KEY (scope, key)
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS scheduled_charges (
+  id UUID PRIMARY KEY,
+  booking_id UUID NOT NULL REFERENCES bookings (id),
+  sequence SMALLINT NOT NULL,
+  amount INTEGER NOT NULL CHECK (amount > 0),
+  due_on DATE NOT NULL,
+  mandatory BOOLEAN NOT NULL,
+  payment_token VARCHAR(255) NOT NULL,
+  transaction_id VARCHAR(255),
+  status VARCHAR(20) NOT NULL,
+  attempts SMALLINT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP NOT NULL,
+  last_failure_reason TEXT,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (booking_id, sequence)
+);
+
+CREATE INDEX IF NOT EXISTS scheduled_charges_due_idx ON scheduled_charges (next_attempt_at) WHERE status = 'SCHEDULED';
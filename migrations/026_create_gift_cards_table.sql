@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS gift_cards (
+  id UUID PRIMARY KEY,
+  code VARCHAR(19) UNIQUE NOT NULL,
+  amount INTEGER NOT NULL CHECK (amount > 0),
+  balance INTEGER NOT NULL CHECK (balance >= 0 AND balance <= amount),
+  status VARCHAR(20) NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  redeemed_by UUID REFERENCES customers (id),
+  redeemed_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,49 @@
+CREATE TABLE IF NOT EXISTS credit_entries (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL REFERENCES customers (id),
+  position INTEGER NOT NULL CHECK (position > 0),
+  type VARCHAR(20) NOT NULL,
+  amount BIGINT NOT NULL CHECK (amount <> 0),
+  balance_after BIGINT NOT NULL CHECK (balance_after >= 0),
+  reference UUID,
+  description TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  UNIQUE (customer_id, position)
+);
+
+CREATE OR REPLACE FUNCTION check_credit_entry_balance() RETURNS TRIGGER AS $$
+DECLARE
+  previous_balance BIGINT;
+BEGIN
+  SELECT balance_after INTO previous_balance FROM credit_entries
+    WHERE customer_id = NEW.customer_id AND position = NEW.position - 1;
+
+  IF NOT FOUND AND NEW.position <> 1 THEN
+    RAISE EXCEPTION 'credit entries must follow the previous entry of the customer';
+  END IF;
+
+  IF NEW.balance_after <> COALESCE(previous_balance, 0) + NEW.amount THEN
+    RAISE EXCEPTION 'credit entry balance does not follow the previous balance';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS credit_entries_follow_balance ON credit_entries;
+
+CREATE TRIGGER credit_entries_follow_balance
+  BEFORE INSERT ON credit_entries
+  FOR EACH ROW EXECUTE FUNCTION check_credit_entry_balance();
+
+CREATE OR REPLACE FUNCTION prevent_credit_entry_changes() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'credit entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS credit_entries_are_append_only ON credit_entries;
+
+CREATE TRIGGER credit_entries_are_append_only
+  BEFORE UPDATE OR DELETE ON credit_entries
+  FOR EACH ROW EXECUTE FUNCTION prevent_credit_entry_changes();
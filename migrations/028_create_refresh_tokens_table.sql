@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+  id UUID PRIMARY KEY,
+  family_id UUID NOT NULL,
+  customer_id UUID NOT NULL REFERENCES customers (id),
+  hashed_token CHAR(64) UNIQUE NOT NULL,
+  status VARCHAR(20) NOT NULL CHECK (status IN ('ACTIVE', 'ROTATED', 'REVOKED')),
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_idx ON refresh_tokens (family_id);
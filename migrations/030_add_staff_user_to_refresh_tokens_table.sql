@@ -0,0 +1,6 @@
+ALTER TABLE refresh_tokens ALTER COLUMN customer_id DROP NOT NULL;
+
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS staff_user_id UUID REFERENCES staff_users (id);
+
+ALTER TABLE refresh_tokens ADD CONSTRAINT refresh_tokens_subject_check
+  CHECK (num_nonnulls(customer_id, staff_user_id) = 1);
@@ -0,0 +1,64 @@
+CREATE TABLE IF NOT EXISTS roles (
+  name VARCHAR(30) PRIMARY KEY,
+  description VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+  role VARCHAR(30) NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+  permission VARCHAR(60) NOT NULL,
+  PRIMARY KEY (role, permission)
+);
+
+INSERT INTO roles (name, description) VALUES
+  ('CUSTOMER', 'Guests booking and managing their own stays'),
+  ('FRONT_DESK', 'Reception staff handling stays, folios and check-out'),
+  ('HOUSEKEEPER', 'Housekeeping staff looking up rooms'),
+  ('REVENUE_MANAGER', 'Staff managing prices, rate plans, packages and availability'),
+  ('ADMIN', 'Full access, including staff accounts')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('CUSTOMER', 'rooms:read'),
+  ('CUSTOMER', 'catalog:read'),
+  ('CUSTOMER', 'quotes:create'),
+  ('CUSTOMER', 'bookings:create'),
+  ('CUSTOMER', 'bookings:read:own'),
+  ('CUSTOMER', 'bookings:write:own'),
+  ('CUSTOMER', 'credit:own'),
+  ('FRONT_DESK', 'rooms:read'),
+  ('FRONT_DESK', 'catalog:read'),
+  ('FRONT_DESK', 'quotes:create'),
+  ('FRONT_DESK', 'bookings:read:any'),
+  ('FRONT_DESK', 'bookings:write:any'),
+  ('FRONT_DESK', 'bookings:check-out'),
+  ('FRONT_DESK', 'folio:post'),
+  ('FRONT_DESK', 'invoices:write'),
+  ('HOUSEKEEPER', 'rooms:read'),
+  ('REVENUE_MANAGER', 'rooms:read'),
+  ('REVENUE_MANAGER', 'catalog:read'),
+  ('REVENUE_MANAGER', 'catalog:write'),
+  ('REVENUE_MANAGER', 'pricing:read'),
+  ('REVENUE_MANAGER', 'pricing:write'),
+  ('REVENUE_MANAGER', 'inventory:write'),
+  ('REVENUE_MANAGER', 'bookings:read:any'),
+  ('ADMIN', 'rooms:read'),
+  ('ADMIN', 'rooms:write'),
+  ('ADMIN', 'catalog:read'),
+  ('ADMIN', 'catalog:write'),
+  ('ADMIN', 'quotes:create'),
+  ('ADMIN', 'pricing:read'),
+  ('ADMIN', 'pricing:write'),
+  ('ADMIN', 'inventory:write'),
+  ('ADMIN', 'bookings:read:any'),
+  ('ADMIN', 'bookings:write:any'),
+  ('ADMIN', 'bookings:check-out'),
+  ('ADMIN', 'folio:post'),
+  ('ADMIN', 'refunds:write'),
+  ('ADMIN', 'invoices:write'),
+  ('ADMIN', 'credit:write'),
+  ('ADMIN', 'staff:write')
+ON CONFLICT (role, permission) DO NOTHING;
+
+UPDATE staff_users SET role = 'FRONT_DESK' WHERE role = 'STAFF';
+
+ALTER TABLE staff_users ADD CONSTRAINT staff_users_role_fkey FOREIGN KEY (role) REFERENCES roles (name);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL REFERENCES customers (id),
+  hashed_token CHAR(64) UNIQUE NOT NULL,
+  status VARCHAR(20) NOT NULL CHECK (status IN ('ACTIVE', 'USED')),
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_tokens_customer_id_idx ON password_reset_tokens (customer_id);
@@ -0,0 +1,3 @@
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'VERIFIED' CHECK (status IN ('UNVERIFIED', 'VERIFIED'));
+ALTER TABLE customers ALTER COLUMN status SET DEFAULT 'UNVERIFIED';
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS verification_email_sent_at TIMESTAMP;
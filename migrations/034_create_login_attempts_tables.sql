@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS login_attempts (
+  scope VARCHAR(20) NOT NULL CHECK (scope IN ('ACCOUNT', 'IP_ADDRESS')),
+  subject VARCHAR(255) NOT NULL,
+  failures INTEGER NOT NULL,
+  last_failed_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (scope, subject)
+);
+
+CREATE TABLE IF NOT EXISTS login_lockouts (
+  id UUID PRIMARY KEY,
+  scope VARCHAR(20) NOT NULL CHECK (scope IN ('ACCOUNT', 'IP_ADDRESS')),
+  subject VARCHAR(255) NOT NULL,
+  failures INTEGER NOT NULL,
+  locked_until TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS login_lockouts_subject_idx ON login_lockouts (scope, subject);
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS totp_factors (
+  id UUID PRIMARY KEY,
+  customer_id UUID UNIQUE REFERENCES customers (id),
+  staff_user_id UUID UNIQUE REFERENCES staff_users (id),
+  secret BYTEA NOT NULL,
+  status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'ACTIVE')),
+  last_used_step BIGINT NOT NULL DEFAULT 0,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  CHECK (num_nonnulls(customer_id, staff_user_id) = 1)
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+  id UUID PRIMARY KEY,
+  totp_factor_id UUID NOT NULL REFERENCES totp_factors (id) ON DELETE CASCADE,
+  hashed_code CHAR(64) NOT NULL,
+  used_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS mfa_recovery_codes_totp_factor_id_idx ON mfa_recovery_codes (totp_factor_id);
+
+ALTER TABLE login_attempts DROP CONSTRAINT IF EXISTS login_attempts_scope_check;
+ALTER TABLE login_attempts ADD CONSTRAINT login_attempts_scope_check CHECK (scope IN ('ACCOUNT', 'IP_ADDRESS', 'MFA'));
+ALTER TABLE login_lockouts DROP CONSTRAINT IF EXISTS login_lockouts_scope_check;
+ALTER TABLE login_lockouts ADD CONSTRAINT login_lockouts_scope_check CHECK (scope IN ('ACCOUNT', 'IP_ADDRESS', 'MFA'));
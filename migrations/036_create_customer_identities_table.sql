@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS customer_identities (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL REFERENCES customers (id),
+  provider VARCHAR(50) NOT NULL,
+  subject VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  UNIQUE (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS customer_identities_customer_id_idx ON customer_identities (customer_id);
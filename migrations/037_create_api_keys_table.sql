@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+  id UUID PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  prefix VARCHAR(12) UNIQUE NOT NULL,
+  hashed_secret VARCHAR(64) NOT NULL,
+  scopes TEXT[] NOT NULL,
+  created_by_staff_user_id UUID REFERENCES staff_users (id),
+  expires_at TIMESTAMP,
+  last_used_at TIMESTAMP,
+  revoked_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL
+);
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('ADMIN', 'api-keys:write')
+ON CONFLICT (role, permission) DO NOTHING;
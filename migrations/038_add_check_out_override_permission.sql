@@ -0,0 +1,3 @@
+INSERT INTO role_permissions (role, permission) VALUES
+  ('ADMIN', 'bookings:check-out:override')
+ON CONFLICT (role, permission) DO NOTHING;
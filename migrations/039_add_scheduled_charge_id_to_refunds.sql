@@ -0,0 +1,8 @@
+ALTER TABLE refunds ALTER COLUMN payment_id DROP NOT NULL;
+
+ALTER TABLE refunds ADD COLUMN IF NOT EXISTS scheduled_charge_id UUID REFERENCES scheduled_charges (id);
+
+ALTER TABLE refunds ADD CONSTRAINT refunds_single_source_check
+  CHECK ((payment_id IS NULL) <> (scheduled_charge_id IS NULL));
+
+CREATE INDEX IF NOT EXISTS refunds_scheduled_charge_id_idx ON refunds (scheduled_charge_id);
@@ -0,0 +1,10 @@
+INSERT INTO roles (name, description) VALUES
+  ('PARTNER', 'Scopes admins may grant to API keys of partner systems booking for customers')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('PARTNER', 'rooms:read'),
+  ('PARTNER', 'catalog:read'),
+  ('PARTNER', 'quotes:create'),
+  ('PARTNER', 'bookings:create')
+ON CONFLICT (role, permission) DO NOTHING;
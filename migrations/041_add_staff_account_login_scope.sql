@@ -0,0 +1,6 @@
+ALTER TABLE login_attempts DROP CONSTRAINT IF EXISTS login_attempts_scope_check;
+ALTER TABLE login_attempts ADD CONSTRAINT login_attempts_scope_check
+  CHECK (scope IN ('ACCOUNT', 'STAFF_ACCOUNT', 'IP_ADDRESS', 'MFA'));
+ALTER TABLE login_lockouts DROP CONSTRAINT IF EXISTS login_lockouts_scope_check;
+ALTER TABLE login_lockouts ADD CONSTRAINT login_lockouts_scope_check
+  CHECK (scope IN ('ACCOUNT', 'STAFF_ACCOUNT', 'IP_ADDRESS', 'MFA'));